@@ -390,7 +390,7 @@ func NewLiquidityApp(
 
 	app.LiquidityKeeper = liquiditykeeper.NewKeeper(
 		appCodec, keys[liquiditytypes.StoreKey], app.GetSubspace(liquiditytypes.ModuleName),
-		app.BankKeeper, app.AccountKeeper, app.DistrKeeper,
+		app.BankKeeper, app.AccountKeeper, app.DistrKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	// NOTE: Any module instantiated in the module manager that is later modified
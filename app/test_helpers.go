@@ -22,6 +22,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/require"
@@ -369,6 +370,7 @@ func CreateTestInput() (*LiquidityApp, sdk.Context) {
 		app.BankKeeper,
 		app.AccountKeeper,
 		app.DistrKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	return app, ctx
@@ -597,13 +599,13 @@ func GetSwapMsg(t *testing.T, simapp *LiquidityApp, ctx sdk.Context, offerCoins
 	pool, found := simapp.LiquidityKeeper.GetPool(ctx, poolID)
 	require.True(t, found)
 
-	params := simapp.LiquidityKeeper.GetParams(ctx)
+	swapFeeRate := simapp.LiquidityKeeper.GetPoolSwapFeeRate(ctx, pool)
 
 	iterNum := len(addrs)
 	for i := 0; i < iterNum; i++ {
 		currentBalance := simapp.BankKeeper.GetBalance(ctx, addrs[i], offerCoins[i].Denom)
 		if currentBalance.IsLT(offerCoins[i]) {
-			SaveAccountWithFee(simapp, ctx, addrs[i], sdk.NewCoins(offerCoins[i]), offerCoins[i])
+			SaveAccount(simapp, ctx, addrs[i], sdk.NewCoins(offerCoins[i].Add(types.GetOfferCoinFee(offerCoins[i], swapFeeRate))))
 		}
 		var demandCoinDenom string
 		if pool.ReserveCoinDenoms[0] == offerCoins[i].Denom {
@@ -614,11 +616,41 @@ func GetSwapMsg(t *testing.T, simapp *LiquidityApp, ctx sdk.Context, offerCoins
 			require.True(t, false)
 		}
 
-		msgs = append(msgs, types.NewMsgSwapWithinBatch(addrs[i], poolID, types.DefaultSwapTypeID, offerCoins[i], demandCoinDenom, orderPrices[i], params.SwapFeeRate))
+		msgs = append(msgs, types.NewMsgSwapWithinBatch(addrs[i], poolID, types.DefaultSwapTypeID, offerCoins[i], demandCoinDenom, orderPrices[i], swapFeeRate))
 	}
 	return msgs
 }
 
+// TestSwapPool puts swap msgs of the given offer coins and order prices into the batch of the pool,
+// and executes the batch on endblock if withEndblock is true.
+func TestSwapPool(t *testing.T, simapp *LiquidityApp, ctx sdk.Context, offerCoins []sdk.Coin, orderPrices []sdk.Dec,
+	addrs []sdk.AccAddress, poolID uint64, withEndblock bool) ([]*types.SwapMsgState, types.PoolBatch) {
+	msgs := GetSwapMsg(t, simapp, ctx, offerCoins, orderPrices, addrs, poolID)
+
+	moduleAccAddress := simapp.AccountKeeper.GetModuleAddress(types.ModuleName)
+	for _, msg := range msgs {
+		moduleAccEscrowAmt := simapp.BankKeeper.GetBalance(ctx, moduleAccAddress, msg.OfferCoin.Denom)
+
+		_, err := simapp.LiquidityKeeper.SwapWithinBatch(ctx, msg, types.CancelOrderLifeSpan)
+		require.NoError(t, err)
+
+		// check escrow balance of module account
+		moduleAccEscrowAmtAfter := simapp.BankKeeper.GetBalance(ctx, moduleAccAddress, msg.OfferCoin.Denom)
+		require.Equal(t, moduleAccEscrowAmt.Add(msg.OfferCoin).Add(msg.OfferCoinFee), moduleAccEscrowAmtAfter)
+	}
+
+	batch, found := simapp.LiquidityKeeper.GetPoolBatch(ctx, poolID)
+	require.True(t, found)
+
+	if withEndblock {
+		liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+		batch, found = simapp.LiquidityKeeper.GetPoolBatch(ctx, poolID)
+		require.True(t, found)
+	}
+
+	return simapp.LiquidityKeeper.GetAllPoolBatchSwapMsgStatesAsPointer(ctx, batch), batch
+}
+
 // EmptyAppOptions is a stub implementing AppOptions
 type EmptyAppOptions struct{}
 
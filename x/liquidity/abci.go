@@ -19,7 +19,11 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 
 // In case of deposit, withdraw, and swap msgs, unlike other normal tx msgs,
 // collect them in the liquidity pool batch and perform an execution once at the endblock to calculate and use the universal price.
+// After the batch execution, the pool coin holders of the winding down pools are paid out and the pools without pool coin supply are removed,
+// and the pools which stay depleted for the DepletedPoolLifespan param are pruned.
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 	k.ExecutePoolBatches(ctx)
+	k.WindDownPools(ctx)
+	k.PruneDepletedPools(ctx)
 }
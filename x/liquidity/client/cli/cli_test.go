@@ -5,7 +5,6 @@ package cli_test
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"strings"
@@ -27,10 +26,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
 	genutiltest "github.com/cosmos/cosmos-sdk/x/genutil/client/testutil"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
-	paramscutils "github.com/cosmos/cosmos-sdk/x/params/client/utils"
 
 	lapp "github.com/gravity-devs/liquidity/v2/app"
 	"github.com/gravity-devs/liquidity/v2/x/liquidity"
@@ -132,15 +132,15 @@ func (s *IntegrationTestSuite) TestNewCreatePoolCmd() {
 		{
 			"pool type id is not supported",
 			[]string{
-				fmt.Sprintf("%d", uint32(2)),
-				sdk.NewCoins(sdk.NewCoin(denomX, sdk.NewInt(100_000_000)), sdk.NewCoin(denomY, sdk.NewInt(100_000_000)), sdk.NewCoin("denomZ", sdk.NewInt(100_000_000))).String(),
+				fmt.Sprintf("%d", uint32(4)),
+				sdk.NewCoins(sdk.NewCoin(denomX, sdk.NewInt(100_000_000)), sdk.NewCoin(denomY, sdk.NewInt(100_000_000))).String(),
 				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
 				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
 				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
 				fmt.Sprintf("--%s=%s", flags.FlagGas, "1000000"),
 			},
-			true, nil, 0,
+			false, &sdk.TxResponse{}, 2,
 		},
 		{
 			"invalid number of denoms",
@@ -153,6 +153,19 @@ func (s *IntegrationTestSuite) TestNewCreatePoolCmd() {
 				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
 				fmt.Sprintf("--%s=%s", flags.FlagGas, "1000000"),
 			},
+			false, &sdk.TxResponse{}, 5,
+		},
+		{
+			"too many denoms",
+			[]string{
+				fmt.Sprintf("%d", liquiditytypes.MultiAssetPoolTypeID),
+				"1denoma,1denomb,1denomc,1denomd,1denome,1denomf,1denomg,1denomh,1denomi",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+				fmt.Sprintf("--%s=%s", flags.FlagGas, "1000000"),
+			},
 			true, nil, 0,
 		},
 		{
@@ -168,6 +181,62 @@ func (s *IntegrationTestSuite) TestNewCreatePoolCmd() {
 			},
 			false, &sdk.TxResponse{}, 9,
 		},
+		{
+			"invalid swap fee rate",
+			[]string{
+				fmt.Sprintf("%d", liquiditytypes.DefaultPoolTypeID),
+				sdk.NewCoins(sdk.NewCoin(denomX, sdk.NewInt(100_000_000)), sdk.NewCoin(denomY, sdk.NewInt(100_000_000))).String(),
+				fmt.Sprintf("--%s=%s", cli.FlagSwapFeeRate, "invalid_value"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+				fmt.Sprintf("--%s=%s", flags.FlagGas, "1000000"),
+			},
+			true, nil, 0,
+		},
+		{
+			"swap fee rate not in the swap fee tiers",
+			[]string{
+				fmt.Sprintf("%d", liquiditytypes.DefaultPoolTypeID),
+				sdk.NewCoins(sdk.NewCoin(denomX, sdk.NewInt(100_000_000)), sdk.NewCoin(denomY, sdk.NewInt(100_000_000))).String(),
+				fmt.Sprintf("--%s=%s", cli.FlagSwapFeeRate, "0.02"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+				fmt.Sprintf("--%s=%s", flags.FlagGas, "1000000"),
+			},
+			false, &sdk.TxResponse{}, 54,
+		},
+		{
+			"invalid batch interval",
+			[]string{
+				fmt.Sprintf("%d", liquiditytypes.DefaultPoolTypeID),
+				sdk.NewCoins(sdk.NewCoin(denomX, sdk.NewInt(100_000_000)), sdk.NewCoin(denomY, sdk.NewInt(100_000_000))).String(),
+				fmt.Sprintf("--%s=%s", cli.FlagBatchInterval, "invalid_value"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+				fmt.Sprintf("--%s=%s", flags.FlagGas, "1000000"),
+			},
+			true, nil, 0,
+		},
+		{
+			"batch interval greater than the max batch interval",
+			[]string{
+				fmt.Sprintf("%d", liquiditytypes.DefaultPoolTypeID),
+				sdk.NewCoins(sdk.NewCoin(denomX, sdk.NewInt(100_000_000)), sdk.NewCoin(denomY, sdk.NewInt(100_000_000))).String(),
+				fmt.Sprintf("--%s=%s", cli.FlagBatchInterval, "101"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+				fmt.Sprintf("--%s=%s", flags.FlagGas, "1000000"),
+			},
+			false, &sdk.TxResponse{}, 59,
+		},
 		{
 			"valid transaction",
 			[]string{
@@ -181,6 +250,36 @@ func (s *IntegrationTestSuite) TestNewCreatePoolCmd() {
 			},
 			false, &sdk.TxResponse{}, 0,
 		},
+		{
+			"equal reserve coin weights",
+			[]string{
+				fmt.Sprintf("%d", liquiditytypes.DefaultPoolTypeID),
+				sdk.NewCoins(sdk.NewCoin(denomX, sdk.NewInt(100_000_000)), sdk.NewCoin(denomY, sdk.NewInt(100_000_000))).String(),
+				fmt.Sprintf("--%s=%s", cli.FlagReserveCoinWeights, "50,50"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+				fmt.Sprintf("--%s=%s", flags.FlagGas, "1000000"),
+			},
+			true, nil, 0,
+		},
+		{
+			"valid transaction of a weighted pool",
+			[]string{
+				fmt.Sprintf("%d", liquiditytypes.DefaultPoolTypeID),
+				sdk.NewCoins(sdk.NewCoin(denomX, sdk.NewInt(400_000_000)), sdk.NewCoin(denomY, sdk.NewInt(100_000_000))).String(),
+				fmt.Sprintf("--%s=%s", cli.FlagReserveCoinWeights, "80,20"),
+				fmt.Sprintf("--%s=%s", cli.FlagSwapFeeRate, "0.01"),
+				fmt.Sprintf("--%s=%s", cli.FlagBatchInterval, "10"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+				fmt.Sprintf("--%s=%s", flags.FlagGas, "1000000"),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -458,13 +557,17 @@ func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"pool_types":[{"id":1,"name":"StandardLiquidityPool","min_reserve_coin_num":2,"max_reserve_coin_num":2,"description":"Standard liquidity pool with pool price function X/Y, ESPM constraint, and two kinds of reserve coins"}],"min_init_deposit_amount":"1000000","init_pool_coin_mint_amount":"1000000","max_reserve_coin_amount":"0","pool_creation_fee":[{"denom":"stake","amount":"40000000"}],"swap_fee_rate":"0.003000000000000000","withdraw_fee_rate":"0.000000000000000000","max_order_amount_ratio":"0.100000000000000000","unit_batch_height":1,"circuit_breaker_enabled":false}`,
+			`{"pool_types":[{"id":1,"name":"StandardLiquidityPool","min_reserve_coin_num":2,"max_reserve_coin_num":2,"description":"Standard liquidity pool with pool price function X/Y, ESPM constraint, and two kinds of reserve coins"},{"id":2,"name":"StableSwapLiquidityPool","min_reserve_coin_num":2,"max_reserve_coin_num":2,"description":"StableSwap liquidity pool with the StableSwap invariant of the amplification param, ESPM constraint, and two kinds of reserve coins"},{"id":3,"name":"MultiAssetLiquidityPool","min_reserve_coin_num":3,"max_reserve_coin_num":8,"description":"Multi-asset liquidity pool with the weighted geometric mean invariant, ESPM constraint, and three to eight kinds of reserve coins"}],"min_init_deposit_amount":"1000000","init_pool_coin_mint_amount":"1000000","max_reserve_coin_amount":"0","pool_creation_fee":[{"denom":"stake","amount":"40000000"}],"swap_fee_rate":"0.003000000000000000","withdraw_fee_rate":"0.000000000000000000","max_order_amount_ratio":"0.100000000000000000","unit_batch_height":1,"circuit_breaker_enabled":false,"swap_order_lifespan":0,"price_record_lifespan":14400,"stable_swap_amplification":100,"swap_fee_tiers":["0.000500000000000000","0.003000000000000000","0.010000000000000000"],"guardians":[],"depleted_pool_lifespan":0,"max_batch_interval":100,"batch_record_lifespan":14400}`,
 		},
 		{
 			"text output",
 			[]string{fmt.Sprintf("--%s=text", tmcli.OutputFlag)},
-			`circuit_breaker_enabled: false
+			`batch_record_lifespan: 14400
+circuit_breaker_enabled: false
+depleted_pool_lifespan: 0
+guardians: []
 init_pool_coin_mint_amount: "1000000"
+max_batch_interval: 100
 max_order_amount_ratio: "0.100000000000000000"
 max_reserve_coin_amount: "0"
 min_init_deposit_amount: "1000000"
@@ -478,7 +581,26 @@ pool_types:
   max_reserve_coin_num: 2
   min_reserve_coin_num: 2
   name: StandardLiquidityPool
+- description: StableSwap liquidity pool with the StableSwap invariant of the amplification
+    param, ESPM constraint, and two kinds of reserve coins
+  id: 2
+  max_reserve_coin_num: 2
+  min_reserve_coin_num: 2
+  name: StableSwapLiquidityPool
+- description: Multi-asset liquidity pool with the weighted geometric mean invariant,
+    ESPM constraint, and three to eight kinds of reserve coins
+  id: 3
+  max_reserve_coin_num: 8
+  min_reserve_coin_num: 3
+  name: MultiAssetLiquidityPool
+price_record_lifespan: 14400
+stable_swap_amplification: 100
 swap_fee_rate: "0.003000000000000000"
+swap_fee_tiers:
+- "0.000500000000000000"
+- "0.003000000000000000"
+- "0.010000000000000000"
+swap_order_lifespan: 0
 unit_batch_height: 1
 withdraw_fee_rate: "0.000000000000000000"`,
 		},
@@ -614,6 +736,8 @@ func (s *IntegrationTestSuite) TestGetCmdQueryLiquidityPool() {
 				s.Require().Equal(uint64(1), resp.GetPool().Id)
 				s.Require().Equal(uint32(1), resp.GetPool().TypeId)
 				s.Require().Len(resp.GetPool().ReserveCoinDenoms, 2)
+				s.Require().True(resp.GetPool().SwapFeeRate.IsZero())
+				s.Require().Zero(resp.GetPool().BatchInterval)
 			}
 		})
 	}
@@ -650,6 +774,56 @@ func (s *IntegrationTestSuite) TestGetCmdQueryLiquidityPools() {
 			},
 			false,
 		},
+		{
+			"with reserve coin denom",
+			[]string{
+				fmt.Sprintf("--%s=%s", cli.FlagReserveCoinDenom, denomX),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+		},
+		{
+			"with not existing reserve coin denom",
+			[]string{
+				fmt.Sprintf("--%s=%s", cli.FlagReserveCoinDenom, "notexistingdenom"),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+		},
+		{
+			"with pair",
+			[]string{
+				fmt.Sprintf("--%s=%s,%s", cli.FlagPair, denomY, denomX),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+		},
+		{
+			"with pair and pool type id",
+			[]string{
+				fmt.Sprintf("--%s=%s,%s", cli.FlagPair, denomX, denomY),
+				fmt.Sprintf("--%s=%d", cli.FlagPoolTypeID, liquiditytypes.DefaultPoolTypeID),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+		},
+		{
+			"with pair of another pool type id",
+			[]string{
+				fmt.Sprintf("--%s=%s,%s", cli.FlagPair, denomX, denomY),
+				fmt.Sprintf("--%s=%d", cli.FlagPoolTypeID, 2),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+		},
+		{
+			"with invalid pair",
+			[]string{
+				fmt.Sprintf("--%s=%s", cli.FlagPair, denomX),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -668,6 +842,7 @@ func (s *IntegrationTestSuite) TestGetCmdQueryLiquidityPools() {
 				err = val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &resps)
 				s.Require().NoError(err)
 
+				s.Require().Len(resps.GetPools(), 1)
 				for _, pool := range resps.GetPools() {
 					s.Require().Equal(uint64(1), pool.Id)
 					s.Require().Equal(uint32(1), pool.TypeId)
@@ -1093,6 +1268,460 @@ func (s *IntegrationTestSuite) TestGetCmdQueryPoolBatchWithdrawMsgs() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetCmdQueryPoolBatchRecords() {
+	val := s.network.Validators[0]
+
+	// use two different tokens that are minted to the test account
+	denomX, denomY := liquiditytypes.AlphabeticalDenomPair("node0token", s.network.Config.BondDenom)
+
+	// liquidity pool should be created prior to test this integration test
+	_, err := liquiditytestutil.MsgCreatePoolExec(
+		val.ClientCtx,
+		val.Address.String(),
+		fmt.Sprintf("%d", liquiditytypes.DefaultPoolTypeID),
+		sdk.NewCoins(sdk.NewCoin(denomX, sdk.NewInt(100_000_000)), sdk.NewCoin(denomY, sdk.NewInt(100_000_000))).String(),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	)
+	s.Require().NoError(err)
+
+	err = s.network.WaitForNextBlock()
+	s.Require().NoError(err)
+
+	// withdraw pool coin from the pool, the batch is recorded when it is executed
+	poolCoin := sdk.NewCoin("poolC33A77E752C183913636A37FE1388ACA22FE7BED792BEB2E72EF2DA857703D8D", sdk.NewInt(10_000))
+	_, err = liquiditytestutil.MsgWithdrawWithinBatchExec(
+		val.ClientCtx,
+		val.Address.String(),
+		fmt.Sprintf("%d", uint32(1)),
+		sdk.NewCoins(poolCoin).String(),
+	)
+	s.Require().NoError(err)
+
+	err = s.network.WaitForNextBlock()
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{
+			"with invalid pool id",
+			[]string{
+				"invalidpoolid",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+		},
+		{
+			"with not supported pool id",
+			[]string{
+				fmt.Sprintf("%d", uint32(2)),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+		},
+		{
+			"valid case",
+			[]string{
+				fmt.Sprintf("%d", uint32(1)),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryPoolBatchRecords()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				var resps liquiditytypes.QueryPoolBatchRecordsResponse
+				err = val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &resps)
+				s.Require().NoError(err)
+				s.Require().Len(resps.GetBatchRecords(), 1)
+				record := resps.GetBatchRecords()[0]
+				s.Require().Equal(poolCoin, record.BurnedPoolCoin)
+				s.Require().Equal(uint64(1), record.SucceededMsgCount)
+
+				// the record is queried by the batch index
+				out, err = clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdQueryPoolBatchRecord(), []string{
+					fmt.Sprintf("%d", uint32(1)),
+					fmt.Sprintf("%d", record.BatchIndex),
+					fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+				})
+				s.Require().NoError(err)
+				var resp liquiditytypes.QueryPoolBatchRecordResponse
+				s.Require().NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp))
+				s.Require().Equal(record, resp.GetBatchRecord())
+
+				_, err = clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdQueryPoolBatchRecord(), []string{
+					fmt.Sprintf("%d", uint32(1)),
+					fmt.Sprintf("%d", record.BatchIndex+1),
+					fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+				})
+				s.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestGetCmdQueryBatchMsgsByAddress() {
+	val := s.network.Validators[0]
+
+	// use two different tokens that are minted to the test account
+	denomX, denomY := liquiditytypes.AlphabeticalDenomPair("node0token", s.network.Config.BondDenom)
+
+	// liquidity pool should be created prior to test this integration test
+	_, err := liquiditytestutil.MsgCreatePoolExec(
+		val.ClientCtx,
+		val.Address.String(),
+		fmt.Sprintf("%d", liquiditytypes.DefaultPoolTypeID),
+		sdk.NewCoins(sdk.NewCoin(denomX, sdk.NewInt(100_000_000)), sdk.NewCoin(denomY, sdk.NewInt(100_000_000))).String(),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	)
+	s.Require().NoError(err)
+
+	err = s.network.WaitForNextBlock()
+	s.Require().NoError(err)
+
+	// withdraw pool coin from the pool
+	poolCoinDenom := "poolC33A77E752C183913636A37FE1388ACA22FE7BED792BEB2E72EF2DA857703D8D"
+	_, err = liquiditytestutil.MsgWithdrawWithinBatchExec(
+		val.ClientCtx,
+		val.Address.String(),
+		fmt.Sprintf("%d", uint32(1)),
+		sdk.NewCoins(sdk.NewCoin(poolCoinDenom, sdk.NewInt(10_000))).String(),
+	)
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{
+			"with invalid address",
+			[]string{
+				"invalidaddress",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+		},
+		{
+			"valid case",
+			[]string{
+				val.Address.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryBatchMsgsByAddress()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				var resps liquiditytypes.QueryBatchMsgsByAddressResponse
+				err = val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &resps)
+				s.Require().NoError(err)
+				s.Require().Len(resps.GetWithdraws(), 1)
+				s.Require().Equal(val.Address.String(), resps.GetWithdraws()[0].Msg.WithdrawerAddress)
+				s.Require().Equal(poolCoinDenom, resps.GetWithdraws()[0].Msg.PoolCoin.Denom)
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestGetCmdQueryEstimateDeposit() {
+	val := s.network.Validators[0]
+
+	// use two different tokens that are minted to the test account
+	denomX, denomY := liquiditytypes.AlphabeticalDenomPair("node0token", s.network.Config.BondDenom)
+
+	// liquidity pool should be created prior to test this integration test
+	_, err := liquiditytestutil.MsgCreatePoolExec(
+		val.ClientCtx,
+		val.Address.String(),
+		fmt.Sprintf("%d", liquiditytypes.DefaultPoolTypeID),
+		sdk.NewCoins(sdk.NewCoin(denomX, sdk.NewInt(100_000_000)), sdk.NewCoin(denomY, sdk.NewInt(100_000_000))).String(),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	)
+	s.Require().NoError(err)
+
+	err = s.network.WaitForNextBlock()
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{
+			"with invalid pool id",
+			[]string{
+				"invalidpoolid",
+				sdk.NewCoins(sdk.NewCoin(denomX, sdk.NewInt(1_000_000)), sdk.NewCoin(denomY, sdk.NewInt(1_000_000))).String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+		},
+		{
+			"with not supported pool id",
+			[]string{
+				fmt.Sprintf("%d", uint32(2)),
+				sdk.NewCoins(sdk.NewCoin(denomX, sdk.NewInt(1_000_000)), sdk.NewCoin(denomY, sdk.NewInt(1_000_000))).String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+		},
+		{
+			"with one deposit coin",
+			[]string{
+				fmt.Sprintf("%d", uint32(1)),
+				sdk.NewCoins(sdk.NewCoin(denomX, sdk.NewInt(1_000_000))).String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+		},
+		{
+			"valid case",
+			[]string{
+				fmt.Sprintf("%d", uint32(1)),
+				sdk.NewCoins(sdk.NewCoin(denomX, sdk.NewInt(1_000_000)), sdk.NewCoin(denomY, sdk.NewInt(2_000_000))).String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryEstimateDeposit()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				var resp liquiditytypes.QueryEstimateDepositResponse
+				err = val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp)
+				s.Require().NoError(err)
+				s.Require().True(resp.PoolCoin.IsPositive())
+				s.Require().Equal(sdk.NewInt(1_000_000), resp.AcceptedCoins.AmountOf(denomX))
+				s.Require().Equal(sdk.NewInt(1_000_000), resp.AcceptedCoins.AmountOf(denomY))
+				s.Require().Equal(sdk.NewInt(1_000_000), resp.RefundedCoins.AmountOf(denomY))
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestGetCmdQueryEstimateWithdraw() {
+	val := s.network.Validators[0]
+
+	// use two different tokens that are minted to the test account
+	denomX, denomY := liquiditytypes.AlphabeticalDenomPair("node0token", s.network.Config.BondDenom)
+
+	// liquidity pool should be created prior to test this integration test
+	_, err := liquiditytestutil.MsgCreatePoolExec(
+		val.ClientCtx,
+		val.Address.String(),
+		fmt.Sprintf("%d", liquiditytypes.DefaultPoolTypeID),
+		sdk.NewCoins(sdk.NewCoin(denomX, sdk.NewInt(100_000_000)), sdk.NewCoin(denomY, sdk.NewInt(100_000_000))).String(),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	)
+	s.Require().NoError(err)
+
+	err = s.network.WaitForNextBlock()
+	s.Require().NoError(err)
+
+	poolCoinDenom := "poolC33A77E752C183913636A37FE1388ACA22FE7BED792BEB2E72EF2DA857703D8D"
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{
+			"with invalid pool id",
+			[]string{
+				"invalidpoolid",
+				sdk.NewCoin(poolCoinDenom, sdk.NewInt(10_000)).String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+		},
+		{
+			"with invalid pool coin",
+			[]string{
+				fmt.Sprintf("%d", uint32(1)),
+				"invalidpoolcoin",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+		},
+		{
+			"with not matched pool coin denom",
+			[]string{
+				fmt.Sprintf("%d", uint32(1)),
+				sdk.NewCoin(denomX, sdk.NewInt(10_000)).String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+		},
+		{
+			"valid case",
+			[]string{
+				fmt.Sprintf("%d", uint32(1)),
+				sdk.NewCoin(poolCoinDenom, sdk.NewInt(10_000)).String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryEstimateWithdraw()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				var resp liquiditytypes.QueryEstimateWithdrawResponse
+				err = val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp)
+				s.Require().NoError(err)
+				s.Require().Len(resp.WithdrawCoins, 2)
+				s.Require().True(resp.WithdrawCoins.IsAllPositive())
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestGetCmdQuerySimulateSwap() {
+	val := s.network.Validators[0]
+
+	// use two different tokens that are minted to the test account
+	denomX, denomY := liquiditytypes.AlphabeticalDenomPair("node0token", s.network.Config.BondDenom)
+
+	// liquidity pool should be created prior to test this integration test
+	_, err := liquiditytestutil.MsgCreatePoolExec(
+		val.ClientCtx,
+		val.Address.String(),
+		fmt.Sprintf("%d", liquiditytypes.DefaultPoolTypeID),
+		sdk.NewCoins(sdk.NewCoin(denomX, sdk.NewInt(100_000_000)), sdk.NewCoin(denomY, sdk.NewInt(100_000_000))).String(),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	)
+	s.Require().NoError(err)
+
+	err = s.network.WaitForNextBlock()
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{
+			"with invalid pool id",
+			[]string{
+				"invalidpoolid",
+				sdk.NewCoin(denomX, sdk.NewInt(1_000_000)).String(),
+				denomY,
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+		},
+		{
+			"with invalid order price",
+			[]string{
+				fmt.Sprintf("%d", uint32(1)),
+				sdk.NewCoin(denomX, sdk.NewInt(1_000_000)).String(),
+				denomY,
+				fmt.Sprintf("--%s=%s", cli.FlagOrderPrice, "invalidprice"),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+		},
+		{
+			"with exceeded max order amount",
+			[]string{
+				fmt.Sprintf("%d", uint32(1)),
+				sdk.NewCoin(denomX, sdk.NewInt(50_000_000)).String(),
+				denomY,
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+		},
+		{
+			"valid case",
+			[]string{
+				fmt.Sprintf("%d", uint32(1)),
+				sdk.NewCoin(denomX, sdk.NewInt(1_000_000)).String(),
+				denomY,
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+		},
+		{
+			"valid case with order price",
+			[]string{
+				fmt.Sprintf("%d", uint32(1)),
+				sdk.NewCoin(denomX, sdk.NewInt(1_000_000)).String(),
+				denomY,
+				fmt.Sprintf("--%s=%s", cli.FlagOrderPrice, "1.1"),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQuerySimulateSwap()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				var resp liquiditytypes.QuerySimulateSwapResponse
+				err = val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp)
+				s.Require().NoError(err)
+				s.Require().Equal(sdk.NewCoin(denomX, sdk.NewInt(1_000_000)), resp.ExchangedOfferCoin)
+				s.Require().True(resp.ExchangedDemandCoin.IsPositive())
+				s.Require().True(resp.PriceImpact.IsPositive())
+				s.Require().Equal(sdk.NewCoin(denomX, sdk.NewInt(10_000_000)), resp.MaxOrderCoin)
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestGetCircuitBreaker() {
 	val := s.network.Validators[0]
 
@@ -1132,34 +1761,22 @@ func (s *IntegrationTestSuite) TestGetCircuitBreaker() {
 	s.Require().Equal(txRes.Code, uint32(0))
 	s.Require().NoError(err)
 
-	circuitBreakerEnabled := true
-	circuitBreakerEnabledStr, err := json.Marshal(&circuitBreakerEnabled)
-	if err != nil {
-		panic(err)
-	}
+	params := liquiditytypes.DefaultParams()
+	params.CircuitBreakerEnabled = true
+	msgUpdateParams, err := val.ClientCtx.Codec.MarshalInterfaceJSON(
+		liquiditytypes.NewMsgUpdateParams(authtypes.NewModuleAddress(govtypes.ModuleName), params))
+	s.Require().NoError(err)
 
-	paramChange := paramscutils.ParamChangeProposalJSON{
-		Title:       "enable-circuit-breaker",
-		Description: "enable circuit breaker",
-		Changes: []paramscutils.ParamChangeJSON{{
-			Subspace: liquiditytypes.ModuleName,
-			Key:      "CircuitBreakerEnabled",
-			Value:    circuitBreakerEnabledStr,
-		},
-		},
-		Deposit: sdk.NewCoin(s.cfg.BondDenom, govv1.DefaultMinDepositTokens).String(),
-	}
-	paramChangeProp, err := json.Marshal(&paramChange)
-	if err != nil {
-		panic(err)
-	}
+	proposal := fmt.Sprintf(`{"messages":[%s],"metadata":"enable circuit breaker","deposit":"%s"}`,
+		msgUpdateParams, sdk.NewCoin(s.cfg.BondDenom, govv1.DefaultMinDepositTokens))
 
-	//create a param change proposal with deposit
-	_, err = liquiditytestutil.MsgParamChangeProposalExec(
+	// create a proposal of the params update with deposit
+	_, err = liquiditytestutil.MsgSubmitProposalExec(
 		val.ClientCtx,
 		val.Address.String(),
-		testutil.WriteToNewTempFile(s.T(), string(paramChangeProp)).Name(),
+		testutil.WriteToNewTempFile(s.T(), proposal).Name(),
 	)
+	s.Require().NoError(err)
 	err = s.network.WaitForNextBlock()
 	s.Require().NoError(err)
 
@@ -1169,7 +1786,7 @@ func (s *IntegrationTestSuite) TestGetCircuitBreaker() {
 	s.Require().NoError(err)
 
 	// check if circuit breaker is enabled
-	expectedOutput := `{"pool_types":[{"id":1,"name":"StandardLiquidityPool","min_reserve_coin_num":2,"max_reserve_coin_num":2,"description":"Standard liquidity pool with pool price function X/Y, ESPM constraint, and two kinds of reserve coins"}],"min_init_deposit_amount":"1000000","init_pool_coin_mint_amount":"1000000","max_reserve_coin_amount":"0","pool_creation_fee":[{"denom":"stake","amount":"40000000"}],"swap_fee_rate":"0.003000000000000000","withdraw_fee_rate":"0.000000000000000000","max_order_amount_ratio":"0.100000000000000000","unit_batch_height":1,"circuit_breaker_enabled":true}`
+	expectedOutput := `{"pool_types":[{"id":1,"name":"StandardLiquidityPool","min_reserve_coin_num":2,"max_reserve_coin_num":2,"description":"Standard liquidity pool with pool price function X/Y, ESPM constraint, and two kinds of reserve coins"},{"id":2,"name":"StableSwapLiquidityPool","min_reserve_coin_num":2,"max_reserve_coin_num":2,"description":"StableSwap liquidity pool with the StableSwap invariant of the amplification param, ESPM constraint, and two kinds of reserve coins"},{"id":3,"name":"MultiAssetLiquidityPool","min_reserve_coin_num":3,"max_reserve_coin_num":8,"description":"Multi-asset liquidity pool with the weighted geometric mean invariant, ESPM constraint, and three to eight kinds of reserve coins"}],"min_init_deposit_amount":"1000000","init_pool_coin_mint_amount":"1000000","max_reserve_coin_amount":"0","pool_creation_fee":[{"denom":"stake","amount":"40000000"}],"swap_fee_rate":"0.003000000000000000","withdraw_fee_rate":"0.000000000000000000","max_order_amount_ratio":"0.100000000000000000","unit_batch_height":1,"circuit_breaker_enabled":true,"swap_order_lifespan":0,"price_record_lifespan":14400,"stable_swap_amplification":100,"swap_fee_tiers":["0.000500000000000000","0.003000000000000000","0.010000000000000000"],"guardians":[],"depleted_pool_lifespan":0,"max_batch_interval":100,"batch_record_lifespan":14400}`
 	out, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.GetCmdQueryParams(), []string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)})
 	s.Require().NoError(err)
 	s.Require().Equal(expectedOutput, strings.TrimSpace(out.String()))
@@ -9,6 +9,25 @@ import (
 const (
 	FlagPoolCoinDenom = "pool-coin-denom"
 	FlagReserveAcc    = "reserve-acc"
+
+	FlagReserveCoinDenom = "reserve-coin-denom"
+	FlagPair             = "pair"
+	FlagPoolTypeID       = "pool-type-id"
+
+	FlagReserveCoinWeights = "reserve-coin-weights"
+	FlagSwapFeeRate        = "swap-fee-rate"
+	FlagBatchInterval      = "batch-interval"
+
+	FlagMinPoolCoinAmount = "min-pool-coin-amount"
+	FlagMinWithdrawCoins  = "min-withdraw-coins"
+	FlagTargetDenom       = "target-denom"
+
+	FlagStartHeight = "start-height"
+	FlagEndHeight   = "end-height"
+	FlagStartTime   = "start-time"
+	FlagEndTime     = "end-time"
+
+	FlagOrderPrice = "order-price"
 )
 
 func flagSetPool() *flag.FlagSet {
@@ -19,3 +38,59 @@ func flagSetPool() *flag.FlagSet {
 
 	return fs
 }
+
+func flagSetPools() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagReserveCoinDenom, "", "The reserve coin denomination of the pools")
+	fs.StringSlice(FlagPair, nil, "The two reserve coin denominations of the pools, separated by a comma")
+	fs.Uint32(FlagPoolTypeID, 0, "The pool type id of the pools of the pair, zero for all pool types")
+
+	return fs
+}
+
+func flagSetCreatePool() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.UintSlice(FlagReserveCoinWeights, nil, "The weights of the deposit coins in the order of their denoms summing up to 100, empty for equally weighted reserve coins")
+	fs.String(FlagSwapFeeRate, "", "The swap fee rate of the pool which is one of the swap fee tiers of the params, empty for the swap fee rate of the params")
+	fs.Uint32(FlagBatchInterval, 0, "The number of blocks in a batch of the pool up to the max batch interval of the params, zero for the unit batch height of the params")
+
+	return fs
+}
+
+func flagSetDeposit() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagMinPoolCoinAmount, "", "The minimum amount of pool coin to be minted, the deposit is refunded when less pool coin is minted")
+
+	return fs
+}
+
+func flagSetWithdraw() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagMinWithdrawCoins, "", "The minimum amounts of reserve coins to be withdrawn, the withdrawal is refunded when less coins are withdrawn")
+	fs.String(FlagTargetDenom, "", "The reserve coin denomination to receive, the other reserve coin withdrawn is swapped into it within the batch")
+
+	return fs
+}
+
+func flagSetTwap() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.Int64(FlagStartHeight, 0, "The start height of the period")
+	fs.Int64(FlagEndHeight, 0, "The end height of the period")
+	fs.String(FlagStartTime, "", "The start time of the period in RFC3339 format, used when no heights are given")
+	fs.String(FlagEndTime, "", "The end time of the period in RFC3339 format, used when no heights are given")
+
+	return fs
+}
+
+func flagSetSimulateSwap() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagOrderPrice, "", "The limit order price of the swap in the alphabetically sorted denom order X/Y, empty for a market order")
+
+	return fs
+}
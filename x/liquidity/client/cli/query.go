@@ -8,9 +8,11 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/spf13/cobra"
 
@@ -38,6 +40,13 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryPoolBatchWithdrawMsg(),
 		GetCmdQueryPoolBatchSwapMsgs(),
 		GetCmdQueryPoolBatchSwapMsg(),
+		GetCmdQueryPoolTwap(),
+		GetCmdQueryPoolBatchRecords(),
+		GetCmdQueryPoolBatchRecord(),
+		GetCmdQueryBatchMsgsByAddress(),
+		GetCmdQueryEstimateDeposit(),
+		GetCmdQueryEstimateWithdraw(),
+		GetCmdQuerySimulateSwap(),
 	)
 
 	return liquidityQueryCmd
@@ -174,7 +183,13 @@ func GetCmdQueryLiquidityPools() *cobra.Command {
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Query details about all liquidity pools on a network.
 Example:
-$ %s query %s pools
+$ %[1]s query %[2]s pools
+
+Example (with reserve coin denom):
+$ %[1]s query %[2]s pools --reserve-coin-denom=[denom]
+
+Example (with pair of reserve coin denoms, optionally of a pool type):
+$ %[1]s query %[2]s pools --pair=[denom-x],[denom-y] --pool-type-id=1
 `,
 				version.AppName, types.ModuleName,
 			),
@@ -191,10 +206,31 @@ $ %s query %s pools
 				return err
 			}
 
-			res, err := queryClient.LiquidityPools(
-				context.Background(),
-				&types.QueryLiquidityPoolsRequest{Pagination: pageReq},
-			)
+			reserveCoinDenom, _ := cmd.Flags().GetString(FlagReserveCoinDenom)
+			pair, _ := cmd.Flags().GetStringSlice(FlagPair)
+			poolTypeID, _ := cmd.Flags().GetUint32(FlagPoolTypeID)
+
+			var res *types.QueryLiquidityPoolsResponse
+			switch {
+			case reserveCoinDenom != "":
+				res, err = queryClient.LiquidityPoolsByReserveCoinDenom(
+					context.Background(),
+					&types.QueryLiquidityPoolsByReserveCoinDenomRequest{ReserveCoinDenom: reserveCoinDenom, Pagination: pageReq},
+				)
+			case len(pair) > 0:
+				if len(pair) != 2 {
+					return fmt.Errorf("--%s must be two denoms separated by a comma", FlagPair)
+				}
+				res, err = queryClient.LiquidityPoolsByPair(
+					context.Background(),
+					&types.QueryLiquidityPoolsByPairRequest{DenomX: pair[0], DenomY: pair[1], PoolTypeId: poolTypeID, Pagination: pageReq},
+				)
+			default:
+				res, err = queryClient.LiquidityPools(
+					context.Background(),
+					&types.QueryLiquidityPoolsRequest{Pagination: pageReq},
+				)
+			}
 			if err != nil {
 				return err
 			}
@@ -202,8 +238,9 @@ $ %s query %s pools
 			return clientCtx.PrintProto(res)
 		},
 	}
-
+	cmd.Flags().AddFlagSet(flagSetPools())
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pools")
 
 	return cmd
 }
@@ -574,3 +611,401 @@ $ %s query %s swap 1 20
 
 	return cmd
 }
+
+// GetCmdQueryPoolTwap implements the pool twap query command.
+func GetCmdQueryPoolTwap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "twap [pool-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the time-weighted average price of a liquidity pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the time-weighted average price of a liquidity pool between the start and end heights,
+or between the start and end times when no heights are given.
+
+The price is the ratio of the reserve coins X/Y where X is the amount of the first coin and Y is the amount of the second coin
+when their denoms are sorted alphabetically. The price is recorded at every batch execution height and the records are kept
+for the price record lifespan param.
+
+Example:
+$ %s query %s twap 1 --start-height=100 --end-height=200
+$ %s query %s twap 1 --start-time=2022-09-01T00:00:00Z --end-time=2022-09-02T00:00:00Z
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("pool-id %s not a valid uint, input a valid unsigned 32-bit integer for pool-id", args[0])
+			}
+
+			req := &types.QueryPoolTwapRequest{PoolId: poolID}
+
+			req.StartHeight, err = cmd.Flags().GetInt64(FlagStartHeight)
+			if err != nil {
+				return err
+			}
+			req.EndHeight, err = cmd.Flags().GetInt64(FlagEndHeight)
+			if err != nil {
+				return err
+			}
+
+			req.StartTime, err = parseTimeFlag(cmd, FlagStartTime)
+			if err != nil {
+				return err
+			}
+			req.EndTime, err = parseTimeFlag(cmd, FlagEndTime)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.PoolTwap(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetTwap())
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPoolBatchRecords implements the pool batch records query command.
+func GetCmdQueryPoolBatchRecords() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-records [pool-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for the results of the executed batches of a liquidity pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the recorded results of the executed batches of a liquidity pool in ascending order of the batch index.
+
+Each record has the reserve coins of the pool before and after the batch execution, the total coins deposited and withdrawn,
+the total pool coin minted and burned, the swap and withdraw fees collected and the number of the succeeded and failed messages.
+The records are kept for the batch record lifespan param.
+
+Example:
+$ %s query %s batch-records 1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("pool-id %s not a valid uint, input a valid unsigned 32-bit integer for pool-id", args[0])
+			}
+
+			res, err := queryClient.PoolBatchRecords(context.Background(), &types.QueryPoolBatchRecordsRequest{
+				PoolId: poolID, Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "batch-records")
+
+	return cmd
+}
+
+// GetCmdQueryPoolBatchRecord implements the pool batch record query command.
+func GetCmdQueryPoolBatchRecord() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-record [pool-id] [batch-index]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the result of an executed batch of a liquidity pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the recorded result of the executed batch of a liquidity pool for the specified pool-id and batch-index.
+
+Example:
+$ %s query %s batch-record 1 20
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("pool-id %s not a valid uint, input a valid unsigned 32-bit integer for pool-id", args[0])
+			}
+
+			batchIndex, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("batch-index %s not a valid uint, input a valid unsigned 64-bit integer for batch-index", args[1])
+			}
+
+			res, err := queryClient.PoolBatchRecord(context.Background(), &types.QueryPoolBatchRecordRequest{
+				PoolId:     poolID,
+				BatchIndex: batchIndex,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryBatchMsgsByAddress implements the batch messages by address query command.
+func GetCmdQueryBatchMsgsByAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-msgs-by-address [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query for all batch messages of an address across all liquidity pools",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the deposit, withdraw, swap and swap route messages of which the address is the depositor, withdrawer or swap requester in the batches of all liquidity pools.
+
+The result includes the messages pending in the batches and the messages executed in the last batches. The executed messages are deleted at the next block, so they are visible only in the block of the batch execution. Use the batch-records command for the results of the older batches.
+
+Example:
+$ %s query %s batch-msgs-by-address cosmos1zaavvzxez0elundtn32qnk9lkm8kmcszzsv80v
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BatchMsgsByAddress(context.Background(), &types.QueryBatchMsgsByAddressRequest{
+				Address: args[0], Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "batch-msgs-by-address")
+
+	return cmd
+}
+
+// parseTimeFlag returns the RFC3339 time of the flag, nil if the flag is not given.
+func parseTimeFlag(cmd *cobra.Command, flag string) (*time.Time, error) {
+	value, err := cmd.Flags().GetString(flag)
+	if err != nil || value == "" {
+		return nil, err
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("%s %s is not a valid RFC3339 time: %w", flag, value, err)
+	}
+	return &t, nil
+}
+
+// GetCmdQueryEstimateDeposit implements the estimate deposit query command.
+func GetCmdQueryEstimateDeposit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-deposit [pool-id] [deposit-coins]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Estimate the result of a deposit to a liquidity pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Estimate the pool coin minted and the coins accepted and refunded by a deposit of the deposit-coins to the liquidity pool for the specified pool-id.
+
+The deposit is calculated on the current reserves of the pool, the result of the batch execution can differ by the other messages executed in the same batch.
+
+Example:
+$ %s query %s estimate-deposit 1 100000000uatom,5000000000uusd
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("pool-id %s not a valid uint, input a valid unsigned 32-bit integer for pool-id", args[0])
+			}
+
+			depositCoins, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EstimateDeposit(context.Background(), &types.QueryEstimateDepositRequest{
+				PoolId:       poolID,
+				DepositCoins: depositCoins.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryEstimateWithdraw implements the estimate withdraw query command.
+func GetCmdQueryEstimateWithdraw() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-withdraw [pool-id] [pool-coin]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Estimate the result of a withdrawal from a liquidity pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Estimate the reserve coins withdrawn and the withdraw fee coins of a withdrawal of the pool-coin from the liquidity pool for the specified pool-id.
+
+The withdrawal is calculated on the current reserves of the pool, the result of the batch execution can differ by the other messages executed in the same batch.
+
+Example:
+$ %s query %s estimate-withdraw 1 10000pool96EF6EA6E5AC828ED87E8D07E7AE2A8180570ADD212117B2DA6F0B75D17A6295
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("pool-id %s not a valid uint, input a valid unsigned 32-bit integer for pool-id", args[0])
+			}
+
+			poolCoin, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EstimateWithdraw(context.Background(), &types.QueryEstimateWithdrawRequest{
+				PoolId:   poolID,
+				PoolCoin: poolCoin.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQuerySimulateSwap implements the simulate swap query command.
+func GetCmdQuerySimulateSwap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-swap [pool-id] [offer-coin] [demand-coin-denom]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Simulate a swap in the batch of a liquidity pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Simulate a swap of the offer-coin into the demand-coin-denom in the liquidity pool for the specified pool-id.
+
+The swap is matched at the universal swap price of the batch together with the swap orders queued in the batch on the current reserves of the pool.
+The result includes the exchanged offer and demand coins, the swap fees, the swap price, the effective price and the price impact of the swap, and the max order coin of the pool.
+The swap is simulated as a market order unless the limit order price is given by the --order-price flag.
+
+Example:
+$ %s query %s simulate-swap 1 10000uatom uusd
+$ %s query %s simulate-swap 1 10000uatom uusd --order-price=0.019
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("pool-id %s not a valid uint, input a valid unsigned 32-bit integer for pool-id", args[0])
+			}
+
+			offerCoin, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			orderPrice, _ := cmd.Flags().GetString(FlagOrderPrice)
+			if orderPrice != "" {
+				if _, err := sdk.NewDecFromStr(orderPrice); err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.SimulateSwap(context.Background(), &types.QuerySimulateSwapRequest{
+				PoolId:          poolID,
+				OfferCoin:       offerCoin.String(),
+				DemandCoinDenom: args[2],
+				OrderPrice:      orderPrice,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().AddFlagSet(flagSetSimulateSwap())
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
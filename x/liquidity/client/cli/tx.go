@@ -31,8 +31,15 @@ func GetTxCmd() *cobra.Command {
 	liquidityTxCmd.AddCommand(
 		NewCreatePoolCmd(),
 		NewDepositWithinBatchCmd(),
+		NewDepositSingleAssetWithinBatchCmd(),
 		NewWithdrawWithinBatchCmd(),
 		NewSwapWithinBatchCmd(),
+		NewSwapRouteCmd(),
+		NewCancelDepositCmd(),
+		NewCancelWithdrawCmd(),
+		NewCancelSwapCmd(),
+		NewSetPoolStatusCmd(),
+		NewSetCircuitBreakerCmd(),
 	)
 
 	return liquidityTxCmd
@@ -48,13 +55,28 @@ func NewCreatePoolCmd() *cobra.Command {
 			fmt.Sprintf(`Create liquidity pool and deposit coins.
 
 Example:
-$ %s tx %s create-pool 1 1000000000uatom,50000000000uusd --from mykey
+$ %[1]s tx %[2]s create-pool 1 1000000000uatom,50000000000uusd --from mykey
 
 This example creates a liquidity pool of pool-type 1 (two coins) and deposits 1000000000uatom and 50000000000uusd.
 New liquidity pools can be created only for coin combinations that do not already exist in the network.
 
-[pool-type]: The id of the liquidity pool-type. The only supported pool type is 1
-[deposit-coins]: The amount of coins to deposit to the liquidity pool. The number of deposit coins must be 2 in pool type 1.
+$ %[1]s tx %[2]s create-pool 1 4000000000uatom,10000000000uusd --reserve-coin-weights 80,20 --from mykey
+
+This example creates a weighted liquidity pool of 80%% uatom and 20%% uusd, of which the initial pool price is 1uatom for 10uusd.
+The weights of the deposit coins are given in the order of their denoms, and only the pool types 1 and 3 support the weights.
+
+$ %[1]s tx %[2]s create-pool 1 1000000000uatom,50000000000uusd --swap-fee-rate 0.0005 --from mykey
+
+This example creates a liquidity pool of which the swap fee rate is 0.0005, that must be one of the swap fee tiers of the params.
+The swap fee rate of the params is applied to the pool when no swap fee rate is given.
+
+$ %[1]s tx %[2]s create-pool 1 1000000000uatom,50000000000uusd --batch-interval 10 --from mykey
+
+This example creates a liquidity pool of which the batch is executed every 10 blocks, up to the max batch interval of the params.
+The unit batch height of the params is applied to the pool when no batch interval is given.
+
+[pool-type]: The id of the liquidity pool-type. The built-in pool types are 1 (standard), 2 (StableSwap) and 3 (multi-asset)
+[deposit-coins]: The amount of coins to deposit to the liquidity pool. The number of deposit coins must be 2 in pool types 1 and 2, and from 3 to 8 in pool type 3.
 `,
 				version.AppName, types.ModuleName,
 			),
@@ -83,15 +105,35 @@ New liquidity pools can be created only for coin combinations that do not alread
 				return err
 			}
 
-			if poolTypeID != 1 {
-				return types.ErrPoolTypeNotExists
+			msg := types.NewMsgCreatePool(poolCreator, uint32(poolTypeID), depositCoins)
+
+			reserveCoinWeights, err := cmd.Flags().GetUintSlice(FlagReserveCoinWeights)
+			if err != nil {
+				return err
+			}
+			for _, weight := range reserveCoinWeights {
+				if weight > uint(types.TotalReserveCoinWeight) {
+					return types.ErrBadReserveCoinWeights
+				}
+				msg.ReserveCoinWeights = append(msg.ReserveCoinWeights, uint32(weight))
 			}
 
-			if depositCoins.Len() != 2 {
-				return fmt.Errorf("the number of deposit coins must be two in pool-type 1")
+			swapFeeRateStr, err := cmd.Flags().GetString(FlagSwapFeeRate)
+			if err != nil {
+				return err
+			}
+			if swapFeeRateStr != "" {
+				msg.SwapFeeRate, err = sdk.NewDecFromStr(swapFeeRateStr)
+				if err != nil {
+					return fmt.Errorf("swap-fee-rate %s not a valid decimal: %w", swapFeeRateStr, err)
+				}
+			}
+
+			msg.BatchInterval, err = cmd.Flags().GetUint32(FlagBatchInterval)
+			if err != nil {
+				return err
 			}
 
-			msg := types.NewMsgCreatePool(poolCreator, uint32(poolTypeID), depositCoins)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -100,6 +142,7 @@ New liquidity pools can be created only for coin combinations that do not alread
 		},
 	}
 
+	cmd.Flags().AddFlagSet(flagSetCreatePool())
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -118,11 +161,15 @@ This deposit request is not processed immediately since it is accumulated in the
 All requests in a batch are treated equally and executed at the same swap price.
 
 Example:
-$ %s tx %s deposit 1 100000000uatom,5000000000uusd --from mykey
+$ %[1]s tx %[2]s deposit 1 100000000uatom,5000000000uusd --from mykey
 
 This example request deposits 100000000uatom and 5000000000uusd to pool-id 1.
 Deposits must be the same coin denoms as the reserve coins.
 
+$ %[1]s tx %[2]s deposit 1 100000000uatom,5000000000uusd --min-pool-coin-amount 1000 --from mykey
+
+This example request is refunded when less than 1000 pool coin is minted for the deposit.
+
 [pool-id]: The pool id of the liquidity pool
 [deposit-coins]: The amount of coins to deposit to the liquidity pool
 `,
@@ -158,6 +205,90 @@ Deposits must be the same coin denoms as the reserve coins.
 			}
 
 			msg := types.NewMsgDepositWithinBatch(depositor, poolID, depositCoins)
+
+			minPoolCoinAmountStr, _ := cmd.Flags().GetString(FlagMinPoolCoinAmount)
+			if minPoolCoinAmountStr != "" {
+				minPoolCoinAmount, ok := sdk.NewIntFromString(minPoolCoinAmountStr)
+				if !ok {
+					return fmt.Errorf("invalid min pool coin amount: %s", minPoolCoinAmountStr)
+				}
+				msg.MinPoolCoinAmount = minPoolCoinAmount
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(flagSetDeposit())
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Deposit a single reserve coin to the specified liquidity pool.
+func NewDepositSingleAssetWithinBatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-single-asset [pool-id] [deposit-coin]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Deposit a single reserve coin to a liquidity pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Deposit a single reserve coin to a liquidity pool.
+
+The optimal portion of the deposit coin is swapped to the other reserve coin by the batch swap of the pool,
+and the rest of the deposit coin and the exchanged coin are deposited to the pool in the same batch execution.
+The coins that are not deposited in the reserve ratio of the pool are refunded.
+
+This deposit request is not processed immediately since it is accumulated in the liquidity pool batch.
+All requests in a batch are treated equally and executed at the same swap price.
+
+Example:
+$ %[1]s tx %[2]s deposit-single-asset 1 100000000uatom --from mykey
+
+This example request deposits 100000000uatom to pool-id 1.
+The deposit coin must be one of the reserve coins of the pool.
+
+$ %[1]s tx %[2]s deposit-single-asset 1 100000000uatom --min-pool-coin-amount 1000 --from mykey
+
+This example request is refunded when less than 1000 pool coin is minted for the deposit.
+
+[pool-id]: The pool id of the liquidity pool
+[deposit-coin]: The reserve coin to deposit to the liquidity pool
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			depositor := clientCtx.GetFromAddress()
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("pool-id %s not a valid uint, input a valid unsigned 32-bit integer for pool-id", args[0])
+			}
+
+			depositCoin, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDepositSingleAssetWithinBatch(depositor, poolID, depositCoin)
+
+			minPoolCoinAmountStr, _ := cmd.Flags().GetString(FlagMinPoolCoinAmount)
+			if minPoolCoinAmountStr != "" {
+				minPoolCoinAmount, ok := sdk.NewIntFromString(minPoolCoinAmountStr)
+				if !ok {
+					return fmt.Errorf("invalid min pool coin amount: %s", minPoolCoinAmountStr)
+				}
+				msg.MinPoolCoinAmount = minPoolCoinAmount
+			}
+
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -166,6 +297,7 @@ Deposits must be the same coin denoms as the reserve coins.
 		},
 	}
 
+	cmd.Flags().AddFlagSet(flagSetDeposit())
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -184,11 +316,19 @@ This swap request is not processed immediately since it is accumulated in the li
 All requests in a batch are treated equally and executed at the same swap price.
 
 Example:
-$ %s tx %s withdraw 1 10000pool96EF6EA6E5AC828ED87E8D07E7AE2A8180570ADD212117B2DA6F0B75D17A6295 --from mykey
+$ %[1]s tx %[2]s withdraw 1 10000pool96EF6EA6E5AC828ED87E8D07E7AE2A8180570ADD212117B2DA6F0B75D17A6295 --from mykey
 
 This example request withdraws 10000 pool coin from the specified liquidity pool.
 The appropriate pool coin must be requested from the specified pool.
 
+$ %[1]s tx %[2]s withdraw 1 10000pool96EF6EA6E5AC828ED87E8D07E7AE2A8180570ADD212117B2DA6F0B75D17A6295 --min-withdraw-coins 1000uatom,50000uusd --from mykey
+
+This example request is refunded when less than 1000uatom or 50000uusd is withdrawn.
+
+$ %[1]s tx %[2]s withdraw 1 10000pool96EF6EA6E5AC828ED87E8D07E7AE2A8180570ADD212117B2DA6F0B75D17A6295 --target-denom uatom --from mykey
+
+This example request withdraws both reserve coins and swaps the withdrawn uusd into uatom within the same batch.
+
 [pool-id]: The pool id of the liquidity pool
 [pool-coin]: The amount of pool coin to withdraw from the liquidity pool
 `,
@@ -220,6 +360,18 @@ The appropriate pool coin must be requested from the specified pool.
 			}
 
 			msg := types.NewMsgWithdrawWithinBatch(withdrawer, poolID, poolCoin)
+
+			minWithdrawCoinsStr, _ := cmd.Flags().GetString(FlagMinWithdrawCoins)
+			if minWithdrawCoinsStr != "" {
+				minWithdrawCoins, err := sdk.ParseCoinsNormalized(minWithdrawCoinsStr)
+				if err != nil {
+					return fmt.Errorf("invalid min withdraw coins: %w", err)
+				}
+				msg.MinWithdrawCoins = minWithdrawCoins
+			}
+
+			msg.TargetDenom, _ = cmd.Flags().GetString(FlagTargetDenom)
+
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -228,6 +380,7 @@ The appropriate pool coin must be requested from the specified pool.
 		},
 	}
 
+	cmd.Flags().AddFlagSet(flagSetWithdraw())
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -259,7 +412,7 @@ A sufficient balance of half of the swap-fee-rate of the offer coin is required
 The order price is the exchange ratio of X/Y, where X is the amount of the first coin and Y is the amount of the second coin when their denoms are sorted alphabetically.
 Increasing order price reduces the possibility for your request to be processed and results in buying uatom at a lower price than the pool price.
 
-For explicit calculations, The swap fee rate must be the value that set as liquidity parameter in the current network.
+For explicit calculations, The swap fee rate must be the swap fee rate of the pool, which is the value that set as liquidity parameter in the current network when the pool has no swap fee rate.
 The only supported swap-type is 1. For the detailed swap algorithm, see https://github.com/gravity-devs/liquidity/v2
 
 [pool-id]: The pool id of the liquidity pool 
@@ -267,7 +420,7 @@ The only supported swap-type is 1. For the detailed swap algorithm, see https://
 [offer-coin]: The amount of offer coin to swap 
 [demand-coin-denom]: The denomination of the coin to exchange with offer coin 
 [order-price]: The limit order price for the swap order. The price is the exchange ratio of X/Y where X is the amount of the first coin and Y is the amount of the second coin when their denoms are sorted alphabetically 
-[swap-fee-rate]: The swap fee rate to pay for swap that is proportional to swap amount. The swap fee rate must be the value that set as liquidity parameter in the current network.
+[swap-fee-rate]: The swap fee rate to pay for swap that is proportional to swap amount. The swap fee rate must be the swap fee rate of the pool.
 `,
 				version.AppName, types.ModuleName,
 			),
@@ -334,3 +487,352 @@ The only supported swap-type is 1. For the detailed swap algorithm, see https://
 
 	return cmd
 }
+
+// Swap offer coin through the liquidity pools of the route in sequence within one batch execution.
+func NewSwapRouteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-route [pool-ids] [offer-coin] [min-demand-coin] [swap-fee-rate]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Swap offer coin through the liquidity pools of the route in sequence",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Swap offer coin through the liquidity pools of the route in sequence.
+
+This swap route request is accumulated in the batch of the first liquidity pool of the route.
+When the batch is executed, the swaps of the route are executed one after another against the pools of the route, and the intermediate coins are kept in the module escrow.
+The whole route is refunded when any of the swaps is not fully matched or the final demand coin is less than the minimum demand coin.
+
+Example:
+$ %s tx %s swap-route 1,2 50000000uusd 900000uosmo 0.003 --from mykey
+
+For this example, imagine that the liquidity pool 1 has uatom and uusd, and the liquidity pool 2 has uatom and uosmo.
+This example request swaps 50000000uusd for uatom in the pool 1, then swaps the exchanged uatom for at least 900000uosmo in the pool 2.
+A sufficient balance of half of the swap-fee-rate of the offer coin is required to reserve the offer coin fee of the first swap.
+The fees of the following swaps are paid from the intermediate coins at the swap fee rates of their pools.
+
+[pool-ids]: The comma separated ids of the liquidity pools to swap through, in order
+[offer-coin]: The amount of offer coin to swap
+[min-demand-coin]: The minimum amount of the coin to receive at the end of the route
+[swap-fee-rate]: The swap fee rate to pay for swap that is proportional to swap amount. The swap fee rate must be the swap fee rate of the first pool.
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			swapRequester := clientCtx.GetFromAddress()
+
+			var poolIDs []uint64
+			for _, poolIDStr := range strings.Split(args[0], ",") {
+				poolID, err := strconv.ParseUint(strings.TrimSpace(poolIDStr), 10, 64)
+				if err != nil {
+					return fmt.Errorf("pool-id %s not a valid uint, input comma separated unsigned integers for pool-ids", poolIDStr)
+				}
+				poolIDs = append(poolIDs, poolID)
+			}
+
+			offerCoin, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			minDemandCoin, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			swapFeeRate, err := sdk.NewDecFromStr(args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSwapRoute(swapRequester, poolIDs, offerCoin, minDemandCoin, swapFeeRate)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Cancel the deposit request that is not executed yet from the liquidity pool batch.
+func NewCancelDepositCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-deposit [pool-id] [msg-index]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Cancel a deposit request that is not executed yet from the liquidity pool batch",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a deposit request that is not executed yet from the liquidity pool batch.
+
+The deposit coins of the request are released from the escrow immediately.
+Only the account that submitted the request can cancel it.
+
+Example:
+$ %s tx %s cancel-deposit 1 3 --from mykey
+
+This example request cancels the deposit request with msg index 3 in the batch of the liquidity pool 1.
+
+[pool-id]: The pool id of the liquidity pool
+[msg-index]: The msg index of the deposit request in the liquidity pool batch
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("pool-id %s not a valid uint, input a valid unsigned 32-bit integer for pool-id", args[0])
+			}
+
+			msgIndex, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("msg-index %s not a valid uint, input a valid unsigned 64-bit integer for msg-index", args[1])
+			}
+
+			msg := types.NewMsgCancelDeposit(clientCtx.GetFromAddress(), poolID, msgIndex)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Cancel the withdraw request that is not executed yet from the liquidity pool batch.
+func NewCancelWithdrawCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-withdraw [pool-id] [msg-index]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Cancel a withdraw request that is not executed yet from the liquidity pool batch",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a withdraw request that is not executed yet from the liquidity pool batch.
+
+The pool coin of the request are released from the escrow immediately.
+Only the account that submitted the request can cancel it.
+
+Example:
+$ %s tx %s cancel-withdraw 1 3 --from mykey
+
+This example request cancels the withdraw request with msg index 3 in the batch of the liquidity pool 1.
+
+[pool-id]: The pool id of the liquidity pool
+[msg-index]: The msg index of the withdraw request in the liquidity pool batch
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("pool-id %s not a valid uint, input a valid unsigned 32-bit integer for pool-id", args[0])
+			}
+
+			msgIndex, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("msg-index %s not a valid uint, input a valid unsigned 64-bit integer for msg-index", args[1])
+			}
+
+			msg := types.NewMsgCancelWithdraw(clientCtx.GetFromAddress(), poolID, msgIndex)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Cancel the swap request that is not executed yet from the liquidity pool batch.
+func NewCancelSwapCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-swap [pool-id] [msg-index]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Cancel a swap request that is not executed yet from the liquidity pool batch",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a swap request that is not executed yet from the liquidity pool batch.
+
+The remaining offer coin and the unused offer coin fee of the request are released from the escrow immediately.
+Only the account that submitted the request can cancel it.
+
+Example:
+$ %s tx %s cancel-swap 1 3 --from mykey
+
+This example request cancels the swap request with msg index 3 in the batch of the liquidity pool 1.
+
+[pool-id]: The pool id of the liquidity pool
+[msg-index]: The msg index of the swap request in the liquidity pool batch
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("pool-id %s not a valid uint, input a valid unsigned 32-bit integer for pool-id", args[0])
+			}
+
+			msgIndex, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("msg-index %s not a valid uint, input a valid unsigned 64-bit integer for msg-index", args[1])
+			}
+
+			msg := types.NewMsgCancelSwap(clientCtx.GetFromAddress(), poolID, msgIndex)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Set the status of the liquidity pool as a guardian.
+func NewSetPoolStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-pool-status [pool-id] [status]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Set the status of the liquidity pool as a guardian",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the status of the liquidity pool as a guardian.
+
+A guardian can only make the status of the pool more restrictive. Any status can be set by a governance proposal
+with the msg of the gov module account as the authority.
+
+Example:
+$ %s tx %s set-pool-status 1 frozen --from mykey
+
+This example request freezes the liquidity pool 1.
+
+[pool-id]: The pool id of the liquidity pool
+[status]: The status of the liquidity pool, one of active, deposits-paused, withdraw-only and frozen
+
+The deposits are allowed only to the active pools, the swaps are also allowed through the pools of which deposits are paused,
+the withdrawals are also allowed from the withdraw-only pools and no msg is allowed to the frozen pools except the cancels.
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("pool-id %s not a valid uint, input a valid unsigned 32-bit integer for pool-id", args[0])
+			}
+
+			status, err := types.ParsePoolStatus(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetPoolStatus(clientCtx.GetFromAddress(), poolID, status)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// Enable the circuit breaker of all pools or of the liquidity pool as a guardian.
+func NewSetCircuitBreakerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-circuit-breaker [pool-id] [enabled]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Enable the circuit breaker of all pools or of the liquidity pool as a guardian",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Enable the circuit breaker of all pools or of the liquidity pool as a guardian.
+
+A guardian can only enable the circuit breaker, it is disabled again by a governance proposal with the msg of the
+gov module account as the authority.
+
+Example:
+$ %s tx %s set-circuit-breaker 0 true --from mykey
+
+This example request enables the circuit breaker of all pools, which rejects the pool creations, deposits and swaps
+from the next msg on.
+
+Example:
+$ %s tx %s set-circuit-breaker 1 true --from mykey
+
+This example request enables the circuit breaker of the liquidity pool 1, which makes the pool withdraw-only.
+
+[pool-id]: The pool id of the liquidity pool, 0 for all pools
+[enabled]: Whether the circuit breaker is enabled
+`,
+				version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("pool-id %s not a valid uint, input a valid unsigned 32-bit integer for pool-id", args[0])
+			}
+
+			enabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return fmt.Errorf("enabled %s not a valid bool, input true or false for enabled", args[1])
+			}
+
+			msg := types.NewMsgSetCircuitBreaker(clientCtx.GetFromAddress(), poolID, enabled)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
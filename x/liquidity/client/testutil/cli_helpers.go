@@ -15,10 +15,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
-	paramscli "github.com/cosmos/cosmos-sdk/x/params/client/cli"
 
 	liquidityapp "github.com/gravity-devs/liquidity/v2/app"
 	liquiditycli "github.com/gravity-devs/liquidity/v2/x/liquidity/client/cli"
+	liquiditytypes "github.com/gravity-devs/liquidity/v2/x/liquidity/types"
 
 	dbm "github.com/tendermint/tm-db"
 )
@@ -29,6 +29,7 @@ func NewConfig(dbm *dbm.MemDB) network.Config {
 	encCfg := simapp.MakeTestEncodingConfig()
 
 	cfg := network.DefaultConfig()
+	liquiditytypes.RegisterInterfaces(cfg.InterfaceRegistry)               // to resolve the liquidity msgs of the gov proposals
 	cfg.AppConstructor = NewAppConstructor(encCfg, dbm)                    // the ABCI application constructor
 	cfg.GenesisState = liquidityapp.ModuleBasics.DefaultGenesis(cfg.Codec) // liquidity genesis state to provide
 	return cfg
@@ -121,8 +122,8 @@ func MsgSwapWithinBatchExec(clientCtx client.Context, from, poolID, swapTypeID,
 	return clitestutil.ExecTestCLICmd(clientCtx, liquiditycli.NewSwapWithinBatchCmd(), args)
 }
 
-// MsgParamChangeProposalExec creates a transaction for submitting param change proposal
-func MsgParamChangeProposalExec(clientCtx client.Context, from string, file string) (testutil.BufferWriter, error) {
+// MsgSubmitProposalExec creates a transaction for submitting a gov proposal of msgs
+func MsgSubmitProposalExec(clientCtx client.Context, from string, file string) (testutil.BufferWriter, error) {
 
 	args := append([]string{
 		file,
@@ -130,10 +131,7 @@ func MsgParamChangeProposalExec(clientCtx client.Context, from string, file stri
 		fmt.Sprintf("--%s=%s", flags.FlagGas, "1000000"),
 	}, commonArgs...)
 
-	paramChangeCmd := paramscli.NewSubmitParamChangeProposalTxCmd()
-	flags.AddTxFlagsToCmd(paramChangeCmd)
-
-	return clitestutil.ExecTestCLICmd(clientCtx, paramChangeCmd, args)
+	return clitestutil.ExecTestCLICmd(clientCtx, govcli.NewCmdSubmitProposal(), args)
 }
 
 // MsgVote votes for a proposal
@@ -295,3 +295,79 @@ func TestMsgServerSwap(t *testing.T) {
 	require.Equal(t, 0, len(msgs))
 	require.Equal(t, 0, len(notProcessedMsgs))
 }
+
+func TestMsgServerSwapExecution(t *testing.T) {
+	simapp, ctx := app.CreateTestInput()
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+
+	denomX, denomY := types.AlphabeticalDenomPair("denomX", "denomY")
+	X := params.MinInitDepositAmount.MulRaw(1000)
+	Y := params.MinInitDepositAmount.MulRaw(1000)
+
+	addrs := app.AddTestAddrs(simapp, ctx, 3, params.PoolCreationFee)
+	poolID := app.TestCreatePool(t, simapp, ctx, X, Y, denomX, denomY, addrs[0])
+
+	xOfferCoins := []sdk.Coin{sdk.NewCoin(denomX, sdk.NewInt(10000))}
+	yOfferCoins := []sdk.Coin{sdk.NewCoin(denomY, sdk.NewInt(10000))}
+	msgX := app.GetSwapMsg(t, simapp, ctx, xOfferCoins, []sdk.Dec{sdk.MustNewDecFromStr("1.1")}, addrs[1:2], poolID)
+	msgY := app.GetSwapMsg(t, simapp, ctx, yOfferCoins, []sdk.Dec{sdk.MustNewDecFromStr("0.9")}, addrs[2:3], poolID)
+
+	handler := liquidity.NewHandler(simapp.LiquidityKeeper)
+	res, err := handler(ctx, msgX[0])
+	require.NoError(t, err)
+	require.NotNil(t, res)
+	_, err = handler(ctx, msgY[0])
+	require.NoError(t, err)
+
+	batch, found := simapp.LiquidityKeeper.GetPoolBatch(ctx, poolID)
+	require.True(t, found)
+	require.Len(t, simapp.LiquidityKeeper.GetAllNotProcessedPoolBatchSwapMsgStates(ctx, batch), 2)
+
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+
+	msgs := simapp.LiquidityKeeper.GetAllPoolBatchSwapMsgStatesAsPointer(ctx, batch)
+	require.Len(t, msgs, 2)
+	for _, msg := range msgs {
+		require.True(t, msg.Executed)
+		require.True(t, msg.Succeeded)
+		require.True(t, msg.ToBeDeleted)
+	}
+	require.True(t, simapp.BankKeeper.GetBalance(ctx, addrs[1], denomY).IsPositive())
+	require.True(t, simapp.BankKeeper.GetBalance(ctx, addrs[2], denomX).IsPositive())
+}
+
+func TestMsgServerCancelSwap(t *testing.T) {
+	simapp, ctx := app.CreateTestInput()
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+
+	denomX, denomY := types.AlphabeticalDenomPair("denomX", "denomY")
+	X := params.MinInitDepositAmount.MulRaw(1000)
+	Y := params.MinInitDepositAmount.MulRaw(1000)
+
+	addrs := app.AddTestAddrs(simapp, ctx, 2, params.PoolCreationFee)
+	poolID := app.TestCreatePool(t, simapp, ctx, X, Y, denomX, denomY, addrs[0])
+
+	offerCoin := sdk.NewCoin(denomX, sdk.NewInt(10000))
+	msgs := app.GetSwapMsg(t, simapp, ctx, []sdk.Coin{offerCoin}, []sdk.Dec{sdk.MustNewDecFromStr("1.1")}, addrs[1:2], poolID)
+
+	handler := liquidity.NewHandler(simapp.LiquidityKeeper)
+	_, err := handler(ctx, msgs[0])
+	require.NoError(t, err)
+
+	batch, found := simapp.LiquidityKeeper.GetPoolBatch(ctx, poolID)
+	require.True(t, found)
+	msgStates := simapp.LiquidityKeeper.GetAllNotProcessedPoolBatchSwapMsgStates(ctx, batch)
+	require.Len(t, msgStates, 1)
+
+	res, err := handler(ctx, types.NewMsgCancelSwap(addrs[1], poolID, msgStates[0].MsgIndex))
+	require.NoError(t, err)
+	var cancelEvent sdk.Event
+	for _, event := range res.GetEvents() {
+		if event.Type == types.EventTypeCancelSwap {
+			cancelEvent = sdk.Event(event)
+		}
+	}
+	require.Equal(t, types.EventTypeCancelSwap, cancelEvent.Type)
+	require.Equal(t, offerCoin.Add(msgs[0].OfferCoinFee), simapp.BankKeeper.GetBalance(ctx, addrs[1], denomX))
+	require.Empty(t, simapp.LiquidityKeeper.GetAllNotProcessedPoolBatchSwapMsgStates(ctx, batch))
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"strconv"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
@@ -33,13 +35,39 @@ func (k Keeper) DeleteAndInitPoolBatches(ctx sdk.Context) {
 				k.SetPoolBatchWithdrawMsgStatesByPointer(ctx, poolBatch.PoolId, withdrawMsgs)
 			}
 
+			// Swap msgs that are not fully matched are carried over to the next batch until their order expiry height.
+			swapMsgs := k.GetAllRemainingPoolBatchSwapMsgStates(ctx, poolBatch)
+			if len(swapMsgs) > 0 {
+				for _, msg := range swapMsgs {
+					msg.Executed = false
+					msg.Succeeded = false
+				}
+				k.SetPoolBatchSwapMsgStatesByPointer(ctx, poolBatch.PoolId, swapMsgs)
+			}
+
 			// Delete all batch msg states that are ready to be deleted.
 			k.DeleteAllReadyPoolBatchDepositMsgStates(ctx, poolBatch)
 			k.DeleteAllReadyPoolBatchWithdrawMsgStates(ctx, poolBatch)
+			k.DeleteAllReadyPoolBatchSwapMsgStates(ctx, poolBatch)
+			k.DeleteAllReadyPoolBatchSwapRouteMsgStates(ctx, poolBatch)
 
 			if err := k.InitNextPoolBatch(ctx, poolBatch); err != nil {
 				panic(err)
 			}
+
+			for _, msg := range swapMsgs {
+				ctx.EventManager().EmitEvent(
+					sdk.NewEvent(
+						types.EventTypeSwapCarriedOver,
+						sdk.NewAttribute(types.AttributeValuePoolId, strconv.FormatUint(poolBatch.PoolId, 10)),
+						sdk.NewAttribute(types.AttributeValueBatchIndex, strconv.FormatUint(poolBatch.Index+1, 10)),
+						sdk.NewAttribute(types.AttributeValueMsgIndex, strconv.FormatUint(msg.MsgIndex, 10)),
+						sdk.NewAttribute(types.AttributeValueSwapRequester, msg.Msg.GetSwapRequester().String()),
+						sdk.NewAttribute(types.AttributeValueRemainingOfferCoinAmount, msg.RemainingOfferCoin.Amount.String()),
+						sdk.NewAttribute(types.AttributeValueReservedOfferCoinFeeAmount, msg.ReservedOfferCoinFee.Amount.String()),
+						sdk.NewAttribute(types.AttributeValueOrderExpiryHeight, strconv.FormatInt(msg.OrderExpiryHeight, 10)),
+					))
+			}
 		}
 		return false
 	})
@@ -59,15 +87,60 @@ func (k Keeper) InitNextPoolBatch(ctx sdk.Context, poolBatch types.PoolBatch) er
 	return nil
 }
 
-// ExecutePoolBatches executes the accumulated msgs in the batch.
-// The order is (1)swap, (2)deposit, (3)withdraw.
+// ExecutePoolBatches executes the accumulated msgs in the batches of the pools at the batch execution height of each pool.
+// The order is (1)withdraw with a target denom, (2)swap, (3)deposit, (4)withdraw, (5)swap route.
+// The withdrawals with a target denom are executed first so that their swap msgs are executed within the same batch.
 func (k Keeper) ExecutePoolBatches(ctx sdk.Context) {
 	params := k.GetParams(ctx)
 	logger := k.Logger(ctx)
 
 	k.IterateAllPoolBatches(ctx, func(poolBatch types.PoolBatch) bool {
-		if !poolBatch.Executed && ctx.BlockHeight()%int64(params.UnitBatchHeight) == 0 {
-			executedMsgCount := 0
+		// the msgs of the batch of a deleted pool are refunded at the unit batch height
+		batchInterval := int64(params.UnitBatchHeight)
+		pool, poolFound := k.GetPool(ctx, poolBatch.PoolId)
+		if poolFound {
+			batchInterval = k.GetPoolBatchInterval(ctx, pool)
+		}
+		if !poolBatch.Executed && ctx.BlockHeight()%batchInterval == 0 {
+			var executedMsgCount uint64
+
+			if poolFound && params.BatchRecordLifespan > 0 {
+				k.StartPoolBatchRecord(ctx, pool, poolBatch)
+			}
+
+			executeWithdrawals := func(withTargetDenom bool) {
+				k.IterateAllPoolBatchWithdrawMsgStates(ctx, poolBatch, func(batchMsg types.WithdrawMsgState) bool {
+					if batchMsg.Executed || batchMsg.ToBeDeleted || batchMsg.Succeeded {
+						return false
+					}
+					if (batchMsg.Msg.TargetDenom != "") != withTargetDenom {
+						return false
+					}
+					executedMsgCount++
+					if err := k.ExecuteWithdrawal(ctx, batchMsg, poolBatch); err != nil {
+						logger.Error("withdraw failed",
+							"poolID", poolBatch.PoolId,
+							"batchIndex", poolBatch.Index,
+							"msgIndex", batchMsg.MsgIndex,
+							"withdrawer", batchMsg.Msg.GetWithdrawer(),
+							"error", err)
+						if refundErr := k.RefundWithdrawal(ctx, batchMsg, poolBatch, err); refundErr != nil {
+							panic(refundErr)
+						}
+					}
+					return false
+				})
+			}
+
+			executeWithdrawals(true)
+
+			swapMsgCount, err := k.SwapExecution(ctx, poolBatch)
+			if err != nil {
+				panic(err)
+			}
+			executedMsgCount += swapMsgCount
+
+			k.SettleWithdrawTargetCoins(ctx, poolBatch)
 
 			k.IterateAllPoolBatchDepositMsgStates(ctx, poolBatch, func(batchMsg types.DepositMsgState) bool {
 				if batchMsg.Executed || batchMsg.ToBeDeleted || batchMsg.Succeeded {
@@ -81,27 +154,29 @@ func (k Keeper) ExecutePoolBatches(ctx sdk.Context) {
 						"msgIndex", batchMsg.MsgIndex,
 						"depositor", batchMsg.Msg.GetDepositor(),
 						"error", err)
-					if err := k.RefundDeposit(ctx, batchMsg, poolBatch); err != nil {
-						panic(err)
+					if refundErr := k.RefundDeposit(ctx, batchMsg, poolBatch, err); refundErr != nil {
+						panic(refundErr)
 					}
 				}
 				return false
 			})
 
-			k.IterateAllPoolBatchWithdrawMsgStates(ctx, poolBatch, func(batchMsg types.WithdrawMsgState) bool {
+			executeWithdrawals(false)
+
+			k.IterateAllPoolBatchSwapRouteMsgStates(ctx, poolBatch, func(batchMsg types.SwapRouteMsgState) bool {
 				if batchMsg.Executed || batchMsg.ToBeDeleted || batchMsg.Succeeded {
 					return false
 				}
 				executedMsgCount++
-				if err := k.ExecuteWithdrawal(ctx, batchMsg, poolBatch); err != nil {
-					logger.Error("withdraw failed",
+				if err := k.ExecuteSwapRoute(ctx, batchMsg, poolBatch); err != nil {
+					logger.Error("swap route failed",
 						"poolID", poolBatch.PoolId,
 						"batchIndex", poolBatch.Index,
 						"msgIndex", batchMsg.MsgIndex,
-						"withdrawer", batchMsg.Msg.GetWithdrawer(),
+						"swapRequester", batchMsg.Msg.GetSwapRequester(),
 						"error", err)
-					if err := k.RefundWithdrawal(ctx, batchMsg, poolBatch); err != nil {
-						panic(err)
+					if refundErr := k.RefundSwapRoute(ctx, batchMsg, poolBatch, err); refundErr != nil {
+						panic(refundErr)
 					}
 				}
 				return false
@@ -112,7 +187,45 @@ func (k Keeper) ExecutePoolBatches(ctx sdk.Context) {
 				poolBatch.Executed = true
 				k.SetPoolBatch(ctx, poolBatch)
 			}
+
+			// Record the price of the pool at every batch execution height for the time-weighted average price,
+			// and the result of the batch when any msgs were executed.
+			if pool, found := k.GetPool(ctx, poolBatch.PoolId); found {
+				k.RecordPoolPrice(ctx, pool)
+				k.PrunePoolPriceRecords(ctx, pool.Id, params.PriceRecordLifespan)
+				k.FinishPoolBatchRecord(ctx, pool, poolBatch, executedMsgCount > 0)
+				k.PrunePoolBatchRecords(ctx, pool.Id, params.BatchRecordLifespan)
+			}
+		}
+		return false
+	})
+}
+
+// SettleWithdrawTargetCoins adds the demand coins exchanged by the swap msgs of the withdrawals with a target denom
+// to their target coins, after the swap execution of the batch.
+func (k Keeper) SettleWithdrawTargetCoins(ctx sdk.Context, poolBatch types.PoolBatch) {
+	k.IterateAllPoolBatchWithdrawMsgStates(ctx, poolBatch, func(batchMsg types.WithdrawMsgState) bool {
+		if !batchMsg.Succeeded || batchMsg.Msg.TargetDenom == "" {
+			return false
+		}
+		if batchMsg.SwapMsgIndex != 0 {
+			swapMsg, found := k.GetPoolBatchSwapMsgState(ctx, poolBatch.PoolId, batchMsg.SwapMsgIndex)
+			if found && !swapMsg.ExchangedDemandCoin.Amount.IsNil() {
+				batchMsg.TargetCoin = batchMsg.TargetCoin.Add(swapMsg.ExchangedDemandCoin)
+				k.SetPoolBatchWithdrawMsgState(ctx, poolBatch.PoolId, batchMsg)
+			}
 		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeWithdrawTargetCoin,
+				sdk.NewAttribute(types.AttributeValuePoolId, strconv.FormatUint(poolBatch.PoolId, 10)),
+				sdk.NewAttribute(types.AttributeValueBatchIndex, strconv.FormatUint(poolBatch.Index, 10)),
+				sdk.NewAttribute(types.AttributeValueMsgIndex, strconv.FormatUint(batchMsg.MsgIndex, 10)),
+				sdk.NewAttribute(types.AttributeValueWithdrawer, batchMsg.Msg.GetWithdrawer().String()),
+				sdk.NewAttribute(types.AttributeValueSwapMsgIndex, strconv.FormatUint(batchMsg.SwapMsgIndex, 10)),
+				sdk.NewAttribute(types.AttributeValueTargetCoin, batchMsg.TargetCoin.String()),
+			))
 		return false
 	})
 }
@@ -181,6 +294,74 @@ func (k Keeper) DepositWithinBatch(ctx sdk.Context, msg *types.MsgDepositWithinB
 	return msgState, nil
 }
 
+// DepositSingleAssetWithinBatch splits the single asset deposit into a swap msg of the optimal portion of the deposit coin
+// and a deposit msg of the rest of the deposit coin, and holds the deposit coin in escrow. The demand coin exchanged by
+// the swap msg is kept in escrow and deposited to the pool together with the rest of the deposit coin in the same batch.
+func (k Keeper) DepositSingleAssetWithinBatch(ctx sdk.Context, msg *types.MsgDepositSingleAssetWithinBatch) (types.DepositMsgState, error) {
+	if err := k.ValidateMsgDepositSingleAssetWithinBatch(ctx, *msg); err != nil {
+		return types.DepositMsgState{}, err
+	}
+
+	pool, found := k.GetPool(ctx, msg.PoolId)
+	if !found {
+		return types.DepositMsgState{}, types.ErrPoolNotExists
+	}
+
+	demandCoinDenom, direction := pool.ReserveCoinDenoms[1], types.DirectionXtoY
+	if msg.DepositCoin.Denom == pool.ReserveCoinDenoms[1] {
+		demandCoinDenom, direction = pool.ReserveCoinDenoms[0], types.DirectionYtoX
+	}
+
+	// With the equivalent swap price model (ESPM) of the batch, swapping a half of the deposit coin leaves the rest of the
+	// deposit coin and the exchanged demand coin in the reserve ratio of the pool after the swap, regardless of the swap fee.
+	// The portion of the deposit coin swapped from a weighted pool is the weight of the demand coin over the sum of the
+	// weights of the pair instead of a half, to leave the value of both coins in the ratio of the weights.
+	// On the StableSwap curve the reserve ratio differs from the swap price, and the excess coin is refunded by the deposit.
+	reserveCoins := k.GetReserveCoins(ctx, pool)
+	depositWeight, demandWeight := pool.ReserveCoinWeight(msg.DepositCoin.Denom), pool.ReserveCoinWeight(demandCoinDenom)
+	offerAmt := msg.DepositCoin.Amount.MulRaw(int64(demandWeight)).QuoRaw(int64(depositWeight + demandWeight))
+	offerCoin := sdk.NewCoin(msg.DepositCoin.Denom, offerAmt)
+	swapFeeRate := k.GetPoolSwapFeeRate(ctx, pool)
+	offerCoinFee := types.GetOfferCoinFee(offerCoin, swapFeeRate)
+	if !offerCoin.IsPositive() || !msg.DepositCoin.Amount.GT(offerCoin.Amount.Add(offerCoinFee.Amount)) {
+		return types.DepositMsgState{}, types.ErrBadDepositCoinsAmount
+	}
+	currentPoolPrice := k.GetPoolPrice(ctx, pool, reserveCoins)
+	orderPrice := types.GetMaxDeviatedOrderPrice(currentPoolPrice, direction)
+
+	swapMsg := types.NewMsgSwapWithinBatch(msg.GetDepositor(), msg.PoolId, types.DefaultSwapTypeID, offerCoin, demandCoinDenom, orderPrice, swapFeeRate)
+	swapMsgState, err := k.SwapWithinBatch(ctx, swapMsg, 0)
+	if err != nil {
+		return types.DepositMsgState{}, err
+	}
+	swapMsgState.Internal = true
+	k.SetPoolBatchSwapMsgState(ctx, msg.PoolId, swapMsgState)
+
+	poolBatch, found := k.GetPoolBatch(ctx, msg.PoolId)
+	if !found {
+		return types.DepositMsgState{}, types.ErrPoolBatchNotExists
+	}
+
+	depositCoins := sdk.NewCoins(msg.DepositCoin.Sub(offerCoin).Sub(offerCoinFee))
+	msgState := types.DepositMsgState{
+		MsgHeight:    ctx.BlockHeight(),
+		MsgIndex:     poolBatch.DepositMsgIndex,
+		SwapMsgIndex: swapMsgState.MsgIndex,
+		Msg:          types.NewMsgDepositWithinBatch(msg.GetDepositor(), msg.PoolId, depositCoins),
+	}
+	msgState.Msg.MinPoolCoinAmount = msg.GetMinPoolCoinAmount()
+
+	if err := k.HoldEscrow(ctx, msg.GetDepositor(), depositCoins); err != nil {
+		return types.DepositMsgState{}, err
+	}
+
+	poolBatch.DepositMsgIndex++
+	k.SetPoolBatch(ctx, poolBatch)
+	k.SetPoolBatchDepositMsgState(ctx, poolBatch.PoolId, msgState)
+
+	return msgState, nil
+}
+
 // In order to deal with the batch at the same time, the coins of msgs are deposited in escrow.
 func (k Keeper) WithdrawWithinBatch(ctx sdk.Context, msg *types.MsgWithdrawWithinBatch) (types.WithdrawMsgState, error) {
 	if err := k.ValidateMsgWithdrawWithinBatch(ctx, *msg); err != nil {
@@ -212,3 +393,177 @@ func (k Keeper) WithdrawWithinBatch(ctx sdk.Context, msg *types.MsgWithdrawWithi
 
 	return batchPoolMsg, nil
 }
+
+// In order to deal with the batch at the same time, the coins of msgs are deposited in escrow.
+// The offer coin and the reserved offer coin fee are held until the order is matched or expired.
+// The order expires orderExpirySpanHeight blocks after the current height, but not before the next batch
+// execution height of the pool, which is also the expiry height when orderExpirySpanHeight is zero.
+func (k Keeper) SwapWithinBatch(ctx sdk.Context, msg *types.MsgSwapWithinBatch, orderExpirySpanHeight int64) (types.SwapMsgState, error) {
+	if err := k.ValidateMsgSwapWithinBatch(ctx, *msg); err != nil {
+		return types.SwapMsgState{}, err
+	}
+
+	poolBatch, found := k.GetPoolBatch(ctx, msg.PoolId)
+	if !found {
+		return types.SwapMsgState{}, types.ErrPoolBatchNotExists
+	}
+
+	if poolBatch.BeginHeight == 0 {
+		poolBatch.BeginHeight = ctx.BlockHeight()
+	}
+
+	pool, found := k.GetPool(ctx, msg.PoolId)
+	if !found {
+		return types.SwapMsgState{}, types.ErrPoolNotExists
+	}
+
+	// the order is not expired before the next batch execution of the pool even if the lifespan is shorter than the
+	// batch interval of the pool
+	currentHeight := ctx.BlockHeight()
+	batchInterval := k.GetPoolBatchInterval(ctx, pool)
+	nextBatchSpanHeight := (batchInterval - currentHeight%batchInterval) % batchInterval
+	if orderExpirySpanHeight < nextBatchSpanHeight {
+		orderExpirySpanHeight = nextBatchSpanHeight
+	}
+
+	msgState := types.SwapMsgState{
+		MsgHeight:            currentHeight,
+		MsgIndex:             poolBatch.SwapMsgIndex,
+		OrderExpiryHeight:    currentHeight + orderExpirySpanHeight,
+		ExchangedOfferCoin:   sdk.NewCoin(msg.OfferCoin.Denom, sdk.ZeroInt()),
+		RemainingOfferCoin:   msg.OfferCoin,
+		ReservedOfferCoinFee: msg.OfferCoinFee,
+		Msg:                  msg,
+		ExchangedDemandCoin:  sdk.NewCoin(msg.DemandCoinDenom, sdk.ZeroInt()),
+	}
+
+	if err := k.HoldEscrow(ctx, msg.GetSwapRequester(), sdk.NewCoins(msg.OfferCoin.Add(msg.OfferCoinFee))); err != nil {
+		return types.SwapMsgState{}, err
+	}
+
+	poolBatch.SwapMsgIndex++
+	k.SetPoolBatch(ctx, poolBatch)
+	k.SetPoolBatchSwapMsgState(ctx, poolBatch.PoolId, msgState)
+
+	return msgState, nil
+}
+
+// SwapRouteWithinBatch appends the swap route to the batch of the first pool of the route, and the offer coin and
+// the offer coin fee are deposited in escrow.
+func (k Keeper) SwapRouteWithinBatch(ctx sdk.Context, msg *types.MsgSwapRoute) (types.SwapRouteMsgState, error) {
+	if err := k.ValidateMsgSwapRoute(ctx, *msg); err != nil {
+		return types.SwapRouteMsgState{}, err
+	}
+
+	poolBatch, found := k.GetPoolBatch(ctx, msg.PoolIds[0])
+	if !found {
+		return types.SwapRouteMsgState{}, types.ErrPoolBatchNotExists
+	}
+
+	if poolBatch.BeginHeight == 0 {
+		poolBatch.BeginHeight = ctx.BlockHeight()
+	}
+
+	msgState := types.SwapRouteMsgState{
+		MsgHeight:           ctx.BlockHeight(),
+		MsgIndex:            poolBatch.SwapRouteMsgIndex,
+		Msg:                 msg,
+		ExchangedDemandCoin: sdk.NewCoin(msg.MinDemandCoin.Denom, sdk.ZeroInt()),
+	}
+
+	if err := k.HoldEscrow(ctx, msg.GetSwapRequester(), sdk.NewCoins(msg.OfferCoin.Add(msg.OfferCoinFee))); err != nil {
+		return types.SwapRouteMsgState{}, err
+	}
+
+	poolBatch.SwapRouteMsgIndex++
+	k.SetPoolBatch(ctx, poolBatch)
+	k.SetPoolBatchSwapRouteMsgState(ctx, poolBatch.PoolId, msgState)
+
+	return msgState, nil
+}
+
+// CancelDeposit cancels the deposit msg of the batch that is not executed yet, and releases the escrowed deposit coins
+// to the depositor. The msg state is deleted with the other batch msgs on the begin block after the batch execution.
+func (k Keeper) CancelDeposit(ctx sdk.Context, msg *types.MsgCancelDeposit) (types.DepositMsgState, error) {
+	batchMsg, found := k.GetPoolBatchDepositMsgState(ctx, msg.PoolId, msg.MsgIndex)
+	if !found {
+		return types.DepositMsgState{}, types.ErrBatchMsgNotExists
+	}
+	if !batchMsg.Msg.GetDepositor().Equals(msg.GetDepositor()) {
+		return types.DepositMsgState{}, types.ErrNotBatchMsgRequester
+	}
+	if batchMsg.Executed || batchMsg.ToBeDeleted {
+		return types.DepositMsgState{}, types.ErrBatchMsgNotCancelable
+	}
+
+	// the swap msg of the single asset deposit is cancelled together
+	if batchMsg.SwapMsgIndex != 0 {
+		swapMsg, found := k.GetPoolBatchSwapMsgState(ctx, msg.PoolId, batchMsg.SwapMsgIndex)
+		if found && !swapMsg.ToBeDeleted {
+			batchMsg.Msg.DepositCoins = batchMsg.Msg.DepositCoins.Add(swapMsg.RemainingOfferCoin.Add(swapMsg.ReservedOfferCoinFee))
+			swapMsg.ToBeDeleted = true
+			k.SetPoolBatchSwapMsgState(ctx, msg.PoolId, swapMsg)
+		}
+	}
+
+	if err := k.ReleaseEscrow(ctx, batchMsg.Msg.GetDepositor(), batchMsg.Msg.DepositCoins); err != nil {
+		return types.DepositMsgState{}, err
+	}
+
+	batchMsg.ToBeDeleted = true
+	k.SetPoolBatchDepositMsgState(ctx, msg.PoolId, batchMsg)
+
+	return batchMsg, nil
+}
+
+// CancelWithdraw cancels the withdraw msg of the batch that is not executed yet, and releases the escrowed pool coin
+// to the withdrawer. The msg state is deleted with the other batch msgs on the begin block after the batch execution.
+func (k Keeper) CancelWithdraw(ctx sdk.Context, msg *types.MsgCancelWithdraw) (types.WithdrawMsgState, error) {
+	batchMsg, found := k.GetPoolBatchWithdrawMsgState(ctx, msg.PoolId, msg.MsgIndex)
+	if !found {
+		return types.WithdrawMsgState{}, types.ErrBatchMsgNotExists
+	}
+	if !batchMsg.Msg.GetWithdrawer().Equals(msg.GetWithdrawer()) {
+		return types.WithdrawMsgState{}, types.ErrNotBatchMsgRequester
+	}
+	if batchMsg.Executed || batchMsg.ToBeDeleted {
+		return types.WithdrawMsgState{}, types.ErrBatchMsgNotCancelable
+	}
+
+	if err := k.ReleaseEscrow(ctx, batchMsg.Msg.GetWithdrawer(), sdk.NewCoins(batchMsg.Msg.PoolCoin)); err != nil {
+		return types.WithdrawMsgState{}, err
+	}
+
+	batchMsg.ToBeDeleted = true
+	k.SetPoolBatchWithdrawMsgState(ctx, msg.PoolId, batchMsg)
+
+	return batchMsg, nil
+}
+
+// CancelSwap cancels the swap msg of the batch that is not executed yet, including the orders carried over from the
+// previous batches, and releases the remaining offer coin and the unused reserved offer coin fee to the swap requester.
+// The msg state is deleted with the other batch msgs on the begin block after the batch execution.
+func (k Keeper) CancelSwap(ctx sdk.Context, msg *types.MsgCancelSwap) (types.SwapMsgState, error) {
+	batchMsg, found := k.GetPoolBatchSwapMsgState(ctx, msg.PoolId, msg.MsgIndex)
+	if !found {
+		return types.SwapMsgState{}, types.ErrBatchMsgNotExists
+	}
+	if !batchMsg.Msg.GetSwapRequester().Equals(msg.GetSwapRequester()) {
+		return types.SwapMsgState{}, types.ErrNotBatchMsgRequester
+	}
+	if batchMsg.Executed || batchMsg.ToBeDeleted || batchMsg.Internal {
+		return types.SwapMsgState{}, types.ErrBatchMsgNotCancelable
+	}
+
+	refundCoins := sdk.NewCoins(batchMsg.RemainingOfferCoin.Add(batchMsg.ReservedOfferCoinFee))
+	if !refundCoins.Empty() {
+		if err := k.ReleaseEscrow(ctx, batchMsg.Msg.GetSwapRequester(), refundCoins); err != nil {
+			return types.SwapMsgState{}, err
+		}
+	}
+
+	batchMsg.ToBeDeleted = true
+	k.SetPoolBatchSwapMsgState(ctx, msg.PoolId, batchMsg)
+
+	return batchMsg, nil
+}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gravity-devs/liquidity/v2/x/liquidity/types"
+)
+
+// StartPoolBatchRecord starts the record of the batch of the pool with the reserve coins before the batch execution.
+// The totals of the record are accumulated while the msgs of the batch are executed.
+func (k Keeper) StartPoolBatchRecord(ctx sdk.Context, pool types.Pool, batch types.PoolBatch) {
+	k.SetPoolBatchRecord(ctx, types.PoolBatchRecord{
+		PoolId:             pool.Id,
+		BatchIndex:         batch.Index,
+		Height:             ctx.BlockHeight(),
+		ReserveCoinsBefore: k.GetReserveCoins(ctx, pool),
+		MintedPoolCoin:     sdk.NewCoin(pool.PoolCoinDenom, sdk.ZeroInt()),
+		BurnedPoolCoin:     sdk.NewCoin(pool.PoolCoinDenom, sdk.ZeroInt()),
+	})
+}
+
+// FinishPoolBatchRecord records the reserve coins of the pool after the batch execution. The started record is deleted
+// when no msgs were executed in the batch, since the batch is executed again at the next batch execution height.
+func (k Keeper) FinishPoolBatchRecord(ctx sdk.Context, pool types.Pool, batch types.PoolBatch, executed bool) {
+	record, found := k.GetPoolBatchRecord(ctx, pool.Id, batch.Index)
+	if !found || record.Height != ctx.BlockHeight() {
+		return
+	}
+	if !executed {
+		k.DeletePoolBatchRecord(ctx, record)
+		return
+	}
+	record.ReserveCoinsAfter = k.GetReserveCoins(ctx, pool)
+	k.SetPoolBatchRecord(ctx, record)
+}
+
+// PrunePoolBatchRecords deletes the batch records of the pool older than the lifespan in blocks.
+func (k Keeper) PrunePoolBatchRecords(ctx sdk.Context, poolID uint64, lifespan uint32) {
+	minHeight := ctx.BlockHeight() - int64(lifespan)
+
+	var records []types.PoolBatchRecord
+	k.IteratePoolBatchRecords(ctx, poolID, func(record types.PoolBatchRecord) bool {
+		if record.Height >= minHeight {
+			return true
+		}
+		records = append(records, record)
+		return false
+	})
+	for _, record := range records {
+		k.DeletePoolBatchRecord(ctx, record)
+	}
+}
+
+// updatePoolBatchRecord updates the record of the batch being executed, if the batch is recorded.
+func (k Keeper) updatePoolBatchRecord(ctx sdk.Context, batch types.PoolBatch, update func(record *types.PoolBatchRecord)) {
+	record, found := k.GetPoolBatchRecord(ctx, batch.PoolId, batch.Index)
+	if !found || record.Height != ctx.BlockHeight() {
+		return
+	}
+	update(&record)
+	k.SetPoolBatchRecord(ctx, record)
+}
+
+// recordSucceededMsg adds a succeeded msg of the batch to the batch record.
+func (k Keeper) recordSucceededMsg(ctx sdk.Context, batch types.PoolBatch) {
+	k.updatePoolBatchRecord(ctx, batch, func(record *types.PoolBatchRecord) {
+		record.SucceededMsgCount++
+	})
+}
+
+// recordFailedMsg adds a failed msg of the batch to the batch record.
+func (k Keeper) recordFailedMsg(ctx sdk.Context, batch types.PoolBatch) {
+	k.updatePoolBatchRecord(ctx, batch, func(record *types.PoolBatchRecord) {
+		record.FailedMsgCount++
+	})
+}
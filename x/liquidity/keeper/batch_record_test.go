@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravity-devs/liquidity/v2/app"
+	"github.com/gravity-devs/liquidity/v2/x/liquidity"
+	"github.com/gravity-devs/liquidity/v2/x/liquidity/keeper"
+	"github.com/gravity-devs/liquidity/v2/x/liquidity/types"
+)
+
+func TestPoolBatchRecord(t *testing.T) {
+	simapp, ctx := createTestInput()
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+	params.BatchRecordLifespan = 5
+	params.WithdrawFeeRate = sdk.NewDecWithPrec(1, 2)
+	simapp.LiquidityKeeper.SetParams(ctx, params)
+
+	x, y := sdk.NewInt(1_000_000_000), sdk.NewInt(1_000_000_000)
+	creator := app.AddRandomTestAddr(simapp, ctx, params.PoolCreationFee)
+	poolID := app.TestCreatePool(t, simapp, ctx, x, y, DenomX, DenomY, creator)
+	pool, _ := simapp.LiquidityKeeper.GetPool(ctx, poolID)
+	reserveCoins := simapp.LiquidityKeeper.GetReserveCoins(ctx, pool)
+
+	// a deposit, a withdrawal and a swap succeed, and a deposit fails by the min pool coin amount
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	depositCoins := sdk.NewCoins(sdk.NewCoin(DenomX, sdk.NewInt(1_000_000)), sdk.NewCoin(DenomY, sdk.NewInt(1_000_000)))
+	depositor := app.AddRandomTestAddr(simapp, ctx, depositCoins.Add(depositCoins...))
+	_, err := simapp.LiquidityKeeper.DepositWithinBatch(ctx, types.NewMsgDepositWithinBatch(depositor, poolID, depositCoins))
+	require.NoError(t, err)
+	failingMsg := types.NewMsgDepositWithinBatch(depositor, poolID, depositCoins)
+	failingMsg.MinPoolCoinAmount = params.InitPoolCoinMintAmount
+	_, err = simapp.LiquidityKeeper.DepositWithinBatch(ctx, failingMsg)
+	require.NoError(t, err)
+
+	poolCoin := sdk.NewCoin(pool.PoolCoinDenom, sdk.NewInt(1_000))
+	_, err = simapp.LiquidityKeeper.WithdrawWithinBatch(ctx, types.NewMsgWithdrawWithinBatch(creator, poolID, poolCoin))
+	require.NoError(t, err)
+
+	swapFeeRate := simapp.LiquidityKeeper.GetPoolSwapFeeRate(ctx, pool)
+	offerCoin := sdk.NewCoin(DenomX, sdk.NewInt(1_000_000))
+	swapRequester := app.AddRandomTestAddr(simapp, ctx, sdk.NewCoins(offerCoin.Add(types.GetOfferCoinFee(offerCoin, swapFeeRate))))
+	_, err = simapp.LiquidityKeeper.SwapWithinBatch(ctx, types.NewMsgSwapWithinBatch(
+		swapRequester, poolID, types.DefaultSwapTypeID, offerCoin, DenomY, sdk.MustNewDecFromStr("1.1"), swapFeeRate), 0)
+	require.NoError(t, err)
+	batch, _ := simapp.LiquidityKeeper.GetPoolBatch(ctx, poolID)
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+	executedHeight := ctx.BlockHeight()
+
+	record, found := simapp.LiquidityKeeper.GetPoolBatchRecord(ctx, poolID, batch.Index)
+	require.True(t, found)
+	require.Equal(t, executedHeight, record.Height)
+	require.Equal(t, reserveCoins, record.ReserveCoinsBefore)
+	require.Equal(t, simapp.LiquidityKeeper.GetReserveCoins(ctx, pool), record.ReserveCoinsAfter)
+	// the deposit is accepted in the reserve ratio after the swap
+	require.True(t, record.DepositedCoins.IsAllPositive())
+	require.True(t, record.DepositedCoins.IsAllLTE(depositCoins))
+	require.True(t, record.MintedPoolCoin.IsPositive())
+	require.Equal(t, pool.PoolCoinDenom, record.MintedPoolCoin.Denom)
+	require.Equal(t, poolCoin, record.BurnedPoolCoin)
+	require.True(t, record.WithdrawnCoins.IsAllPositive())
+	require.True(t, record.WithdrawFeeCoins.IsAllPositive())
+	require.True(t, record.SwapFeeCoins.AmountOf(DenomX).IsPositive())
+	require.True(t, record.SwapFeeCoins.AmountOf(DenomY).IsPositive())
+	require.Equal(t, uint64(3), record.SucceededMsgCount)
+	require.Equal(t, uint64(1), record.FailedMsgCount)
+
+	// the batches without msgs are not recorded
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+	require.Len(t, simapp.LiquidityKeeper.GetAllPoolBatchRecords(ctx, poolID), 1)
+
+	// the records are queried by the pool and the batch index
+	querier := keeper.Querier{Keeper: simapp.LiquidityKeeper}
+	res, err := querier.PoolBatchRecord(sdk.WrapSDKContext(ctx), &types.QueryPoolBatchRecordRequest{PoolId: poolID, BatchIndex: batch.Index})
+	require.NoError(t, err)
+	require.Equal(t, record, res.BatchRecord)
+	_, err = querier.PoolBatchRecord(sdk.WrapSDKContext(ctx), &types.QueryPoolBatchRecordRequest{PoolId: poolID, BatchIndex: batch.Index + 1})
+	require.Error(t, err)
+	resAll, err := querier.PoolBatchRecords(sdk.WrapSDKContext(ctx), &types.QueryPoolBatchRecordsRequest{PoolId: poolID})
+	require.NoError(t, err)
+	require.Equal(t, []types.PoolBatchRecord{record}, resAll.BatchRecords)
+	_, err = querier.PoolBatchRecords(sdk.WrapSDKContext(ctx), &types.QueryPoolBatchRecordsRequest{PoolId: poolID + 1})
+	require.Error(t, err)
+
+	// the record is pruned after the lifespan
+	for ctx.BlockHeight() < executedHeight+int64(params.BatchRecordLifespan) {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+		liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+	}
+	_, found = simapp.LiquidityKeeper.GetPoolBatchRecord(ctx, poolID, batch.Index)
+	require.True(t, found)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+	require.Empty(t, simapp.LiquidityKeeper.GetAllPoolBatchRecords(ctx, poolID))
+
+	// the batches are not recorded when the lifespan is zero
+	params.BatchRecordLifespan = 0
+	simapp.LiquidityKeeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	app.TestDepositPool(t, simapp, ctx, x, y, []sdk.AccAddress{app.AddRandomTestAddr(simapp, ctx, nil)}, poolID, true)
+	require.Empty(t, simapp.LiquidityKeeper.GetAllPoolBatchRecords(ctx, poolID))
+}
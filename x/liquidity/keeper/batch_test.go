@@ -10,6 +10,7 @@ import (
 
 	"github.com/gravity-devs/liquidity/v2/app"
 	"github.com/gravity-devs/liquidity/v2/x/liquidity"
+	"github.com/gravity-devs/liquidity/v2/x/liquidity/keeper"
 	"github.com/gravity-devs/liquidity/v2/x/liquidity/types"
 )
 
@@ -629,6 +630,462 @@ func TestDepositRefundTooSmallDepositAmount(t *testing.T) {
 	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
 }
 
+func TestDepositRefundLessThanMinPoolCoinAmount(t *testing.T) {
+	simapp, ctx := createTestInput()
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+
+	x, y := sdk.NewInt(1_000_000_000), sdk.NewInt(1_000_000_000)
+	addrs := app.AddTestAddrs(simapp, ctx, 3, params.PoolCreationFee)
+	poolID := app.TestCreatePool(t, simapp, ctx, x, y, DenomX, DenomY, addrs[0])
+	pool, found := simapp.LiquidityKeeper.GetPool(ctx, poolID)
+	require.True(t, found)
+
+	// the deposit mints 1% of the pool coin supply
+	depositCoins := sdk.NewCoins(sdk.NewCoin(DenomX, sdk.NewInt(10_000_000)), sdk.NewCoin(DenomY, sdk.NewInt(10_000_000)))
+	expectedPoolCoinAmt := simapp.LiquidityKeeper.GetPoolCoinTotalSupply(ctx, pool).QuoRaw(100)
+
+	app.SaveAccount(simapp, ctx, addrs[1], depositCoins)
+	msg := types.NewMsgDepositWithinBatch(addrs[1], poolID, depositCoins)
+	msg.MinPoolCoinAmount = expectedPoolCoinAmt.AddRaw(1)
+	_, err := simapp.LiquidityKeeper.DepositWithinBatch(ctx, msg)
+	require.NoError(t, err)
+
+	app.SaveAccount(simapp, ctx, addrs[2], depositCoins)
+	msg = types.NewMsgDepositWithinBatch(addrs[2], poolID, depositCoins)
+	msg.MinPoolCoinAmount = expectedPoolCoinAmt
+	_, err = simapp.LiquidityKeeper.DepositWithinBatch(ctx, msg)
+	require.NoError(t, err)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+
+	// the deposit which mints less pool coin than the minimum is refunded with the reason
+	require.Equal(t, depositCoins, simapp.BankKeeper.GetAllBalances(ctx, addrs[1]).Sub(params.PoolCreationFee...))
+	require.True(t, simapp.BankKeeper.GetBalance(ctx, addrs[1], pool.PoolCoinDenom).IsZero())
+	reason := ""
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeDepositToPool {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeValueReason {
+				reason = string(attr.Value)
+			}
+		}
+	}
+	require.Equal(t, types.ErrLessThanMinPoolCoinAmount.Error(), reason)
+
+	// the deposit which mints the minimum pool coin amount is executed
+	require.Equal(t, expectedPoolCoinAmt, simapp.BankKeeper.GetBalance(ctx, addrs[2], pool.PoolCoinDenom).Amount)
+}
+
+func TestDepositSingleAssetWithinBatch(t *testing.T) {
+	simapp, ctx := createTestInput()
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+
+	x, y := sdk.NewInt(1_000_000_000), sdk.NewInt(1_000_000_000)
+	addrs := app.AddTestAddrs(simapp, ctx, 2, params.PoolCreationFee)
+	poolID := app.TestCreatePool(t, simapp, ctx, x, y, DenomX, DenomY, addrs[0])
+	pool, found := simapp.LiquidityKeeper.GetPool(ctx, poolID)
+	require.True(t, found)
+	poolCoinTotalSupply := simapp.LiquidityKeeper.GetPoolCoinTotalSupply(ctx, pool)
+
+	// a coin which is not a reserve coin of the pool can not be deposited
+	_, err := simapp.LiquidityKeeper.DepositSingleAssetWithinBatch(ctx, types.NewMsgDepositSingleAssetWithinBatch(addrs[1], poolID, sdk.NewCoin("denomZ", sdk.NewInt(1000))))
+	require.ErrorIs(t, err, types.ErrNotMatchedReserveCoin)
+
+	depositCoin := sdk.NewCoin(DenomX, sdk.NewInt(10_000_000))
+	app.SaveAccount(simapp, ctx, addrs[1], sdk.NewCoins(depositCoin))
+	msgState, err := simapp.LiquidityKeeper.DepositSingleAssetWithinBatch(ctx, types.NewMsgDepositSingleAssetWithinBatch(addrs[1], poolID, depositCoin))
+	require.NoError(t, err)
+	require.True(t, simapp.BankKeeper.GetBalance(ctx, addrs[1], DenomX).IsZero())
+
+	// the deposit coin is split into the internal swap msg and the deposit msg
+	swapMsgState, found := simapp.LiquidityKeeper.GetPoolBatchSwapMsgState(ctx, poolID, msgState.SwapMsgIndex)
+	require.True(t, found)
+	require.True(t, swapMsgState.Internal)
+	require.Equal(t, DenomY, swapMsgState.Msg.DemandCoinDenom)
+	require.Equal(t, depositCoin, msgState.Msg.DepositCoins[0].Add(swapMsgState.Msg.OfferCoin).Add(swapMsgState.Msg.OfferCoinFee))
+
+	// the internal swap msg can not be cancelled alone
+	_, err = simapp.LiquidityKeeper.CancelSwap(ctx, types.NewMsgCancelSwap(addrs[1], poolID, msgState.SwapMsgIndex))
+	require.ErrorIs(t, err, types.ErrBatchMsgNotCancelable)
+
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+
+	// the exchanged demand coin is deposited with the rest of the deposit coin in the same batch
+	swapMsgState, found = simapp.LiquidityKeeper.GetPoolBatchSwapMsgState(ctx, poolID, msgState.SwapMsgIndex)
+	require.True(t, found)
+	require.True(t, swapMsgState.ToBeDeleted)
+	require.True(t, swapMsgState.ExchangedDemandCoin.IsPositive())
+	msgState, found = simapp.LiquidityKeeper.GetPoolBatchDepositMsgState(ctx, poolID, msgState.MsgIndex)
+	require.True(t, found)
+	require.True(t, msgState.Succeeded)
+	require.Equal(t, swapMsgState.ExchangedDemandCoin.Amount, msgState.Msg.DepositCoins.AmountOf(DenomY))
+
+	// about a half of the deposit coin is minted as pool coin, and only a small amount is refunded
+	poolCoinAmt := simapp.BankKeeper.GetBalance(ctx, addrs[1], pool.PoolCoinDenom).Amount
+	require.True(t, poolCoinAmt.GT(poolCoinTotalSupply.Mul(depositCoin.Amount).Quo(x.MulRaw(2)).MulRaw(99).QuoRaw(100)))
+	require.True(t, simapp.BankKeeper.GetBalance(ctx, addrs[1], DenomX).Amount.LT(depositCoin.Amount.QuoRaw(1000)))
+	require.True(t, simapp.BankKeeper.GetBalance(ctx, addrs[1], DenomY).Amount.LT(depositCoin.Amount.QuoRaw(1000)))
+
+	// nothing is left in the escrow
+	escrowAcc := simapp.AccountKeeper.GetModuleAddress(types.ModuleName)
+	require.True(t, simapp.BankKeeper.GetAllBalances(ctx, escrowAcc).IsZero())
+}
+
+func TestWithdrawRefundLessThanMinWithdrawCoins(t *testing.T) {
+	simapp, ctx := createTestInput()
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+
+	x, y := sdk.NewInt(1_000_000_000), sdk.NewInt(1_000_000_000)
+	addrs := app.AddTestAddrs(simapp, ctx, 1, params.PoolCreationFee)
+	poolID := app.TestCreatePool(t, simapp, ctx, x, y, DenomX, DenomY, addrs[0])
+	pool, found := simapp.LiquidityKeeper.GetPool(ctx, poolID)
+	require.True(t, found)
+
+	// each withdrawal burns 1% of the pool coin supply
+	poolCoin := sdk.NewCoin(pool.PoolCoinDenom, simapp.LiquidityKeeper.GetPoolCoinTotalSupply(ctx, pool).QuoRaw(100))
+	withdrawAmt := sdk.NewDecFromInt(x.QuoRaw(100)).Mul(sdk.OneDec().Sub(params.WithdrawFeeRate)).TruncateInt()
+	expectedWithdrawCoins := sdk.NewCoins(sdk.NewCoin(DenomX, withdrawAmt), sdk.NewCoin(DenomY, withdrawAmt))
+	poolCoinBalance := simapp.BankKeeper.GetBalance(ctx, addrs[0], pool.PoolCoinDenom)
+
+	msg := types.NewMsgWithdrawWithinBatch(addrs[0], poolID, poolCoin)
+	msg.MinWithdrawCoins = sdk.NewCoins(sdk.NewCoin(DenomX, withdrawAmt.AddRaw(1)))
+	_, err := simapp.LiquidityKeeper.WithdrawWithinBatch(ctx, msg)
+	require.NoError(t, err)
+
+	msg = types.NewMsgWithdrawWithinBatch(addrs[0], poolID, poolCoin)
+	msg.MinWithdrawCoins = expectedWithdrawCoins
+	_, err = simapp.LiquidityKeeper.WithdrawWithinBatch(ctx, msg)
+	require.NoError(t, err)
+
+	// the denoms of the minimum withdraw coins must be the reserve coin denoms
+	msg = types.NewMsgWithdrawWithinBatch(addrs[0], poolID, poolCoin)
+	msg.MinWithdrawCoins = sdk.NewCoins(sdk.NewCoin("denomZ", sdk.NewInt(1)))
+	_, err = simapp.LiquidityKeeper.WithdrawWithinBatch(ctx, msg)
+	require.ErrorIs(t, err, types.ErrNotMatchedReserveCoin)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+
+	// only the withdrawal which withdraws the minimum withdraw coins is executed,
+	// the other one is refunded with the reason
+	require.Equal(t, poolCoinBalance.Sub(poolCoin), simapp.BankKeeper.GetBalance(ctx, addrs[0], pool.PoolCoinDenom))
+	require.Equal(t, withdrawAmt, simapp.BankKeeper.GetBalance(ctx, addrs[0], DenomX).Amount)
+	require.Equal(t, withdrawAmt, simapp.BankKeeper.GetBalance(ctx, addrs[0], DenomY).Amount)
+	reason := ""
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeWithdrawFromPool {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeValueReason {
+				reason = string(attr.Value)
+			}
+		}
+	}
+	require.Equal(t, types.ErrLessThanMinWithdrawCoins.Error(), reason)
+}
+
+func TestWithdrawWithTargetDenom(t *testing.T) {
+	simapp, ctx := createTestInput()
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+
+	x, y := sdk.NewInt(1_000_000_000), sdk.NewInt(1_000_000_000)
+	addrs := app.AddTestAddrs(simapp, ctx, 1, params.PoolCreationFee)
+	poolID := app.TestCreatePool(t, simapp, ctx, x, y, DenomX, DenomY, addrs[0])
+	pool, found := simapp.LiquidityKeeper.GetPool(ctx, poolID)
+	require.True(t, found)
+	poolCoinTotalSupply := simapp.LiquidityKeeper.GetPoolCoinTotalSupply(ctx, pool)
+
+	// the target denom must be a reserve coin denom
+	msg := types.NewMsgWithdrawWithinBatch(addrs[0], poolID, sdk.NewCoin(pool.PoolCoinDenom, poolCoinTotalSupply.QuoRaw(100)))
+	msg.TargetDenom = "denomZ"
+	_, err := simapp.LiquidityKeeper.WithdrawWithinBatch(ctx, msg)
+	require.ErrorIs(t, err, types.ErrNotMatchedReserveCoin)
+
+	// withdraws 1% of the pool and swaps the withdrawn Y into X
+	msg.TargetDenom = DenomX
+	res, err := keeper.NewMsgServerImpl(simapp.LiquidityKeeper).WithdrawWithinBatch(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	require.Equal(t, DenomX, res.TargetDenom)
+	msgState, found := simapp.LiquidityKeeper.GetPoolBatchWithdrawMsgState(ctx, poolID, res.MsgIndex)
+	require.True(t, found)
+
+	// the swap of a withdrawal of a half of the pool exceeds the max order amount ratio, so it is refunded
+	refundedMsg := types.NewMsgWithdrawWithinBatch(addrs[0], poolID, sdk.NewCoin(pool.PoolCoinDenom, poolCoinTotalSupply.QuoRaw(2)))
+	refundedMsg.TargetDenom = DenomY
+	refundedMsgState, err := simapp.LiquidityKeeper.WithdrawWithinBatch(ctx, refundedMsg)
+	require.NoError(t, err)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+
+	refundedMsgState, found = simapp.LiquidityKeeper.GetPoolBatchWithdrawMsgState(ctx, poolID, refundedMsgState.MsgIndex)
+	require.True(t, found)
+	require.False(t, refundedMsgState.Succeeded)
+
+	msgState, found = simapp.LiquidityKeeper.GetPoolBatchWithdrawMsgState(ctx, poolID, msgState.MsgIndex)
+	require.True(t, found)
+	require.True(t, msgState.Succeeded)
+	swapMsgState, found := simapp.LiquidityKeeper.GetPoolBatchSwapMsgState(ctx, poolID, msgState.SwapMsgIndex)
+	require.True(t, found)
+	require.False(t, swapMsgState.Internal)
+	require.True(t, swapMsgState.Succeeded)
+	require.True(t, swapMsgState.RemainingOfferCoin.IsZero())
+
+	// the target coin is paid to the withdrawer, only a dust of Y which can not pay the swap fee is left
+	withdrawAmt := sdk.NewDecFromInt(x.QuoRaw(100)).Mul(sdk.OneDec().Sub(params.WithdrawFeeRate)).TruncateInt()
+	require.Equal(t, DenomX, msgState.TargetCoin.Denom)
+	require.Equal(t, withdrawAmt.Add(swapMsgState.ExchangedDemandCoin.Amount), msgState.TargetCoin.Amount)
+	require.Equal(t, msgState.TargetCoin, simapp.BankKeeper.GetBalance(ctx, addrs[0], DenomX))
+	require.Equal(t, res.ExpectedTargetCoin, msgState.TargetCoin)
+	require.True(t, msgState.TargetCoin.Amount.GT(withdrawAmt.MulRaw(2).MulRaw(97).QuoRaw(100)))
+	require.True(t, simapp.BankKeeper.GetBalance(ctx, addrs[0], DenomY).Amount.LTE(sdk.OneInt()))
+
+	targetCoin := ""
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeWithdrawTargetCoin {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeValueTargetCoin {
+				targetCoin = string(attr.Value)
+			}
+		}
+	}
+	require.Equal(t, msgState.TargetCoin.String(), targetCoin)
+
+	// nothing is left in the escrow
+	escrowAcc := simapp.AccountKeeper.GetModuleAddress(types.ModuleName)
+	require.True(t, simapp.BankKeeper.GetAllBalances(ctx, escrowAcc).IsZero())
+}
+
+func TestWithdrawWithTargetDenomRefund(t *testing.T) {
+	simapp, ctx := createTestInput()
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+
+	x, y := sdk.NewInt(1_000_000_000), sdk.NewInt(1_000_000)
+	addrs := app.AddTestAddrs(simapp, ctx, 1, params.PoolCreationFee)
+	poolID := app.TestCreatePool(t, simapp, ctx, x, y, DenomX, DenomY, addrs[0])
+	pool, _ := simapp.LiquidityKeeper.GetPool(ctx, poolID)
+
+	// the withdrawn Y of the withdrawal is less than the minimum offer coin amount of the target swap
+	msg := types.NewMsgWithdrawWithinBatch(addrs[0], poolID, sdk.NewCoin(pool.PoolCoinDenom, sdk.NewInt(50)))
+	msg.TargetDenom = DenomX
+	msgState, err := simapp.LiquidityKeeper.WithdrawWithinBatch(ctx, msg)
+	require.NoError(t, err)
+
+	balances := simapp.BankKeeper.GetAllBalances(ctx, addrs[0]).Add(msg.PoolCoin)
+	reserveCoins := simapp.LiquidityKeeper.GetReserveCoins(ctx, pool)
+	poolCoinTotalSupply := simapp.LiquidityKeeper.GetPoolCoinTotalSupply(ctx, pool)
+
+	// the withdrawal is refunded as a whole without paying out the reserve coins or burning the pool coins
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+
+	msgState, found := simapp.LiquidityKeeper.GetPoolBatchWithdrawMsgState(ctx, poolID, msgState.MsgIndex)
+	require.True(t, found)
+	require.True(t, msgState.Executed)
+	require.False(t, msgState.Succeeded)
+	require.Zero(t, msgState.SwapMsgIndex)
+	require.Equal(t, balances, simapp.BankKeeper.GetAllBalances(ctx, addrs[0]))
+	require.Equal(t, reserveCoins, simapp.LiquidityKeeper.GetReserveCoins(ctx, pool))
+	require.Equal(t, poolCoinTotalSupply, simapp.LiquidityKeeper.GetPoolCoinTotalSupply(ctx, pool))
+	escrowAcc := simapp.AccountKeeper.GetModuleAddress(types.ModuleName)
+	require.True(t, simapp.BankKeeper.GetAllBalances(ctx, escrowAcc).IsZero())
+
+	reason := ""
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeWithdrawFromPool {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeValueReason {
+				reason = string(attr.Value)
+			}
+		}
+	}
+	require.Equal(t, types.ErrLessThanMinOfferAmount.Error(), reason)
+}
+
+func TestSwapRoute(t *testing.T) {
+	simapp, ctx := createTestInput()
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+	denomZ := "denomZ"
+
+	x, y, z := sdk.NewInt(1_000_000_000), sdk.NewInt(1_000_000_000), sdk.NewInt(2_000_000_000)
+	addrs := app.AddTestAddrs(simapp, ctx, 3, params.PoolCreationFee.Add(params.PoolCreationFee...))
+	poolID1 := app.TestCreatePool(t, simapp, ctx, x, y, DenomX, DenomY, addrs[0])
+	poolID2 := app.TestCreatePool(t, simapp, ctx, y, z, DenomY, denomZ, addrs[0])
+	pool1, _ := simapp.LiquidityKeeper.GetPool(ctx, poolID1)
+	pool2, _ := simapp.LiquidityKeeper.GetPool(ctx, poolID2)
+	reserveCoins1 := simapp.LiquidityKeeper.GetReserveCoins(ctx, pool1)
+	reserveCoins2 := simapp.LiquidityKeeper.GetReserveCoins(ctx, pool2)
+
+	// the denom of the minimum demand coin must be reachable through the route
+	offerCoin := sdk.NewCoin(DenomX, sdk.NewInt(1_000_000))
+	_, err := simapp.LiquidityKeeper.SwapRouteWithinBatch(ctx, types.NewMsgSwapRoute(addrs[1], []uint64{poolID1, poolID2}, offerCoin, sdk.NewCoin(DenomY, sdk.OneInt()), params.SwapFeeRate))
+	require.ErrorIs(t, err, types.ErrBadSwapRoute)
+	_, err = simapp.LiquidityKeeper.SwapRouteWithinBatch(ctx, types.NewMsgSwapRoute(addrs[1], []uint64{poolID2, poolID1}, offerCoin, sdk.NewCoin(denomZ, sdk.OneInt()), params.SwapFeeRate))
+	require.ErrorIs(t, err, types.ErrNotMatchedReserveCoin)
+
+	// swaps X for Z through the pools of X/Y and Y/Z, about 2 Z for 1 X
+	msg := types.NewMsgSwapRoute(addrs[1], []uint64{poolID1, poolID2}, offerCoin, sdk.NewCoin(denomZ, sdk.NewInt(1_900_000)), params.SwapFeeRate)
+	app.SaveAccount(simapp, ctx, addrs[1], sdk.NewCoins(offerCoin.Add(msg.OfferCoinFee)))
+	msgState, err := simapp.LiquidityKeeper.SwapRouteWithinBatch(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, simapp.BankKeeper.GetBalance(ctx, addrs[1], DenomX).IsZero())
+
+	// the same route with an unreachable minimum demand coin is refunded as a whole
+	refundedMsg := types.NewMsgSwapRoute(addrs[2], []uint64{poolID1, poolID2}, offerCoin, sdk.NewCoin(denomZ, sdk.NewInt(2_000_000)), params.SwapFeeRate)
+	app.SaveAccount(simapp, ctx, addrs[2], sdk.NewCoins(offerCoin.Add(refundedMsg.OfferCoinFee)))
+	refundedMsgState, err := simapp.LiquidityKeeper.SwapRouteWithinBatch(ctx, refundedMsg)
+	require.NoError(t, err)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+
+	msgState, found := simapp.LiquidityKeeper.GetPoolBatchSwapRouteMsgState(ctx, poolID1, msgState.MsgIndex)
+	require.True(t, found)
+	require.True(t, msgState.Succeeded)
+	require.True(t, msgState.ToBeDeleted)
+	require.True(t, msgState.ExchangedDemandCoin.Amount.GTE(msg.MinDemandCoin.Amount))
+	require.Equal(t, msgState.ExchangedDemandCoin, simapp.BankKeeper.GetBalance(ctx, addrs[1], denomZ))
+	require.True(t, simapp.BankKeeper.GetBalance(ctx, addrs[1], DenomX).IsZero())
+
+	// only the intermediate coin of the succeeded route is transacted with the reserves
+	reserveCoins1After := simapp.LiquidityKeeper.GetReserveCoins(ctx, pool1)
+	reserveCoins2After := simapp.LiquidityKeeper.GetReserveCoins(ctx, pool2)
+	require.Equal(t, reserveCoins1.AmountOf(DenomX).Add(offerCoin.Amount).Add(msg.OfferCoinFee.Amount), reserveCoins1After.AmountOf(DenomX))
+	require.Equal(t, reserveCoins2.AmountOf(denomZ).Sub(msgState.ExchangedDemandCoin.Amount), reserveCoins2After.AmountOf(denomZ))
+	require.Equal(t, reserveCoins1.AmountOf(DenomY).Sub(reserveCoins1After.AmountOf(DenomY)),
+		reserveCoins2After.AmountOf(DenomY).Sub(reserveCoins2.AmountOf(DenomY)).Add(simapp.BankKeeper.GetBalance(ctx, addrs[1], DenomY).Amount))
+
+	refundedMsgState, found = simapp.LiquidityKeeper.GetPoolBatchSwapRouteMsgState(ctx, poolID1, refundedMsgState.MsgIndex)
+	require.True(t, found)
+	require.False(t, refundedMsgState.Succeeded)
+	require.True(t, refundedMsgState.ToBeDeleted)
+	require.Equal(t, offerCoin.Add(refundedMsg.OfferCoinFee), simapp.BankKeeper.GetBalance(ctx, addrs[2], DenomX))
+	require.True(t, simapp.BankKeeper.GetBalance(ctx, addrs[2], denomZ).IsZero())
+
+	reason := ""
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypeSwapRouteTransacted {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeValueReason {
+				reason = string(attr.Value)
+			}
+		}
+	}
+	require.Equal(t, types.ErrLessThanMinDemandCoin.Error(), reason)
+
+	// nothing is left in the escrow
+	escrowAcc := simapp.AccountKeeper.GetModuleAddress(types.ModuleName)
+	require.True(t, simapp.BankKeeper.GetAllBalances(ctx, escrowAcc).IsZero())
+
+	// the executed swap route msg states are deleted on the next begin block
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	_, found = simapp.LiquidityKeeper.GetPoolBatchSwapRouteMsgState(ctx, poolID1, msgState.MsgIndex)
+	require.False(t, found)
+}
+
+func TestSwapRouteSkipsIntermediateBatch(t *testing.T) {
+	simapp, ctx := createTestInput()
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+	denomZ := "denomZ"
+
+	x, y, z := sdk.NewInt(1_000_000_000), sdk.NewInt(1_000_000_000), sdk.NewInt(1_000_000_000)
+	addrs := app.AddTestAddrs(simapp, ctx, 3, params.PoolCreationFee.Add(params.PoolCreationFee...))
+	poolID1 := app.TestCreatePool(t, simapp, ctx, x, y, DenomX, DenomY, addrs[0])
+
+	// the intermediate pool executes its batch every 5 blocks
+	depositCoins := sdk.NewCoins(sdk.NewCoin(DenomY, y), sdk.NewCoin(denomZ, z))
+	app.SaveAccount(simapp, ctx, addrs[0], depositCoins)
+	createMsg := types.NewMsgCreatePool(addrs[0], types.DefaultPoolTypeID, depositCoins)
+	createMsg.BatchInterval = 5
+	pool2, err := simapp.LiquidityKeeper.CreatePool(ctx, createMsg)
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(11)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+
+	// a swap is queued in the batch of the intermediate pool before the swap route
+	queuedCoin := sdk.NewCoin(denomZ, sdk.NewInt(1_000_000))
+	app.SaveAccount(simapp, ctx, addrs[2], sdk.NewCoins(queuedCoin.Add(types.GetOfferCoinFee(queuedCoin, params.SwapFeeRate))))
+	queuedMsgState, err := simapp.LiquidityKeeper.SwapWithinBatch(ctx, types.NewMsgSwapWithinBatch(
+		addrs[2], pool2.Id, types.DefaultSwapTypeID, queuedCoin, DenomY, sdk.MustNewDecFromStr("0.9"), params.SwapFeeRate), 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(15), queuedMsgState.OrderExpiryHeight)
+
+	offerCoin := sdk.NewCoin(DenomX, sdk.NewInt(1_000_000))
+	msg := types.NewMsgSwapRoute(addrs[1], []uint64{poolID1, pool2.Id}, offerCoin, sdk.NewCoin(denomZ, sdk.OneInt()), params.SwapFeeRate)
+	app.SaveAccount(simapp, ctx, addrs[1], sdk.NewCoins(offerCoin.Add(msg.OfferCoinFee)))
+	msgState, err := simapp.LiquidityKeeper.SwapRouteWithinBatch(ctx, msg)
+	require.NoError(t, err)
+	reserveCoins2 := simapp.LiquidityKeeper.GetReserveCoins(ctx, pool2)
+
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+
+	// the swap of the route is matched alone with the intermediate pool at the batch height of the first pool,
+	// without waiting for the batch of the intermediate pool or being matched with the swap queued in it
+	msgState, found := simapp.LiquidityKeeper.GetPoolBatchSwapRouteMsgState(ctx, poolID1, msgState.MsgIndex)
+	require.True(t, found)
+	require.True(t, msgState.Succeeded)
+	require.Equal(t, msgState.ExchangedDemandCoin, simapp.BankKeeper.GetBalance(ctx, addrs[1], denomZ))
+	require.Equal(t, reserveCoins2.AmountOf(denomZ).Sub(msgState.ExchangedDemandCoin.Amount),
+		simapp.LiquidityKeeper.GetReserveCoins(ctx, pool2).AmountOf(denomZ))
+
+	batch2, _ := simapp.LiquidityKeeper.GetPoolBatch(ctx, pool2.Id)
+	require.False(t, batch2.Executed)
+	queuedMsgState, found = simapp.LiquidityKeeper.GetPoolBatchSwapMsgState(ctx, pool2.Id, queuedMsgState.MsgIndex)
+	require.True(t, found)
+	require.False(t, queuedMsgState.Executed)
+	require.Equal(t, queuedCoin, queuedMsgState.RemainingOfferCoin)
+}
+
+func TestSwapRouteWeightedPool(t *testing.T) {
+	simapp, ctx := createTestInput()
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+	denomZ := "denomZ"
+
+	defer func(flag bool) { keeper.BatchLogicInvariantCheckFlag = flag }(keeper.BatchLogicInvariantCheckFlag)
+	keeper.BatchLogicInvariantCheckFlag = true
+
+	// the first pool of the route is weighted 80:20, of which the price of Y moves 5 times of the offered ratio of X
+	depositCoins := sdk.NewCoins(sdk.NewCoin(DenomX, sdk.NewInt(4_000_000_000)), sdk.NewCoin(DenomY, sdk.NewInt(1_000_000_000)))
+	addrs := app.AddTestAddrs(simapp, ctx, 2, params.PoolCreationFee.Add(params.PoolCreationFee...))
+	app.SaveAccount(simapp, ctx, addrs[0], depositCoins)
+	createMsg := types.NewMsgCreatePool(addrs[0], types.DefaultPoolTypeID, depositCoins)
+	createMsg.ReserveCoinWeights = []uint32{80, 20}
+	pool1, err := simapp.LiquidityKeeper.CreatePool(ctx, createMsg)
+	require.NoError(t, err)
+	poolID2 := app.TestCreatePool(t, simapp, ctx, sdk.NewInt(10_000_000_000), sdk.NewInt(10_000_000_000), DenomY, denomZ, addrs[0])
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+
+	// the offer coin is close to the max order amount ratio of the reserve of X in the weighted pool
+	offerCoin := sdk.NewCoin(DenomX, sdk.NewInt(360_000_000))
+	msg := types.NewMsgSwapRoute(addrs[1], []uint64{pool1.Id, poolID2}, offerCoin, sdk.NewCoin(denomZ, sdk.OneInt()), params.SwapFeeRate)
+	app.SaveAccount(simapp, ctx, addrs[1], sdk.NewCoins(offerCoin.Add(msg.OfferCoinFee)))
+	msgState, err := simapp.LiquidityKeeper.SwapRouteWithinBatch(ctx, msg)
+	require.NoError(t, err)
+
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+
+	// the swap of the weighted pool is fully matched, so that the route succeeds
+	msgState, found := simapp.LiquidityKeeper.GetPoolBatchSwapRouteMsgState(ctx, pool1.Id, msgState.MsgIndex)
+	require.True(t, found)
+	require.True(t, msgState.Succeeded)
+	require.True(t, msgState.ExchangedDemandCoin.IsPositive())
+	require.Equal(t, msgState.ExchangedDemandCoin, simapp.BankKeeper.GetBalance(ctx, addrs[1], denomZ))
+	require.True(t, simapp.BankKeeper.GetBalance(ctx, addrs[1], DenomX).IsZero())
+}
+
 // This scenario tests deposit refund scenario
 func TestDepositRefundDeletedPool(t *testing.T) {
 	simapp, ctx := createTestInput()
@@ -1049,3 +1506,105 @@ func TestDeleteAndInitPoolBatchWithdraw(t *testing.T) {
 	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
 	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
 }
+
+func TestCancelDeposit(t *testing.T) {
+	simapp, ctx := app.CreateTestInput()
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+
+	x, y := sdk.NewInt(1_000_000_000), sdk.NewInt(1_000_000_000)
+	addrs := app.AddTestAddrs(simapp, ctx, 3, params.PoolCreationFee)
+	poolID := app.TestCreatePool(t, simapp, ctx, x, y, DenomX, DenomY, addrs[0])
+
+	depositCoins := sdk.NewCoins(sdk.NewCoin(DenomX, sdk.NewInt(10000)), sdk.NewCoin(DenomY, sdk.NewInt(10000)))
+	app.SaveAccount(simapp, ctx, addrs[1], depositCoins)
+	msgState, err := simapp.LiquidityKeeper.DepositWithinBatch(ctx, types.NewMsgDepositWithinBatch(addrs[1], poolID, depositCoins))
+	require.NoError(t, err)
+	require.True(t, simapp.BankKeeper.GetBalance(ctx, addrs[1], DenomX).IsZero())
+
+	// cancel a msg which does not exist
+	_, err = simapp.LiquidityKeeper.CancelDeposit(ctx, types.NewMsgCancelDeposit(addrs[1], poolID, msgState.MsgIndex+1))
+	require.ErrorIs(t, err, types.ErrBatchMsgNotExists)
+
+	// cancel a msg of the other depositor
+	_, err = simapp.LiquidityKeeper.CancelDeposit(ctx, types.NewMsgCancelDeposit(addrs[2], poolID, msgState.MsgIndex))
+	require.ErrorIs(t, err, types.ErrNotBatchMsgRequester)
+
+	// the deposit coins are released from the escrow
+	cancelled, err := simapp.LiquidityKeeper.CancelDeposit(ctx, types.NewMsgCancelDeposit(addrs[1], poolID, msgState.MsgIndex))
+	require.NoError(t, err)
+	require.True(t, cancelled.ToBeDeleted)
+	require.False(t, cancelled.Executed)
+	require.Equal(t, depositCoins, simapp.BankKeeper.GetAllBalances(ctx, addrs[1]).Sub(params.PoolCreationFee...))
+
+	// the msg can not be cancelled twice
+	_, err = simapp.LiquidityKeeper.CancelDeposit(ctx, types.NewMsgCancelDeposit(addrs[1], poolID, msgState.MsgIndex))
+	require.ErrorIs(t, err, types.ErrBatchMsgNotCancelable)
+
+	// the cancelled msg is not executed
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+	state, found := simapp.LiquidityKeeper.GetPoolBatchDepositMsgState(ctx, poolID, msgState.MsgIndex)
+	require.True(t, found)
+	require.False(t, state.Executed)
+	require.Equal(t, depositCoins, simapp.BankKeeper.GetAllBalances(ctx, addrs[1]).Sub(params.PoolCreationFee...))
+}
+
+func TestCancelSingleAssetDeposit(t *testing.T) {
+	simapp, ctx := app.CreateTestInput()
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+
+	x, y := sdk.NewInt(1_000_000_000), sdk.NewInt(1_000_000_000)
+	addrs := app.AddTestAddrs(simapp, ctx, 2, params.PoolCreationFee)
+	poolID := app.TestCreatePool(t, simapp, ctx, x, y, DenomX, DenomY, addrs[0])
+
+	depositCoin := sdk.NewCoin(DenomY, sdk.NewInt(10000))
+	app.SaveAccount(simapp, ctx, addrs[1], sdk.NewCoins(depositCoin))
+	msgState, err := simapp.LiquidityKeeper.DepositSingleAssetWithinBatch(ctx, types.NewMsgDepositSingleAssetWithinBatch(addrs[1], poolID, depositCoin))
+	require.NoError(t, err)
+
+	// the internal swap msg is cancelled together, and the whole deposit coin is released from the escrow
+	cancelled, err := simapp.LiquidityKeeper.CancelDeposit(ctx, types.NewMsgCancelDeposit(addrs[1], poolID, msgState.MsgIndex))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(depositCoin), cancelled.Msg.DepositCoins)
+	require.Equal(t, depositCoin, simapp.BankKeeper.GetBalance(ctx, addrs[1], DenomY))
+	swapMsgState, found := simapp.LiquidityKeeper.GetPoolBatchSwapMsgState(ctx, poolID, msgState.SwapMsgIndex)
+	require.True(t, found)
+	require.True(t, swapMsgState.ToBeDeleted)
+
+	// the cancelled msgs are not executed
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+	require.Equal(t, depositCoin, simapp.BankKeeper.GetBalance(ctx, addrs[1], DenomY))
+}
+
+func TestCancelWithdraw(t *testing.T) {
+	simapp, ctx := app.CreateTestInput()
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+
+	x, y := sdk.NewInt(1_000_000_000), sdk.NewInt(1_000_000_000)
+	addrs := app.AddTestAddrs(simapp, ctx, 2, params.PoolCreationFee)
+	poolID := app.TestCreatePool(t, simapp, ctx, x, y, DenomX, DenomY, addrs[0])
+	pool, found := simapp.LiquidityKeeper.GetPool(ctx, poolID)
+	require.True(t, found)
+
+	poolCoin := sdk.NewCoin(pool.PoolCoinDenom, sdk.NewInt(1000))
+	poolCoinBalance := simapp.BankKeeper.GetBalance(ctx, addrs[0], pool.PoolCoinDenom)
+	msgState, err := simapp.LiquidityKeeper.WithdrawWithinBatch(ctx, types.NewMsgWithdrawWithinBatch(addrs[0], poolID, poolCoin))
+	require.NoError(t, err)
+	require.Equal(t, poolCoinBalance.Sub(poolCoin), simapp.BankKeeper.GetBalance(ctx, addrs[0], pool.PoolCoinDenom))
+
+	// cancel a msg of the other withdrawer
+	_, err = simapp.LiquidityKeeper.CancelWithdraw(ctx, types.NewMsgCancelWithdraw(addrs[1], poolID, msgState.MsgIndex))
+	require.ErrorIs(t, err, types.ErrNotBatchMsgRequester)
+
+	// the pool coin is released from the escrow
+	cancelled, err := simapp.LiquidityKeeper.CancelWithdraw(ctx, types.NewMsgCancelWithdraw(addrs[0], poolID, msgState.MsgIndex))
+	require.NoError(t, err)
+	require.True(t, cancelled.ToBeDeleted)
+	require.Equal(t, poolCoinBalance, simapp.BankKeeper.GetBalance(ctx, addrs[0], pool.PoolCoinDenom))
+
+	// the executed msg can not be cancelled
+	msgState, err = simapp.LiquidityKeeper.WithdrawWithinBatch(ctx, types.NewMsgWithdrawWithinBatch(addrs[0], poolID, poolCoin))
+	require.NoError(t, err)
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+	_, err = simapp.LiquidityKeeper.CancelWithdraw(ctx, types.NewMsgCancelWithdraw(addrs[0], poolID, msgState.MsgIndex))
+	require.ErrorIs(t, err, types.ErrBatchMsgNotCancelable)
+}
@@ -5,6 +5,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -126,6 +127,83 @@ func (k Querier) LiquidityPools(c context.Context, req *types.QueryLiquidityPool
 	}, nil
 }
 
+// LiquidityPoolsByReserveCoinDenom queries all liquidity pools with the given reserve coin denom.
+func (k Querier) LiquidityPoolsByReserveCoinDenom(c context.Context, req *types.QueryLiquidityPoolsByReserveCoinDenomRequest) (*types.QueryLiquidityPoolsResponse, error) {
+	empty := &types.QueryLiquidityPoolsByReserveCoinDenomRequest{}
+	if req == nil || *req == *empty {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	indexStore := prefix.NewStore(store, types.GetPoolsByReserveCoinDenomIndexPrefix(req.ReserveCoinDenom))
+
+	var pools types.Pools
+
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, _ []byte) error {
+		pool, found := k.GetPool(ctx, sdk.BigEndianToUint64(key))
+		if found {
+			pools = append(pools, pool)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if len(pools) == 0 {
+		return nil, status.Errorf(codes.NotFound, "there are no pools with the reserve coin denom %s", req.ReserveCoinDenom)
+	}
+
+	return &types.QueryLiquidityPoolsResponse{
+		Pools:      pools,
+		Pagination: pageRes,
+	}, nil
+}
+
+// LiquidityPoolsByPair queries all liquidity pools of the given pool type with both denoms of the given pair.
+func (k Querier) LiquidityPoolsByPair(c context.Context, req *types.QueryLiquidityPoolsByPairRequest) (*types.QueryLiquidityPoolsResponse, error) {
+	if req == nil || req.DenomX == "" || req.DenomY == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	if req.DenomX == req.DenomY {
+		return nil, status.Errorf(codes.InvalidArgument, "the denoms of the pair must be different")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	indexStore := prefix.NewStore(store, types.GetPoolsByReserveCoinDenomIndexPrefix(req.DenomX))
+
+	var pools types.Pools
+
+	pageRes, err := query.FilteredPaginate(indexStore, req.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		pool, found := k.GetPool(ctx, sdk.BigEndianToUint64(key))
+		if !found || (req.PoolTypeId != 0 && pool.TypeId != req.PoolTypeId) || !pool.HasReserveCoinDenom(req.DenomY) {
+			return false, nil
+		}
+		if accumulate {
+			pools = append(pools, pool)
+		}
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if len(pools) == 0 {
+		return nil, status.Errorf(codes.NotFound, "there are no pools with the pair %s/%s", req.DenomX, req.DenomY)
+	}
+
+	return &types.QueryLiquidityPoolsResponse{
+		Pools:      pools,
+		Pagination: pageRes,
+	}, nil
+}
+
 // PoolBatchSwapMsg queries the pool batch swap message with the message index of the liquidity pool.
 func (k Querier) PoolBatchSwapMsg(c context.Context, req *types.QueryPoolBatchSwapMsgRequest) (*types.QueryPoolBatchSwapMsgResponse, error) {
 	empty := &types.QueryPoolBatchSwapMsgRequest{}
@@ -301,6 +379,328 @@ func (k Querier) PoolBatchWithdrawMsgs(c context.Context, req *types.QueryPoolBa
 	}, nil
 }
 
+// PoolTwap queries the time-weighted average price of the pool between the start and end heights,
+// or between the start and end times when no heights are given.
+func (k Querier) PoolTwap(c context.Context, req *types.QueryPoolTwapRequest) (*types.QueryPoolTwapResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if _, found := k.GetPool(ctx, req.PoolId); !found {
+		return nil, status.Errorf(codes.NotFound, "liquidity pool %d doesn't exist", req.PoolId)
+	}
+
+	var twap sdk.Dec
+	var err error
+	switch {
+	case req.StartHeight != 0 || req.EndHeight != 0:
+		twap, err = k.GetPoolTwapByHeight(ctx, req.PoolId, req.StartHeight, req.EndHeight)
+	case req.StartTime != nil && req.EndTime != nil:
+		twap, err = k.GetPoolTwap(ctx, req.PoolId, *req.StartTime, *req.EndTime)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "start and end heights or start and end times are required")
+	}
+	if errors.Is(err, types.ErrNoPriceRecord) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	} else if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryPoolTwapResponse{
+		Twap: twap,
+	}, nil
+}
+
+// PoolBatchRecords queries all batch records of the liquidity pool.
+func (k Querier) PoolBatchRecords(c context.Context, req *types.QueryPoolBatchRecordsRequest) (*types.QueryPoolBatchRecordsResponse, error) {
+	empty := &types.QueryPoolBatchRecordsRequest{}
+	if req == nil || *req == *empty {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	_, found := k.GetPool(ctx, req.PoolId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "liquidity pool %d doesn't exist", req.PoolId)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	recordStore := prefix.NewStore(store, types.GetPoolBatchRecordsPrefix(req.PoolId))
+	var records []types.PoolBatchRecord
+
+	pageRes, err := query.Paginate(recordStore, req.Pagination, func(key []byte, value []byte) error {
+		record, err := types.UnmarshalPoolBatchRecord(k.cdc, value)
+		if err != nil {
+			return err
+		}
+
+		records = append(records, record)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPoolBatchRecordsResponse{
+		BatchRecords: records,
+		Pagination:   pageRes,
+	}, nil
+}
+
+// PoolBatchRecord queries the batch record of the liquidity pool with the given batch index.
+func (k Querier) PoolBatchRecord(c context.Context, req *types.QueryPoolBatchRecordRequest) (*types.QueryPoolBatchRecordResponse, error) {
+	empty := &types.QueryPoolBatchRecordRequest{}
+	if req == nil || *req == *empty {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	record, found := k.GetPoolBatchRecord(ctx, req.PoolId, req.BatchIndex)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "the batch record of batch_index %d doesn't exist or pruned", req.BatchIndex)
+	}
+
+	return &types.QueryPoolBatchRecordResponse{
+		BatchRecord: record,
+	}, nil
+}
+
+// BatchMsgsByAddress queries all batch messages of the given depositor, withdrawer or swap requester address in the
+// batches of all pools. The executed messages are deleted with their index at the next begin block, so they are
+// returned only until then.
+func (k Querier) BatchMsgsByAddress(c context.Context, req *types.QueryBatchMsgsByAddressRequest) (*types.QueryBatchMsgsByAddressResponse, error) {
+	empty := &types.QueryBatchMsgsByAddressRequest{}
+	if req == nil || *req == *empty {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "the address %s is not valid", req.Address)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	indexStore := prefix.NewStore(store, types.GetBatchMsgStatesByAddressIndexPrefix(addr))
+	res := &types.QueryBatchMsgsByAddressResponse{}
+
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, _ []byte) error {
+		value := store.Get(key)
+		if value == nil {
+			return nil
+		}
+
+		switch key[0] {
+		case types.PoolBatchDepositMsgStateIndexKeyPrefix[0]:
+			state, err := types.UnmarshalDepositMsgState(k.cdc, value)
+			if err != nil {
+				return err
+			}
+			res.Deposits = append(res.Deposits, state)
+		case types.PoolBatchWithdrawMsgStateIndexKeyPrefix[0]:
+			state, err := types.UnmarshalWithdrawMsgState(k.cdc, value)
+			if err != nil {
+				return err
+			}
+			res.Withdraws = append(res.Withdraws, state)
+		case types.PoolBatchSwapMsgStateIndexKeyPrefix[0]:
+			state, err := types.UnmarshalSwapMsgState(k.cdc, value)
+			if err != nil {
+				return err
+			}
+			res.Swaps = append(res.Swaps, state)
+		case types.PoolBatchSwapRouteMsgStateIndexKeyPrefix[0]:
+			state, err := types.UnmarshalSwapRouteMsgState(k.cdc, value)
+			if err != nil {
+				return err
+			}
+			res.SwapRoutes = append(res.SwapRoutes, state)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res.Pagination = pageRes
+	return res, nil
+}
+
+// EstimateDeposit queries the result of a deposit to the liquidity pool on the current reserves.
+func (k Querier) EstimateDeposit(c context.Context, req *types.QueryEstimateDepositRequest) (*types.QueryEstimateDepositResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	depositCoins, err := sdk.ParseCoinsNormalized(req.DepositCoins)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// the deposit is calculated on a cache context, so that no state of the query context is written
+	ctx, _ := sdk.UnwrapSDKContext(c).CacheContext()
+
+	if _, found := k.GetPool(ctx, req.PoolId); !found {
+		return nil, status.Errorf(codes.NotFound, "liquidity pool %d doesn't exist", req.PoolId)
+	}
+
+	poolCoin, acceptedCoins, refundedCoins, err := k.CalculateDeposit(ctx, types.MsgDepositWithinBatch{
+		PoolId:       req.PoolId,
+		DepositCoins: depositCoins,
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryEstimateDepositResponse{
+		PoolCoin:      poolCoin,
+		AcceptedCoins: acceptedCoins,
+		RefundedCoins: refundedCoins,
+	}, nil
+}
+
+// EstimateWithdraw queries the result of a withdrawal from the liquidity pool on the current reserves.
+func (k Querier) EstimateWithdraw(c context.Context, req *types.QueryEstimateWithdrawRequest) (*types.QueryEstimateWithdrawResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	poolCoin, err := sdk.ParseCoinNormalized(req.PoolCoin)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if !poolCoin.IsPositive() {
+		return nil, status.Errorf(codes.InvalidArgument, "pool coin amount must be positive")
+	}
+
+	// the withdrawal is calculated on a cache context, so that no state of the query context is written
+	ctx, _ := sdk.UnwrapSDKContext(c).CacheContext()
+
+	if _, found := k.GetPool(ctx, req.PoolId); !found {
+		return nil, status.Errorf(codes.NotFound, "liquidity pool %d doesn't exist", req.PoolId)
+	}
+
+	withdrawCoins, withdrawFeeCoins, err := k.CalculateWithdrawal(ctx, types.MsgWithdrawWithinBatch{
+		PoolId:   req.PoolId,
+		PoolCoin: poolCoin,
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryEstimateWithdrawResponse{
+		WithdrawCoins:    withdrawCoins,
+		WithdrawFeeCoins: withdrawFeeCoins,
+	}, nil
+}
+
+// SimulateSwap queries the expected result of a swap in the batch of the liquidity pool on the current reserves.
+func (k Querier) SimulateSwap(c context.Context, req *types.QuerySimulateSwapRequest) (*types.QuerySimulateSwapResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	offerCoin, err := sdk.ParseCoinNormalized(req.OfferCoin)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if !offerCoin.IsPositive() {
+		return nil, status.Errorf(codes.InvalidArgument, "offer coin amount must be positive")
+	}
+
+	// the swap is calculated on a cache context, so that no state of the query context is written
+	ctx, _ := sdk.UnwrapSDKContext(c).CacheContext()
+
+	pool, found := k.GetPool(ctx, req.PoolId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "liquidity pool %d doesn't exist", req.PoolId)
+	}
+	if offerCoin.Denom == req.DemandCoinDenom || !pool.HasReserveCoinDenom(offerCoin.Denom) || !pool.HasReserveCoinDenom(req.DemandCoinDenom) {
+		return nil, status.Error(codes.InvalidArgument, types.ErrNotMatchedReserveCoin.Error())
+	}
+	if k.IsDepletedPool(ctx, pool) {
+		return nil, status.Error(codes.InvalidArgument, types.ErrDepletedPool.Error())
+	}
+
+	denomX, denomY := types.AlphabeticalDenomPair(offerCoin.Denom, req.DemandCoinDenom)
+	direction := types.DirectionXtoY
+	if offerCoin.Denom == denomY {
+		direction = types.DirectionYtoX
+	}
+	reserveCoins := k.GetReserveCoins(ctx, pool)
+	poolPrice := k.GetPoolSwapCurve(ctx, pool, denomX, denomY).Price(
+		sdk.NewDecFromInt(reserveCoins.AmountOf(denomX)), sdk.NewDecFromInt(reserveCoins.AmountOf(denomY)))
+
+	orderPrice := types.GetMaxDeviatedOrderPrice(poolPrice, direction)
+	if req.OrderPrice != "" {
+		orderPrice, err = sdk.NewDecFromStr(req.OrderPrice)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if !orderPrice.IsPositive() {
+			return nil, status.Errorf(codes.InvalidArgument, "order price must be positive")
+		}
+	}
+
+	match, batchResult, err := k.CalculateSwap(ctx, types.MsgSwapWithinBatch{
+		PoolId:          req.PoolId,
+		SwapTypeId:      types.DefaultSwapTypeID,
+		OfferCoin:       offerCoin,
+		DemandCoinDenom: req.DemandCoinDenom,
+		OfferCoinFee:    types.GetOfferCoinFee(offerCoin, k.GetPoolSwapFeeRate(ctx, pool)),
+		OrderPrice:      orderPrice,
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// the amounts are truncated as they are transacted in the batch execution
+	receiveAmt := match.ExchangedDemandCoinAmt.Sub(match.ExchangedCoinFeeAmt).TruncateInt()
+	offerCoinFeeAmt := match.OfferCoinFeeAmt.TruncateInt()
+	exchangedCoinFeeAmt := match.ExchangedCoinFeeAmt.TruncateInt()
+
+	swapPrice := batchResult.SwapPrice
+	if swapPrice.IsNil() || !swapPrice.IsPositive() {
+		swapPrice = poolPrice
+	}
+	// the effective price is expressed in X/Y as the pool price and the swap price
+	effectivePrice := sdk.ZeroDec()
+	paidAmt := match.SwapMsgState.ExchangedOfferCoin.Amount.Add(offerCoinFeeAmt)
+	if receiveAmt.IsPositive() {
+		if direction == types.DirectionXtoY {
+			effectivePrice = sdk.NewDecFromInt(paidAmt).QuoInt(receiveAmt)
+		} else {
+			effectivePrice = sdk.NewDecFromInt(receiveAmt).QuoInt(paidAmt)
+		}
+	}
+
+	params := k.GetParams(ctx)
+	reserveAmt := reserveCoins.AmountOf(offerCoin.Denom)
+
+	return &types.QuerySimulateSwapResponse{
+		ExchangedOfferCoin:  match.SwapMsgState.ExchangedOfferCoin,
+		RemainingOfferCoin:  match.SwapMsgState.RemainingOfferCoin,
+		ExchangedDemandCoin: sdk.NewCoin(req.DemandCoinDenom, receiveAmt),
+		OfferCoinFee:        sdk.NewCoin(offerCoin.Denom, offerCoinFeeAmt),
+		ExchangedCoinFee:    sdk.NewCoin(req.DemandCoinDenom, exchangedCoinFeeAmt),
+		PoolPrice:           poolPrice,
+		SwapPrice:           swapPrice,
+		EffectivePrice:      effectivePrice,
+		PriceImpact:         swapPrice.Sub(poolPrice).Abs().Quo(poolPrice),
+		MaxOrderCoin:        sdk.NewCoin(offerCoin.Denom, sdk.NewDecFromInt(reserveAmt).MulTruncate(params.MaxOrderAmountRatio).TruncateInt()),
+		OrderAmountRatio:    sdk.NewDecFromInt(offerCoin.Amount).QuoInt(reserveAmt),
+	}, nil
+}
+
 // Params queries params of liquidity module.
 func (k Querier) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -40,6 +40,12 @@ func LiquidityPoolsEscrowAmountInvariant(k Keeper) sdk.Invariant {
 			for _, msg := range withdrawMsgs {
 				remainingCoins = remainingCoins.Add(msg.Msg.PoolCoin)
 			}
+			k.IterateAllPoolBatchSwapRouteMsgStates(ctx, batch, func(msg types.SwapRouteMsgState) bool {
+				if !msg.ToBeDeleted {
+					remainingCoins = remainingCoins.Add(msg.Msg.OfferCoin)
+				}
+				return false
+			})
 		}
 
 		batchEscrowAcc := k.accountKeeper.GetModuleAddress(types.ModuleName)
@@ -69,6 +75,12 @@ var (
 	coinAmountThreshold = sdk.NewInt(20)           // If a decimal error occurs at a value less than 20, the error rate is over 5%.
 )
 
+// isProportionalPoolType returns true if the pool type is one of the built-in pool types of two reserve coins,
+// of which the deposits and withdrawals are checked by the proportional invariants below.
+func isProportionalPoolType(poolTypeID uint32) bool {
+	return poolTypeID == types.DefaultPoolTypeID || poolTypeID == types.StableSwapPoolTypeID
+}
+
 func errorRate(expected, actual sdk.Dec) sdk.Dec {
 	// To prevent divide-by-zero panics, return 1.0(=100%) as the error rate
 	// when the expected value is 0.
@@ -233,3 +245,90 @@ func ImmutablePoolPriceAfterWithdrawInvariant(reserveCoinA, reserveCoinB, withdr
 		}
 	}
 }
+
+// SwapPriceDirectionInvariants checks that the swap price moves in the price direction of the batch result.
+func SwapPriceDirectionInvariants(currentPoolPrice sdk.Dec, batchResult types.BatchResult) {
+	if batchResult.MatchType == types.NoMatch {
+		return
+	}
+	switch batchResult.PriceDirection {
+	case types.Increasing:
+		if !batchResult.SwapPrice.GTE(currentPoolPrice) {
+			panic("invariant check fails due to incorrect price direction")
+		}
+	case types.Decreasing:
+		if !batchResult.SwapPrice.LTE(currentPoolPrice) {
+			panic("invariant check fails due to incorrect price direction")
+		}
+	case types.Staying:
+		if !batchResult.SwapPrice.Equal(currentPoolPrice) {
+			panic("invariant check fails due to incorrect price direction")
+		}
+	}
+}
+
+// SwapPriceInvariants checks that all matched orders are executed at the swap price of the batch result
+// and that no order is matched at a price worse than its order price.
+func SwapPriceInvariants(matchResultXtoY, matchResultYtoX []types.MatchResult, batchResult types.BatchResult) {
+	if !types.CheckSwapPrice(matchResultXtoY, matchResultYtoX, batchResult.SwapPrice) {
+		panic("invariant check fails due to incorrect swap price")
+	}
+	for _, m := range matchResultXtoY {
+		if m.SwapMsgState.Msg.OrderPrice.LT(batchResult.SwapPrice) {
+			panic("invariant check fails due to incorrect order price of the matched X to Y order")
+		}
+	}
+	for _, m := range matchResultYtoX {
+		if m.SwapMsgState.Msg.OrderPrice.GT(batchResult.SwapPrice) {
+			panic("invariant check fails due to incorrect order price of the matched Y to X order")
+		}
+	}
+}
+
+// SwapMsgStatesInvariants checks the consistency of the swap msg states after matching.
+func SwapMsgStatesInvariants(swapMsgStates []*types.SwapMsgState) {
+	for _, sms := range swapMsgStates {
+		if !sms.Executed {
+			panic("invariant check fails due to not executed swap msg state")
+		}
+		// ExchangedOfferCoin + RemainingOfferCoin = OfferCoin
+		if !sms.ExchangedOfferCoin.Add(sms.RemainingOfferCoin).IsEqual(sms.Msg.OfferCoin) {
+			panic("invariant check fails due to incorrect remaining offer coin")
+		}
+		if sms.ReservedOfferCoinFee.IsNegative() || sms.ReservedOfferCoinFee.Amount.GT(sms.Msg.OfferCoinFee.Amount) {
+			panic("invariant check fails due to incorrect reserved offer coin fee")
+		}
+		if sms.RemainingOfferCoin.IsZero() && (!sms.Succeeded || !sms.ToBeDeleted) {
+			panic("invariant check fails due to incorrect state of the fully matched swap msg state")
+		}
+	}
+}
+
+// PoolCoinValueInvariant checks that the invariant of the pool curve per pool coin does not decrease
+// by a deposit or a withdrawal.
+func PoolCoinValueInvariant(curve types.PoolCurve, amplification uint32, reserveCoinWeights []uint32, lastReserveCoins sdk.Coins,
+	lastPoolCoinSupply sdk.Int, afterReserveCoins sdk.Coins, afterPoolCoinSupply sdk.Int) {
+	if lastPoolCoinSupply.LT(coinAmountThreshold) || afterPoolCoinSupply.LT(coinAmountThreshold) {
+		return
+	}
+	for _, coin := range append(lastReserveCoins, afterReserveCoins...) {
+		if coin.Amount.LT(coinAmountThreshold) {
+			return
+		}
+	}
+
+	lastValue := curve.Invariant(amplification, lastReserveCoins, reserveCoinWeights).QuoInt(lastPoolCoinSupply)
+	afterValue := curve.Invariant(amplification, afterReserveCoins, reserveCoinWeights).QuoInt(afterPoolCoinSupply)
+	if afterValue.LT(lastValue) && errorRate(lastValue, afterValue).GT(errorRateThreshold) {
+		panic("invariant check fails due to decreased pool coin value")
+	}
+}
+
+// SwapCurveInvariant checks that the invariant of the pool curve does not decrease by the swaps of a batch.
+func SwapCurveInvariant(curve types.PoolCurve, amplification uint32, reserveCoinWeights []uint32, lastReserveCoins, afterReserveCoins sdk.Coins) {
+	lastInvariant := curve.Invariant(amplification, lastReserveCoins, reserveCoinWeights)
+	afterInvariant := curve.Invariant(amplification, afterReserveCoins, reserveCoinWeights)
+	if afterInvariant.LT(lastInvariant) && errorRate(lastInvariant, afterInvariant).GT(errorRateThreshold) {
+		panic("invariant check fails due to decreased pool curve invariant")
+	}
+}
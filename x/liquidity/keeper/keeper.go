@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -19,14 +20,18 @@ type Keeper struct {
 	bankKeeper    types.BankKeeper
 	accountKeeper types.AccountKeeper
 	distrKeeper   types.DistributionKeeper
-	paramSpace    paramstypes.Subspace
+	paramSpace    paramstypes.Subspace // legacy params subspace, only used to migrate the params to the module store
+	poolCurves    map[uint32]types.PoolCurve
+	authority     string
 }
 
 // NewKeeper returns a liquidity keeper. It handles:
 // - creating new ModuleAccounts for each pool ReserveAccount
 // - sending to and from ModuleAccounts
 // - minting, burning PoolCoins
-func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramstypes.Subspace, bankKeeper types.BankKeeper, accountKeeper types.AccountKeeper, distrKeeper types.DistributionKeeper) Keeper {
+// The authority is the address which can execute the governance msgs of the module, usually the gov module account.
+// The params are kept in the module store, and the params subspace is only used by the migration of the legacy params.
+func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramstypes.Subspace, bankKeeper types.BankKeeper, accountKeeper types.AccountKeeper, distrKeeper types.DistributionKeeper, authority string) Keeper {
 	// ensure liquidity module account is set
 	if addr := accountKeeper.GetModuleAddress(types.ModuleName); addr == nil {
 		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
@@ -44,27 +49,85 @@ func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace params
 		distrKeeper:   distrKeeper,
 		cdc:           cdc,
 		paramSpace:    paramSpace,
+		poolCurves: map[uint32]types.PoolCurve{
+			types.DefaultPoolTypeID:    types.ConstantProductCurve{},
+			types.StableSwapPoolTypeID: types.StableSwapCurve{},
+			types.MultiAssetPoolTypeID: types.MultiAssetCurve{},
+		},
+		authority: authority,
 	}
 }
 
+// GetAuthority returns the address which can execute the governance msgs of the module.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// RegisterPoolCurve registers the curve of the pool type id, which must be registered before the pools of the pool
+// type are created. It panics when a curve is already registered for the pool type id.
+func (k Keeper) RegisterPoolCurve(poolTypeID uint32, curve types.PoolCurve) {
+	if _, found := k.poolCurves[poolTypeID]; found {
+		panic(fmt.Sprintf("curve of pool type %d is already registered", poolTypeID))
+	}
+	k.poolCurves[poolTypeID] = curve
+}
+
+// GetPoolCurve returns the curve registered for the pool type id.
+func (k Keeper) GetPoolCurve(poolTypeID uint32) (types.PoolCurve, bool) {
+	curve, found := k.poolCurves[poolTypeID]
+	return curve, found
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", types.ModuleName)
 }
 
-// GetParams gets the parameters for the liquidity module.
+// GetParams gets the parameters for the liquidity module from the module store.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+	k.cdc.MustUnmarshal(bz, &params)
 	return params
 }
 
-// SetParams sets the parameters for the liquidity module.
+// SetParams sets the parameters for the liquidity module to the module store, it panics when the params are invalid.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
-	k.paramSpace.SetParamSet(ctx, &params)
+	if err := params.Validate(); err != nil {
+		panic(err)
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ParamsKey, k.cdc.MustMarshal(&params))
+}
+
+// UpdateParams replaces the params of the liquidity module with the params of the msg of the authority.
+func (k Keeper) UpdateParams(ctx sdk.Context, msg *types.MsgUpdateParams) error {
+	if msg.Authority != k.authority {
+		return sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Authority)
+	}
+	if err := msg.Params.Validate(); err != nil {
+		return sdkerrors.Wrap(types.ErrBadParams, err.Error())
+	}
+	k.SetParams(ctx, msg.Params)
+	return nil
+}
+
+// GetCircuitBreakerEnabled returns circuit breaker enabled param.
+func (k Keeper) GetCircuitBreakerEnabled(ctx sdk.Context) bool {
+	return k.GetParams(ctx).CircuitBreakerEnabled
+}
+
+// SetCircuitBreakerEnabled sets circuit breaker enabled param.
+func (k Keeper) SetCircuitBreakerEnabled(ctx sdk.Context, enabled bool) {
+	params := k.GetParams(ctx)
+	params.CircuitBreakerEnabled = enabled
+	k.SetParams(ctx, params)
 }
 
-// GetCircuitBreakerEnabled returns circuit breaker enabled param from the paramspace.
-func (k Keeper) GetCircuitBreakerEnabled(ctx sdk.Context) (enabled bool) {
-	k.paramSpace.Get(ctx, types.KeyCircuitBreakerEnabled, &enabled)
-	return
+// GetSwapOrderLifespan returns swap order lifespan param.
+func (k Keeper) GetSwapOrderLifespan(ctx sdk.Context) uint32 {
+	return k.GetParams(ctx).SwapOrderLifespan
 }
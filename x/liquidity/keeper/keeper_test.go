@@ -62,3 +62,109 @@ func TestCircuitBreakerEnabled(t *testing.T) {
 	enabled = app.LiquidityKeeper.GetCircuitBreakerEnabled(ctx)
 	require.Equal(t, true, enabled)
 }
+
+func TestSetCircuitBreaker(t *testing.T) {
+	simapp, ctx := createTestInput()
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+	msgServer := keeper.NewMsgServerImpl(simapp.LiquidityKeeper)
+
+	x, y := sdk.NewInt(1_000_000_000), sdk.NewInt(1_000_000_000)
+	addrs := lapp.AddTestAddrs(simapp, ctx, 3, params.PoolCreationFee)
+	poolID := lapp.TestCreatePool(t, simapp, ctx, x, y, DenomX, DenomY, addrs[0])
+	guardian, authority := addrs[2], simapp.LiquidityKeeper.GetAuthority()
+	params.Guardians = []string{guardian.String()}
+	simapp.LiquidityKeeper.SetParams(ctx, params)
+
+	depositCoins := sdk.NewCoins(sdk.NewCoin(DenomX, sdk.NewInt(1_000_000)), sdk.NewCoin(DenomY, sdk.NewInt(1_000_000)))
+	lapp.SaveAccount(simapp, ctx, addrs[1], depositCoins)
+	depositMsg := types.NewMsgDepositWithinBatch(addrs[1], poolID, depositCoins)
+
+	// only the authority and the guardians can set the circuit breaker
+	_, err := msgServer.SetCircuitBreaker(sdk.WrapSDKContext(ctx), types.NewMsgSetCircuitBreaker(addrs[1], 0, true))
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// the circuit breaker of all pools enabled by a guardian rejects the following msgs
+	_, err = msgServer.SetCircuitBreaker(sdk.WrapSDKContext(ctx), types.NewMsgSetCircuitBreaker(guardian, 0, true))
+	require.NoError(t, err)
+	require.True(t, simapp.LiquidityKeeper.GetCircuitBreakerEnabled(ctx))
+	_, err = msgServer.DepositWithinBatch(sdk.WrapSDKContext(ctx), depositMsg)
+	require.ErrorIs(t, err, types.ErrCircuitBreakerEnabled)
+
+	// a guardian can not disable the circuit breaker, while the authority can
+	_, err = msgServer.SetCircuitBreaker(sdk.WrapSDKContext(ctx), types.NewMsgSetCircuitBreaker(guardian, 0, false))
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+	_, err = msgServer.SetCircuitBreaker(sdk.WrapSDKContext(ctx), &types.MsgSetCircuitBreaker{Authority: authority, Enabled: false})
+	require.NoError(t, err)
+	require.False(t, simapp.LiquidityKeeper.GetCircuitBreakerEnabled(ctx))
+
+	// the circuit breaker of the pool makes the pool withdraw-only, but does not relax a more restrictive status
+	_, err = msgServer.SetCircuitBreaker(sdk.WrapSDKContext(ctx), types.NewMsgSetCircuitBreaker(guardian, poolID+1, true))
+	require.ErrorIs(t, err, types.ErrPoolNotExists)
+	_, err = msgServer.SetCircuitBreaker(sdk.WrapSDKContext(ctx), types.NewMsgSetCircuitBreaker(guardian, poolID, true))
+	require.NoError(t, err)
+	pool, _ := simapp.LiquidityKeeper.GetPool(ctx, poolID)
+	require.Equal(t, types.PoolStatusWithdrawOnly, pool.Status)
+	require.False(t, simapp.LiquidityKeeper.GetCircuitBreakerEnabled(ctx))
+	_, err = msgServer.DepositWithinBatch(sdk.WrapSDKContext(ctx), depositMsg)
+	require.ErrorIs(t, err, types.ErrPoolStatusNotAllowed)
+
+	pool.Status = types.PoolStatusFrozen
+	simapp.LiquidityKeeper.SetPool(ctx, pool)
+	_, err = msgServer.SetCircuitBreaker(sdk.WrapSDKContext(ctx), types.NewMsgSetCircuitBreaker(guardian, poolID, true))
+	require.NoError(t, err)
+	pool, _ = simapp.LiquidityKeeper.GetPool(ctx, poolID)
+	require.Equal(t, types.PoolStatusFrozen, pool.Status)
+
+	_, err = msgServer.SetCircuitBreaker(sdk.WrapSDKContext(ctx), types.NewMsgSetCircuitBreaker(guardian, poolID, false))
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// disabling the circuit breaker does not relax the status which is not set by the circuit breaker
+	_, err = msgServer.SetCircuitBreaker(sdk.WrapSDKContext(ctx), &types.MsgSetCircuitBreaker{Authority: authority, PoolId: poolID, Enabled: false})
+	require.ErrorIs(t, err, types.ErrBadPoolStatus)
+	pool, _ = simapp.LiquidityKeeper.GetPool(ctx, poolID)
+	require.Equal(t, types.PoolStatusFrozen, pool.Status)
+
+	pool.Status = types.PoolStatusWithdrawOnly
+	simapp.LiquidityKeeper.SetPool(ctx, pool)
+	_, err = msgServer.SetCircuitBreaker(sdk.WrapSDKContext(ctx), &types.MsgSetCircuitBreaker{Authority: authority, PoolId: poolID, Enabled: false})
+	require.NoError(t, err)
+	pool, _ = simapp.LiquidityKeeper.GetPool(ctx, poolID)
+	require.Equal(t, types.PoolStatusActive, pool.Status)
+	_, err = msgServer.DepositWithinBatch(sdk.WrapSDKContext(ctx), depositMsg)
+	require.NoError(t, err)
+
+	_, err = msgServer.WindDownPool(sdk.WrapSDKContext(ctx), &types.MsgWindDownPool{Authority: authority, PoolId: poolID})
+	require.NoError(t, err)
+	_, err = msgServer.SetCircuitBreaker(sdk.WrapSDKContext(ctx), &types.MsgSetCircuitBreaker{Authority: authority, PoolId: poolID, Enabled: false})
+	require.ErrorIs(t, err, types.ErrBadPoolStatus)
+	pool, _ = simapp.LiquidityKeeper.GetPool(ctx, poolID)
+	require.Equal(t, types.PoolStatusWindDown, pool.Status)
+}
+
+func TestUpdateParams(t *testing.T) {
+	simapp, ctx := createTestInput()
+	msgServer := keeper.NewMsgServerImpl(simapp.LiquidityKeeper)
+	authority, err := sdk.AccAddressFromBech32(simapp.LiquidityKeeper.GetAuthority())
+	require.NoError(t, err)
+
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+	params.CircuitBreakerEnabled = true
+	params.SwapOrderLifespan = 10
+
+	// only the authority can update the params
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(lapp.AddTestAddrs(simapp, ctx, 1, nil)[0], params))
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	invalidParams := params
+	invalidParams.UnitBatchHeight = 0
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(authority, invalidParams))
+	require.ErrorIs(t, err, types.ErrBadParams)
+	require.False(t, simapp.LiquidityKeeper.GetCircuitBreakerEnabled(ctx))
+
+	// the params are kept in the module store
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(ctx), types.NewMsgUpdateParams(authority, params))
+	require.NoError(t, err)
+	require.Equal(t, params, simapp.LiquidityKeeper.GetParams(ctx))
+	require.True(t, simapp.LiquidityKeeper.GetCircuitBreakerEnabled(ctx))
+	require.Equal(t, uint32(10), simapp.LiquidityKeeper.GetSwapOrderLifespan(ctx))
+}
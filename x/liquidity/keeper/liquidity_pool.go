@@ -24,6 +24,9 @@ func (k Keeper) ValidateMsgCreatePool(ctx sdk.Context, msg *types.MsgCreatePool)
 	} else {
 		return types.ErrPoolTypeNotExists
 	}
+	if _, found := k.GetPoolCurve(poolType.Id); !found {
+		return types.ErrPoolTypeNotExists
+	}
 
 	reserveCoinNum := uint32(msg.DepositCoins.Len())
 	if reserveCoinNum > poolType.MaxReserveCoinNum || poolType.MinReserveCoinNum > reserveCoinNum {
@@ -35,20 +38,50 @@ func (k Keeper) ValidateMsgCreatePool(ctx sdk.Context, msg *types.MsgCreatePool)
 		reserveCoinDenoms[i] = msg.DepositCoins.GetDenomByIndex(i)
 	}
 
-	denomA, denomB := types.AlphabeticalDenomPair(reserveCoinDenoms[0], reserveCoinDenoms[1])
-	if denomA != msg.DepositCoins[0].Denom || denomB != msg.DepositCoins[1].Denom {
-		return types.ErrBadOrderingReserveCoin
-	}
-
-	if denomA == denomB {
-		return types.ErrEqualDenom
+	// the reserve coin denoms are sorted alphabetically without duplicates
+	for i := 1; i < len(reserveCoinDenoms); i++ {
+		if reserveCoinDenoms[i-1] == reserveCoinDenoms[i] {
+			return types.ErrEqualDenom
+		}
+		if reserveCoinDenoms[i-1] > reserveCoinDenoms[i] {
+			return types.ErrBadOrderingReserveCoin
+		}
 	}
 
 	if err := types.ValidateReserveCoinLimit(params.MaxReserveCoinAmount, msg.DepositCoins); err != nil {
 		return err
 	}
 
-	poolName := types.PoolName(reserveCoinDenoms, msg.PoolTypeId)
+	// the swap fee rate of the pool is one of the swap fee tiers, zero means the swap fee rate of the params
+	if swapFeeRate := msg.GetSwapFeeRate(); !swapFeeRate.IsZero() {
+		found := false
+		for _, tier := range params.SwapFeeTiers {
+			if tier.Equal(swapFeeRate) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return types.ErrSwapFeeTierNotExists
+		}
+	}
+
+	// the batch interval of the pool is up to the max batch interval, zero means the unit batch height of the params
+	if msg.BatchInterval > params.MaxBatchInterval {
+		return sdkerrors.Wrapf(types.ErrBadBatchInterval, "%d is greater than %d", msg.BatchInterval, params.MaxBatchInterval)
+	}
+
+	// the initial deposit sets the pool price of the weighted reserve coins, which the StableSwap curve does not support
+	if len(msg.ReserveCoinWeights) > 0 {
+		if msg.PoolTypeId == types.StableSwapPoolTypeID {
+			return types.ErrBadReserveCoinWeights
+		}
+		if err := types.ValidateReserveCoinWeights(msg.ReserveCoinWeights, len(msg.DepositCoins)); err != nil {
+			return err
+		}
+	}
+
+	poolName := types.PoolName(reserveCoinDenoms, msg.PoolTypeId, msg.ReserveCoinWeights)
 	reserveAcc := types.GetPoolReserveAcc(poolName, false)
 	_, found := k.GetPoolByReserveAccIndex(ctx, reserveAcc)
 	if found {
@@ -95,10 +128,12 @@ func (k Keeper) CreatePool(ctx sdk.Context, msg *types.MsgCreatePool) (types.Poo
 
 	params := k.GetParams(ctx)
 
-	denom1, denom2 := types.AlphabeticalDenomPair(msg.DepositCoins[0].Denom, msg.DepositCoins[1].Denom)
-	reserveCoinDenoms := []string{denom1, denom2}
+	reserveCoinDenoms := make([]string, len(msg.DepositCoins))
+	for i, coin := range msg.DepositCoins {
+		reserveCoinDenoms[i] = coin.Denom
+	}
 
-	poolName := types.PoolName(reserveCoinDenoms, msg.PoolTypeId)
+	poolName := types.PoolName(reserveCoinDenoms, msg.PoolTypeId, msg.ReserveCoinWeights)
 
 	pool := types.Pool{
 		//Id: will set on SetPoolAtomic
@@ -106,6 +141,12 @@ func (k Keeper) CreatePool(ctx sdk.Context, msg *types.MsgCreatePool) (types.Poo
 		ReserveCoinDenoms:     reserveCoinDenoms,
 		ReserveAccountAddress: types.GetPoolReserveAcc(poolName, false).String(),
 		PoolCoinDenom:         types.GetPoolCoinDenom(poolName),
+		ReserveCoinWeights:    msg.ReserveCoinWeights,
+		SwapFeeRate:           msg.GetSwapFeeRate(),
+		BatchInterval:         msg.BatchInterval,
+	}
+	if msg.PoolTypeId == types.StableSwapPoolTypeID {
+		pool.StableSwapAmplification = params.StableSwapAmplification
 	}
 
 	poolCreator := msg.GetPoolCreator()
@@ -164,39 +205,196 @@ func (k Keeper) CreatePool(ctx sdk.Context, msg *types.MsgCreatePool) (types.Poo
 	return pool, nil
 }
 
+// SetPoolStatus sets the status of the pool. The authority can set any status, while a guardian can only make the status
+// of the pool more restrictive. The msgs of the batch which are not allowed by the new status are refunded on execution.
+func (k Keeper) SetPoolStatus(ctx sdk.Context, msg *types.MsgSetPoolStatus) (types.Pool, error) {
+	pool, found := k.GetPool(ctx, msg.PoolId)
+	if !found {
+		return types.Pool{}, types.ErrPoolNotExists
+	}
+	if pool.Status == types.PoolStatusWindDown || msg.Status == types.PoolStatusWindDown {
+		return types.Pool{}, sdkerrors.Wrap(types.ErrBadPoolStatus, "pool is wound down only by MsgWindDownPool")
+	}
+
+	if msg.Authority != k.authority {
+		if !k.GetParams(ctx).IsGuardian(msg.Authority) {
+			return types.Pool{}, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s or a guardian, got %s", k.authority, msg.Authority)
+		}
+		if msg.Status < pool.Status {
+			return types.Pool{}, sdkerrors.Wrapf(types.ErrInvalidAuthority, "guardian can not relax the status %s to %s", pool.Status, msg.Status)
+		}
+	}
+
+	pool.Status = msg.Status
+	k.SetPool(ctx, pool)
+	return pool, nil
+}
+
+// SetCircuitBreaker enables or disables the circuit breaker of all pools, or of the pool when the pool id is set.
+// The circuit breaker of a pool is its withdraw-only status, enabling it does not relax a more restrictive status and
+// disabling it makes the withdraw-only pool active, while the other statuses are left to SetPoolStatus. The authority
+// can enable or disable the circuit breaker, while a guardian can only enable it.
+func (k Keeper) SetCircuitBreaker(ctx sdk.Context, msg *types.MsgSetCircuitBreaker) error {
+	if msg.Authority != k.authority {
+		if !k.GetParams(ctx).IsGuardian(msg.Authority) {
+			return sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s or a guardian, got %s", k.authority, msg.Authority)
+		}
+		if !msg.Enabled {
+			return sdkerrors.Wrap(types.ErrInvalidAuthority, "guardian can not disable the circuit breaker")
+		}
+	}
+
+	if msg.PoolId == 0 {
+		k.SetCircuitBreakerEnabled(ctx, msg.Enabled)
+		return nil
+	}
+
+	pool, found := k.GetPool(ctx, msg.PoolId)
+	if !found {
+		return types.ErrPoolNotExists
+	}
+	if pool.Status == types.PoolStatusWindDown {
+		return sdkerrors.Wrap(types.ErrBadPoolStatus, "pool is winding down")
+	}
+	switch {
+	case !msg.Enabled && pool.Status == types.PoolStatusWithdrawOnly:
+		pool.Status = types.PoolStatusActive
+	case !msg.Enabled && pool.Status != types.PoolStatusActive:
+		return sdkerrors.Wrapf(types.ErrBadPoolStatus, "circuit breaker of the pool is not enabled, the status is %s", pool.Status)
+	case msg.Enabled && pool.Status < types.PoolStatusWithdrawOnly:
+		pool.Status = types.PoolStatusWithdrawOnly
+	}
+	k.SetPool(ctx, pool)
+	return nil
+}
+
+// CalculateDeposit validates the deposit msg and returns the pool coin minted and the coins accepted and refunded by
+// the deposit on the current reserves of the pool, without writing state. A depleted pool is reinitialized by the
+// deposit, accepting all deposit coins.
+func (k Keeper) CalculateDeposit(ctx sdk.Context, msg types.MsgDepositWithinBatch) (sdk.Coin, sdk.Coins, sdk.Coins, error) {
+	if err := k.ValidateMsgDepositWithinBatch(ctx, msg); err != nil {
+		return sdk.Coin{}, nil, nil, err
+	}
+
+	pool, found := k.GetPool(ctx, msg.PoolId)
+	if !found {
+		return sdk.Coin{}, nil, nil, types.ErrPoolNotExists
+	}
+
+	depositCoins := msg.DepositCoins.Sort()
+	params := k.GetParams(ctx)
+	reserveCoins := k.GetReserveCoins(ctx, pool)
+
+	if k.IsDepletedPool(ctx, pool) {
+		for _, depositCoin := range depositCoins {
+			if depositCoin.Amount.Add(reserveCoins.AmountOf(depositCoin.Denom)).LT(params.MinInitDepositAmount) {
+				return sdk.Coin{}, nil, nil, types.ErrLessThanMinInitDeposit
+			}
+		}
+		if params.InitPoolCoinMintAmount.LT(msg.GetMinPoolCoinAmount()) {
+			return sdk.Coin{}, nil, nil, types.ErrLessThanMinPoolCoinAmount
+		}
+		return sdk.NewCoin(pool.PoolCoinDenom, params.InitPoolCoinMintAmount), depositCoins, sdk.Coins{}, nil
+	}
+
+	reserveCoins.Sort()
+
+	curve, found := k.GetPoolCurve(pool.TypeId)
+	if !found {
+		return sdk.Coin{}, nil, nil, types.ErrPoolTypeNotExists
+	}
+
+	poolCoinTotalSupply := k.GetPoolCoinTotalSupply(ctx, pool)
+	for _, depositCoin := range depositCoins {
+		if err := types.CheckOverflowWithDec(sdk.NewDecFromInt(poolCoinTotalSupply), sdk.NewDecFromInt(depositCoin.Amount)); err != nil {
+			return sdk.Coin{}, nil, nil, err
+		}
+	}
+	poolCoinMintAmt, acceptedCoins := curve.Deposit(reserveCoins, poolCoinTotalSupply, depositCoins)
+	refundedCoins := depositCoins.Sub(acceptedCoins...)
+
+	mintPoolCoin := sdk.NewCoin(pool.PoolCoinDenom, poolCoinMintAmt)
+	if mintPoolCoin.IsZero() || acceptedCoins.IsZero() {
+		return sdk.Coin{}, nil, nil, fmt.Errorf("pool coin truncated, no accepted coin, refund")
+	}
+
+	if mintPoolCoin.Amount.LT(msg.GetMinPoolCoinAmount()) {
+		return sdk.Coin{}, nil, nil, types.ErrLessThanMinPoolCoinAmount
+	}
+	return mintPoolCoin, acceptedCoins, refundedCoins, nil
+}
+
+// CalculateWithdrawal validates the withdraw msg and returns the reserve coins withdrawn and the withdraw fee coins of
+// the withdrawal on the current reserves of the pool, without writing state.
+func (k Keeper) CalculateWithdrawal(ctx sdk.Context, msg types.MsgWithdrawWithinBatch) (sdk.Coins, sdk.Coins, error) {
+	if err := k.ValidateMsgWithdrawWithinBatch(ctx, msg); err != nil {
+		return nil, nil, err
+	}
+
+	pool, found := k.GetPool(ctx, msg.PoolId)
+	if !found {
+		return nil, nil, types.ErrPoolNotExists
+	}
+
+	poolCoinTotalSupply := k.GetPoolCoinTotalSupply(ctx, pool)
+	reserveCoins := k.GetReserveCoins(ctx, pool)
+	reserveCoins.Sort()
+
+	curve, found := k.GetPoolCurve(pool.TypeId)
+	if !found {
+		return nil, nil, types.ErrPoolTypeNotExists
+	}
+
+	for _, reserveCoin := range reserveCoins {
+		if err := types.CheckOverflow(reserveCoin.Amount, msg.PoolCoin.Amount); err != nil {
+			return nil, nil, err
+		}
+		if err := types.CheckOverflow(sdk.NewDecFromInt(reserveCoin.Amount.Mul(msg.PoolCoin.Amount)).TruncateInt(), poolCoinTotalSupply); err != nil {
+			return nil, nil, err
+		}
+	}
+	// Calculate withdraw amount of respective reserve coin considering fees and pool coin's totally supply
+	withdrawCoins, withdrawFeeCoins := curve.Withdraw(reserveCoins, poolCoinTotalSupply, msg.PoolCoin.Amount, k.GetPoolWithdrawFeeRate(ctx, pool))
+
+	if !withdrawCoins.IsAllGTE(msg.MinWithdrawCoins) {
+		return nil, nil, types.ErrLessThanMinWithdrawCoins
+	}
+
+	if !withdrawCoins.IsValid() {
+		return nil, nil, types.ErrBadPoolCoinAmount
+	}
+	return withdrawCoins, withdrawFeeCoins, nil
+}
+
 func (k Keeper) ExecuteDeposit(ctx sdk.Context, msg types.DepositMsgState, batch types.PoolBatch) error {
 	if msg.Executed || msg.ToBeDeleted || msg.Succeeded {
 		return fmt.Errorf("cannot process already executed batch msg")
 	}
 	msg.Executed = true
+	// the demand coin exchanged by the swap msg of the single asset deposit is deposited together
+	if msg.SwapMsgIndex != 0 {
+		if swapMsg, found := k.GetPoolBatchSwapMsgState(ctx, msg.Msg.PoolId, msg.SwapMsgIndex); found && swapMsg.ExchangedDemandCoin.IsPositive() {
+			msg.Msg.DepositCoins = msg.Msg.DepositCoins.Add(swapMsg.ExchangedDemandCoin)
+		}
+	}
 	k.SetPoolBatchDepositMsgState(ctx, msg.Msg.PoolId, msg)
 
-	if err := k.ValidateMsgDepositWithinBatch(ctx, *msg.Msg); err != nil {
+	mintPoolCoin, acceptedCoins, refundedCoins, err := k.CalculateDeposit(ctx, *msg.Msg)
+	if err != nil {
 		return err
 	}
 
-	pool, found := k.GetPool(ctx, msg.Msg.PoolId)
-	if !found {
-		return types.ErrPoolNotExists
-	}
-
+	pool, _ := k.GetPool(ctx, msg.Msg.PoolId)
 	depositCoins := msg.Msg.DepositCoins.Sort()
 
 	batchEscrowAcc := k.accountKeeper.GetModuleAddress(types.ModuleName)
 	reserveAcc := pool.GetReserveAccount()
 	depositor := msg.Msg.GetDepositor()
 
-	params := k.GetParams(ctx)
-
 	reserveCoins := k.GetReserveCoins(ctx, pool)
 
 	// reinitialize pool if the pool is depleted
 	if k.IsDepletedPool(ctx, pool) {
-		for _, depositCoin := range msg.Msg.DepositCoins {
-			if depositCoin.Amount.Add(reserveCoins.AmountOf(depositCoin.Denom)).LT(params.MinInitDepositAmount) {
-				return types.ErrLessThanMinInitDeposit
-			}
-		}
 		poolCoin, err := k.MintAndSendPoolCoin(ctx, pool, batchEscrowAcc, depositor, msg.Msg.DepositCoins)
 		if err != nil {
 			return err
@@ -206,6 +404,11 @@ func (k Keeper) ExecuteDeposit(ctx sdk.Context, msg types.DepositMsgState, batch
 		msg.Succeeded = true
 		msg.ToBeDeleted = true
 		k.SetPoolBatchDepositMsgState(ctx, msg.Msg.PoolId, msg)
+		k.updatePoolBatchRecord(ctx, batch, func(record *types.PoolBatchRecord) {
+			record.DepositedCoins = record.DepositedCoins.Add(msg.Msg.DepositCoins...)
+			record.MintedPoolCoin = record.MintedPoolCoin.Add(poolCoin)
+			record.SucceededMsgCount++
+		})
 
 		reserveCoins = k.GetReserveCoins(ctx, pool)
 		lastReserveCoinA := sdk.NewDecFromInt(reserveCoins[0].Amount)
@@ -238,40 +441,9 @@ func (k Keeper) ExecuteDeposit(ctx sdk.Context, msg types.DepositMsgState, batch
 	}
 
 	reserveCoins.Sort()
-
-	lastReserveCoinA := reserveCoins[0]
-	lastReserveCoinB := reserveCoins[1]
-
-	depositCoinA := depositCoins[0]
-	depositCoinB := depositCoins[1]
-
-	poolCoinTotalSupply := sdk.NewDecFromInt(k.GetPoolCoinTotalSupply(ctx, pool))
-	if err := types.CheckOverflowWithDec(poolCoinTotalSupply, sdk.NewDecFromInt(depositCoinA.Amount)); err != nil {
-		return err
-	}
-	if err := types.CheckOverflowWithDec(poolCoinTotalSupply, sdk.NewDecFromInt(depositCoinB.Amount)); err != nil {
-		return err
-	}
-	poolCoinMintAmt := sdk.MinDec(
-		poolCoinTotalSupply.MulTruncate(sdk.NewDecFromInt(depositCoinA.Amount)).QuoTruncate(sdk.NewDecFromInt(lastReserveCoinA.Amount)),
-		poolCoinTotalSupply.MulTruncate(sdk.NewDecFromInt(depositCoinB.Amount)).QuoTruncate(sdk.NewDecFromInt(lastReserveCoinB.Amount)),
-	)
-	mintRate := poolCoinMintAmt.TruncateDec().QuoTruncate(poolCoinTotalSupply)
-	acceptedCoins := sdk.NewCoins(
-		sdk.NewCoin(depositCoins[0].Denom, sdk.NewDecFromInt(lastReserveCoinA.Amount).Mul(mintRate).TruncateInt()),
-		sdk.NewCoin(depositCoins[1].Denom, sdk.NewDecFromInt(lastReserveCoinB.Amount).Mul(mintRate).TruncateInt()),
-	)
-	refundedCoins := depositCoins.Sub(acceptedCoins...)
-	refundedCoinA := sdk.NewCoin(depositCoinA.Denom, refundedCoins.AmountOf(depositCoinA.Denom))
-	refundedCoinB := sdk.NewCoin(depositCoinB.Denom, refundedCoins.AmountOf(depositCoinB.Denom))
-
-	mintPoolCoin := sdk.NewCoin(pool.PoolCoinDenom, poolCoinMintAmt.TruncateInt())
+	poolCoinTotalSupply := k.GetPoolCoinTotalSupply(ctx, pool)
 	mintPoolCoins := sdk.NewCoins(mintPoolCoin)
 
-	if mintPoolCoins.IsZero() || acceptedCoins.IsZero() {
-		return fmt.Errorf("pool coin truncated, no accepted coin, refund")
-	}
-
 	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, mintPoolCoins); err != nil {
 		return err
 	}
@@ -301,16 +473,27 @@ func (k Keeper) ExecuteDeposit(ctx sdk.Context, msg types.DepositMsgState, batch
 	msg.Succeeded = true
 	msg.ToBeDeleted = true
 	k.SetPoolBatchDepositMsgState(ctx, msg.Msg.PoolId, msg)
+	k.updatePoolBatchRecord(ctx, batch, func(record *types.PoolBatchRecord) {
+		record.DepositedCoins = record.DepositedCoins.Add(acceptedCoins...)
+		record.MintedPoolCoin = record.MintedPoolCoin.Add(mintPoolCoin)
+		record.SucceededMsgCount++
+	})
 
 	if BatchLogicInvariantCheckFlag {
+		curve, _ := k.GetPoolCurve(pool.TypeId)
 		afterReserveCoins := k.GetReserveCoins(ctx, pool)
-		afterReserveCoinA := afterReserveCoins[0].Amount
-		afterReserveCoinB := afterReserveCoins[1].Amount
-
-		MintingPoolCoinsInvariant(poolCoinTotalSupply.TruncateInt(), mintPoolCoin.Amount, depositCoinA.Amount, depositCoinB.Amount,
-			lastReserveCoinA.Amount, lastReserveCoinB.Amount, refundedCoinA.Amount, refundedCoinB.Amount)
-		DepositInvariant(lastReserveCoinA.Amount, lastReserveCoinB.Amount, depositCoinA.Amount, depositCoinB.Amount,
-			afterReserveCoinA, afterReserveCoinB, refundedCoinA.Amount, refundedCoinB.Amount)
+		if isProportionalPoolType(pool.TypeId) {
+			lastReserveCoinA, lastReserveCoinB := reserveCoins[0], reserveCoins[1]
+			depositCoinA, depositCoinB := depositCoins[0], depositCoins[1]
+			refundedCoinA := refundedCoins.AmountOf(depositCoinA.Denom)
+			refundedCoinB := refundedCoins.AmountOf(depositCoinB.Denom)
+
+			MintingPoolCoinsInvariant(poolCoinTotalSupply, mintPoolCoin.Amount, depositCoinA.Amount, depositCoinB.Amount,
+				lastReserveCoinA.Amount, lastReserveCoinB.Amount, refundedCoinA, refundedCoinB)
+			DepositInvariant(lastReserveCoinA.Amount, lastReserveCoinB.Amount, depositCoinA.Amount, depositCoinB.Amount,
+				afterReserveCoins[0].Amount, afterReserveCoins[1].Amount, refundedCoinA, refundedCoinB)
+		}
+		PoolCoinValueInvariant(curve, k.GetPoolStableSwapAmplification(ctx, pool), pool.ReserveCoinWeights, reserveCoins, poolCoinTotalSupply, afterReserveCoins, poolCoinTotalSupply.Add(mintPoolCoin.Amount))
 	}
 
 	ctx.EventManager().EmitEvent(
@@ -353,15 +536,13 @@ func (k Keeper) ExecuteWithdrawal(ctx sdk.Context, msg types.WithdrawMsgState, b
 	msg.Executed = true
 	k.SetPoolBatchWithdrawMsgState(ctx, msg.Msg.PoolId, msg)
 
-	if err := k.ValidateMsgWithdrawWithinBatch(ctx, *msg.Msg); err != nil {
+	withdrawCoins, withdrawFeeCoins, err := k.CalculateWithdrawal(ctx, *msg.Msg)
+	if err != nil {
 		return err
 	}
 	poolCoins := sdk.NewCoins(msg.Msg.PoolCoin)
 
-	pool, found := k.GetPool(ctx, msg.Msg.PoolId)
-	if !found {
-		return types.ErrPoolNotExists
-	}
+	pool, _ := k.GetPool(ctx, msg.Msg.PoolId)
 
 	poolCoinTotalSupply := k.GetPoolCoinTotalSupply(ctx, pool)
 	reserveCoins := k.GetReserveCoins(ctx, pool)
@@ -373,74 +554,80 @@ func (k Keeper) ExecuteWithdrawal(ctx sdk.Context, msg types.WithdrawMsgState, b
 	reserveAcc := pool.GetReserveAccount()
 	withdrawer := msg.Msg.GetWithdrawer()
 
-	params := k.GetParams(ctx)
-	withdrawProportion := sdk.OneDec().Sub(params.WithdrawFeeRate)
-	withdrawCoins := sdk.NewCoins()
-	withdrawFeeCoins := sdk.NewCoins()
+	inputs = append(inputs, banktypes.NewInput(reserveAcc, withdrawCoins))
+	outputs = append(outputs, banktypes.NewOutput(withdrawer, withdrawCoins))
 
-	// Case for withdrawing all reserve coins
-	if msg.Msg.PoolCoin.Amount.Equal(poolCoinTotalSupply) {
-		withdrawCoins = reserveCoins
-	} else {
-		// Calculate withdraw amount of respective reserve coin considering fees and pool coin's totally supply
-		for _, reserveCoin := range reserveCoins {
-			if err := types.CheckOverflow(reserveCoin.Amount, msg.Msg.PoolCoin.Amount); err != nil {
-				return err
-			}
-			if err := types.CheckOverflow(sdk.NewDecFromInt(reserveCoin.Amount.Mul(msg.Msg.PoolCoin.Amount)).TruncateInt(), poolCoinTotalSupply); err != nil {
-				return err
-			}
-			// WithdrawAmount = ReserveAmount * PoolCoinAmount * WithdrawFeeProportion / TotalSupply
-			withdrawAmtWithFee := sdk.NewDecFromInt(reserveCoin.Amount.Mul(msg.Msg.PoolCoin.Amount)).TruncateInt().Quo(poolCoinTotalSupply)
-			withdrawAmt := sdk.NewDecFromInt(reserveCoin.Amount.Mul(msg.Msg.PoolCoin.Amount)).MulTruncate(withdrawProportion).TruncateInt().Quo(poolCoinTotalSupply)
-			withdrawCoins = append(withdrawCoins, sdk.NewCoin(reserveCoin.Denom, withdrawAmt))
-			withdrawFeeCoins = append(withdrawFeeCoins, sdk.NewCoin(reserveCoin.Denom, withdrawAmtWithFee.Sub(withdrawAmt)))
+	var targetSwapMsg *types.MsgSwapWithinBatch
+	if msg.Msg.TargetDenom != "" {
+		targetSwapMsg, err = k.newWithdrawTargetSwapMsg(ctx, pool, *msg.Msg, withdrawCoins)
+		if err != nil {
+			return err
 		}
+		msg.TargetCoin = sdk.NewCoin(msg.Msg.TargetDenom, withdrawCoins.AmountOf(msg.Msg.TargetDenom))
 	}
 
-	if withdrawCoins.IsValid() {
-		inputs = append(inputs, banktypes.NewInput(reserveAcc, withdrawCoins))
-		outputs = append(outputs, banktypes.NewOutput(withdrawer, withdrawCoins))
-	} else {
-		return types.ErrBadPoolCoinAmount
-	}
+	// the payout, the burning and the target swap are committed together, so the escrowed pool coins are left to be
+	// refunded when any of them fails
+	cacheCtx, writeCache := ctx.CacheContext()
 
 	// send withdrawing coins to the withdrawer
-	if err := k.bankKeeper.InputOutputCoins(ctx, inputs, outputs); err != nil {
+	if err := k.bankKeeper.InputOutputCoins(cacheCtx, inputs, outputs); err != nil {
 		return err
 	}
 
 	// burn the escrowed pool coins
-	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, poolCoins); err != nil {
+	if err := k.bankKeeper.BurnCoins(cacheCtx, types.ModuleName, poolCoins); err != nil {
 		return err
 	}
 
+	// the swap msg is executed in the same batch and its exchanged demand coin is added to the target coin afterwards
+	if targetSwapMsg != nil {
+		swapMsgState, err := k.SwapWithinBatch(cacheCtx, targetSwapMsg, 0)
+		if err != nil {
+			return err
+		}
+		msg.SwapMsgIndex = swapMsgState.MsgIndex
+	}
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
 	msg.Succeeded = true
 	msg.ToBeDeleted = true
 	k.SetPoolBatchWithdrawMsgState(ctx, msg.Msg.PoolId, msg)
+	k.updatePoolBatchRecord(ctx, batch, func(record *types.PoolBatchRecord) {
+		record.WithdrawnCoins = record.WithdrawnCoins.Add(withdrawCoins...)
+		record.BurnedPoolCoin = record.BurnedPoolCoin.Add(msg.Msg.PoolCoin)
+		record.WithdrawFeeCoins = record.WithdrawFeeCoins.Add(withdrawFeeCoins...)
+		record.SucceededMsgCount++
+	})
 
 	if BatchLogicInvariantCheckFlag {
+		curve, _ := k.GetPoolCurve(pool.TypeId)
 		afterPoolCoinTotalSupply := k.GetPoolCoinTotalSupply(ctx, pool)
 		afterReserveCoins := k.GetReserveCoins(ctx, pool)
-		afterReserveCoinA := sdk.ZeroInt()
-		afterReserveCoinB := sdk.ZeroInt()
-		if !afterReserveCoins.IsZero() {
-			afterReserveCoinA = afterReserveCoins[0].Amount
-			afterReserveCoinB = afterReserveCoins[1].Amount
-		}
-		burnedPoolCoin := poolCoins[0].Amount
-		withdrawCoinA := withdrawCoins[0].Amount
-		withdrawCoinB := withdrawCoins[1].Amount
-		reserveCoinA := reserveCoins[0].Amount
-		reserveCoinB := reserveCoins[1].Amount
-		lastPoolCoinTotalSupply := poolCoinTotalSupply
-		afterPoolTotalSupply := afterPoolCoinTotalSupply
-
-		BurningPoolCoinsInvariant(burnedPoolCoin, withdrawCoinA, withdrawCoinB, reserveCoinA, reserveCoinB, lastPoolCoinTotalSupply, withdrawFeeCoins)
-		WithdrawReserveCoinsInvariant(withdrawCoinA, withdrawCoinB, reserveCoinA, reserveCoinB,
-			afterReserveCoinA, afterReserveCoinB, afterPoolTotalSupply, lastPoolCoinTotalSupply, burnedPoolCoin)
-		WithdrawAmountInvariant(withdrawCoinA, withdrawCoinB, reserveCoinA, reserveCoinB, burnedPoolCoin, lastPoolCoinTotalSupply, params.WithdrawFeeRate)
-		ImmutablePoolPriceAfterWithdrawInvariant(reserveCoinA, reserveCoinB, withdrawCoinA, withdrawCoinB, afterReserveCoinA, afterReserveCoinB)
+		if isProportionalPoolType(pool.TypeId) {
+			afterReserveCoinA := sdk.ZeroInt()
+			afterReserveCoinB := sdk.ZeroInt()
+			if !afterReserveCoins.IsZero() {
+				afterReserveCoinA = afterReserveCoins[0].Amount
+				afterReserveCoinB = afterReserveCoins[1].Amount
+			}
+			burnedPoolCoin := poolCoins[0].Amount
+			withdrawCoinA := withdrawCoins[0].Amount
+			withdrawCoinB := withdrawCoins[1].Amount
+			reserveCoinA := reserveCoins[0].Amount
+			reserveCoinB := reserveCoins[1].Amount
+			lastPoolCoinTotalSupply := poolCoinTotalSupply
+			afterPoolTotalSupply := afterPoolCoinTotalSupply
+
+			BurningPoolCoinsInvariant(burnedPoolCoin, withdrawCoinA, withdrawCoinB, reserveCoinA, reserveCoinB, lastPoolCoinTotalSupply, withdrawFeeCoins)
+			WithdrawReserveCoinsInvariant(withdrawCoinA, withdrawCoinB, reserveCoinA, reserveCoinB,
+				afterReserveCoinA, afterReserveCoinB, afterPoolTotalSupply, lastPoolCoinTotalSupply, burnedPoolCoin)
+			WithdrawAmountInvariant(withdrawCoinA, withdrawCoinB, reserveCoinA, reserveCoinB, burnedPoolCoin, lastPoolCoinTotalSupply, k.GetPoolWithdrawFeeRate(ctx, pool))
+			ImmutablePoolPriceAfterWithdrawInvariant(reserveCoinA, reserveCoinB, withdrawCoinA, withdrawCoinB, afterReserveCoinA, afterReserveCoinB)
+		}
+		PoolCoinValueInvariant(curve, k.GetPoolStableSwapAmplification(ctx, pool), pool.ReserveCoinWeights, reserveCoins, poolCoinTotalSupply, afterReserveCoins, afterPoolCoinTotalSupply)
 	}
 
 	ctx.EventManager().EmitEvent(
@@ -481,6 +668,76 @@ func (k Keeper) ExecuteWithdrawal(ctx sdk.Context, msg types.WithdrawMsgState, b
 	return nil
 }
 
+// newWithdrawTargetSwapMsg returns the market order swap msg which swaps the withdrawn coin of the other side of the
+// withdrawal into the target denom. The swap msg is validated against the reserve left after the withdrawal before any
+// coins are moved, and it is nil when no coin is left to be swapped after the swap fee.
+func (k Keeper) newWithdrawTargetSwapMsg(ctx sdk.Context, pool types.Pool, msg types.MsgWithdrawWithinBatch, withdrawCoins sdk.Coins) (*types.MsgSwapWithinBatch, error) {
+	if msg.PoolCoin.Amount.Equal(k.GetPoolCoinTotalSupply(ctx, pool)) {
+		return nil, types.ErrDepletedPool
+	}
+	reserveCoins := k.GetReserveCoins(ctx, pool)
+	otherDenom, direction := pool.ReserveCoinDenoms[1], types.DirectionYtoX
+	if msg.TargetDenom == pool.ReserveCoinDenoms[1] {
+		otherDenom, direction = pool.ReserveCoinDenoms[0], types.DirectionXtoY
+	}
+	swapFeeRate := k.GetPoolSwapFeeRate(ctx, pool)
+	offerCoin := types.GetOfferCoinWithinAmount(sdk.NewCoin(otherDenom, withdrawCoins.AmountOf(otherDenom)), swapFeeRate)
+	if !offerCoin.IsPositive() {
+		return nil, nil
+	}
+	otherReserveAmt := reserveCoins.AmountOf(otherDenom).Sub(withdrawCoins.AmountOf(otherDenom))
+	maximumOrderableAmt := sdk.NewDecFromInt(otherReserveAmt).MulTruncate(k.GetParams(ctx).MaxOrderAmountRatio).TruncateInt()
+	if offerCoin.Amount.GT(maximumOrderableAmt) {
+		return nil, types.ErrExceededMaxOrderable
+	}
+	orderPrice := types.GetMaxDeviatedOrderPrice(k.GetPoolPrice(ctx, pool, reserveCoins), direction)
+	swapMsg := types.NewMsgSwapWithinBatch(msg.GetWithdrawer(), pool.Id, types.DefaultSwapTypeID, offerCoin, msg.TargetDenom, orderPrice, swapFeeRate)
+	if err := swapMsg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if err := k.ValidateMsgSwapWithinBatch(ctx, *swapMsg); err != nil {
+		return nil, err
+	}
+	return swapMsg, nil
+}
+
+// CalculateWithdrawalTargetCoin calculates the target coin of the withdrawal with a target denom on the current
+// reserves of the pool and the swap msgs queued in the batch of the pool, without writing state. The target coin paid
+// on the batch execution can differ by the other msgs executed in the same batch.
+func (k Keeper) CalculateWithdrawalTargetCoin(ctx sdk.Context, msg types.MsgWithdrawWithinBatch) (sdk.Coin, error) {
+	pool, found := k.GetPool(ctx, msg.PoolId)
+	if !found {
+		return sdk.Coin{}, types.ErrPoolNotExists
+	}
+	if !pool.HasReserveCoinDenom(msg.TargetDenom) {
+		return sdk.Coin{}, types.ErrNotMatchedReserveCoin
+	}
+
+	ctx, _ = ctx.CacheContext()
+	withdrawCoins, _, err := k.CalculateWithdrawal(ctx, msg)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	targetSwapMsg, err := k.newWithdrawTargetSwapMsg(ctx, pool, msg, withdrawCoins)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	targetCoin := sdk.NewCoin(msg.TargetDenom, withdrawCoins.AmountOf(msg.TargetDenom))
+	if targetSwapMsg == nil {
+		return targetCoin, nil
+	}
+
+	// the withdrawn coins are paid out of the reserve before the target swap is matched as in the batch execution
+	if err := k.bankKeeper.SendCoins(ctx, pool.GetReserveAccount(), msg.GetWithdrawer(), withdrawCoins); err != nil {
+		return sdk.Coin{}, err
+	}
+	match, _, err := k.CalculateSwap(ctx, *targetSwapMsg)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return targetCoin.AddAmount(match.ExchangedDemandCoinAmt.Sub(match.ExchangedCoinFeeAmt).TruncateInt()), nil
+}
+
 // GetPoolCoinTotalSupply returns total supply of pool coin of the pool in form of sdk.Int
 //
 //nolint:staticcheck
@@ -491,9 +748,15 @@ func (k Keeper) GetPoolCoinTotalSupply(ctx sdk.Context, pool types.Pool) sdk.Int
 // IsDepletedPool returns true if the pool is depleted.
 func (k Keeper) IsDepletedPool(ctx sdk.Context, pool types.Pool) bool {
 	reserveCoins := k.GetReserveCoins(ctx, pool)
-	return !k.GetPoolCoinTotalSupply(ctx, pool).IsPositive() ||
-		reserveCoins.AmountOf(pool.ReserveCoinDenoms[0]).IsZero() ||
-		reserveCoins.AmountOf(pool.ReserveCoinDenoms[1]).IsZero()
+	if !k.GetPoolCoinTotalSupply(ctx, pool).IsPositive() {
+		return true
+	}
+	for _, denom := range pool.ReserveCoinDenoms {
+		if reserveCoins.AmountOf(denom).IsZero() {
+			return true
+		}
+	}
+	return false
 }
 
 // GetPoolCoinTotal returns total supply of pool coin of the pool in form of sdk.Coin
@@ -511,6 +774,72 @@ func (k Keeper) GetReserveCoins(ctx sdk.Context, pool types.Pool) (reserveCoins
 	return
 }
 
+// GetPoolSwapCurve returns the swap curve of the pool type of the pool for the pair of the reserve coins of the denoms,
+// with the weights of the pair for a weighted pool.
+func (k Keeper) GetPoolSwapCurve(ctx sdk.Context, pool types.Pool, denomX, denomY string) types.SwapCurve {
+	curve, found := k.GetPoolCurve(pool.TypeId)
+	if !found {
+		panic(fmt.Sprintf("pool type %d of pool %d has no registered curve", pool.TypeId, pool.Id))
+	}
+	return curve.SwapCurve(k.GetPoolStableSwapAmplification(ctx, pool), pool.ReserveCoinWeight(denomX), pool.ReserveCoinWeight(denomY))
+}
+
+// GetPoolSwapFeeRate returns the swap fee rate of the pool, or the swap fee rate of the params when the pool has no
+// swap fee rate chosen at pool creation.
+func (k Keeper) GetPoolSwapFeeRate(ctx sdk.Context, pool types.Pool) sdk.Dec {
+	if pool.SwapFeeRate.IsNil() || pool.SwapFeeRate.IsZero() {
+		return k.GetParams(ctx).SwapFeeRate
+	}
+	return pool.SwapFeeRate
+}
+
+// GetPoolStableSwapAmplification returns the amplification coefficient of the StableSwap pool fixed at pool creation,
+// or the amplification coefficient of the params when the pool has none.
+func (k Keeper) GetPoolStableSwapAmplification(ctx sdk.Context, pool types.Pool) uint32 {
+	if pool.StableSwapAmplification == 0 {
+		return k.GetParams(ctx).StableSwapAmplification
+	}
+	return pool.StableSwapAmplification
+}
+
+// GetPoolWithdrawFeeRate returns the withdraw fee rate of the params, or zero when the pool is winding down so that
+// the pending withdrawals are paid out without the withdraw fee as the other pool coin holders.
+func (k Keeper) GetPoolWithdrawFeeRate(ctx sdk.Context, pool types.Pool) sdk.Dec {
+	if pool.Status == types.PoolStatusWindDown {
+		return sdk.ZeroDec()
+	}
+	return k.GetParams(ctx).WithdrawFeeRate
+}
+
+// GetPoolBatchInterval returns the number of blocks in a batch of the pool, or the unit batch height of the params when
+// the pool has no batch interval chosen at pool creation. The batch interval of the pool is capped by the max batch
+// interval of the params.
+func (k Keeper) GetPoolBatchInterval(ctx sdk.Context, pool types.Pool) int64 {
+	params := k.GetParams(ctx)
+	if pool.BatchInterval == 0 || params.MaxBatchInterval == 0 {
+		return int64(params.UnitBatchHeight)
+	}
+	if pool.BatchInterval > params.MaxBatchInterval {
+		return int64(params.MaxBatchInterval)
+	}
+	return int64(pool.BatchInterval)
+}
+
+// IsPoolBatchHeight returns true if the batch of the pool is executed at the current height.
+func (k Keeper) IsPoolBatchHeight(ctx sdk.Context, pool types.Pool) bool {
+	return ctx.BlockHeight()%k.GetPoolBatchInterval(ctx, pool) == 0
+}
+
+// GetPoolPrice returns the pool price of the reserve coins on the swap curve of the pool,
+// which is the marginal price of the second reserve coin in the first reserve coin.
+// The pool price of a multi-asset pool is the price of the pair of its first two reserve coins.
+func (k Keeper) GetPoolPrice(ctx sdk.Context, pool types.Pool, reserveCoins sdk.Coins) sdk.Dec {
+	denomX, denomY := pool.ReserveCoinDenoms[0], pool.ReserveCoinDenoms[1]
+	x := sdk.NewDecFromInt(reserveCoins.AmountOf(denomX))
+	y := sdk.NewDecFromInt(reserveCoins.AmountOf(denomY))
+	return k.GetPoolSwapCurve(ctx, pool, denomX, denomY).Price(x, y)
+}
+
 // GetPoolMetaData returns metadata of the pool
 func (k Keeper) GetPoolMetaData(ctx sdk.Context, pool types.Pool) types.PoolMetadata {
 	return types.PoolMetadata{
@@ -527,12 +856,15 @@ func (k Keeper) GetPoolRecord(ctx sdk.Context, pool types.Pool) (types.PoolRecor
 		return types.PoolRecord{}, false
 	}
 	return types.PoolRecord{
-		Pool:              pool,
-		PoolMetadata:      k.GetPoolMetaData(ctx, pool),
-		PoolBatch:         batch,
-		DepositMsgStates:  k.GetAllPoolBatchDepositMsgs(ctx, batch),
-		WithdrawMsgStates: k.GetAllPoolBatchWithdrawMsgStates(ctx, batch),
-		SwapMsgStates:     k.GetAllPoolBatchSwapMsgStates(ctx, batch),
+		Pool:               pool,
+		PoolMetadata:       k.GetPoolMetaData(ctx, pool),
+		PoolBatch:          batch,
+		DepositMsgStates:   k.GetAllPoolBatchDepositMsgs(ctx, batch),
+		WithdrawMsgStates:  k.GetAllPoolBatchWithdrawMsgStates(ctx, batch),
+		SwapMsgStates:      k.GetAllPoolBatchSwapMsgStates(ctx, batch),
+		SwapRouteMsgStates: k.GetAllPoolBatchSwapRouteMsgStates(ctx, batch),
+		PriceRecords:       k.GetAllPoolPriceRecords(ctx, pool.Id),
+		BatchRecords:       k.GetAllPoolBatchRecords(ctx, pool.Id),
 	}, true
 }
 
@@ -542,15 +874,22 @@ func (k Keeper) SetPoolRecord(ctx sdk.Context, record types.PoolRecord) types.Po
 	if record.PoolBatch.BeginHeight > ctx.BlockHeight() {
 		record.PoolBatch.BeginHeight = 0
 	}
+	// the records exported before the swap route was introduced have no swap route msg index
+	if record.PoolBatch.SwapRouteMsgIndex == 0 {
+		record.PoolBatch.SwapRouteMsgIndex = 1
+	}
 	k.SetPoolBatch(ctx, record.PoolBatch)
 	k.SetPoolBatchDepositMsgStates(ctx, record.Pool.Id, record.DepositMsgStates)
 	k.SetPoolBatchWithdrawMsgStates(ctx, record.Pool.Id, record.WithdrawMsgStates)
 	k.SetPoolBatchSwapMsgStates(ctx, record.Pool.Id, record.SwapMsgStates)
+	k.SetPoolBatchSwapRouteMsgStates(ctx, record.Pool.Id, record.SwapRouteMsgStates)
+	k.SetPoolPriceRecords(ctx, record.Pool.Id, record.PriceRecords)
+	k.SetPoolBatchRecords(ctx, record.Pool.Id, record.BatchRecords)
 	return record
 }
 
-// RefundDeposit refunds deposit amounts to the depositor
-func (k Keeper) RefundDeposit(ctx sdk.Context, batchMsg types.DepositMsgState, batch types.PoolBatch) error {
+// RefundDeposit refunds deposit amounts to the depositor, the reason of the refund is recorded in the event.
+func (k Keeper) RefundDeposit(ctx sdk.Context, batchMsg types.DepositMsgState, batch types.PoolBatch, reason error) error {
 	batchMsg, _ = k.GetPoolBatchDepositMsgState(ctx, batchMsg.Msg.PoolId, batchMsg.MsgIndex)
 	if !batchMsg.Executed || batchMsg.Succeeded {
 		return fmt.Errorf("cannot refund not executed or already succeeded msg")
@@ -562,6 +901,7 @@ func (k Keeper) RefundDeposit(ctx sdk.Context, batchMsg types.DepositMsgState, b
 	// not delete now, set ToBeDeleted true for delete on next block beginblock
 	batchMsg.ToBeDeleted = true
 	k.SetPoolBatchDepositMsgState(ctx, batchMsg.Msg.PoolId, batchMsg)
+	k.recordFailedMsg(ctx, batch)
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeDepositToPool,
@@ -572,12 +912,13 @@ func (k Keeper) RefundDeposit(ctx sdk.Context, batchMsg types.DepositMsgState, b
 			sdk.NewAttribute(types.AttributeValueAcceptedCoins, sdk.NewCoins().String()),
 			sdk.NewAttribute(types.AttributeValueRefundedCoins, batchMsg.Msg.DepositCoins.String()),
 			sdk.NewAttribute(types.AttributeValueSuccess, types.Failure),
+			sdk.NewAttribute(types.AttributeValueReason, reason.Error()),
 		))
 	return nil
 }
 
-// RefundWithdrawal refunds pool coin of the liquidity pool to the withdrawer
-func (k Keeper) RefundWithdrawal(ctx sdk.Context, batchMsg types.WithdrawMsgState, batch types.PoolBatch) error {
+// RefundWithdrawal refunds pool coin of the liquidity pool to the withdrawer, the reason of the refund is recorded in the event.
+func (k Keeper) RefundWithdrawal(ctx sdk.Context, batchMsg types.WithdrawMsgState, batch types.PoolBatch, reason error) error {
 	batchMsg, _ = k.GetPoolBatchWithdrawMsgState(ctx, batchMsg.Msg.PoolId, batchMsg.MsgIndex)
 	if !batchMsg.Executed || batchMsg.Succeeded {
 		return fmt.Errorf("cannot refund not executed or already succeeded msg")
@@ -596,11 +937,13 @@ func (k Keeper) RefundWithdrawal(ctx sdk.Context, batchMsg types.WithdrawMsgStat
 			sdk.NewAttribute(types.AttributeValuePoolCoinDenom, batchMsg.Msg.PoolCoin.Denom),
 			sdk.NewAttribute(types.AttributeValuePoolCoinAmount, batchMsg.Msg.PoolCoin.Amount.String()),
 			sdk.NewAttribute(types.AttributeValueSuccess, types.Failure),
+			sdk.NewAttribute(types.AttributeValueReason, reason.Error()),
 		))
 
 	// not delete now, set ToBeDeleted true for delete on next block beginblock
 	batchMsg.ToBeDeleted = true
 	k.SetPoolBatchWithdrawMsgState(ctx, batchMsg.Msg.PoolId, batchMsg)
+	k.recordFailedMsg(ctx, batch)
 	return nil
 }
 
@@ -611,6 +954,10 @@ func (k Keeper) ValidateMsgDepositWithinBatch(ctx sdk.Context, msg types.MsgDepo
 		return types.ErrPoolNotExists
 	}
 
+	if !pool.Status.DepositAllowed() {
+		return types.ErrPoolStatusNotAllowed
+	}
+
 	if msg.DepositCoins.Len() != len(pool.ReserveCoinDenoms) {
 		return types.ErrNumOfReserveCoin
 	}
@@ -621,13 +968,43 @@ func (k Keeper) ValidateMsgDepositWithinBatch(ctx sdk.Context, msg types.MsgDepo
 		return err
 	}
 
-	denomA, denomB := types.AlphabeticalDenomPair(msg.DepositCoins[0].Denom, msg.DepositCoins[1].Denom)
-	if denomA != pool.ReserveCoinDenoms[0] || denomB != pool.ReserveCoinDenoms[1] {
-		return types.ErrNotMatchedReserveCoin
+	for i, denom := range pool.ReserveCoinDenoms {
+		if msg.DepositCoins[i].Denom != denom {
+			return types.ErrNotMatchedReserveCoin
+		}
 	}
 	return nil
 }
 
+// ValidateMsgDepositSingleAssetWithinBatch validates MsgDepositSingleAssetWithinBatch
+func (k Keeper) ValidateMsgDepositSingleAssetWithinBatch(ctx sdk.Context, msg types.MsgDepositSingleAssetWithinBatch) error {
+	pool, found := k.GetPool(ctx, msg.PoolId)
+	if !found {
+		return types.ErrPoolNotExists
+	}
+
+	if !pool.Status.DepositAllowed() {
+		return types.ErrPoolStatusNotAllowed
+	}
+
+	// a portion of the deposit coin is swapped into the other reserve coin, so only the pools of a pair are supported
+	if uint32(len(pool.ReserveCoinDenoms)) != types.PairReserveCoinNum {
+		return types.ErrNumOfReserveCoin
+	}
+
+	if !pool.HasReserveCoinDenom(msg.DepositCoin.Denom) {
+		return types.ErrNotMatchedReserveCoin
+	}
+
+	if k.IsDepletedPool(ctx, pool) {
+		return types.ErrDepletedPool
+	}
+
+	params := k.GetParams(ctx)
+	reserveCoins := k.GetReserveCoins(ctx, pool)
+	return types.ValidateReserveCoinLimit(params.MaxReserveCoinAmount, reserveCoins.Add(msg.DepositCoin))
+}
+
 // ValidateMsgWithdrawWithinBatch validates MsgWithdrawWithinBatch
 func (k Keeper) ValidateMsgWithdrawWithinBatch(ctx sdk.Context, msg types.MsgWithdrawWithinBatch) error {
 	pool, found := k.GetPool(ctx, msg.PoolId)
@@ -635,6 +1012,11 @@ func (k Keeper) ValidateMsgWithdrawWithinBatch(ctx sdk.Context, msg types.MsgWit
 		return types.ErrPoolNotExists
 	}
 
+	// the withdrawal with a target denom swaps within the batch, which is also not allowed by the status of the pool
+	if !pool.Status.WithdrawAllowed() || (msg.TargetDenom != "" && !pool.Status.SwapAllowed()) {
+		return types.ErrPoolStatusNotAllowed
+	}
+
 	if msg.PoolCoin.Denom != pool.PoolCoinDenom {
 		return types.ErrBadPoolCoinDenom
 	}
@@ -647,6 +1029,118 @@ func (k Keeper) ValidateMsgWithdrawWithinBatch(ctx sdk.Context, msg types.MsgWit
 	if msg.PoolCoin.Amount.GT(poolCoinTotalSupply) {
 		return types.ErrBadPoolCoinAmount
 	}
+
+	for _, coin := range msg.MinWithdrawCoins {
+		if !pool.HasReserveCoinDenom(coin.Denom) {
+			return types.ErrNotMatchedReserveCoin
+		}
+	}
+
+	if msg.TargetDenom != "" {
+		// the other withdrawn reserve coin is swapped into the target denom, so only the pools of a pair are supported
+		if uint32(len(pool.ReserveCoinDenoms)) != types.PairReserveCoinNum {
+			return types.ErrNumOfReserveCoin
+		}
+		if !pool.HasReserveCoinDenom(msg.TargetDenom) {
+			return types.ErrNotMatchedReserveCoin
+		}
+	}
+	return nil
+}
+
+// ValidateMsgSwapWithinBatch validates MsgSwapWithinBatch
+func (k Keeper) ValidateMsgSwapWithinBatch(ctx sdk.Context, msg types.MsgSwapWithinBatch) error {
+	pool, found := k.GetPool(ctx, msg.PoolId)
+	if !found {
+		return types.ErrPoolNotExists
+	}
+
+	if !pool.Status.SwapAllowed() {
+		return types.ErrPoolStatusNotAllowed
+	}
+
+	if k.IsDepletedPool(ctx, pool) {
+		return types.ErrDepletedPool
+	}
+
+	if msg.SwapTypeId != types.DefaultSwapTypeID {
+		return types.ErrSwapTypeNotExists
+	}
+
+	// the offer coin and the demand coin are a pair of the reserve coins of the pool
+	if msg.OfferCoin.Denom == msg.DemandCoinDenom {
+		return types.ErrEqualDenom
+	}
+	if !pool.HasReserveCoinDenom(msg.OfferCoin.Denom) || !pool.HasReserveCoinDenom(msg.DemandCoinDenom) {
+		return types.ErrNotMatchedReserveCoin
+	}
+
+	params := k.GetParams(ctx)
+
+	// can not exceed max order ratio of reserve coins that can be ordered at a order
+	reserveCoinAmt := k.GetReserveCoins(ctx, pool).AmountOf(msg.OfferCoin.Denom)
+
+	// Decimal Error, Multiply the Int coin amount by the Decimal Rate and erase the decimal point to order a lower value
+	maximumOrderableAmt := sdk.NewDecFromInt(reserveCoinAmt).MulTruncate(params.MaxOrderAmountRatio).TruncateInt()
+	if msg.OfferCoin.Amount.GT(maximumOrderableAmt) {
+		return types.ErrExceededMaxOrderable
+	}
+
+	if msg.OfferCoinFee.Denom != msg.OfferCoin.Denom {
+		return types.ErrBadOfferCoinFee
+	}
+
+	if err := types.CheckOverflowWithDec(sdk.NewDecFromInt(msg.OfferCoin.Amount), msg.OrderPrice); err != nil {
+		return err
+	}
+
+	if !msg.OfferCoinFee.Equal(types.GetOfferCoinFee(msg.OfferCoin, k.GetPoolSwapFeeRate(ctx, pool))) {
+		return types.ErrBadOfferCoinFee
+	}
+
+	return nil
+}
+
+// ValidateMsgSwapRoute validates MsgSwapRoute, the offer coin must be swappable through the pools of the route
+// into the denom of the minimum demand coin.
+func (k Keeper) ValidateMsgSwapRoute(ctx sdk.Context, msg types.MsgSwapRoute) error {
+	denom := msg.OfferCoin.Denom
+	var swapFeeRate sdk.Dec
+	for i, poolID := range msg.PoolIds {
+		pool, found := k.GetPool(ctx, poolID)
+		if !found {
+			return types.ErrPoolNotExists
+		}
+		// the offer coin fee is reserved for the first swap of the route at the swap fee rate of the first pool
+		if i == 0 {
+			swapFeeRate = k.GetPoolSwapFeeRate(ctx, pool)
+		}
+		if !pool.Status.SwapAllowed() {
+			return types.ErrPoolStatusNotAllowed
+		}
+		if k.IsDepletedPool(ctx, pool) {
+			return types.ErrDepletedPool
+		}
+		// the demand coin of each swap is the other reserve coin of the pool, so only the pools of a pair are supported
+		if uint32(len(pool.ReserveCoinDenoms)) != types.PairReserveCoinNum {
+			return types.ErrNumOfReserveCoin
+		}
+		switch denom {
+		case pool.ReserveCoinDenoms[0]:
+			denom = pool.ReserveCoinDenoms[1]
+		case pool.ReserveCoinDenoms[1]:
+			denom = pool.ReserveCoinDenoms[0]
+		default:
+			return types.ErrNotMatchedReserveCoin
+		}
+	}
+	if denom != msg.MinDemandCoin.Denom {
+		return types.ErrBadSwapRoute
+	}
+
+	if !msg.OfferCoinFee.Equal(types.GetOfferCoinFee(msg.OfferCoin, swapFeeRate)) {
+		return types.ErrBadOfferCoinFee
+	}
 	return nil
 }
 
@@ -664,6 +1158,9 @@ func (k Keeper) ValidatePool(ctx sdk.Context, pool *types.Pool) error {
 	} else {
 		return types.ErrPoolTypeNotExists
 	}
+	if _, found := k.GetPoolCurve(pool.TypeId); !found {
+		return types.ErrPoolTypeNotExists
+	}
 
 	if poolType.MaxReserveCoinNum > types.MaxReserveCoinNum || types.MinReserveCoinNum > poolType.MinReserveCoinNum {
 		return types.ErrNumOfReserveCoin
@@ -683,12 +1180,19 @@ func (k Keeper) ValidatePool(ctx sdk.Context, pool *types.Pool) error {
 		}
 	}
 
-	denomA, denomB := types.AlphabeticalDenomPair(pool.ReserveCoinDenoms[0], pool.ReserveCoinDenoms[1])
-	if denomA != pool.ReserveCoinDenoms[0] || denomB != pool.ReserveCoinDenoms[1] {
-		return types.ErrBadOrderingReserveCoin
+	for i := 1; i < len(pool.ReserveCoinDenoms); i++ {
+		if pool.ReserveCoinDenoms[i-1] >= pool.ReserveCoinDenoms[i] {
+			return types.ErrBadOrderingReserveCoin
+		}
 	}
 
-	poolName := types.PoolName(pool.ReserveCoinDenoms, pool.TypeId)
+	if len(pool.ReserveCoinWeights) > 0 {
+		if err := types.ValidateReserveCoinWeights(pool.ReserveCoinWeights, len(pool.ReserveCoinDenoms)); err != nil {
+			return err
+		}
+	}
+
+	poolName := types.PoolName(pool.ReserveCoinDenoms, pool.TypeId, pool.ReserveCoinWeights)
 	poolCoin := k.GetPoolCoinTotal(ctx, *pool)
 	if poolCoin.Denom != types.GetPoolCoinDenom(poolName) {
 		return types.ErrBadPoolCoinDenom
@@ -735,6 +1239,9 @@ func (k Keeper) ValidatePoolRecord(ctx sdk.Context, record types.PoolRecord) err
 	if len(record.SwapMsgStates) != 0 && record.PoolBatch.SwapMsgIndex != record.SwapMsgStates[len(record.SwapMsgStates)-1].MsgIndex+1 {
 		return types.ErrBadBatchMsgIndex
 	}
+	if len(record.SwapRouteMsgStates) != 0 && record.PoolBatch.SwapRouteMsgIndex != record.SwapRouteMsgStates[len(record.SwapRouteMsgStates)-1].MsgIndex+1 {
+		return types.ErrBadBatchMsgIndex
+	}
 
 	return nil
 }
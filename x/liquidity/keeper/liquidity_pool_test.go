@@ -22,6 +22,7 @@ func TestLiquidityPool(t *testing.T) {
 		ReserveCoinDenoms:     []string{"a", "b"},
 		ReserveAccountAddress: "",
 		PoolCoinDenom:         "poolCoin",
+		SwapFeeRate:           sdk.ZeroDec(),
 	}
 	app.LiquidityKeeper.SetPool(ctx, lp)
 
@@ -55,7 +56,7 @@ func TestCreatePool(t *testing.T) {
 	_, err := simapp.LiquidityKeeper.CreatePool(ctx, msg)
 	require.NoError(t, err)
 
-	invalidMsg := types.NewMsgCreatePool(addrs[0], 2, depositBalance)
+	invalidMsg := types.NewMsgCreatePool(addrs[0], 4, depositBalance)
 	_, err = simapp.LiquidityKeeper.CreatePool(ctx, invalidMsg)
 	require.ErrorIs(t, err, types.ErrPoolTypeNotExists)
 
@@ -1202,3 +1203,526 @@ func TestExecuteBigDeposit(t *testing.T) {
 	require.Equal(t, sdk.NewInt(-4), balanceAfter.AmountOf(denomA).SubRaw(hugeInt))
 	require.Equal(t, sdk.NewInt(-4), balanceAfter.AmountOf(denomB).SubRaw(hugeInt))
 }
+
+// fixedFeeCurve is a custom pool curve of the constant product which charges a fixed withdraw fee rate.
+type fixedFeeCurve struct {
+	types.ConstantProductCurve
+}
+
+func (fixedFeeCurve) Withdraw(reserveCoins sdk.Coins, poolCoinSupply, poolCoinAmt sdk.Int, _ sdk.Dec) (sdk.Coins, sdk.Coins) {
+	return types.ProportionalWithdraw(reserveCoins, poolCoinSupply, poolCoinAmt, sdk.NewDecWithPrec(1, 1))
+}
+
+func TestRegisterPoolCurve(t *testing.T) {
+	simapp, ctx := createTestInput()
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+	customPoolType := types.PoolType{Id: 4, Name: "FixedFeePool", MinReserveCoinNum: 2, MaxReserveCoinNum: 2}
+	params.PoolTypes = append(params.PoolTypes, customPoolType)
+	simapp.LiquidityKeeper.SetParams(ctx, params)
+
+	defer func(flag bool) { keeper.BatchLogicInvariantCheckFlag = flag }(keeper.BatchLogicInvariantCheckFlag)
+	keeper.BatchLogicInvariantCheckFlag = true
+
+	x, y := sdk.NewInt(1_000_000_000), sdk.NewInt(1_000_000_000)
+	depositCoins := sdk.NewCoins(sdk.NewCoin(DenomX, x), sdk.NewCoin(DenomY, y))
+	addrs := app.AddTestAddrs(simapp, ctx, 2, params.PoolCreationFee)
+	app.SaveAccount(simapp, ctx, addrs[0], depositCoins)
+	msg := types.NewMsgCreatePool(addrs[0], customPoolType.Id, depositCoins)
+
+	// the pool type of the params has no curve until it is registered
+	_, found := simapp.LiquidityKeeper.GetPoolCurve(customPoolType.Id)
+	require.False(t, found)
+	_, err := simapp.LiquidityKeeper.CreatePool(ctx, msg)
+	require.ErrorIs(t, err, types.ErrPoolTypeNotExists)
+
+	simapp.LiquidityKeeper.RegisterPoolCurve(customPoolType.Id, fixedFeeCurve{})
+	require.Panics(t, func() { simapp.LiquidityKeeper.RegisterPoolCurve(customPoolType.Id, fixedFeeCurve{}) })
+	require.Panics(t, func() { simapp.LiquidityKeeper.RegisterPoolCurve(types.DefaultPoolTypeID, fixedFeeCurve{}) })
+
+	pool, err := simapp.LiquidityKeeper.CreatePool(ctx, msg)
+	require.NoError(t, err)
+	require.NoError(t, simapp.LiquidityKeeper.ValidatePool(ctx, &pool))
+	require.Equal(t, types.ConstantProductCurve{}, simapp.LiquidityKeeper.GetPoolSwapCurve(ctx, pool, DenomX, DenomY))
+
+	// the deposit is proportional, and the withdrawal charges the fixed fee of the curve
+	app.TestDepositPool(t, simapp, ctx, x.QuoRaw(10), y.QuoRaw(10), addrs[1:2], pool.Id, true)
+	poolCoin := simapp.BankKeeper.GetBalance(ctx, addrs[1], pool.PoolCoinDenom)
+	require.Equal(t, params.InitPoolCoinMintAmount.QuoRaw(10), poolCoin.Amount)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	_, err = simapp.LiquidityKeeper.WithdrawWithinBatch(ctx, types.NewMsgWithdrawWithinBatch(addrs[1], pool.Id, poolCoin))
+	require.NoError(t, err)
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+	require.Equal(t, x.QuoRaw(10).MulRaw(9).QuoRaw(10), simapp.BankKeeper.GetBalance(ctx, addrs[1], DenomX).Amount)
+	require.Equal(t, y.QuoRaw(10).MulRaw(9).QuoRaw(10), simapp.BankKeeper.GetBalance(ctx, addrs[1], DenomY).Amount)
+	require.Equal(t, depositCoins.Add(sdk.NewCoin(DenomX, x.QuoRaw(100)), sdk.NewCoin(DenomY, y.QuoRaw(100))),
+		simapp.LiquidityKeeper.GetReserveCoins(ctx, pool))
+}
+
+func TestMultiAssetPool(t *testing.T) {
+	simapp, ctx := createTestInput()
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+
+	defer func(flag bool) { keeper.BatchLogicInvariantCheckFlag = flag }(keeper.BatchLogicInvariantCheckFlag)
+	keeper.BatchLogicInvariantCheckFlag = true
+
+	amt := sdk.NewInt(1_000_000_000)
+	depositCoins := sdk.NewCoins(sdk.NewCoin(DenomA, amt), sdk.NewCoin(DenomB, amt), sdk.NewCoin(DenomX, amt))
+	addrs := app.AddTestAddrs(simapp, ctx, 4, params.PoolCreationFee)
+	app.SaveAccount(simapp, ctx, addrs[0], depositCoins)
+
+	// the number of reserve coins must be within the bounds of the pool type
+	_, err := simapp.LiquidityKeeper.CreatePool(ctx, types.NewMsgCreatePool(addrs[0], types.DefaultPoolTypeID, depositCoins))
+	require.ErrorIs(t, err, types.ErrNumOfReserveCoin)
+	_, err = simapp.LiquidityKeeper.CreatePool(ctx, types.NewMsgCreatePool(addrs[0], types.MultiAssetPoolTypeID, depositCoins[:2]))
+	require.ErrorIs(t, err, types.ErrNumOfReserveCoin)
+
+	pool, err := simapp.LiquidityKeeper.CreatePool(ctx, types.NewMsgCreatePool(addrs[0], types.MultiAssetPoolTypeID, depositCoins))
+	require.NoError(t, err)
+	require.Equal(t, []string{DenomA, DenomB, DenomX}, pool.ReserveCoinDenoms)
+	require.NoError(t, simapp.LiquidityKeeper.ValidatePool(ctx, &pool))
+	require.False(t, simapp.LiquidityKeeper.IsDepletedPool(ctx, pool))
+
+	// all reserve coins are deposited in proportion
+	app.SaveAccount(simapp, ctx, addrs[1], depositCoins)
+	_, err = simapp.LiquidityKeeper.DepositWithinBatch(ctx, types.NewMsgDepositWithinBatch(addrs[1], pool.Id, depositCoins[:2]))
+	require.ErrorIs(t, err, types.ErrNumOfReserveCoin)
+	_, err = simapp.LiquidityKeeper.DepositWithinBatch(ctx, types.NewMsgDepositWithinBatch(addrs[1], pool.Id, depositCoins.QuoInt(sdk.NewInt(10))))
+	require.NoError(t, err)
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+	poolCoin := simapp.BankKeeper.GetBalance(ctx, addrs[1], pool.PoolCoinDenom)
+	require.Equal(t, params.InitPoolCoinMintAmount.QuoRaw(10), poolCoin.Amount)
+
+	// the single asset deposit and the withdrawal with a target denom are supported only by the pools of a pair
+	_, err = simapp.LiquidityKeeper.DepositSingleAssetWithinBatch(ctx, types.NewMsgDepositSingleAssetWithinBatch(addrs[1], pool.Id, sdk.NewCoin(DenomA, amt)))
+	require.ErrorIs(t, err, types.ErrNumOfReserveCoin)
+	withdrawMsg := types.NewMsgWithdrawWithinBatch(addrs[1], pool.Id, poolCoin)
+	withdrawMsg.TargetDenom = DenomA
+	require.ErrorIs(t, simapp.LiquidityKeeper.ValidateMsgWithdrawWithinBatch(ctx, *withdrawMsg), types.ErrNumOfReserveCoin)
+
+	// the swaps of different pairs of the pool are executed in the same batch
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	offerCoinA, offerCoinX := sdk.NewCoin(DenomA, sdk.NewInt(10_000_000)), sdk.NewCoin(DenomX, sdk.NewInt(10_000_000))
+	app.SaveAccount(simapp, ctx, addrs[2], sdk.NewCoins(offerCoinA.Add(types.GetOfferCoinFee(offerCoinA, params.SwapFeeRate))))
+	app.SaveAccount(simapp, ctx, addrs[3], sdk.NewCoins(offerCoinX.Add(types.GetOfferCoinFee(offerCoinX, params.SwapFeeRate))))
+	_, err = simapp.LiquidityKeeper.SwapWithinBatch(ctx, types.NewMsgSwapWithinBatch(addrs[2], pool.Id, types.DefaultSwapTypeID,
+		offerCoinA, DenomB, types.GetMaxDeviatedOrderPrice(sdk.OneDec(), types.DirectionXtoY), params.SwapFeeRate), 0)
+	require.NoError(t, err)
+	_, err = simapp.LiquidityKeeper.SwapWithinBatch(ctx, types.NewMsgSwapWithinBatch(addrs[3], pool.Id, types.DefaultSwapTypeID,
+		offerCoinX, DenomB, types.GetMaxDeviatedOrderPrice(sdk.OneDec(), types.DirectionYtoX), params.SwapFeeRate), 0)
+	require.NoError(t, err)
+	_, err = simapp.LiquidityKeeper.SwapWithinBatch(ctx, types.NewMsgSwapWithinBatch(addrs[3], pool.Id, types.DefaultSwapTypeID,
+		offerCoinX, DenomY, sdk.OneDec(), params.SwapFeeRate), 0)
+	require.ErrorIs(t, err, types.ErrNotMatchedReserveCoin)
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+
+	reserveCoins := simapp.LiquidityKeeper.GetReserveCoins(ctx, pool)
+	receivedB2 := simapp.BankKeeper.GetBalance(ctx, addrs[2], DenomB).Amount
+	receivedB3 := simapp.BankKeeper.GetBalance(ctx, addrs[3], DenomB).Amount
+	require.True(t, receivedB2.IsPositive())
+	require.True(t, receivedB3.IsPositive())
+	require.Equal(t, amt.MulRaw(11).QuoRaw(10).Sub(receivedB2).Sub(receivedB3), reserveCoins.AmountOf(DenomB))
+	require.True(t, reserveCoins.AmountOf(DenomA).GT(amt.MulRaw(11).QuoRaw(10).Add(offerCoinA.Amount)))
+	require.True(t, reserveCoins.AmountOf(DenomX).GT(amt.MulRaw(11).QuoRaw(10).Add(offerCoinX.Amount)))
+
+	// the pool coin is withdrawn into all reserve coins
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	_, err = simapp.LiquidityKeeper.WithdrawWithinBatch(ctx, types.NewMsgWithdrawWithinBatch(addrs[1], pool.Id, poolCoin))
+	require.NoError(t, err)
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+	balances := simapp.BankKeeper.GetAllBalances(ctx, addrs[1])
+	for _, coin := range reserveCoins {
+		require.Equal(t, depositCoins.AmountOf(coin.Denom).Sub(amt.QuoRaw(10)).Add(coin.Amount.QuoRaw(11)), balances.AmountOf(coin.Denom))
+	}
+}
+
+func TestWeightedPool(t *testing.T) {
+	simapp, ctx := createTestInput()
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+
+	defer func(flag bool) { keeper.BatchLogicInvariantCheckFlag = flag }(keeper.BatchLogicInvariantCheckFlag)
+	keeper.BatchLogicInvariantCheckFlag = true
+
+	// the value of X is 80% of the pool at the initial pool price of 1
+	x, y := sdk.NewInt(4_000_000_000), sdk.NewInt(1_000_000_000)
+	depositCoins := sdk.NewCoins(sdk.NewCoin(DenomX, x), sdk.NewCoin(DenomY, y))
+	addrs := app.AddTestAddrs(simapp, ctx, 4, params.PoolCreationFee)
+	app.SaveAccount(simapp, ctx, addrs[0], depositCoins)
+
+	// the StableSwap pools and the equal weights are not supported
+	msg := types.NewMsgCreatePool(addrs[0], types.StableSwapPoolTypeID, depositCoins)
+	msg.ReserveCoinWeights = []uint32{80, 20}
+	_, err := simapp.LiquidityKeeper.CreatePool(ctx, msg)
+	require.ErrorIs(t, err, types.ErrBadReserveCoinWeights)
+	msg = types.NewMsgCreatePool(addrs[0], types.DefaultPoolTypeID, depositCoins)
+	msg.ReserveCoinWeights = []uint32{50, 50}
+	_, err = simapp.LiquidityKeeper.CreatePool(ctx, msg)
+	require.ErrorIs(t, err, types.ErrBadReserveCoinWeights)
+
+	msg.ReserveCoinWeights = []uint32{80, 20}
+	pool, err := simapp.LiquidityKeeper.CreatePool(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, []uint32{80, 20}, pool.ReserveCoinWeights)
+	require.Equal(t, DenomX+"/"+DenomY+"/1/80:20", pool.Name())
+	require.NoError(t, simapp.LiquidityKeeper.ValidatePool(ctx, &pool))
+	require.Equal(t, types.WeightedProductCurve{WeightX: 80, WeightY: 20}, simapp.LiquidityKeeper.GetPoolSwapCurve(ctx, pool, DenomX, DenomY))
+	require.Equal(t, sdk.OneDec(), simapp.LiquidityKeeper.GetPoolPrice(ctx, pool, simapp.LiquidityKeeper.GetReserveCoins(ctx, pool)))
+
+	// the equally weighted pool of the same reserve coins is a different pool
+	app.SaveAccount(simapp, ctx, addrs[1], depositCoins)
+	equalPool, err := simapp.LiquidityKeeper.CreatePool(ctx, types.NewMsgCreatePool(addrs[1], types.DefaultPoolTypeID, depositCoins))
+	require.NoError(t, err)
+	require.NotEqual(t, pool.ReserveAccountAddress, equalPool.ReserveAccountAddress)
+	require.Equal(t, sdk.NewDec(4), simapp.LiquidityKeeper.GetPoolPrice(ctx, equalPool, simapp.LiquidityKeeper.GetReserveCoins(ctx, equalPool)))
+
+	// the single asset deposit swaps the portion of the weight of the other reserve coin
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	depositCoin := sdk.NewCoin(DenomX, sdk.NewInt(100_000_000))
+	app.SaveAccount(simapp, ctx, addrs[2], sdk.NewCoins(depositCoin))
+	depositMsgState, err := simapp.LiquidityKeeper.DepositSingleAssetWithinBatch(ctx, types.NewMsgDepositSingleAssetWithinBatch(addrs[2], pool.Id, depositCoin))
+	require.NoError(t, err)
+	swapMsgState, found := simapp.LiquidityKeeper.GetPoolBatchSwapMsgState(ctx, pool.Id, depositMsgState.SwapMsgIndex)
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(20_000_000), swapMsgState.Msg.OfferCoin.Amount)
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+
+	// the rest of the deposit coin and the exchanged coin are deposited in the reserve ratio of the pool
+	reserveCoins := simapp.LiquidityKeeper.GetReserveCoins(ctx, pool)
+	poolCoin := simapp.BankKeeper.GetBalance(ctx, addrs[2], pool.PoolCoinDenom)
+	require.True(t, poolCoin.IsPositive())
+	balances := simapp.BankKeeper.GetAllBalances(ctx, addrs[2])
+	require.True(t, balances.AmountOf(DenomX).LT(depositCoin.Amount.QuoRaw(1000)))
+	require.True(t, balances.AmountOf(DenomY).LT(depositCoin.Amount.QuoRaw(1000)))
+
+	// the swap price moves the pool price of the weighted reserve coins
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	lastPrice := simapp.LiquidityKeeper.GetPoolPrice(ctx, pool, reserveCoins)
+	offerCoins := []sdk.Coin{sdk.NewCoin(DenomX, sdk.NewInt(10_000_000))}
+	orderPrices := []sdk.Dec{lastPrice.Mul(sdk.MustNewDecFromStr("1.1"))}
+	app.TestSwapPool(t, simapp, ctx, offerCoins, orderPrices, addrs[3:4], pool.Id, true)
+	receivedY := simapp.BankKeeper.GetBalance(ctx, addrs[3], DenomY).Amount
+	require.True(t, receivedY.IsPositive())
+	afterReserveCoins := simapp.LiquidityKeeper.GetReserveCoins(ctx, pool)
+	require.Equal(t, reserveCoins.AmountOf(DenomY).Sub(receivedY), afterReserveCoins.AmountOf(DenomY))
+	require.True(t, simapp.LiquidityKeeper.GetPoolPrice(ctx, pool, afterReserveCoins).GT(lastPrice))
+
+	// the pool coin is withdrawn in the reserve ratio of the pool
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	_, err = simapp.LiquidityKeeper.WithdrawWithinBatch(ctx, types.NewMsgWithdrawWithinBatch(addrs[2], pool.Id, poolCoin))
+	require.NoError(t, err)
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+	withdrawnX := simapp.BankKeeper.GetBalance(ctx, addrs[2], DenomX).Amount.Sub(balances.AmountOf(DenomX))
+	withdrawnY := simapp.BankKeeper.GetBalance(ctx, addrs[2], DenomY).Amount.Sub(balances.AmountOf(DenomY))
+	require.True(t, withdrawnX.IsPositive())
+	ratio := sdk.NewDecFromInt(afterReserveCoins.AmountOf(DenomX)).QuoInt(afterReserveCoins.AmountOf(DenomY))
+	require.True(t, sdk.NewDecFromInt(withdrawnX).QuoInt(withdrawnY).Sub(ratio).Abs().LTE(sdk.NewDecWithPrec(1, 6)))
+}
+
+func TestPoolSwapFeeTier(t *testing.T) {
+	simapp, ctx := createTestInput()
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+
+	defer func(flag bool) { keeper.BatchLogicInvariantCheckFlag = flag }(keeper.BatchLogicInvariantCheckFlag)
+	keeper.BatchLogicInvariantCheckFlag = true
+
+	x, y := sdk.NewInt(1_000_000_000), sdk.NewInt(1_000_000_000)
+	depositCoins := sdk.NewCoins(sdk.NewCoin(DenomX, x), sdk.NewCoin(DenomY, y))
+	addrs := app.AddTestAddrs(simapp, ctx, 3, params.PoolCreationFee)
+	app.SaveAccount(simapp, ctx, addrs[0], depositCoins)
+
+	// the swap fee rate of the pool must be one of the swap fee tiers
+	msg := types.NewMsgCreatePool(addrs[0], types.DefaultPoolTypeID, depositCoins)
+	msg.SwapFeeRate = sdk.NewDecWithPrec(2, 2)
+	_, err := simapp.LiquidityKeeper.CreatePool(ctx, msg)
+	require.ErrorIs(t, err, types.ErrSwapFeeTierNotExists)
+
+	msg.SwapFeeRate = sdk.NewDecWithPrec(1, 2)
+	pool, err := simapp.LiquidityKeeper.CreatePool(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(1, 2), pool.SwapFeeRate)
+	require.Equal(t, sdk.NewDecWithPrec(1, 2), simapp.LiquidityKeeper.GetPoolSwapFeeRate(ctx, pool))
+	require.NoError(t, simapp.LiquidityKeeper.ValidatePool(ctx, &pool))
+
+	// the pool without the swap fee rate uses the swap fee rate of the params
+	app.SaveAccount(simapp, ctx, addrs[1], sdk.NewCoins(sdk.NewCoin(DenomX, x), sdk.NewCoin("denomZ", y)))
+	defaultPool, err := simapp.LiquidityKeeper.CreatePool(ctx, types.NewMsgCreatePool(addrs[1], types.DefaultPoolTypeID, sdk.NewCoins(sdk.NewCoin(DenomX, x), sdk.NewCoin("denomZ", y))))
+	require.NoError(t, err)
+	require.Equal(t, params.SwapFeeRate, simapp.LiquidityKeeper.GetPoolSwapFeeRate(ctx, defaultPool))
+
+	// the offer coin fee of the swap is reserved at the swap fee rate of the pool
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	offerCoin := sdk.NewCoin(DenomX, sdk.NewInt(10_000_000))
+	app.SaveAccount(simapp, ctx, addrs[2], sdk.NewCoins(offerCoin.Add(types.GetOfferCoinFee(offerCoin, pool.SwapFeeRate))))
+	swapMsg := types.NewMsgSwapWithinBatch(addrs[2], pool.Id, types.DefaultSwapTypeID, offerCoin, DenomY, sdk.MustNewDecFromStr("1.1"), params.SwapFeeRate)
+	_, err = simapp.LiquidityKeeper.SwapWithinBatch(ctx, swapMsg, 0)
+	require.ErrorIs(t, err, types.ErrBadOfferCoinFee)
+	swapMsg = types.NewMsgSwapWithinBatch(addrs[2], pool.Id, types.DefaultSwapTypeID, offerCoin, DenomY, sdk.MustNewDecFromStr("1.1"), pool.SwapFeeRate)
+	_, err = simapp.LiquidityKeeper.SwapWithinBatch(ctx, swapMsg, 0)
+	require.NoError(t, err)
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+
+	// the swap fee of the swap is paid at the swap fee rate of the pool
+	require.True(t, simapp.BankKeeper.GetBalance(ctx, addrs[2], DenomX).IsZero())
+	receivedY := simapp.BankKeeper.GetBalance(ctx, addrs[2], DenomY).Amount
+	require.True(t, receivedY.IsPositive())
+	swapPrice := sdk.NewDecFromInt(offerCoin.Amount).QuoInt(receivedY)
+	require.True(t, swapPrice.GT(sdk.OneDec().Add(pool.SwapFeeRate.QuoInt64(2))))
+}
+
+func TestPoolStatus(t *testing.T) {
+	simapp, ctx := createTestInput()
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+
+	defer func(flag bool) { keeper.BatchLogicInvariantCheckFlag = flag }(keeper.BatchLogicInvariantCheckFlag)
+	keeper.BatchLogicInvariantCheckFlag = true
+
+	x, y := sdk.NewInt(1_000_000_000), sdk.NewInt(1_000_000_000)
+	addrs := app.AddTestAddrs(simapp, ctx, 4, params.PoolCreationFee)
+	poolID := app.TestCreatePool(t, simapp, ctx, x, y, DenomX, DenomY, addrs[0])
+	pool, _ := simapp.LiquidityKeeper.GetPool(ctx, poolID)
+	require.Equal(t, types.PoolStatusActive, pool.Status)
+
+	authority := simapp.LiquidityKeeper.GetAuthority()
+	guardian := addrs[3]
+	depositCoins := sdk.NewCoins(sdk.NewCoin(DenomX, sdk.NewInt(1_000_000)), sdk.NewCoin(DenomY, sdk.NewInt(1_000_000)))
+	offerCoin := sdk.NewCoin(DenomX, sdk.NewInt(1_000_000))
+	offerCoinFee := types.GetOfferCoinFee(offerCoin, params.SwapFeeRate)
+	app.SaveAccount(simapp, ctx, addrs[1], depositCoins)
+	app.SaveAccount(simapp, ctx, addrs[2], sdk.NewCoins(offerCoin.Add(offerCoinFee)))
+	swapMsg := types.NewMsgSwapWithinBatch(addrs[2], poolID, types.DefaultSwapTypeID, offerCoin, DenomY, sdk.MustNewDecFromStr("1.1"), params.SwapFeeRate)
+
+	// only the authority and the guardians can set the status of the pool
+	_, err := simapp.LiquidityKeeper.SetPoolStatus(ctx, types.NewMsgSetPoolStatus(guardian, poolID, types.PoolStatusDepositsPaused))
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+	params.Guardians = []string{guardian.String()}
+	simapp.LiquidityKeeper.SetParams(ctx, params)
+	_, err = simapp.LiquidityKeeper.SetPoolStatus(ctx, types.NewMsgSetPoolStatus(guardian, poolID+1, types.PoolStatusDepositsPaused))
+	require.ErrorIs(t, err, types.ErrPoolNotExists)
+
+	// the deposit submitted before the deposits are paused is refunded on execution while the swap is executed
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	_, err = simapp.LiquidityKeeper.DepositWithinBatch(ctx, types.NewMsgDepositWithinBatch(addrs[1], poolID, depositCoins))
+	require.NoError(t, err)
+	_, err = simapp.LiquidityKeeper.SwapWithinBatch(ctx, swapMsg, 0)
+	require.NoError(t, err)
+	pool, err = simapp.LiquidityKeeper.SetPoolStatus(ctx, types.NewMsgSetPoolStatus(guardian, poolID, types.PoolStatusDepositsPaused))
+	require.NoError(t, err)
+	require.Equal(t, types.PoolStatusDepositsPaused, pool.Status)
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+	require.Equal(t, depositCoins, sdk.NewCoins(simapp.BankKeeper.GetBalance(ctx, addrs[1], DenomX), simapp.BankKeeper.GetBalance(ctx, addrs[1], DenomY)))
+	require.True(t, simapp.BankKeeper.GetBalance(ctx, addrs[2], DenomY).IsPositive())
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	_, err = simapp.LiquidityKeeper.DepositWithinBatch(ctx, types.NewMsgDepositWithinBatch(addrs[1], poolID, depositCoins))
+	require.ErrorIs(t, err, types.ErrPoolStatusNotAllowed)
+	_, err = simapp.LiquidityKeeper.DepositSingleAssetWithinBatch(ctx, types.NewMsgDepositSingleAssetWithinBatch(addrs[1], poolID, depositCoins[0]))
+	require.ErrorIs(t, err, types.ErrPoolStatusNotAllowed)
+
+	// a guardian can not relax the status of the pool
+	_, err = simapp.LiquidityKeeper.SetPoolStatus(ctx, types.NewMsgSetPoolStatus(guardian, poolID, types.PoolStatusActive))
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// only the withdrawals without a target denom are allowed from the withdraw-only pool
+	_, err = simapp.LiquidityKeeper.SetPoolStatus(ctx, types.NewMsgSetPoolStatus(guardian, poolID, types.PoolStatusWithdrawOnly))
+	require.NoError(t, err)
+	app.SaveAccount(simapp, ctx, addrs[2], sdk.NewCoins(offerCoin.Add(offerCoinFee)))
+	_, err = simapp.LiquidityKeeper.SwapWithinBatch(ctx, swapMsg, 0)
+	require.ErrorIs(t, err, types.ErrPoolStatusNotAllowed)
+	poolCoin := sdk.NewCoin(pool.PoolCoinDenom, sdk.NewInt(1_000))
+	withdrawMsg := types.NewMsgWithdrawWithinBatch(addrs[0], poolID, poolCoin)
+	withdrawMsg.TargetDenom = DenomX
+	_, err = simapp.LiquidityKeeper.WithdrawWithinBatch(ctx, withdrawMsg)
+	require.ErrorIs(t, err, types.ErrPoolStatusNotAllowed)
+	_, err = simapp.LiquidityKeeper.WithdrawWithinBatch(ctx, types.NewMsgWithdrawWithinBatch(addrs[0], poolID, poolCoin))
+	require.NoError(t, err)
+
+	// the withdrawal submitted before the pool is frozen is refunded on execution
+	poolCoinBalance := simapp.BankKeeper.GetBalance(ctx, addrs[0], pool.PoolCoinDenom)
+	_, err = simapp.LiquidityKeeper.SetPoolStatus(ctx, types.NewMsgSetPoolStatus(guardian, poolID, types.PoolStatusFrozen))
+	require.NoError(t, err)
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+	require.Equal(t, poolCoinBalance.Add(poolCoin), simapp.BankKeeper.GetBalance(ctx, addrs[0], pool.PoolCoinDenom))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	_, err = simapp.LiquidityKeeper.WithdrawWithinBatch(ctx, types.NewMsgWithdrawWithinBatch(addrs[0], poolID, poolCoin))
+	require.ErrorIs(t, err, types.ErrPoolStatusNotAllowed)
+
+	// the authority can relax the status of the pool
+	pool, err = simapp.LiquidityKeeper.SetPoolStatus(ctx, &types.MsgSetPoolStatus{Authority: authority, PoolId: poolID, Status: types.PoolStatusActive})
+	require.NoError(t, err)
+	require.Equal(t, types.PoolStatusActive, pool.Status)
+	_, err = simapp.LiquidityKeeper.DepositWithinBatch(ctx, types.NewMsgDepositWithinBatch(addrs[1], poolID, depositCoins))
+	require.NoError(t, err)
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+	require.True(t, simapp.BankKeeper.GetBalance(ctx, addrs[1], pool.PoolCoinDenom).IsPositive())
+}
+
+func TestPoolBatchInterval(t *testing.T) {
+	simapp, ctx := createTestInput()
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+
+	x, y := sdk.NewInt(1_000_000_000), sdk.NewInt(1_000_000_000)
+	depositCoins := sdk.NewCoins(sdk.NewCoin(DenomX, x), sdk.NewCoin(DenomY, y))
+	addrs := app.AddTestAddrs(simapp, ctx, 3, params.PoolCreationFee)
+	app.SaveAccount(simapp, ctx, addrs[0], depositCoins)
+
+	// the batch interval of the pool must not exceed the max batch interval
+	msg := types.NewMsgCreatePool(addrs[0], types.DefaultPoolTypeID, depositCoins)
+	msg.BatchInterval = params.MaxBatchInterval + 1
+	_, err := simapp.LiquidityKeeper.CreatePool(ctx, msg)
+	require.ErrorIs(t, err, types.ErrBadBatchInterval)
+
+	msg.BatchInterval = 5
+	pool, err := simapp.LiquidityKeeper.CreatePool(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, uint32(5), pool.BatchInterval)
+	require.Equal(t, int64(5), simapp.LiquidityKeeper.GetPoolBatchInterval(ctx, pool))
+
+	// the pool without the batch interval uses the unit batch height of the params
+	otherCoins := sdk.NewCoins(sdk.NewCoin(DenomX, x), sdk.NewCoin("denomZ", y))
+	app.SaveAccount(simapp, ctx, addrs[1], otherCoins)
+	defaultPool, err := simapp.LiquidityKeeper.CreatePool(ctx, types.NewMsgCreatePool(addrs[1], types.DefaultPoolTypeID, otherCoins))
+	require.NoError(t, err)
+	require.Equal(t, int64(params.UnitBatchHeight), simapp.LiquidityKeeper.GetPoolBatchInterval(ctx, defaultPool))
+
+	// the swaps of both pools are submitted at a height which is not the batch execution height of the pool
+	ctx = ctx.WithBlockHeight(11)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	swapMsgs := make([]types.SwapMsgState, 2)
+	for i, p := range []types.Pool{pool, defaultPool} {
+		offerCoin := sdk.NewCoin(DenomX, sdk.NewInt(10_000_000))
+		app.SaveAccount(simapp, ctx, addrs[2], sdk.NewCoins(offerCoin.Add(types.GetOfferCoinFee(offerCoin, params.SwapFeeRate))))
+		swapMsg := types.NewMsgSwapWithinBatch(addrs[2], p.Id, types.DefaultSwapTypeID, offerCoin, p.ReserveCoinDenoms[1], sdk.MustNewDecFromStr("1.1"), params.SwapFeeRate)
+		swapMsgs[i], err = simapp.LiquidityKeeper.SwapWithinBatch(ctx, swapMsg, 0)
+		require.NoError(t, err)
+	}
+	require.Equal(t, int64(15), swapMsgs[0].OrderExpiryHeight)
+	require.Equal(t, int64(11), swapMsgs[1].OrderExpiryHeight)
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+
+	// the swap of the default pool is executed while the swap of the pool waits for its batch execution height
+	batch, _ := simapp.LiquidityKeeper.GetPoolBatch(ctx, pool.Id)
+	require.False(t, batch.Executed)
+	swapMsg, _ := simapp.LiquidityKeeper.GetPoolBatchSwapMsgState(ctx, pool.Id, swapMsgs[0].MsgIndex)
+	require.False(t, swapMsg.Executed)
+	defaultBatch, _ := simapp.LiquidityKeeper.GetPoolBatch(ctx, defaultPool.Id)
+	require.True(t, defaultBatch.Executed)
+
+	for ctx.BlockHeight() < 15 {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+		liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+	}
+	batch, _ = simapp.LiquidityKeeper.GetPoolBatch(ctx, pool.Id)
+	require.True(t, batch.Executed)
+	swapMsg, _ = simapp.LiquidityKeeper.GetPoolBatchSwapMsgState(ctx, pool.Id, swapMsgs[0].MsgIndex)
+	require.True(t, swapMsg.Executed)
+	require.True(t, swapMsg.Succeeded)
+
+	// the swap order of a lifespan shorter than the batch interval of the pool expires at the next batch execution
+	ctx = ctx.WithBlockHeight(16)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	offerCoin := sdk.NewCoin(DenomX, sdk.NewInt(10_000_000))
+	app.SaveAccount(simapp, ctx, addrs[2], sdk.NewCoins(offerCoin.Add(types.GetOfferCoinFee(offerCoin, params.SwapFeeRate))))
+	swapMsgState, err := simapp.LiquidityKeeper.SwapWithinBatch(ctx, types.NewMsgSwapWithinBatch(
+		addrs[2], pool.Id, types.DefaultSwapTypeID, offerCoin, DenomY, sdk.MustNewDecFromStr("1.1"), params.SwapFeeRate), 2)
+	require.NoError(t, err)
+	require.Equal(t, int64(20), swapMsgState.OrderExpiryHeight)
+	for ctx.BlockHeight() < 20 {
+		liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	}
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+	swapMsg, _ = simapp.LiquidityKeeper.GetPoolBatchSwapMsgState(ctx, pool.Id, swapMsgState.MsgIndex)
+	require.True(t, swapMsg.Executed)
+	require.True(t, swapMsg.Succeeded)
+
+	// the batch interval of the pool is capped by the max batch interval, and ignored when it is zero
+	params.MaxBatchInterval = 2
+	simapp.LiquidityKeeper.SetParams(ctx, params)
+	require.Equal(t, int64(2), simapp.LiquidityKeeper.GetPoolBatchInterval(ctx, pool))
+	params.MaxBatchInterval = 0
+	simapp.LiquidityKeeper.SetParams(ctx, params)
+	require.Equal(t, int64(params.UnitBatchHeight), simapp.LiquidityKeeper.GetPoolBatchInterval(ctx, pool))
+}
+
+func TestEstimateDepositAndWithdraw(t *testing.T) {
+	simapp, ctx := createTestInput()
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+	params.WithdrawFeeRate = sdk.NewDecWithPrec(1, 2)
+	simapp.LiquidityKeeper.SetParams(ctx, params)
+	querier := keeper.Querier{Keeper: simapp.LiquidityKeeper}
+
+	x, y := sdk.NewInt(1_000_000_000), sdk.NewInt(2_000_000_000)
+	creator := app.AddRandomTestAddr(simapp, ctx, params.PoolCreationFee)
+	poolID := app.TestCreatePool(t, simapp, ctx, x, y, DenomX, DenomY, creator)
+	pool, _ := simapp.LiquidityKeeper.GetPool(ctx, poolID)
+	reserveCoins := simapp.LiquidityKeeper.GetReserveCoins(ctx, pool)
+	poolCoinTotal := simapp.LiquidityKeeper.GetPoolCoinTotal(ctx, pool)
+
+	// the deposit out of the reserve ratio is partially refunded
+	depositCoins := sdk.NewCoins(sdk.NewCoin(DenomX, sdk.NewInt(1_000_000)), sdk.NewCoin(DenomY, sdk.NewInt(3_000_000)))
+	depositRes, err := querier.EstimateDeposit(sdk.WrapSDKContext(ctx), &types.QueryEstimateDepositRequest{PoolId: poolID, DepositCoins: depositCoins.String()})
+	require.NoError(t, err)
+	require.True(t, depositRes.PoolCoin.IsPositive())
+	require.Equal(t, depositCoins, depositRes.AcceptedCoins.Add(depositRes.RefundedCoins...))
+	require.True(t, depositRes.RefundedCoins.AmountOf(DenomY).IsPositive())
+
+	poolCoin := sdk.NewCoin(pool.PoolCoinDenom, sdk.NewInt(100_000))
+	withdrawRes, err := querier.EstimateWithdraw(sdk.WrapSDKContext(ctx), &types.QueryEstimateWithdrawRequest{PoolId: poolID, PoolCoin: poolCoin.String()})
+	require.NoError(t, err)
+	require.True(t, withdrawRes.WithdrawCoins.IsAllPositive())
+	require.True(t, withdrawRes.WithdrawFeeCoins.IsAllPositive())
+
+	// the estimates do not write state
+	require.Equal(t, reserveCoins, simapp.LiquidityKeeper.GetReserveCoins(ctx, pool))
+	require.Equal(t, poolCoinTotal, simapp.LiquidityKeeper.GetPoolCoinTotal(ctx, pool))
+
+	// the estimates match the results of the batch execution
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	depositor := app.AddRandomTestAddr(simapp, ctx, depositCoins)
+	_, err = simapp.LiquidityKeeper.DepositWithinBatch(ctx, types.NewMsgDepositWithinBatch(depositor, poolID, depositCoins))
+	require.NoError(t, err)
+	balanceBefore := simapp.BankKeeper.GetAllBalances(ctx, creator)
+	_, err = simapp.LiquidityKeeper.WithdrawWithinBatch(ctx, types.NewMsgWithdrawWithinBatch(creator, poolID, poolCoin))
+	require.NoError(t, err)
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+
+	require.Equal(t, depositRes.RefundedCoins.Add(depositRes.PoolCoin), simapp.BankKeeper.GetAllBalances(ctx, depositor))
+	balanceAfter := simapp.BankKeeper.GetAllBalances(ctx, creator)
+	require.Equal(t, withdrawRes.WithdrawCoins, balanceAfter.Sub(balanceBefore.Sub(poolCoin)...))
+
+	// the estimates fail as the msgs would fail on execution
+	_, err = querier.EstimateDeposit(sdk.WrapSDKContext(ctx), &types.QueryEstimateDepositRequest{PoolId: poolID + 1, DepositCoins: depositCoins.String()})
+	require.Error(t, err)
+	_, err = querier.EstimateDeposit(sdk.WrapSDKContext(ctx), &types.QueryEstimateDepositRequest{PoolId: poolID, DepositCoins: "1000000" + DenomX})
+	require.Error(t, err)
+	_, err = querier.EstimateDeposit(sdk.WrapSDKContext(ctx), &types.QueryEstimateDepositRequest{PoolId: poolID, DepositCoins: "invalid"})
+	require.Error(t, err)
+	minPoolCoinMsg := types.NewMsgDepositWithinBatch(depositor, poolID, depositCoins)
+	minPoolCoinMsg.MinPoolCoinAmount = depositRes.PoolCoin.Amount.AddRaw(1)
+	_, _, _, err = simapp.LiquidityKeeper.CalculateDeposit(ctx, *minPoolCoinMsg)
+	require.ErrorIs(t, err, types.ErrLessThanMinPoolCoinAmount)
+
+	_, err = querier.EstimateWithdraw(sdk.WrapSDKContext(ctx), &types.QueryEstimateWithdrawRequest{PoolId: poolID + 1, PoolCoin: poolCoin.String()})
+	require.Error(t, err)
+	_, err = querier.EstimateWithdraw(sdk.WrapSDKContext(ctx), &types.QueryEstimateWithdrawRequest{PoolId: poolID, PoolCoin: "1000" + DenomX})
+	require.Error(t, err)
+	_, err = querier.EstimateWithdraw(sdk.WrapSDKContext(ctx), &types.QueryEstimateWithdrawRequest{PoolId: poolID, PoolCoin: "0" + pool.PoolCoinDenom})
+	require.Error(t, err)
+	_, err = querier.EstimateWithdraw(sdk.WrapSDKContext(ctx), &types.QueryEstimateWithdrawRequest{
+		PoolId: poolID, PoolCoin: simapp.LiquidityKeeper.GetPoolCoinTotal(ctx, pool).AddAmount(sdk.OneInt()).String()})
+	require.Error(t, err)
+}
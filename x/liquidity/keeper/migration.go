@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	v043 "github.com/gravity-devs/liquidity/v2/x/liquidity/legacy/v043"
+	v046 "github.com/gravity-devs/liquidity/v2/x/liquidity/legacy/v046"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -20,3 +21,8 @@ func NewMigrator(keeper Keeper) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v043.MigrateStore(ctx, m.keeper.storeKey)
 }
+
+// Migrate2to3 migrates from version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v046.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc, m.keeper.paramSpace)
+}
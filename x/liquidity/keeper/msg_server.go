@@ -94,10 +94,51 @@ func (k msgServer) DepositWithinBatch(goCtx context.Context, msg *types.MsgDepos
 	return &types.MsgDepositWithinBatchResponse{}, nil
 }
 
+// Message server, handler for MsgDepositSingleAssetWithinBatch
+func (k msgServer) DepositSingleAssetWithinBatch(goCtx context.Context, msg *types.MsgDepositSingleAssetWithinBatch) (*types.MsgDepositSingleAssetWithinBatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetCircuitBreakerEnabled(ctx) {
+		return nil, types.ErrCircuitBreakerEnabled
+	}
+
+	poolBatch, found := k.GetPoolBatch(ctx, msg.PoolId)
+	if !found {
+		return nil, types.ErrPoolBatchNotExists
+	}
+
+	batchMsg, err := k.Keeper.DepositSingleAssetWithinBatch(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeDepositSingleAssetWithinBatch,
+			sdk.NewAttribute(types.AttributeValuePoolId, strconv.FormatUint(batchMsg.Msg.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeValueBatchIndex, strconv.FormatUint(poolBatch.Index, 10)),
+			sdk.NewAttribute(types.AttributeValueMsgIndex, strconv.FormatUint(batchMsg.MsgIndex, 10)),
+			sdk.NewAttribute(types.AttributeValueSwapMsgIndex, strconv.FormatUint(batchMsg.SwapMsgIndex, 10)),
+			sdk.NewAttribute(types.AttributeValueDepositCoins, msg.DepositCoin.String()),
+		),
+	})
+
+	return &types.MsgDepositSingleAssetWithinBatchResponse{}, nil
+}
+
 // Message server, handler for MsgWithdrawWithinBatch
 func (k msgServer) WithdrawWithinBatch(goCtx context.Context, msg *types.MsgWithdrawWithinBatch) (*types.MsgWithdrawWithinBatchResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// the withdrawal with a target denom swaps within the batch, which is not allowed while the circuit breaker is enabled
+	if msg.TargetDenom != "" && k.GetCircuitBreakerEnabled(ctx) {
+		return nil, types.ErrCircuitBreakerEnabled
+	}
+
 	poolBatch, found := k.GetPoolBatch(ctx, msg.PoolId)
 	if !found {
 		return nil, types.ErrPoolBatchNotExists
@@ -108,6 +149,17 @@ func (k msgServer) WithdrawWithinBatch(goCtx context.Context, msg *types.MsgWith
 		return nil, err
 	}
 
+	res := &types.MsgWithdrawWithinBatchResponse{
+		MsgIndex:    batchMsg.MsgIndex,
+		TargetDenom: msg.TargetDenom,
+	}
+	if msg.TargetDenom != "" {
+		res.ExpectedTargetCoin, err = k.CalculateWithdrawalTargetCoin(ctx, *msg)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -123,10 +175,10 @@ func (k msgServer) WithdrawWithinBatch(goCtx context.Context, msg *types.MsgWith
 		),
 	})
 
-	return &types.MsgWithdrawWithinBatchResponse{}, nil
+	return res, nil
 }
 
-// Deprecated: Message server, handler for MsgSwapWithinBatch
+// Message server, handler for MsgSwapWithinBatch
 func (k msgServer) Swap(goCtx context.Context, msg *types.MsgSwapWithinBatch) (*types.MsgSwapWithinBatchResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -134,5 +186,264 @@ func (k msgServer) Swap(goCtx context.Context, msg *types.MsgSwapWithinBatch) (*
 		return nil, types.ErrCircuitBreakerEnabled
 	}
 
+	poolBatch, found := k.GetPoolBatch(ctx, msg.PoolId)
+	if !found {
+		return nil, types.ErrPoolBatchNotExists
+	}
+
+	batchMsg, err := k.Keeper.SwapWithinBatch(ctx, msg, int64(k.GetSwapOrderLifespan(ctx)))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeSwapWithinBatch,
+			sdk.NewAttribute(types.AttributeValuePoolId, strconv.FormatUint(batchMsg.Msg.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeValueBatchIndex, strconv.FormatUint(poolBatch.Index, 10)),
+			sdk.NewAttribute(types.AttributeValueMsgIndex, strconv.FormatUint(batchMsg.MsgIndex, 10)),
+			sdk.NewAttribute(types.AttributeValueSwapTypeId, strconv.FormatUint(uint64(batchMsg.Msg.SwapTypeId), 10)),
+			sdk.NewAttribute(types.AttributeValueOfferCoinDenom, batchMsg.Msg.OfferCoin.Denom),
+			sdk.NewAttribute(types.AttributeValueOfferCoinAmount, batchMsg.Msg.OfferCoin.Amount.String()),
+			sdk.NewAttribute(types.AttributeValueOfferCoinFeeAmount, batchMsg.Msg.OfferCoinFee.Amount.String()),
+			sdk.NewAttribute(types.AttributeValueDemandCoinDenom, batchMsg.Msg.DemandCoinDenom),
+			sdk.NewAttribute(types.AttributeValueOrderPrice, batchMsg.Msg.OrderPrice.String()),
+			sdk.NewAttribute(types.AttributeValueOrderExpiryHeight, strconv.FormatInt(batchMsg.OrderExpiryHeight, 10)),
+		),
+	})
+
 	return &types.MsgSwapWithinBatchResponse{}, nil
 }
+
+// Message server, handler for MsgSwapRoute
+func (k msgServer) SwapRoute(goCtx context.Context, msg *types.MsgSwapRoute) (*types.MsgSwapRouteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetCircuitBreakerEnabled(ctx) {
+		return nil, types.ErrCircuitBreakerEnabled
+	}
+
+	poolBatch, found := k.GetPoolBatch(ctx, msg.PoolIds[0])
+	if !found {
+		return nil, types.ErrPoolBatchNotExists
+	}
+
+	batchMsg, err := k.Keeper.SwapRouteWithinBatch(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeSwapRoute,
+			sdk.NewAttribute(types.AttributeValuePoolId, strconv.FormatUint(poolBatch.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeValueBatchIndex, strconv.FormatUint(poolBatch.Index, 10)),
+			sdk.NewAttribute(types.AttributeValueMsgIndex, strconv.FormatUint(batchMsg.MsgIndex, 10)),
+			sdk.NewAttribute(types.AttributeValuePoolIds, types.PoolIDsString(batchMsg.Msg.PoolIds)),
+			sdk.NewAttribute(types.AttributeValueOfferCoinDenom, batchMsg.Msg.OfferCoin.Denom),
+			sdk.NewAttribute(types.AttributeValueOfferCoinAmount, batchMsg.Msg.OfferCoin.Amount.String()),
+			sdk.NewAttribute(types.AttributeValueOfferCoinFeeAmount, batchMsg.Msg.OfferCoinFee.Amount.String()),
+			sdk.NewAttribute(types.AttributeValueMinDemandCoin, batchMsg.Msg.MinDemandCoin.String()),
+		),
+	})
+
+	return &types.MsgSwapRouteResponse{}, nil
+}
+
+// Message server, handler for MsgCancelDeposit
+func (k msgServer) CancelDeposit(goCtx context.Context, msg *types.MsgCancelDeposit) (*types.MsgCancelDepositResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	poolBatch, found := k.GetPoolBatch(ctx, msg.PoolId)
+	if !found {
+		return nil, types.ErrPoolBatchNotExists
+	}
+
+	batchMsg, err := k.Keeper.CancelDeposit(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeCancelDeposit,
+			sdk.NewAttribute(types.AttributeValuePoolId, strconv.FormatUint(batchMsg.Msg.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeValueBatchIndex, strconv.FormatUint(poolBatch.Index, 10)),
+			sdk.NewAttribute(types.AttributeValueMsgIndex, strconv.FormatUint(batchMsg.MsgIndex, 10)),
+			sdk.NewAttribute(types.AttributeValueDepositor, batchMsg.Msg.GetDepositor().String()),
+			sdk.NewAttribute(types.AttributeValueRefundedCoins, batchMsg.Msg.DepositCoins.String()),
+		),
+	})
+
+	return &types.MsgCancelDepositResponse{}, nil
+}
+
+// Message server, handler for MsgCancelWithdraw
+func (k msgServer) CancelWithdraw(goCtx context.Context, msg *types.MsgCancelWithdraw) (*types.MsgCancelWithdrawResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	poolBatch, found := k.GetPoolBatch(ctx, msg.PoolId)
+	if !found {
+		return nil, types.ErrPoolBatchNotExists
+	}
+
+	batchMsg, err := k.Keeper.CancelWithdraw(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeCancelWithdraw,
+			sdk.NewAttribute(types.AttributeValuePoolId, strconv.FormatUint(batchMsg.Msg.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeValueBatchIndex, strconv.FormatUint(poolBatch.Index, 10)),
+			sdk.NewAttribute(types.AttributeValueMsgIndex, strconv.FormatUint(batchMsg.MsgIndex, 10)),
+			sdk.NewAttribute(types.AttributeValueWithdrawer, batchMsg.Msg.GetWithdrawer().String()),
+			sdk.NewAttribute(types.AttributeValueRefundedCoins, sdk.NewCoins(batchMsg.Msg.PoolCoin).String()),
+		),
+	})
+
+	return &types.MsgCancelWithdrawResponse{}, nil
+}
+
+// Message server, handler for MsgCancelSwap
+func (k msgServer) CancelSwap(goCtx context.Context, msg *types.MsgCancelSwap) (*types.MsgCancelSwapResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	poolBatch, found := k.GetPoolBatch(ctx, msg.PoolId)
+	if !found {
+		return nil, types.ErrPoolBatchNotExists
+	}
+
+	batchMsg, err := k.Keeper.CancelSwap(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeCancelSwap,
+			sdk.NewAttribute(types.AttributeValuePoolId, strconv.FormatUint(batchMsg.Msg.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeValueBatchIndex, strconv.FormatUint(poolBatch.Index, 10)),
+			sdk.NewAttribute(types.AttributeValueMsgIndex, strconv.FormatUint(batchMsg.MsgIndex, 10)),
+			sdk.NewAttribute(types.AttributeValueSwapRequester, batchMsg.Msg.GetSwapRequester().String()),
+			sdk.NewAttribute(types.AttributeValueRefundedCoins, sdk.NewCoins(batchMsg.RemainingOfferCoin.Add(batchMsg.ReservedOfferCoinFee)).String()),
+		),
+	})
+
+	return &types.MsgCancelSwapResponse{}, nil
+}
+
+// Message server, handler for MsgSetPoolStatus
+func (k msgServer) SetPoolStatus(goCtx context.Context, msg *types.MsgSetPoolStatus) (*types.MsgSetPoolStatusResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pool, err := k.Keeper.SetPoolStatus(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeSetPoolStatus,
+			sdk.NewAttribute(types.AttributeValuePoolId, strconv.FormatUint(pool.Id, 10)),
+			sdk.NewAttribute(types.AttributeValueStatus, pool.Status.String()),
+			sdk.NewAttribute(types.AttributeValueAuthority, msg.Authority),
+		),
+	})
+
+	return &types.MsgSetPoolStatusResponse{}, nil
+}
+
+// Message server, handler for MsgSetCircuitBreaker
+func (k msgServer) SetCircuitBreaker(goCtx context.Context, msg *types.MsgSetCircuitBreaker) (*types.MsgSetCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.SetCircuitBreaker(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeSetCircuitBreaker,
+			sdk.NewAttribute(types.AttributeValuePoolId, strconv.FormatUint(msg.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeValueEnabled, strconv.FormatBool(msg.Enabled)),
+			sdk.NewAttribute(types.AttributeValueAuthority, msg.Authority),
+		),
+	})
+
+	return &types.MsgSetCircuitBreakerResponse{}, nil
+}
+
+// Message server, handler for MsgUpdateParams
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.UpdateParams(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeUpdateParams,
+			sdk.NewAttribute(types.AttributeValueAuthority, msg.Authority),
+		),
+	})
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// Message server, handler for MsgWindDownPool
+func (k msgServer) WindDownPool(goCtx context.Context, msg *types.MsgWindDownPool) (*types.MsgWindDownPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.Keeper.WindDownPool(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+		sdk.NewEvent(
+			types.EventTypeWindDownPool,
+			sdk.NewAttribute(types.AttributeValuePoolId, strconv.FormatUint(msg.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeValueAuthority, msg.Authority),
+		),
+	})
+
+	return &types.MsgWindDownPoolResponse{}, nil
+}
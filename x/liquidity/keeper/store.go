@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	gogotypes "github.com/gogo/protobuf/types"
 
@@ -119,11 +121,61 @@ func (k Keeper) SetPoolByReserveAccIndex(ctx sdk.Context, pool types.Pool) {
 	store.Set(types.GetPoolByReserveAccIndexKey(pool.GetReserveAccount()), b)
 }
 
-// SetPoolAtomic sets pool with set global pool id index +1 and index by reserveAcc
+// DeletePoolByReserveAccIndex deletes the index of the pool by the reserve account
+func (k Keeper) DeletePoolByReserveAccIndex(ctx sdk.Context, pool types.Pool) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPoolByReserveAccIndexKey(pool.GetReserveAccount()))
+}
+
+// SetPoolByReserveCoinDenomIndex sets the index of the pool by each reserve coin denom of the pool
+func (k Keeper) SetPoolByReserveCoinDenomIndex(ctx sdk.Context, pool types.Pool) {
+	store := ctx.KVStore(k.storeKey)
+	for _, denom := range pool.ReserveCoinDenoms {
+		store.Set(types.GetPoolByReserveCoinDenomIndexKey(denom, pool.Id), []byte{})
+	}
+}
+
+// DeletePoolByReserveCoinDenomIndex deletes the index of the pool by each reserve coin denom of the pool
+func (k Keeper) DeletePoolByReserveCoinDenomIndex(ctx sdk.Context, pool types.Pool) {
+	store := ctx.KVStore(k.storeKey)
+	for _, denom := range pool.ReserveCoinDenoms {
+		store.Delete(types.GetPoolByReserveCoinDenomIndexKey(denom, pool.Id))
+	}
+}
+
+// IteratePoolsByReserveCoinDenom iterates through the pools with the reserve coin denom in ascending order of the pool id
+func (k Keeper) IteratePoolsByReserveCoinDenom(ctx sdk.Context, denom string, cb func(pool types.Pool) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetPoolsByReserveCoinDenomIndexPrefix(denom)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		pool, found := k.GetPool(ctx, sdk.BigEndianToUint64(iterator.Key()[len(prefix):]))
+		if !found {
+			continue
+		}
+		if cb(pool) {
+			break
+		}
+	}
+}
+
+// GetPoolsByReserveCoinDenom returns all pools with the reserve coin denom
+func (k Keeper) GetPoolsByReserveCoinDenom(ctx sdk.Context, denom string) (pools []types.Pool) {
+	k.IteratePoolsByReserveCoinDenom(ctx, denom, func(pool types.Pool) bool {
+		pools = append(pools, pool)
+		return false
+	})
+	return pools
+}
+
+// SetPoolAtomic sets pool with set global pool id index +1 and indexes by reserveAcc and by reserve coin denoms
 func (k Keeper) SetPoolAtomic(ctx sdk.Context, pool types.Pool) types.Pool {
 	pool.Id = k.GetNextPoolIDWithUpdate(ctx)
 	k.SetPool(ctx, pool)
 	k.SetPoolByReserveAccIndex(ctx, pool)
+	k.SetPoolByReserveCoinDenomIndex(ctx, pool)
 	return pool
 }
 
@@ -199,7 +251,7 @@ func (k Keeper) GetPoolBatchDepositMsgState(ctx sdk.Context, poolID, msgIndex ui
 func (k Keeper) SetPoolBatchDepositMsgState(ctx sdk.Context, poolID uint64, state types.DepositMsgState) {
 	store := ctx.KVStore(k.storeKey)
 	b := types.MustMarshalDepositMsgState(k.cdc, state)
-	store.Set(types.GetPoolBatchDepositMsgStateIndexKey(poolID, state.MsgIndex), b)
+	k.setPoolBatchMsgState(store, state.Msg.GetDepositor(), types.GetPoolBatchDepositMsgStateIndexKey(poolID, state.MsgIndex), b)
 }
 
 // SetPoolBatchDepositMsgStatesByPointer sets deposit batch msgs of the pool batch, with current state using pointers
@@ -210,7 +262,7 @@ func (k Keeper) SetPoolBatchDepositMsgStatesByPointer(ctx sdk.Context, poolID ui
 			continue
 		}
 		b := types.MustMarshalDepositMsgState(k.cdc, *state)
-		store.Set(types.GetPoolBatchDepositMsgStateIndexKey(poolID, state.MsgIndex), b)
+		k.setPoolBatchMsgState(store, state.Msg.GetDepositor(), types.GetPoolBatchDepositMsgStateIndexKey(poolID, state.MsgIndex), b)
 	}
 }
 
@@ -222,7 +274,7 @@ func (k Keeper) SetPoolBatchDepositMsgStates(ctx sdk.Context, poolID uint64, sta
 			continue
 		}
 		b := types.MustMarshalDepositMsgState(k.cdc, state)
-		store.Set(types.GetPoolBatchDepositMsgStateIndexKey(poolID, state.MsgIndex), b)
+		k.setPoolBatchMsgState(store, state.Msg.GetDepositor(), types.GetPoolBatchDepositMsgStateIndexKey(poolID, state.MsgIndex), b)
 	}
 }
 
@@ -307,7 +359,7 @@ func (k Keeper) DeleteAllReadyPoolBatchDepositMsgStates(ctx sdk.Context, poolBat
 	for ; iterator.Valid(); iterator.Next() {
 		state := types.MustUnmarshalDepositMsgState(k.cdc, iterator.Value())
 		if state.ToBeDeleted {
-			store.Delete(iterator.Key())
+			k.deletePoolBatchMsgState(store, state.Msg.GetDepositor(), iterator.Key())
 		}
 	}
 }
@@ -330,7 +382,7 @@ func (k Keeper) GetPoolBatchWithdrawMsgState(ctx sdk.Context, poolID, msgIndex u
 func (k Keeper) SetPoolBatchWithdrawMsgState(ctx sdk.Context, poolID uint64, state types.WithdrawMsgState) {
 	store := ctx.KVStore(k.storeKey)
 	b := types.MustMarshalWithdrawMsgState(k.cdc, state)
-	store.Set(types.GetPoolBatchWithdrawMsgStateIndexKey(poolID, state.MsgIndex), b)
+	k.setPoolBatchMsgState(store, state.Msg.GetWithdrawer(), types.GetPoolBatchWithdrawMsgStateIndexKey(poolID, state.MsgIndex), b)
 }
 
 // set withdraw batch msgs of the liquidity pool batch, with current state using pointers
@@ -341,7 +393,7 @@ func (k Keeper) SetPoolBatchWithdrawMsgStatesByPointer(ctx sdk.Context, poolID u
 			continue
 		}
 		b := types.MustMarshalWithdrawMsgState(k.cdc, *state)
-		store.Set(types.GetPoolBatchWithdrawMsgStateIndexKey(poolID, state.MsgIndex), b)
+		k.setPoolBatchMsgState(store, state.Msg.GetWithdrawer(), types.GetPoolBatchWithdrawMsgStateIndexKey(poolID, state.MsgIndex), b)
 	}
 }
 
@@ -353,7 +405,7 @@ func (k Keeper) SetPoolBatchWithdrawMsgStates(ctx sdk.Context, poolID uint64, st
 			continue
 		}
 		b := types.MustMarshalWithdrawMsgState(k.cdc, state)
-		store.Set(types.GetPoolBatchWithdrawMsgStateIndexKey(poolID, state.MsgIndex), b)
+		k.setPoolBatchMsgState(store, state.Msg.GetWithdrawer(), types.GetPoolBatchWithdrawMsgStateIndexKey(poolID, state.MsgIndex), b)
 	}
 }
 
@@ -437,7 +489,7 @@ func (k Keeper) DeleteAllReadyPoolBatchWithdrawMsgStates(ctx sdk.Context, poolBa
 	for ; iterator.Valid(); iterator.Next() {
 		state := types.MustUnmarshalWithdrawMsgState(k.cdc, iterator.Value())
 		if state.ToBeDeleted {
-			store.Delete(iterator.Key())
+			k.deletePoolBatchMsgState(store, state.Msg.GetWithdrawer(), iterator.Key())
 		}
 	}
 }
@@ -460,14 +512,17 @@ func (k Keeper) GetPoolBatchSwapMsgState(ctx sdk.Context, poolID, msgIndex uint6
 func (k Keeper) SetPoolBatchSwapMsgState(ctx sdk.Context, poolID uint64, state types.SwapMsgState) {
 	store := ctx.KVStore(k.storeKey)
 	b := types.MustMarshalSwapMsgState(k.cdc, state)
-	store.Set(types.GetPoolBatchSwapMsgStateIndexKey(poolID, state.MsgIndex), b)
+	k.setPoolBatchMsgState(store, state.Msg.GetSwapRequester(), types.GetPoolBatchSwapMsgStateIndexKey(poolID, state.MsgIndex), b)
 }
 
 // Delete swap batch msg of the liquidity pool batch, it used for test case
 func (k Keeper) DeletePoolBatchSwapMsgState(ctx sdk.Context, poolID uint64, msgIndex uint64) {
+	state, found := k.GetPoolBatchSwapMsgState(ctx, poolID, msgIndex)
+	if !found {
+		return
+	}
 	store := ctx.KVStore(k.storeKey)
-	batchKey := types.GetPoolBatchSwapMsgStateIndexKey(poolID, msgIndex)
-	store.Delete(batchKey)
+	k.deletePoolBatchMsgState(store, state.Msg.GetSwapRequester(), types.GetPoolBatchSwapMsgStateIndexKey(poolID, msgIndex))
 }
 
 // IterateAllPoolBatchSwapMsgStates iterate through all of the LiquidityPoolBatchSwapMsgs
@@ -519,7 +574,7 @@ func (k Keeper) DeleteAllReadyPoolBatchSwapMsgStates(ctx sdk.Context, poolBatch
 	for ; iterator.Valid(); iterator.Next() {
 		state := types.MustUnmarshalSwapMsgState(k.cdc, iterator.Value())
 		if state.ToBeDeleted {
-			store.Delete(iterator.Key())
+			k.deletePoolBatchMsgState(store, state.Msg.GetSwapRequester(), iterator.Key())
 		}
 	}
 }
@@ -583,7 +638,7 @@ func (k Keeper) SetPoolBatchSwapMsgStatesByPointer(ctx sdk.Context, poolID uint6
 			continue
 		}
 		b := types.MustMarshalSwapMsgState(k.cdc, *state)
-		store.Set(types.GetPoolBatchSwapMsgStateIndexKey(poolID, state.MsgIndex), b)
+		k.setPoolBatchMsgState(store, state.Msg.GetSwapRequester(), types.GetPoolBatchSwapMsgStateIndexKey(poolID, state.MsgIndex), b)
 	}
 }
 
@@ -595,6 +650,266 @@ func (k Keeper) SetPoolBatchSwapMsgStates(ctx sdk.Context, poolID uint64, states
 			continue
 		}
 		b := types.MustMarshalSwapMsgState(k.cdc, state)
-		store.Set(types.GetPoolBatchSwapMsgStateIndexKey(poolID, state.MsgIndex), b)
+		k.setPoolBatchMsgState(store, state.Msg.GetSwapRequester(), types.GetPoolBatchSwapMsgStateIndexKey(poolID, state.MsgIndex), b)
+	}
+}
+
+// return a specific SwapRouteMsgState given the pool_id with the msg_index
+func (k Keeper) GetPoolBatchSwapRouteMsgState(ctx sdk.Context, poolID, msgIndex uint64) (state types.SwapRouteMsgState, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetPoolBatchSwapRouteMsgStateIndexKey(poolID, msgIndex)
+
+	value := store.Get(key)
+	if value == nil {
+		return state, false
+	}
+
+	state = types.MustUnmarshalSwapRouteMsgState(k.cdc, value)
+	return state, true
+}
+
+// set swap route batch msg of the liquidity pool batch, with current state
+func (k Keeper) SetPoolBatchSwapRouteMsgState(ctx sdk.Context, poolID uint64, state types.SwapRouteMsgState) {
+	store := ctx.KVStore(k.storeKey)
+	b := types.MustMarshalSwapRouteMsgState(k.cdc, state)
+	k.setPoolBatchMsgState(store, state.Msg.GetSwapRequester(), types.GetPoolBatchSwapRouteMsgStateIndexKey(poolID, state.MsgIndex), b)
+}
+
+// IterateAllPoolBatchSwapRouteMsgStates iterate through all of the SwapRouteMsgStates of the liquidity pool batch
+func (k Keeper) IterateAllPoolBatchSwapRouteMsgStates(ctx sdk.Context, poolBatch types.PoolBatch, cb func(state types.SwapRouteMsgState) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	prefix := types.GetPoolBatchSwapRouteMsgStatesPrefix(poolBatch.PoolId)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		state := types.MustUnmarshalSwapRouteMsgState(k.cdc, iterator.Value())
+		if cb(state) {
+			break
+		}
+	}
+}
+
+// IterateAllSwapRouteMsgStates iterate through all of the SwapRouteMsgState of all batches
+func (k Keeper) IterateAllSwapRouteMsgStates(ctx sdk.Context, cb func(state types.SwapRouteMsgState) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	prefix := types.PoolBatchSwapRouteMsgStateIndexKeyPrefix
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		state := types.MustUnmarshalSwapRouteMsgState(k.cdc, iterator.Value())
+		if cb(state) {
+			break
+		}
+	}
+}
+
+// GetAllSwapRouteMsgStates returns all SwapRouteMsgStates of all batches
+func (k Keeper) GetAllSwapRouteMsgStates(ctx sdk.Context) (states []types.SwapRouteMsgState) {
+	k.IterateAllSwapRouteMsgStates(ctx, func(state types.SwapRouteMsgState) bool {
+		states = append(states, state)
+		return false
+	})
+	return states
+}
+
+// delete swap route batch msgs of the liquidity pool batch which has state ToBeDeleted
+func (k Keeper) DeleteAllReadyPoolBatchSwapRouteMsgStates(ctx sdk.Context, poolBatch types.PoolBatch) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetPoolBatchSwapRouteMsgStatesPrefix(poolBatch.PoolId))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		state := types.MustUnmarshalSwapRouteMsgState(k.cdc, iterator.Value())
+		if state.ToBeDeleted {
+			k.deletePoolBatchMsgState(store, state.Msg.GetSwapRequester(), iterator.Key())
+		}
+	}
+}
+
+// GetAllPoolBatchSwapRouteMsgStates returns all SwapRouteMsgStates indexed by the liquidityPoolBatch
+func (k Keeper) GetAllPoolBatchSwapRouteMsgStates(ctx sdk.Context, poolBatch types.PoolBatch) (states []types.SwapRouteMsgState) {
+	k.IterateAllPoolBatchSwapRouteMsgStates(ctx, poolBatch, func(state types.SwapRouteMsgState) bool {
+		states = append(states, state)
+		return false
+	})
+	return states
+}
+
+// set swap route batch msgs of the liquidity pool batch, with current state
+func (k Keeper) SetPoolBatchSwapRouteMsgStates(ctx sdk.Context, poolID uint64, states []types.SwapRouteMsgState) {
+	store := ctx.KVStore(k.storeKey)
+	for _, state := range states {
+		if poolID != state.Msg.PoolIds[0] {
+			continue
+		}
+		b := types.MustMarshalSwapRouteMsgState(k.cdc, state)
+		k.setPoolBatchMsgState(store, state.Msg.GetSwapRequester(), types.GetPoolBatchSwapRouteMsgStateIndexKey(poolID, state.MsgIndex), b)
+	}
+}
+
+// setPoolBatchMsgState sets the batch msg state at the key, indexed by the address of the depositor, withdrawer or
+// swap requester of the msg
+func (k Keeper) setPoolBatchMsgState(store sdk.KVStore, addr sdk.AccAddress, key, value []byte) {
+	store.Set(key, value)
+	store.Set(types.GetBatchMsgStateByAddressIndexKey(addr, key), []byte{})
+}
+
+// deletePoolBatchMsgState deletes the batch msg state at the key and its index by the address of the msg
+func (k Keeper) deletePoolBatchMsgState(store sdk.KVStore, addr sdk.AccAddress, key []byte) {
+	store.Delete(types.GetBatchMsgStateByAddressIndexKey(addr, key))
+	store.Delete(key)
+}
+
+// GetPoolPriceRecord returns the price record of the pool at the height
+func (k Keeper) GetPoolPriceRecord(ctx sdk.Context, poolID uint64, height int64) (record types.PoolPriceRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetPoolPriceRecordKey(poolID, height))
+	if value == nil {
+		return record, false
+	}
+
+	record = types.MustUnmarshalPoolPriceRecord(k.cdc, value)
+	return record, true
+}
+
+// SetPoolPriceRecord sets the price record of the pool with the index by time
+func (k Keeper) SetPoolPriceRecord(ctx sdk.Context, record types.PoolPriceRecord) {
+	store := ctx.KVStore(k.storeKey)
+	b := types.MustMarshalPoolPriceRecord(k.cdc, record)
+	store.Set(types.GetPoolPriceRecordKey(record.PoolId, record.Height), b)
+	store.Set(types.GetPoolPriceRecordByTimeIndexKey(record.PoolId, record.Time), sdk.Uint64ToBigEndian(uint64(record.Height)))
+}
+
+// DeletePoolPriceRecord deletes the price record of the pool and the index by time
+func (k Keeper) DeletePoolPriceRecord(ctx sdk.Context, record types.PoolPriceRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPoolPriceRecordKey(record.PoolId, record.Height))
+	store.Delete(types.GetPoolPriceRecordByTimeIndexKey(record.PoolId, record.Time))
+}
+
+// GetLastPoolPriceRecordAtHeight returns the last price record of the pool at or before the height
+func (k Keeper) GetLastPoolPriceRecordAtHeight(ctx sdk.Context, poolID uint64, height int64) (record types.PoolPriceRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.ReverseIterator(types.GetPoolPriceRecordsPrefix(poolID), types.GetPoolPriceRecordKey(poolID, height+1))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return record, false
+	}
+	return types.MustUnmarshalPoolPriceRecord(k.cdc, iterator.Value()), true
+}
+
+// GetLastPoolPriceRecordAtTime returns the last price record of the pool at or before the time
+func (k Keeper) GetLastPoolPriceRecordAtTime(ctx sdk.Context, poolID uint64, t time.Time) (record types.PoolPriceRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := store.ReverseIterator(
+		types.GetPoolPriceRecordByTimeIndexPrefix(poolID),
+		types.GetPoolPriceRecordByTimeIndexKey(poolID, t.Add(time.Nanosecond)),
+	)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return record, false
+	}
+	return k.GetPoolPriceRecord(ctx, poolID, int64(sdk.BigEndianToUint64(iterator.Value())))
+}
+
+// IteratePoolPriceRecords iterates through the price records of the pool in ascending order of the height
+func (k Keeper) IteratePoolPriceRecords(ctx sdk.Context, poolID uint64, cb func(record types.PoolPriceRecord) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetPoolPriceRecordsPrefix(poolID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.MustUnmarshalPoolPriceRecord(k.cdc, iterator.Value())
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// GetAllPoolPriceRecords returns all price records of the pool
+func (k Keeper) GetAllPoolPriceRecords(ctx sdk.Context, poolID uint64) (records []types.PoolPriceRecord) {
+	k.IteratePoolPriceRecords(ctx, poolID, func(record types.PoolPriceRecord) bool {
+		records = append(records, record)
+		return false
+	})
+	return records
+}
+
+// SetPoolPriceRecords sets the price records of the pool
+func (k Keeper) SetPoolPriceRecords(ctx sdk.Context, poolID uint64, records []types.PoolPriceRecord) {
+	for _, record := range records {
+		if record.PoolId != poolID {
+			continue
+		}
+		k.SetPoolPriceRecord(ctx, record)
+	}
+}
+
+// GetPoolBatchRecord returns the record of the executed batch of the pool
+func (k Keeper) GetPoolBatchRecord(ctx sdk.Context, poolID, batchIndex uint64) (record types.PoolBatchRecord, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	value := store.Get(types.GetPoolBatchRecordKey(poolID, batchIndex))
+	if value == nil {
+		return record, false
+	}
+
+	record = types.MustUnmarshalPoolBatchRecord(k.cdc, value)
+	return record, true
+}
+
+// SetPoolBatchRecord sets the record of the executed batch of the pool
+func (k Keeper) SetPoolBatchRecord(ctx sdk.Context, record types.PoolBatchRecord) {
+	store := ctx.KVStore(k.storeKey)
+	b := types.MustMarshalPoolBatchRecord(k.cdc, record)
+	store.Set(types.GetPoolBatchRecordKey(record.PoolId, record.BatchIndex), b)
+}
+
+// DeletePoolBatchRecord deletes the record of the executed batch of the pool
+func (k Keeper) DeletePoolBatchRecord(ctx sdk.Context, record types.PoolBatchRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPoolBatchRecordKey(record.PoolId, record.BatchIndex))
+}
+
+// IteratePoolBatchRecords iterates through the batch records of the pool in ascending order of the batch index
+func (k Keeper) IteratePoolBatchRecords(ctx sdk.Context, poolID uint64, cb func(record types.PoolBatchRecord) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.GetPoolBatchRecordsPrefix(poolID))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.MustUnmarshalPoolBatchRecord(k.cdc, iterator.Value())
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// GetAllPoolBatchRecords returns all batch records of the pool
+func (k Keeper) GetAllPoolBatchRecords(ctx sdk.Context, poolID uint64) (records []types.PoolBatchRecord) {
+	k.IteratePoolBatchRecords(ctx, poolID, func(record types.PoolBatchRecord) bool {
+		records = append(records, record)
+		return false
+	})
+	return records
+}
+
+// SetPoolBatchRecords sets the batch records of the pool
+func (k Keeper) SetPoolBatchRecords(ctx sdk.Context, poolID uint64, records []types.PoolBatchRecord) {
+	for _, record := range records {
+		if record.PoolId != poolID {
+			continue
+		}
+		k.SetPoolBatchRecord(ctx, record)
 	}
 }
@@ -4,10 +4,12 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/require"
 
 	"github.com/gravity-devs/liquidity/v2/app"
 	"github.com/gravity-devs/liquidity/v2/x/liquidity"
+	"github.com/gravity-devs/liquidity/v2/x/liquidity/keeper"
 	"github.com/gravity-devs/liquidity/v2/x/liquidity/types"
 )
 
@@ -155,3 +157,154 @@ func TestIterateAllBatchMsgs(t *testing.T) {
 	require.Equal(t, types.PoolBatch{}, batch)
 	require.False(t, found)
 }
+
+func TestBatchMsgsByAddress(t *testing.T) {
+	simapp, ctx := createTestInput()
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+	querier := keeper.Querier{Keeper: simapp.LiquidityKeeper}
+	denomZ := "denomZ"
+
+	x, y, z := sdk.NewInt(1_000_000_000), sdk.NewInt(1_000_000_000), sdk.NewInt(1_000_000_000)
+	creator := app.AddRandomTestAddr(simapp, ctx, params.PoolCreationFee.Add(params.PoolCreationFee...))
+	poolID1 := app.TestCreatePool(t, simapp, ctx, x, y, DenomX, DenomY, creator)
+	poolID2 := app.TestCreatePool(t, simapp, ctx, y, z, DenomY, denomZ, creator)
+	pool1, _ := simapp.LiquidityKeeper.GetPool(ctx, poolID1)
+
+	// the sender has msgs of every type in the batches of both pools
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	offerCoin := sdk.NewCoin(DenomY, sdk.NewInt(1_000_000))
+	routeOfferCoin := sdk.NewCoin(DenomX, sdk.NewInt(1_000_000))
+	depositCoins := sdk.NewCoins(sdk.NewCoin(DenomY, sdk.NewInt(1_000_000)), sdk.NewCoin(denomZ, sdk.NewInt(1_000_000)))
+	poolCoin := sdk.NewCoin(pool1.PoolCoinDenom, sdk.NewInt(1_000))
+	sender := app.AddRandomTestAddr(simapp, ctx, depositCoins.Add(sdk.NewCoins(
+		offerCoin.Add(types.GetOfferCoinFee(offerCoin, params.SwapFeeRate)),
+		routeOfferCoin.Add(types.GetOfferCoinFee(routeOfferCoin, params.SwapFeeRate)))...))
+	require.NoError(t, simapp.BankKeeper.SendCoins(ctx, creator, sender, sdk.NewCoins(poolCoin)))
+	other := app.AddRandomTestAddr(simapp, ctx, depositCoins)
+
+	_, err := simapp.LiquidityKeeper.DepositWithinBatch(ctx, types.NewMsgDepositWithinBatch(sender, poolID2, depositCoins))
+	require.NoError(t, err)
+	_, err = simapp.LiquidityKeeper.DepositWithinBatch(ctx, types.NewMsgDepositWithinBatch(other, poolID2, depositCoins))
+	require.NoError(t, err)
+	_, err = simapp.LiquidityKeeper.WithdrawWithinBatch(ctx, types.NewMsgWithdrawWithinBatch(sender, poolID1, poolCoin))
+	require.NoError(t, err)
+	_, err = simapp.LiquidityKeeper.SwapWithinBatch(ctx, types.NewMsgSwapWithinBatch(
+		sender, poolID1, types.DefaultSwapTypeID, offerCoin, DenomX, sdk.MustNewDecFromStr("1.1"), params.SwapFeeRate), 0)
+	require.NoError(t, err)
+	_, err = simapp.LiquidityKeeper.SwapRouteWithinBatch(ctx, types.NewMsgSwapRoute(
+		sender, []uint64{poolID1, poolID2}, routeOfferCoin, sdk.NewCoin(denomZ, sdk.OneInt()), params.SwapFeeRate))
+	require.NoError(t, err)
+
+	res, err := querier.BatchMsgsByAddress(sdk.WrapSDKContext(ctx), &types.QueryBatchMsgsByAddressRequest{Address: sender.String()})
+	require.NoError(t, err)
+	require.Len(t, res.Deposits, 1)
+	require.Equal(t, sender.String(), res.Deposits[0].Msg.DepositorAddress)
+	require.Len(t, res.Withdraws, 1)
+	require.Equal(t, poolID1, res.Withdraws[0].Msg.PoolId)
+	require.Len(t, res.Swaps, 1)
+	require.Len(t, res.SwapRoutes, 1)
+	require.False(t, res.Swaps[0].Executed)
+
+	// the msgs are paginated across the msg types
+	res, err = querier.BatchMsgsByAddress(sdk.WrapSDKContext(ctx), &types.QueryBatchMsgsByAddressRequest{
+		Address:    sender.String(),
+		Pagination: &query.PageRequest{Limit: 3, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(res.Deposits)+len(res.Withdraws)+len(res.Swaps)+len(res.SwapRoutes))
+	require.Equal(t, uint64(4), res.Pagination.Total)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	// the executed msgs are kept until they are deleted at the next block
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+	res, err = querier.BatchMsgsByAddress(sdk.WrapSDKContext(ctx), &types.QueryBatchMsgsByAddressRequest{Address: sender.String()})
+	require.NoError(t, err)
+	require.Len(t, res.Deposits, 1)
+	require.True(t, res.Deposits[0].Executed)
+	require.Len(t, res.Withdraws, 1)
+	require.Len(t, res.Swaps, 1)
+	require.Len(t, res.SwapRoutes, 1)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	res, err = querier.BatchMsgsByAddress(sdk.WrapSDKContext(ctx), &types.QueryBatchMsgsByAddressRequest{Address: sender.String()})
+	require.NoError(t, err)
+	require.Empty(t, res.Deposits)
+	require.Empty(t, res.Withdraws)
+	require.Empty(t, res.Swaps)
+	require.Empty(t, res.SwapRoutes)
+
+	_, err = querier.BatchMsgsByAddress(sdk.WrapSDKContext(ctx), &types.QueryBatchMsgsByAddressRequest{Address: "invalid"})
+	require.Error(t, err)
+	_, err = querier.BatchMsgsByAddress(sdk.WrapSDKContext(ctx), &types.QueryBatchMsgsByAddressRequest{})
+	require.Error(t, err)
+}
+
+func TestPoolsByReserveCoinDenom(t *testing.T) {
+	simapp, ctx := createTestInput()
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+	querier := keeper.Querier{Keeper: simapp.LiquidityKeeper}
+
+	amt := sdk.NewInt(1_000_000_000)
+	creator := app.AddRandomTestAddr(simapp, ctx, params.PoolCreationFee.Add(params.PoolCreationFee...).Add(params.PoolCreationFee...))
+	createPool := func(poolTypeID uint32, denoms ...string) types.Pool {
+		var depositCoins sdk.Coins
+		for _, denom := range denoms {
+			depositCoins = depositCoins.Add(sdk.NewCoin(denom, amt))
+		}
+		app.SaveAccount(simapp, ctx, creator, depositCoins)
+		pool, err := simapp.LiquidityKeeper.CreatePool(ctx, types.NewMsgCreatePool(creator, poolTypeID, depositCoins))
+		require.NoError(t, err)
+		return pool
+	}
+	standardPool := createPool(types.DefaultPoolTypeID, DenomX, DenomY)
+	stablePool := createPool(types.StableSwapPoolTypeID, DenomX, DenomY)
+	multiAssetPool := createPool(types.MultiAssetPoolTypeID, DenomA, DenomB, DenomX)
+
+	require.Equal(t, []types.Pool{standardPool, stablePool, multiAssetPool}, simapp.LiquidityKeeper.GetPoolsByReserveCoinDenom(ctx, DenomX))
+	require.Equal(t, []types.Pool{standardPool, stablePool}, simapp.LiquidityKeeper.GetPoolsByReserveCoinDenom(ctx, DenomY))
+	require.Equal(t, []types.Pool{multiAssetPool}, simapp.LiquidityKeeper.GetPoolsByReserveCoinDenom(ctx, DenomA))
+
+	// the pools are queried by the reserve coin denom with pagination
+	res, err := querier.LiquidityPoolsByReserveCoinDenom(sdk.WrapSDKContext(ctx), &types.QueryLiquidityPoolsByReserveCoinDenomRequest{
+		ReserveCoinDenom: DenomX,
+		Pagination:       &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []types.Pool{standardPool, stablePool}, res.Pools)
+	require.Equal(t, uint64(3), res.Pagination.Total)
+	res, err = querier.LiquidityPoolsByReserveCoinDenom(sdk.WrapSDKContext(ctx), &types.QueryLiquidityPoolsByReserveCoinDenomRequest{
+		ReserveCoinDenom: DenomX,
+		Pagination:       &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []types.Pool{multiAssetPool}, res.Pools)
+	_, err = querier.LiquidityPoolsByReserveCoinDenom(sdk.WrapSDKContext(ctx), &types.QueryLiquidityPoolsByReserveCoinDenomRequest{ReserveCoinDenom: "denomZ"})
+	require.Error(t, err)
+
+	// the pools are queried by the pair in any order of the denoms, optionally of a pool type
+	for _, tc := range []struct {
+		denomX, denomY string
+		poolTypeID     uint32
+		expected       []types.Pool
+	}{
+		{DenomX, DenomY, 0, []types.Pool{standardPool, stablePool}},
+		{DenomY, DenomX, types.DefaultPoolTypeID, []types.Pool{standardPool}},
+		{DenomX, DenomY, types.StableSwapPoolTypeID, []types.Pool{stablePool}},
+		{DenomB, DenomX, 0, []types.Pool{multiAssetPool}},
+		{DenomA, DenomY, 0, nil},
+		{DenomX, DenomY, types.MultiAssetPoolTypeID, nil},
+	} {
+		res, err := querier.LiquidityPoolsByPair(sdk.WrapSDKContext(ctx), &types.QueryLiquidityPoolsByPairRequest{
+			DenomX: tc.denomX, DenomY: tc.denomY, PoolTypeId: tc.poolTypeID})
+		if tc.expected == nil {
+			require.Error(t, err)
+			continue
+		}
+		require.NoError(t, err)
+		require.Equal(t, tc.expected, res.Pools)
+	}
+	_, err = querier.LiquidityPoolsByPair(sdk.WrapSDKContext(ctx), &types.QueryLiquidityPoolsByPairRequest{DenomX: DenomX, DenomY: DenomX})
+	require.Error(t, err)
+}
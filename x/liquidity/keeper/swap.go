@@ -0,0 +1,547 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/gravity-devs/liquidity/v2/x/liquidity/types"
+)
+
+// SwapExecution executes the swap msgs of the pool batch at a universal swap price.
+// All matchable orders of the batch are executed at the same price and the matched amounts are transacted
+// with the pool. The orders that are not fully matched are carried over to the next batch until their
+// order expiry height, and the expired or invalid orders are cancelled.
+func (k Keeper) SwapExecution(ctx sdk.Context, poolBatch types.PoolBatch) (uint64, error) {
+	// get all swap msg states that are not executed, not succeeded and not to be deleted
+	swapMsgStates := k.GetAllNotProcessedPoolBatchSwapMsgStates(ctx, poolBatch)
+	if len(swapMsgStates) == 0 {
+		return 0, nil
+	}
+
+	pool, found := k.GetPool(ctx, poolBatch.PoolId)
+	if !found {
+		return 0, types.ErrPoolNotExists
+	}
+
+	currentHeight := ctx.BlockHeight()
+
+	// set executed states of all msgs to true, and exclude the invalid or already expired msgs from matching
+	executedMsgCount := uint64(0)
+	for _, sms := range swapMsgStates {
+		sms.Executed = true
+		executedMsgCount++
+	}
+	swapMsgStatesToMatch, swapMsgStatesToExpire := k.splitSwapMsgStatesToMatch(ctx, swapMsgStates, currentHeight)
+
+	// the reserve coins are not changed until the matched amounts are transacted
+	var lastReserveCoins sdk.Coins
+	if BatchLogicInvariantCheckFlag {
+		lastReserveCoins = k.GetReserveCoins(ctx, pool)
+	}
+
+	// the swap msgs of each pair of the reserve coins are matched at the universal swap price of the pair,
+	// and transacted before the next pair is matched with the updated reserve coins
+	for i, denomX := range pool.ReserveCoinDenoms {
+		for _, denomY := range pool.ReserveCoinDenoms[i+1:] {
+			pairSwapMsgStates := getPairSwapMsgStates(swapMsgStatesToMatch, denomX, denomY)
+			if len(pairSwapMsgStates) == 0 {
+				continue
+			}
+
+			matchResults, batchResult := k.MatchSwapMsgStates(ctx, pool, denomX, denomY, pairSwapMsgStates)
+			if err := k.TransactSwapLiquidityPool(ctx, pairSwapMsgStates, matchResults, pool, poolBatch, batchResult); err != nil {
+				return executedMsgCount, err
+			}
+		}
+	}
+	k.SetPoolBatchSwapMsgStatesByPointer(ctx, pool.Id, swapMsgStates)
+
+	// the orders that are not fully matched until their order expiry height are expired
+	swapMsgStatesToExpire = append(swapMsgStatesToExpire, types.GetExpiredOrders(swapMsgStatesToMatch, currentHeight)...)
+
+	if BatchLogicInvariantCheckFlag {
+		if curve, found := k.GetPoolCurve(pool.TypeId); found {
+			SwapCurveInvariant(curve, k.GetPoolStableSwapAmplification(ctx, pool), pool.ReserveCoinWeights, lastReserveCoins, k.GetReserveCoins(ctx, pool))
+		}
+	}
+
+	for _, sms := range swapMsgStatesToExpire {
+		if err := k.ExpireSwap(ctx, *sms, poolBatch); err != nil {
+			return executedMsgCount, err
+		}
+	}
+
+	return executedMsgCount, nil
+}
+
+// CalculateSwap matches the swap msg together with the swap msgs queued in the batch of the pool at the universal swap
+// price of the batch execution on the current reserves of the pool, without writing state. The swap msgs of the pairs
+// matched before the pair of the swap msg are transacted first as in the batch execution. It returns the match result
+// of the swap msg, of which the amounts are zero when the swap msg is not matched, and the batch result of the pair.
+func (k Keeper) CalculateSwap(ctx sdk.Context, msg types.MsgSwapWithinBatch) (types.MatchResult, types.BatchResult, error) {
+	if err := k.ValidateMsgSwapWithinBatch(ctx, msg); err != nil {
+		return types.MatchResult{}, types.BatchResult{}, err
+	}
+
+	pool, found := k.GetPool(ctx, msg.PoolId)
+	if !found {
+		return types.MatchResult{}, types.BatchResult{}, types.ErrPoolNotExists
+	}
+	poolBatch, found := k.GetPoolBatch(ctx, msg.PoolId)
+	if !found {
+		return types.MatchResult{}, types.BatchResult{}, types.ErrPoolBatchNotExists
+	}
+
+	// the transacted swap msgs are discarded with the cache context
+	ctx, _ = ctx.CacheContext()
+
+	// the queued swap msgs are matched as they would be at the next batch execution height of the pool
+	batchInterval := k.GetPoolBatchInterval(ctx, pool)
+	executionHeight := ctx.BlockHeight() + (batchInterval-ctx.BlockHeight()%batchInterval)%batchInterval
+	swapMsgStates := k.GetAllNotProcessedPoolBatchSwapMsgStates(ctx, poolBatch)
+	for _, sms := range swapMsgStates {
+		sms.Executed = true
+	}
+	swapMsgStatesToMatch, _ := k.splitSwapMsgStatesToMatch(ctx, swapMsgStates, executionHeight)
+
+	msgState := &types.SwapMsgState{
+		MsgHeight:            ctx.BlockHeight(),
+		Executed:             true,
+		MsgIndex:             poolBatch.SwapMsgIndex,
+		OrderExpiryHeight:    executionHeight,
+		ExchangedOfferCoin:   sdk.NewCoin(msg.OfferCoin.Denom, sdk.ZeroInt()),
+		RemainingOfferCoin:   msg.OfferCoin,
+		ReservedOfferCoinFee: msg.OfferCoinFee,
+		Msg:                  &msg,
+		ExchangedDemandCoin:  sdk.NewCoin(msg.DemandCoinDenom, sdk.ZeroInt()),
+	}
+	swapMsgStatesToMatch = append(swapMsgStatesToMatch, msgState)
+
+	msgDenomX, msgDenomY := types.AlphabeticalDenomPair(msg.OfferCoin.Denom, msg.DemandCoinDenom)
+	for i, denomX := range pool.ReserveCoinDenoms {
+		for _, denomY := range pool.ReserveCoinDenoms[i+1:] {
+			pairSwapMsgStates := getPairSwapMsgStates(swapMsgStatesToMatch, denomX, denomY)
+			if len(pairSwapMsgStates) == 0 {
+				continue
+			}
+
+			matchResults, batchResult := k.MatchSwapMsgStates(ctx, pool, denomX, denomY, pairSwapMsgStates)
+			if denomX != msgDenomX || denomY != msgDenomY {
+				if err := k.TransactSwapLiquidityPool(ctx, pairSwapMsgStates, matchResults, pool, poolBatch, batchResult); err != nil {
+					return types.MatchResult{}, types.BatchResult{}, err
+				}
+				continue
+			}
+
+			for _, match := range matchResults {
+				if match.SwapMsgState == msgState {
+					return match, batchResult, nil
+				}
+			}
+			return types.MatchResult{
+				OfferCoinAmt:           sdk.NewDecFromInt(msg.OfferCoin.Amount),
+				TransactedCoinAmt:      sdk.ZeroDec(),
+				ExchangedDemandCoinAmt: sdk.ZeroDec(),
+				OfferCoinFeeAmt:        sdk.ZeroDec(),
+				ExchangedCoinFeeAmt:    sdk.ZeroDec(),
+				SwapMsgState:           msgState,
+			}, batchResult, nil
+		}
+	}
+	return types.MatchResult{}, types.BatchResult{}, types.ErrNotMatchedReserveCoin
+}
+
+// MatchSwapMsgStates matches the swap msg states of a pair of the reserve coins of the pool at a universal swap price,
+// and updates the swap msg states with the match results. The denom X of the pair is sorted before the denom Y.
+func (k Keeper) MatchSwapMsgStates(ctx sdk.Context, pool types.Pool, denomX, denomY string, swapMsgStates []*types.SwapMsgState) ([]types.MatchResult, types.BatchResult) {
+	// get reserve coins from the liquidity pool and calculate the current pool price on the swap curve of the pool
+	reserveCoins := k.GetReserveCoins(ctx, pool)
+	x := sdk.NewDecFromInt(reserveCoins.AmountOf(denomX))
+	y := sdk.NewDecFromInt(reserveCoins.AmountOf(denomY))
+	curve := k.GetPoolSwapCurve(ctx, pool, denomX, denomY)
+	currentPoolPrice := curve.Price(x, y)
+
+	// make the orderbook by sorting the order map, and compute the batch result with the swap price
+	orderMap, xToY, yToX := types.MakeOrderMap(swapMsgStates, denomX, denomY, false)
+	orderBook := orderMap.SortOrderBook()
+	batchResult, _ := orderBook.Match(curve, x, y)
+
+	var matchResultXtoY, matchResultYtoX []types.MatchResult
+	if batchResult.MatchType != types.NoMatch {
+		matchResultXtoY = types.FindOrderMatch(types.DirectionXtoY, xToY, batchResult.EX, batchResult.SwapPrice)
+		matchResultYtoX = types.FindOrderMatch(types.DirectionYtoX, yToX, batchResult.EY, batchResult.SwapPrice)
+	}
+
+	if BatchLogicInvariantCheckFlag {
+		SwapPriceDirectionInvariants(currentPoolPrice, batchResult)
+		SwapPriceInvariants(matchResultXtoY, matchResultYtoX, batchResult)
+	}
+
+	types.UpdateSwapMsgStates(matchResultXtoY)
+	types.UpdateSwapMsgStates(matchResultYtoX)
+
+	if BatchLogicInvariantCheckFlag {
+		SwapMsgStatesInvariants(swapMsgStates)
+	}
+
+	return append(matchResultXtoY, matchResultYtoX...), batchResult
+}
+
+// splitSwapMsgStatesToMatch splits the swap msg states into the ones to match in the batch execution at the height,
+// and the invalid or already expired ones to expire.
+func (k Keeper) splitSwapMsgStatesToMatch(ctx sdk.Context, swapMsgStates []*types.SwapMsgState, height int64) (toMatch, toExpire []*types.SwapMsgState) {
+	for _, sms := range swapMsgStates {
+		if height > sms.OrderExpiryHeight {
+			toExpire = append(toExpire, sms)
+			continue
+		}
+		if err := k.ValidateMsgSwapWithinBatch(ctx, *sms.Msg); err != nil {
+			toExpire = append(toExpire, sms)
+			continue
+		}
+		toMatch = append(toMatch, sms)
+	}
+	return toMatch, toExpire
+}
+
+// getPairSwapMsgStates returns the swap msg states of the pair of the denoms in either direction.
+func getPairSwapMsgStates(swapMsgStates []*types.SwapMsgState, denomX, denomY string) (pairSwapMsgStates []*types.SwapMsgState) {
+	for _, sms := range swapMsgStates {
+		if (sms.Msg.OfferCoin.Denom == denomX && sms.Msg.DemandCoinDenom == denomY) ||
+			(sms.Msg.OfferCoin.Denom == denomY && sms.Msg.DemandCoinDenom == denomX) {
+			pairSwapMsgStates = append(pairSwapMsgStates, sms)
+		}
+	}
+	return pairSwapMsgStates
+}
+
+// TransactSwapLiquidityPool transacts the matched amounts between the escrow, the pool reserve
+// and the swap requesters, and stores the updated swap msg states. The remaining offer coins and the unused
+// reserved offer coin fees of the swap msgs are kept in the escrow.
+func (k Keeper) TransactSwapLiquidityPool(ctx sdk.Context, swapMsgStates []*types.SwapMsgState,
+	matchResults []types.MatchResult, pool types.Pool, poolBatch types.PoolBatch, batchResult types.BatchResult) error {
+	batchEscrowAcc := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	reserveAcc := pool.GetReserveAccount()
+
+	matchResultMap := make(map[uint64]types.MatchResult)
+	for _, match := range matchResults {
+		if _, ok := matchResultMap[match.SwapMsgState.MsgIndex]; ok {
+			return fmt.Errorf("duplicate match order")
+		}
+		matchResultMap[match.SwapMsgState.MsgIndex] = match
+	}
+
+	// The offer coins are sent to the pool reserve before the pool pays out the demand coins,
+	// so that the pool is able to pay out coins it receives in the same batch.
+	var depositInputs, payoutInputs []banktypes.Input
+	var depositOutputs, payoutOutputs []banktypes.Output
+	sendCoin := func(inputs *[]banktypes.Input, outputs *[]banktypes.Output, from, to sdk.AccAddress, coin sdk.Coin) {
+		coins := sdk.NewCoins(coin)
+		if !coins.Empty() && coins.IsValid() {
+			*inputs = append(*inputs, banktypes.NewInput(from, coins))
+			*outputs = append(*outputs, banktypes.NewOutput(to, coins))
+		}
+	}
+
+	var events sdk.Events
+	swapFeeCoins := sdk.NewCoins()
+	for _, sms := range swapMsgStates {
+		if pool.Id != sms.Msg.PoolId {
+			return fmt.Errorf("broken msg pool consistency")
+		}
+		requester := sms.Msg.GetSwapRequester()
+
+		match, ok := matchResultMap[sms.MsgIndex]
+		if !ok {
+			continue
+		}
+		transactedAmt := match.TransactedCoinAmt.TruncateInt()
+		receiveAmt := match.ExchangedDemandCoinAmt.Sub(match.ExchangedCoinFeeAmt).TruncateInt()
+		offerCoinFeeAmt := match.OfferCoinFeeAmt.TruncateInt()
+		exchangedCoinFeeAmt := match.ExchangedCoinFeeAmt.TruncateInt()
+
+		sendCoin(&depositInputs, &depositOutputs, batchEscrowAcc, reserveAcc, sdk.NewCoin(sms.Msg.OfferCoin.Denom, transactedAmt.Add(offerCoinFeeAmt)))
+		// the demand coin of the internal swap msg is kept in the escrow for the batch msg which made it
+		if sms.Internal {
+			sendCoin(&payoutInputs, &payoutOutputs, reserveAcc, batchEscrowAcc, sdk.NewCoin(sms.Msg.DemandCoinDenom, receiveAmt))
+		} else {
+			sendCoin(&payoutInputs, &payoutOutputs, reserveAcc, requester, sdk.NewCoin(sms.Msg.DemandCoinDenom, receiveAmt))
+		}
+		if sms.ExchangedDemandCoin.Amount.IsNil() {
+			sms.ExchangedDemandCoin = sdk.NewCoin(sms.Msg.DemandCoinDenom, sdk.ZeroInt())
+		}
+		sms.ExchangedDemandCoin = sms.ExchangedDemandCoin.AddAmount(receiveAmt)
+		swapFeeCoins = swapFeeCoins.Add(sdk.NewCoins(sdk.NewCoin(sms.Msg.OfferCoin.Denom, offerCoinFeeAmt), sdk.NewCoin(sms.Msg.DemandCoinDenom, exchangedCoinFeeAmt))...)
+
+		events = append(events, sdk.NewEvent(
+			types.EventTypeSwapTransacted,
+			sdk.NewAttribute(types.AttributeValuePoolId, strconv.FormatUint(pool.Id, 10)),
+			sdk.NewAttribute(types.AttributeValueBatchIndex, strconv.FormatUint(poolBatch.Index, 10)),
+			sdk.NewAttribute(types.AttributeValueMsgIndex, strconv.FormatUint(sms.MsgIndex, 10)),
+			sdk.NewAttribute(types.AttributeValueSwapRequester, requester.String()),
+			sdk.NewAttribute(types.AttributeValueSwapTypeId, strconv.FormatUint(uint64(sms.Msg.SwapTypeId), 10)),
+			sdk.NewAttribute(types.AttributeValueOfferCoinDenom, sms.Msg.OfferCoin.Denom),
+			sdk.NewAttribute(types.AttributeValueOfferCoinAmount, sms.Msg.OfferCoin.Amount.String()),
+			sdk.NewAttribute(types.AttributeValueDemandCoinDenom, sms.Msg.DemandCoinDenom),
+			sdk.NewAttribute(types.AttributeValueOrderPrice, sms.Msg.OrderPrice.String()),
+			sdk.NewAttribute(types.AttributeValueSwapPrice, batchResult.SwapPrice.String()),
+			sdk.NewAttribute(types.AttributeValueTransactedCoinAmount, transactedAmt.String()),
+			sdk.NewAttribute(types.AttributeValueRemainingOfferCoinAmount, sms.RemainingOfferCoin.Amount.String()),
+			sdk.NewAttribute(types.AttributeValueExchangedOfferCoinAmount, sms.ExchangedOfferCoin.Amount.String()),
+			sdk.NewAttribute(types.AttributeValueExchangedDemandCoinAmount, receiveAmt.String()),
+			sdk.NewAttribute(types.AttributeValueOfferCoinFeeAmount, offerCoinFeeAmt.String()),
+			sdk.NewAttribute(types.AttributeValueExchangedCoinFeeAmount, exchangedCoinFeeAmt.String()),
+			sdk.NewAttribute(types.AttributeValueReservedOfferCoinFeeAmount, sms.ReservedOfferCoinFee.Amount.String()),
+			sdk.NewAttribute(types.AttributeValueOrderExpiryHeight, strconv.FormatInt(sms.OrderExpiryHeight, 10)),
+			sdk.NewAttribute(types.AttributeValueSuccess, types.Success),
+		))
+	}
+
+	if len(depositInputs) > 0 {
+		if err := k.bankKeeper.InputOutputCoins(ctx, depositInputs, depositOutputs); err != nil {
+			return err
+		}
+	}
+	if len(payoutInputs) > 0 {
+		if err := k.bankKeeper.InputOutputCoins(ctx, payoutInputs, payoutOutputs); err != nil {
+			return err
+		}
+	}
+
+	k.SetPoolBatchSwapMsgStatesByPointer(ctx, pool.Id, swapMsgStates)
+	if len(events) > 0 {
+		k.updatePoolBatchRecord(ctx, poolBatch, func(record *types.PoolBatchRecord) {
+			record.SwapFeeCoins = record.SwapFeeCoins.Add(swapFeeCoins...)
+			record.SucceededMsgCount += uint64(len(events))
+		})
+	}
+	ctx.EventManager().EmitEvents(events)
+	return nil
+}
+
+// ExpireSwap cancels the swap msg whose order expiry height has been reached or which is no longer executable,
+// and releases the remaining offer coin and the unused reserved offer coin fee from the escrow to the swap requester.
+func (k Keeper) ExpireSwap(ctx sdk.Context, batchMsg types.SwapMsgState, batch types.PoolBatch) error {
+	batchMsg, found := k.GetPoolBatchSwapMsgState(ctx, batchMsg.Msg.PoolId, batchMsg.MsgIndex)
+	if !found {
+		return fmt.Errorf("swap msg state not found")
+	}
+	if !batchMsg.Executed || batchMsg.ToBeDeleted {
+		return fmt.Errorf("cannot expire not executed or already deleted msg")
+	}
+
+	refundCoins := sdk.NewCoins(batchMsg.RemainingOfferCoin.Add(batchMsg.ReservedOfferCoinFee))
+	if !refundCoins.Empty() {
+		if err := k.ReleaseEscrow(ctx, batchMsg.Msg.GetSwapRequester(), refundCoins); err != nil {
+			return err
+		}
+	}
+
+	// not delete now, set ToBeDeleted true for delete on next block beginblock
+	batchMsg.ToBeDeleted = true
+	k.SetPoolBatchSwapMsgState(ctx, batchMsg.Msg.PoolId, batchMsg)
+	// the swap msg fails only when it expires without any match in this or the previous batches
+	if batchMsg.ExchangedOfferCoin.Amount.IsNil() || batchMsg.ExchangedOfferCoin.IsZero() {
+		k.recordFailedMsg(ctx, batch)
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSwapExpired,
+			sdk.NewAttribute(types.AttributeValuePoolId, strconv.FormatUint(batchMsg.Msg.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeValueBatchIndex, strconv.FormatUint(batch.Index, 10)),
+			sdk.NewAttribute(types.AttributeValueMsgIndex, strconv.FormatUint(batchMsg.MsgIndex, 10)),
+			sdk.NewAttribute(types.AttributeValueSwapRequester, batchMsg.Msg.GetSwapRequester().String()),
+			sdk.NewAttribute(types.AttributeValueOfferCoinDenom, batchMsg.Msg.OfferCoin.Denom),
+			sdk.NewAttribute(types.AttributeValueOfferCoinAmount, batchMsg.Msg.OfferCoin.Amount.String()),
+			sdk.NewAttribute(types.AttributeValueRemainingOfferCoinAmount, batchMsg.RemainingOfferCoin.Amount.String()),
+			sdk.NewAttribute(types.AttributeValueExchangedOfferCoinAmount, batchMsg.ExchangedOfferCoin.Amount.String()),
+			sdk.NewAttribute(types.AttributeValueReservedOfferCoinFeeAmount, batchMsg.ReservedOfferCoinFee.Amount.String()),
+			sdk.NewAttribute(types.AttributeValueOrderExpiryHeight, strconv.FormatInt(batchMsg.OrderExpiryHeight, 10)),
+			sdk.NewAttribute(types.AttributeValueRefundedCoins, refundCoins.String()),
+		))
+	return nil
+}
+
+// ExecuteSwapRoute executes the swaps of the swap route in sequence against the pools of the route.
+// Each swap is matched alone with the pool at the universal swap price of a batch, and must be fully matched.
+// The intermediate coins are kept in the escrow, and all swaps are discarded together when any of them fails or
+// the final demand coin is less than the minimum demand coin, so that the swap route can be refunded as a whole.
+// The swaps of the intermediate pools are not appended to their batches, which can be executed at other heights, so
+// they do not wait for the batch interval of the pools and are not matched with the swap msgs queued in them.
+func (k Keeper) ExecuteSwapRoute(ctx sdk.Context, msg types.SwapRouteMsgState, batch types.PoolBatch) error {
+	if msg.Executed || msg.ToBeDeleted || msg.Succeeded {
+		return fmt.Errorf("cannot process already executed batch msg")
+	}
+	msg.Executed = true
+	k.SetPoolBatchSwapRouteMsgState(ctx, batch.PoolId, msg)
+
+	if err := k.ValidateMsgSwapRoute(ctx, *msg.Msg); err != nil {
+		return err
+	}
+
+	params := k.GetParams(ctx)
+	batchEscrowAcc := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	requester := msg.Msg.GetSwapRequester()
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	offerCoin, offerCoinFee := msg.Msg.OfferCoin, msg.Msg.OfferCoinFee
+	refundCoins := sdk.NewCoins()
+	var demandCoin sdk.Coin
+	for i, poolID := range msg.Msg.PoolIds {
+		pool, found := k.GetPool(cacheCtx, poolID)
+		if !found {
+			return types.ErrPoolNotExists
+		}
+		reserveAcc := pool.GetReserveAccount()
+		reserveCoins := k.GetReserveCoins(cacheCtx, pool)
+		currentPoolPrice := k.GetPoolPrice(cacheCtx, pool, reserveCoins)
+
+		// A lone order offering R of the reserve of the offer coin moves the price of the weighted product curve by
+		// (W_X + W_Y) / W_demand * R, so the order which does not exceed the max order amount ratio is fully matched
+		// within this order price, and the StableSwap curve moves less than the constant product of the same weights.
+		// The final demand coin is protected by the minimum demand coin instead of the order price.
+		weightX := sdk.NewDec(int64(pool.ReserveCoinWeight(pool.ReserveCoinDenoms[0])))
+		weightY := sdk.NewDec(int64(pool.ReserveCoinWeight(pool.ReserveCoinDenoms[1])))
+		demandCoinDenom, demandWeight := pool.ReserveCoinDenoms[1], weightY
+		if offerCoin.Denom == pool.ReserveCoinDenoms[1] {
+			demandCoinDenom, demandWeight = pool.ReserveCoinDenoms[0], weightX
+		}
+		maxPriceDeviation := sdk.OneDec().Add(params.MaxOrderAmountRatio.Mul(weightX.Add(weightY)).Quo(demandWeight))
+		orderPrice := currentPoolPrice.Mul(maxPriceDeviation)
+		if demandCoinDenom == pool.ReserveCoinDenoms[0] {
+			orderPrice = currentPoolPrice.Quo(maxPriceDeviation)
+		}
+
+		swapMsg := &types.MsgSwapWithinBatch{
+			SwapRequesterAddress: requester.String(),
+			PoolId:               poolID,
+			SwapTypeId:           types.DefaultSwapTypeID,
+			OfferCoin:            offerCoin,
+			OfferCoinFee:         offerCoinFee,
+			DemandCoinDenom:      demandCoinDenom,
+			OrderPrice:           orderPrice,
+		}
+		if err := k.ValidateMsgSwapWithinBatch(cacheCtx, *swapMsg); err != nil {
+			return err
+		}
+		sms := &types.SwapMsgState{
+			MsgHeight:            msg.MsgHeight,
+			MsgIndex:             msg.MsgIndex,
+			Executed:             true,
+			OrderExpiryHeight:    cacheCtx.BlockHeight(),
+			ExchangedOfferCoin:   sdk.NewCoin(offerCoin.Denom, sdk.ZeroInt()),
+			RemainingOfferCoin:   offerCoin,
+			ReservedOfferCoinFee: offerCoinFee,
+			Msg:                  swapMsg,
+			Internal:             true,
+		}
+		matchResults, _ := k.MatchSwapMsgStates(cacheCtx, pool, pool.ReserveCoinDenoms[0], pool.ReserveCoinDenoms[1], []*types.SwapMsgState{sms})
+		if len(matchResults) != 1 || !sms.RemainingOfferCoin.IsZero() {
+			return types.ErrSwapRouteNotMatched
+		}
+		match := matchResults[0]
+		transactedAmt := match.TransactedCoinAmt.TruncateInt()
+		receiveAmt := match.ExchangedDemandCoinAmt.Sub(match.ExchangedCoinFeeAmt).TruncateInt()
+		offerCoinFeeAmt := match.OfferCoinFeeAmt.TruncateInt()
+		if !receiveAmt.IsPositive() {
+			return types.ErrSwapRouteNotMatched
+		}
+
+		// the demand coin is kept in the escrow for the next swap of the route
+		inputs := []banktypes.Input{
+			banktypes.NewInput(batchEscrowAcc, sdk.NewCoins(sdk.NewCoin(offerCoin.Denom, transactedAmt.Add(offerCoinFeeAmt)))),
+			banktypes.NewInput(reserveAcc, sdk.NewCoins(sdk.NewCoin(demandCoinDenom, receiveAmt))),
+		}
+		outputs := []banktypes.Output{
+			banktypes.NewOutput(reserveAcc, sdk.NewCoins(sdk.NewCoin(offerCoin.Denom, transactedAmt.Add(offerCoinFeeAmt)))),
+			banktypes.NewOutput(batchEscrowAcc, sdk.NewCoins(sdk.NewCoin(demandCoinDenom, receiveAmt))),
+		}
+		if err := k.bankKeeper.InputOutputCoins(cacheCtx, inputs, outputs); err != nil {
+			return err
+		}
+		refundCoins = refundCoins.Add(sdk.NewCoin(offerCoin.Denom, offerCoin.Amount.Add(offerCoinFee.Amount).Sub(transactedAmt).Sub(offerCoinFeeAmt)))
+
+		demandCoin = sdk.NewCoin(demandCoinDenom, receiveAmt)
+		// the offer coin fee of the next swap is reserved at the swap fee rate of the next pool
+		if i < len(msg.Msg.PoolIds)-1 {
+			nextPool, found := k.GetPool(cacheCtx, msg.Msg.PoolIds[i+1])
+			if !found {
+				return types.ErrPoolNotExists
+			}
+			swapFeeRate := k.GetPoolSwapFeeRate(cacheCtx, nextPool)
+			offerCoin = types.GetOfferCoinWithinAmount(demandCoin, swapFeeRate)
+			offerCoinFee = types.GetOfferCoinFee(offerCoin, swapFeeRate)
+			refundCoins = refundCoins.Add(demandCoin.Sub(offerCoin).Sub(offerCoinFee))
+		}
+	}
+
+	if demandCoin.Amount.LT(msg.Msg.MinDemandCoin.Amount) {
+		return types.ErrLessThanMinDemandCoin
+	}
+
+	// the final demand coin and the dust of the coins which are not transacted are released to the swap requester
+	if err := k.ReleaseEscrow(cacheCtx, requester, refundCoins.Add(demandCoin)); err != nil {
+		return err
+	}
+	writeCache()
+
+	msg.Succeeded = true
+	msg.ToBeDeleted = true
+	msg.ExchangedDemandCoin = demandCoin
+	k.SetPoolBatchSwapRouteMsgState(ctx, batch.PoolId, msg)
+	k.recordSucceededMsg(ctx, batch)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSwapRouteTransacted,
+			sdk.NewAttribute(types.AttributeValuePoolId, strconv.FormatUint(batch.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeValueBatchIndex, strconv.FormatUint(batch.Index, 10)),
+			sdk.NewAttribute(types.AttributeValueMsgIndex, strconv.FormatUint(msg.MsgIndex, 10)),
+			sdk.NewAttribute(types.AttributeValueSwapRequester, requester.String()),
+			sdk.NewAttribute(types.AttributeValuePoolIds, types.PoolIDsString(msg.Msg.PoolIds)),
+			sdk.NewAttribute(types.AttributeValueOfferCoinDenom, msg.Msg.OfferCoin.Denom),
+			sdk.NewAttribute(types.AttributeValueOfferCoinAmount, msg.Msg.OfferCoin.Amount.String()),
+			sdk.NewAttribute(types.AttributeValueMinDemandCoin, msg.Msg.MinDemandCoin.String()),
+			sdk.NewAttribute(types.AttributeValueExchangedDemandCoin, demandCoin.String()),
+			sdk.NewAttribute(types.AttributeValueRefundedCoins, refundCoins.String()),
+			sdk.NewAttribute(types.AttributeValueSuccess, types.Success),
+		))
+	return nil
+}
+
+// RefundSwapRoute refunds the offer coin and the offer coin fee of the swap route to the swap requester,
+// the reason of the refund is recorded in the event.
+func (k Keeper) RefundSwapRoute(ctx sdk.Context, batchMsg types.SwapRouteMsgState, batch types.PoolBatch, reason error) error {
+	batchMsg, _ = k.GetPoolBatchSwapRouteMsgState(ctx, batch.PoolId, batchMsg.MsgIndex)
+	if !batchMsg.Executed || batchMsg.Succeeded {
+		return fmt.Errorf("cannot refund not executed or already succeeded msg")
+	}
+	refundCoins := sdk.NewCoins(batchMsg.Msg.OfferCoin.Add(batchMsg.Msg.OfferCoinFee))
+	if err := k.ReleaseEscrow(ctx, batchMsg.Msg.GetSwapRequester(), refundCoins); err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSwapRouteTransacted,
+			sdk.NewAttribute(types.AttributeValuePoolId, strconv.FormatUint(batch.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeValueBatchIndex, strconv.FormatUint(batch.Index, 10)),
+			sdk.NewAttribute(types.AttributeValueMsgIndex, strconv.FormatUint(batchMsg.MsgIndex, 10)),
+			sdk.NewAttribute(types.AttributeValueSwapRequester, batchMsg.Msg.GetSwapRequester().String()),
+			sdk.NewAttribute(types.AttributeValuePoolIds, types.PoolIDsString(batchMsg.Msg.PoolIds)),
+			sdk.NewAttribute(types.AttributeValueOfferCoinDenom, batchMsg.Msg.OfferCoin.Denom),
+			sdk.NewAttribute(types.AttributeValueOfferCoinAmount, batchMsg.Msg.OfferCoin.Amount.String()),
+			sdk.NewAttribute(types.AttributeValueMinDemandCoin, batchMsg.Msg.MinDemandCoin.String()),
+			sdk.NewAttribute(types.AttributeValueRefundedCoins, refundCoins.String()),
+			sdk.NewAttribute(types.AttributeValueSuccess, types.Failure),
+			sdk.NewAttribute(types.AttributeValueReason, reason.Error()),
+		))
+
+	// not delete now, set ToBeDeleted true for delete on next block beginblock
+	batchMsg.ToBeDeleted = true
+	k.SetPoolBatchSwapRouteMsgState(ctx, batch.PoolId, batchMsg)
+	k.recordFailedMsg(ctx, batch)
+	return nil
+}
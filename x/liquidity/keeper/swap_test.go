@@ -0,0 +1,501 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravity-devs/liquidity/v2/app"
+	"github.com/gravity-devs/liquidity/v2/x/liquidity"
+	"github.com/gravity-devs/liquidity/v2/x/liquidity/keeper"
+	"github.com/gravity-devs/liquidity/v2/x/liquidity/types"
+)
+
+func TestSwapExecutionExactMatch(t *testing.T) {
+	simapp, ctx := app.CreateTestInput()
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+
+	x, y := sdk.NewInt(1_000_000_000), sdk.NewInt(1_000_000_000)
+	addrs := app.AddTestAddrs(simapp, ctx, 3, params.PoolCreationFee)
+	poolID := app.TestCreatePool(t, simapp, ctx, x, y, DenomX, DenomY, addrs[0])
+	pool, found := simapp.LiquidityKeeper.GetPool(ctx, poolID)
+	require.True(t, found)
+
+	// the orders are matched with each other at the current pool price
+	offerCoins := []sdk.Coin{sdk.NewCoin(DenomX, sdk.NewInt(10000)), sdk.NewCoin(DenomY, sdk.NewInt(10000))}
+	orderPrices := []sdk.Dec{sdk.MustNewDecFromStr("1.1"), sdk.MustNewDecFromStr("0.9")}
+	msgStates, batch := app.TestSwapPool(t, simapp, ctx, offerCoins, orderPrices, addrs[1:3], poolID, true)
+	require.True(t, batch.Executed)
+	require.Len(t, msgStates, 2)
+
+	offerCoinFee := types.GetOfferCoinFee(offerCoins[0], params.SwapFeeRate)
+	for _, sms := range msgStates {
+		require.True(t, sms.Executed)
+		require.True(t, sms.Succeeded)
+		require.True(t, sms.ToBeDeleted)
+		require.True(t, sms.RemainingOfferCoin.IsZero())
+		require.True(t, sms.ReservedOfferCoinFee.IsZero())
+		require.Equal(t, sms.Msg.OfferCoin, sms.ExchangedOfferCoin)
+	}
+
+	// the exchanged coin fee is deducted at the swap price of 1
+	receiveAmt := sdk.NewInt(10000).Sub(offerCoinFee.Amount)
+	require.Equal(t, receiveAmt, simapp.BankKeeper.GetBalance(ctx, addrs[1], DenomY).Amount)
+	require.Equal(t, receiveAmt, simapp.BankKeeper.GetBalance(ctx, addrs[2], DenomX).Amount)
+	require.True(t, simapp.BankKeeper.GetBalance(ctx, addrs[1], DenomX).IsZero())
+	require.True(t, simapp.BankKeeper.GetBalance(ctx, addrs[2], DenomY).IsZero())
+
+	// the fees are accumulated in the pool
+	reserveCoins := simapp.LiquidityKeeper.GetReserveCoins(ctx, pool)
+	require.Equal(t, x.Add(offerCoinFee.Amount.MulRaw(2)), reserveCoins.AmountOf(DenomX))
+	require.Equal(t, y.Add(offerCoinFee.Amount.MulRaw(2)), reserveCoins.AmountOf(DenomY))
+
+	// the escrow is empty after the execution
+	escrowAcc := simapp.AccountKeeper.GetModuleAddress(types.ModuleName)
+	require.True(t, simapp.BankKeeper.GetAllBalances(ctx, escrowAcc).IsZero())
+
+	// the executed msg states are deleted on the next begin block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	batch, found = simapp.LiquidityKeeper.GetPoolBatch(ctx, poolID)
+	require.True(t, found)
+	require.Empty(t, simapp.LiquidityKeeper.GetAllPoolBatchSwapMsgStates(ctx, batch))
+}
+
+func TestSwapExecutionWithPool(t *testing.T) {
+	simapp, ctx := app.CreateTestInput()
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+
+	x, y := sdk.NewInt(1_000_000_000), sdk.NewInt(1_000_000_000)
+	addrs := app.AddTestAddrs(simapp, ctx, 2, params.PoolCreationFee)
+	poolID := app.TestCreatePool(t, simapp, ctx, x, y, DenomX, DenomY, addrs[0])
+	pool, found := simapp.LiquidityKeeper.GetPool(ctx, poolID)
+	require.True(t, found)
+
+	// a single X to Y order is matched against the pool and increases the pool price
+	offerCoins := []sdk.Coin{sdk.NewCoin(DenomX, sdk.NewInt(10_000_000))}
+	orderPrices := []sdk.Dec{sdk.MustNewDecFromStr("1.1")}
+	msgStates, _ := app.TestSwapPool(t, simapp, ctx, offerCoins, orderPrices, addrs[1:2], poolID, true)
+	require.Len(t, msgStates, 1)
+	require.True(t, msgStates[0].Succeeded)
+	require.True(t, msgStates[0].ToBeDeleted)
+	require.True(t, msgStates[0].RemainingOfferCoin.IsZero())
+
+	received := simapp.BankKeeper.GetBalance(ctx, addrs[1], DenomY).Amount
+	require.True(t, received.IsPositive())
+	require.True(t, received.LT(offerCoins[0].Amount))
+
+	reserveCoins := simapp.LiquidityKeeper.GetReserveCoins(ctx, pool)
+	require.True(t, reserveCoins.AmountOf(DenomX).GT(x))
+	require.True(t, reserveCoins.AmountOf(DenomY).LT(y))
+	require.Equal(t, y.Sub(received), reserveCoins.AmountOf(DenomY))
+}
+
+func TestSwapExecutionStableSwap(t *testing.T) {
+	simapp, ctx := app.CreateTestInput()
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+
+	x, y := sdk.NewInt(1_000_000_000), sdk.NewInt(1_000_000_000)
+	addrs := app.AddTestAddrs(simapp, ctx, 4, params.PoolCreationFee)
+	poolID := app.TestCreatePool(t, simapp, ctx, x, y, DenomX, DenomY, addrs[0])
+
+	// the StableSwap pool of the same reserve coins is a different pool
+	depositCoins := sdk.NewCoins(sdk.NewCoin(DenomX, x), sdk.NewCoin(DenomY, y))
+	app.SaveAccount(simapp, ctx, addrs[1], depositCoins.Add(params.PoolCreationFee...))
+	stablePool, err := simapp.LiquidityKeeper.CreatePool(ctx, types.NewMsgCreatePool(addrs[1], types.StableSwapPoolTypeID, depositCoins))
+	require.NoError(t, err)
+	require.NotEqual(t, poolID, stablePool.Id)
+	require.Equal(t, types.StableSwapCurve{Amplification: params.StableSwapAmplification}, simapp.LiquidityKeeper.GetPoolSwapCurve(ctx, stablePool, DenomX, DenomY))
+
+	// the same order is swapped on both pools
+	offerCoins := []sdk.Coin{sdk.NewCoin(DenomX, sdk.NewInt(10_000_000))}
+	orderPrices := []sdk.Dec{sdk.MustNewDecFromStr("1.1")}
+	app.TestSwapPool(t, simapp, ctx, offerCoins, orderPrices, addrs[2:3], poolID, false)
+	msgStates, _ := app.TestSwapPool(t, simapp, ctx, offerCoins, orderPrices, addrs[3:4], stablePool.Id, true)
+	require.Len(t, msgStates, 1)
+	require.True(t, msgStates[0].Succeeded)
+	require.True(t, msgStates[0].RemainingOfferCoin.IsZero())
+
+	// the StableSwap pool has much less slippage for the pegged reserve coins
+	received := simapp.BankKeeper.GetBalance(ctx, addrs[2], DenomY).Amount
+	stableReceived := simapp.BankKeeper.GetBalance(ctx, addrs[3], DenomY).Amount
+	require.True(t, stableReceived.GT(received))
+	require.True(t, stableReceived.GT(offerCoins[0].Amount.MulRaw(99).QuoRaw(100)))
+
+	pool, _ := simapp.LiquidityKeeper.GetPool(ctx, poolID)
+	poolPrice := simapp.LiquidityKeeper.GetPoolPrice(ctx, pool, simapp.LiquidityKeeper.GetReserveCoins(ctx, pool))
+	stablePoolPrice := simapp.LiquidityKeeper.GetPoolPrice(ctx, stablePool, simapp.LiquidityKeeper.GetReserveCoins(ctx, stablePool))
+	require.True(t, stablePoolPrice.GT(sdk.OneDec()))
+	require.True(t, stablePoolPrice.LT(poolPrice))
+}
+
+func TestStableSwapPoolAmplification(t *testing.T) {
+	simapp, ctx := app.CreateTestInput()
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+
+	depositCoins := sdk.NewCoins(sdk.NewCoin(DenomX, sdk.NewInt(1_000_000_000)), sdk.NewCoin(DenomY, sdk.NewInt(1_200_000_000)))
+	addrs := app.AddTestAddrs(simapp, ctx, 2, params.PoolCreationFee)
+	app.SaveAccount(simapp, ctx, addrs[0], depositCoins)
+	stablePool, err := simapp.LiquidityKeeper.CreatePool(ctx, types.NewMsgCreatePool(addrs[0], types.StableSwapPoolTypeID, depositCoins))
+	require.NoError(t, err)
+	require.Equal(t, params.StableSwapAmplification, stablePool.StableSwapAmplification)
+	stablePoolPrice := simapp.LiquidityKeeper.GetPoolPrice(ctx, stablePool, simapp.LiquidityKeeper.GetReserveCoins(ctx, stablePool))
+
+	// the other pool types have no amplification
+	app.SaveAccount(simapp, ctx, addrs[1], depositCoins)
+	pool, err := simapp.LiquidityKeeper.CreatePool(ctx, types.NewMsgCreatePool(addrs[1], types.DefaultPoolTypeID, depositCoins))
+	require.NoError(t, err)
+	require.Zero(t, pool.StableSwapAmplification)
+
+	// the change of the param does not reprice the existing StableSwap pool
+	params.StableSwapAmplification = 10
+	simapp.LiquidityKeeper.SetParams(ctx, params)
+	stablePool, _ = simapp.LiquidityKeeper.GetPool(ctx, stablePool.Id)
+	require.Equal(t, types.StableSwapCurve{Amplification: types.DefaultStableSwapAmplification}, simapp.LiquidityKeeper.GetPoolSwapCurve(ctx, stablePool, DenomX, DenomY))
+	require.Equal(t, stablePoolPrice, simapp.LiquidityKeeper.GetPoolPrice(ctx, stablePool, simapp.LiquidityKeeper.GetReserveCoins(ctx, stablePool)))
+
+	// the StableSwap pool without the amplification falls back to the param
+	stablePool.StableSwapAmplification = 0
+	require.Equal(t, types.StableSwapCurve{Amplification: 10}, simapp.LiquidityKeeper.GetPoolSwapCurve(ctx, stablePool, DenomX, DenomY))
+}
+
+func TestSwapExecutionNoMatch(t *testing.T) {
+	simapp, ctx := app.CreateTestInput()
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+
+	x, y := sdk.NewInt(1_000_000_000), sdk.NewInt(1_000_000_000)
+	addrs := app.AddTestAddrs(simapp, ctx, 2, params.PoolCreationFee)
+	poolID := app.TestCreatePool(t, simapp, ctx, x, y, DenomX, DenomY, addrs[0])
+
+	// the order price is lower than the pool price, so the order is not matched and refunded
+	offerCoin := sdk.NewCoin(DenomX, sdk.NewInt(10000))
+	msgStates, _ := app.TestSwapPool(t, simapp, ctx, []sdk.Coin{offerCoin}, []sdk.Dec{sdk.MustNewDecFromStr("0.5")}, addrs[1:2], poolID, true)
+	require.Len(t, msgStates, 1)
+	require.True(t, msgStates[0].Executed)
+	require.False(t, msgStates[0].Succeeded)
+	require.True(t, msgStates[0].ToBeDeleted)
+	require.Equal(t, offerCoin, msgStates[0].RemainingOfferCoin)
+
+	offerCoinFee := types.GetOfferCoinFee(offerCoin, params.SwapFeeRate)
+	require.Equal(t, offerCoin.Add(offerCoinFee), simapp.BankKeeper.GetBalance(ctx, addrs[1], DenomX))
+	require.True(t, simapp.BankKeeper.GetBalance(ctx, addrs[1], DenomY).IsZero())
+
+	escrowAcc := simapp.AccountKeeper.GetModuleAddress(types.ModuleName)
+	require.True(t, simapp.BankKeeper.GetAllBalances(ctx, escrowAcc).IsZero())
+}
+
+func TestSwapExecutionCarryOverAndExpiry(t *testing.T) {
+	simapp, ctx := app.CreateTestInput()
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+
+	x, y := sdk.NewInt(1_000_000_000), sdk.NewInt(1_000_000_000)
+	addrs := app.AddTestAddrs(simapp, ctx, 2, params.PoolCreationFee)
+	poolID := app.TestCreatePool(t, simapp, ctx, x, y, DenomX, DenomY, addrs[0])
+	escrowAcc := simapp.AccountKeeper.GetModuleAddress(types.ModuleName)
+
+	// the order is partially matched with the pool, because the pool price reaches the order price
+	offerCoin := sdk.NewCoin(DenomX, sdk.NewInt(10_000_000))
+	msgs := app.GetSwapMsg(t, simapp, ctx, []sdk.Coin{offerCoin}, []sdk.Dec{sdk.MustNewDecFromStr("1.001")}, addrs[1:2], poolID)
+	msgState, err := simapp.LiquidityKeeper.SwapWithinBatch(ctx, msgs[0], 3)
+	require.NoError(t, err)
+	orderExpiryHeight := ctx.BlockHeight() + 3
+	require.Equal(t, orderExpiryHeight, msgState.OrderExpiryHeight)
+
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+	sms, found := simapp.LiquidityKeeper.GetPoolBatchSwapMsgState(ctx, poolID, msgState.MsgIndex)
+	require.True(t, found)
+	require.True(t, sms.Executed)
+	require.True(t, sms.Succeeded)
+	require.False(t, sms.ToBeDeleted)
+	require.True(t, sms.ExchangedOfferCoin.IsPositive())
+	require.True(t, sms.RemainingOfferCoin.IsPositive())
+	require.True(t, sms.ReservedOfferCoinFee.IsPositive())
+
+	// the remaining offer coin and the unused offer coin fee are kept in the escrow
+	require.Equal(t, sms.RemainingOfferCoin.Add(sms.ReservedOfferCoinFee), simapp.BankKeeper.GetBalance(ctx, escrowAcc, DenomX))
+
+	// the order is carried over to the next batches until the order expiry height
+	for ctx.BlockHeight() < orderExpiryHeight {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+
+		sms, found = simapp.LiquidityKeeper.GetPoolBatchSwapMsgState(ctx, poolID, msgState.MsgIndex)
+		require.True(t, found)
+		require.False(t, sms.Executed)
+		require.False(t, sms.Succeeded)
+		require.False(t, sms.ToBeDeleted)
+		require.Equal(t, types.EventTypeSwapCarriedOver, ctx.EventManager().Events()[0].Type)
+
+		liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+	}
+
+	// the expired order is cancelled and the remaining coins are released from the escrow
+	sms, found = simapp.LiquidityKeeper.GetPoolBatchSwapMsgState(ctx, poolID, msgState.MsgIndex)
+	require.True(t, found)
+	require.True(t, sms.ToBeDeleted)
+	require.True(t, simapp.BankKeeper.GetAllBalances(ctx, escrowAcc).IsZero())
+	var expiredEvent sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeSwapExpired {
+			expiredEvent = event
+		}
+	}
+	require.Equal(t, types.EventTypeSwapExpired, expiredEvent.Type)
+
+	refundCoin := sms.RemainingOfferCoin.Add(sms.ReservedOfferCoinFee)
+	require.Equal(t, refundCoin, simapp.BankKeeper.GetBalance(ctx, addrs[1], DenomX))
+	require.True(t, simapp.BankKeeper.GetBalance(ctx, addrs[1], DenomY).IsPositive())
+
+	_, broken := keeper.AllInvariants(simapp.LiquidityKeeper)(ctx)
+	require.False(t, broken)
+
+	// the expired msg state is deleted on the next begin block
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	_, found = simapp.LiquidityKeeper.GetPoolBatchSwapMsgState(ctx, poolID, msgState.MsgIndex)
+	require.False(t, found)
+}
+
+func TestCancelSwap(t *testing.T) {
+	simapp, ctx := app.CreateTestInput()
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+
+	x, y := sdk.NewInt(1_000_000_000), sdk.NewInt(1_000_000_000)
+	addrs := app.AddTestAddrs(simapp, ctx, 3, params.PoolCreationFee)
+	poolID := app.TestCreatePool(t, simapp, ctx, x, y, DenomX, DenomY, addrs[0])
+	escrowAcc := simapp.AccountKeeper.GetModuleAddress(types.ModuleName)
+
+	// the order is not matched and carried over to the next batch
+	offerCoin := sdk.NewCoin(DenomX, sdk.NewInt(10000))
+	msgs := app.GetSwapMsg(t, simapp, ctx, []sdk.Coin{offerCoin}, []sdk.Dec{sdk.MustNewDecFromStr("0.5")}, addrs[1:2], poolID)
+	msgState, err := simapp.LiquidityKeeper.SwapWithinBatch(ctx, msgs[0], 10)
+	require.NoError(t, err)
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+
+	// the order being executed in the batch can not be cancelled
+	_, err = simapp.LiquidityKeeper.CancelSwap(ctx, types.NewMsgCancelSwap(addrs[1], poolID, msgState.MsgIndex))
+	require.ErrorIs(t, err, types.ErrBatchMsgNotCancelable)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+
+	// cancel a msg which does not exist
+	_, err = simapp.LiquidityKeeper.CancelSwap(ctx, types.NewMsgCancelSwap(addrs[1], poolID, msgState.MsgIndex+1))
+	require.ErrorIs(t, err, types.ErrBatchMsgNotExists)
+
+	// cancel a msg of the other swap requester
+	_, err = simapp.LiquidityKeeper.CancelSwap(ctx, types.NewMsgCancelSwap(addrs[2], poolID, msgState.MsgIndex))
+	require.ErrorIs(t, err, types.ErrNotBatchMsgRequester)
+
+	// the offer coin and the offer coin fee are released from the escrow
+	cancelled, err := simapp.LiquidityKeeper.CancelSwap(ctx, types.NewMsgCancelSwap(addrs[1], poolID, msgState.MsgIndex))
+	require.NoError(t, err)
+	require.True(t, cancelled.ToBeDeleted)
+	require.Equal(t, offerCoin.Add(msgs[0].OfferCoinFee), simapp.BankKeeper.GetBalance(ctx, addrs[1], DenomX))
+	require.True(t, simapp.BankKeeper.GetAllBalances(ctx, escrowAcc).IsZero())
+
+	_, broken := keeper.AllInvariants(simapp.LiquidityKeeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestSwapExecutionRandomOrders(t *testing.T) {
+	simapp, ctx := app.CreateTestInput()
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+	r := rand.New(rand.NewSource(0))
+
+	x, y := sdk.NewInt(1_000_000_000), sdk.NewInt(2_000_000_000)
+	addrs := app.AddTestAddrs(simapp, ctx, 1, params.PoolCreationFee)
+	poolID := app.TestCreatePool(t, simapp, ctx, x, y, DenomX, DenomY, addrs[0])
+
+	matchedMsgCount := 0
+	for i := 0; i < 10; i++ {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+
+		pool, found := simapp.LiquidityKeeper.GetPool(ctx, poolID)
+		require.True(t, found)
+		reserveCoins := simapp.LiquidityKeeper.GetReserveCoins(ctx, pool)
+		xToY, yToX := app.GetRandomOrders(DenomX, DenomY, reserveCoins.AmountOf(DenomX), reserveCoins.AmountOf(DenomY), r, 20, 20)
+
+		var offerCoins []sdk.Coin
+		var orderPrices []sdk.Dec
+		for _, msg := range append(xToY, yToX...) {
+			offerCoins = append(offerCoins, msg.OfferCoin)
+			orderPrices = append(orderPrices, msg.OrderPrice)
+		}
+		orderAddrs := app.AddTestAddrs(simapp, ctx, len(offerCoins), sdk.NewCoins())
+
+		msgStates, _ := app.TestSwapPool(t, simapp, ctx, offerCoins, orderPrices, orderAddrs, poolID, true)
+		require.Len(t, msgStates, len(offerCoins))
+		for _, sms := range msgStates {
+			require.True(t, sms.Executed)
+			require.True(t, sms.ToBeDeleted)
+			require.True(t, sms.ExchangedOfferCoin.Add(sms.RemainingOfferCoin).IsEqual(sms.Msg.OfferCoin))
+		}
+		matchedMsgCount += len(msgStates) - types.CountNotMatchedMsgs(msgStates)
+
+		// all matched and refunded coins are released from the escrow
+		escrowAcc := simapp.AccountKeeper.GetModuleAddress(types.ModuleName)
+		require.True(t, simapp.BankKeeper.GetAllBalances(ctx, escrowAcc).IsZero())
+
+		_, broken := keeper.AllInvariants(simapp.LiquidityKeeper)(ctx)
+		require.False(t, broken)
+	}
+	require.Positive(t, matchedMsgCount)
+}
+
+func TestBadSwap(t *testing.T) {
+	simapp, ctx := app.CreateTestInput()
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+
+	x, y := sdk.NewInt(1_000_000_000), sdk.NewInt(1_000_000_000)
+	addrs := app.AddTestAddrs(simapp, ctx, 2, params.PoolCreationFee)
+	poolID := app.TestCreatePool(t, simapp, ctx, x, y, DenomX, DenomY, addrs[0])
+
+	offerCoin := sdk.NewCoin(DenomX, sdk.NewInt(10000))
+	app.SaveAccountWithFee(simapp, ctx, addrs[1], sdk.NewCoins(offerCoin), offerCoin)
+	orderPrice := sdk.MustNewDecFromStr("1.1")
+
+	// swap with empty message
+	_, err := simapp.LiquidityKeeper.SwapWithinBatch(ctx, &types.MsgSwapWithinBatch{}, types.CancelOrderLifeSpan)
+	require.ErrorIs(t, err, types.ErrPoolNotExists)
+
+	// swap with a demand coin denom which is not in the pool
+	msg := types.NewMsgSwapWithinBatch(addrs[1], poolID, types.DefaultSwapTypeID, offerCoin, DenomA, orderPrice, params.SwapFeeRate)
+	_, err = simapp.LiquidityKeeper.SwapWithinBatch(ctx, msg, types.CancelOrderLifeSpan)
+	require.ErrorIs(t, err, types.ErrNotMatchedReserveCoin)
+
+	// swap with an invalid swap type
+	msg = types.NewMsgSwapWithinBatch(addrs[1], poolID, 2, offerCoin, DenomY, orderPrice, params.SwapFeeRate)
+	_, err = simapp.LiquidityKeeper.SwapWithinBatch(ctx, msg, types.CancelOrderLifeSpan)
+	require.ErrorIs(t, err, types.ErrSwapTypeNotExists)
+
+	// swap with a wrong offer coin fee
+	msg = types.NewMsgSwapWithinBatch(addrs[1], poolID, types.DefaultSwapTypeID, offerCoin, DenomY, orderPrice, sdk.ZeroDec())
+	_, err = simapp.LiquidityKeeper.SwapWithinBatch(ctx, msg, types.CancelOrderLifeSpan)
+	require.ErrorIs(t, err, types.ErrBadOfferCoinFee)
+
+	// swap more than the max order amount ratio of the reserve
+	largeOfferCoin := sdk.NewCoin(DenomX, x)
+	msg = types.NewMsgSwapWithinBatch(addrs[1], poolID, types.DefaultSwapTypeID, largeOfferCoin, DenomY, orderPrice, params.SwapFeeRate)
+	_, err = simapp.LiquidityKeeper.SwapWithinBatch(ctx, msg, types.CancelOrderLifeSpan)
+	require.ErrorIs(t, err, types.ErrExceededMaxOrderable)
+
+	// valid swap msg is accepted and the order expires at the next batch execution height
+	msg = types.NewMsgSwapWithinBatch(addrs[1], poolID, types.DefaultSwapTypeID, offerCoin, DenomY, orderPrice, params.SwapFeeRate)
+	msgState, err := simapp.LiquidityKeeper.SwapWithinBatch(ctx, msg, types.CancelOrderLifeSpan)
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockHeight(), msgState.OrderExpiryHeight)
+	require.Equal(t, offerCoin, msgState.RemainingOfferCoin)
+	require.Equal(t, msg.OfferCoinFee, msgState.ReservedOfferCoinFee)
+	require.True(t, msgState.ExchangedOfferCoin.IsZero())
+}
+
+func TestSimulateSwap(t *testing.T) {
+	simapp, ctx := app.CreateTestInput()
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+	querier := keeper.Querier{Keeper: simapp.LiquidityKeeper}
+
+	x, y := sdk.NewInt(1_000_000_000), sdk.NewInt(1_000_000_000)
+	addrs := app.AddTestAddrs(simapp, ctx, 3, params.PoolCreationFee)
+	poolID := app.TestCreatePool(t, simapp, ctx, x, y, DenomX, DenomY, addrs[0])
+	pool, _ := simapp.LiquidityKeeper.GetPool(ctx, poolID)
+	swapFeeRate := simapp.LiquidityKeeper.GetPoolSwapFeeRate(ctx, pool)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+
+	// a market order against the pool is fully matched above the pool price
+	offerCoin := sdk.NewCoin(DenomX, sdk.NewInt(10_000_000))
+	res, err := querier.SimulateSwap(sdk.WrapSDKContext(ctx), &types.QuerySimulateSwapRequest{
+		PoolId: poolID, OfferCoin: offerCoin.String(), DemandCoinDenom: DenomY})
+	require.NoError(t, err)
+	require.Equal(t, offerCoin, res.ExchangedOfferCoin)
+	require.True(t, res.RemainingOfferCoin.IsZero())
+	require.True(t, res.ExchangedDemandCoin.IsPositive())
+	require.Equal(t, types.GetOfferCoinFee(offerCoin, swapFeeRate), res.OfferCoinFee)
+	require.True(t, res.ExchangedCoinFee.IsPositive())
+	require.Equal(t, sdk.OneDec(), res.PoolPrice)
+	require.True(t, res.SwapPrice.GT(res.PoolPrice))
+	require.True(t, res.EffectivePrice.GT(res.SwapPrice))
+	require.True(t, res.PriceImpact.IsPositive())
+	require.Equal(t, sdk.NewCoin(DenomX, sdk.NewInt(100_000_000)), res.MaxOrderCoin)
+	require.Equal(t, sdk.NewDecWithPrec(1, 2), res.OrderAmountRatio)
+
+	// the effective price of the opposite order is also expressed in X/Y, below the swap price by the fees
+	resYtoX, err := querier.SimulateSwap(sdk.WrapSDKContext(ctx), &types.QuerySimulateSwapRequest{
+		PoolId: poolID, OfferCoin: sdk.NewCoin(DenomY, offerCoin.Amount).String(), DemandCoinDenom: DenomX})
+	require.NoError(t, err)
+	require.True(t, resYtoX.SwapPrice.LT(resYtoX.PoolPrice))
+	require.True(t, resYtoX.EffectivePrice.LT(resYtoX.SwapPrice))
+	require.True(t, resYtoX.EffectivePrice.GT(resYtoX.SwapPrice.Mul(sdk.MustNewDecFromStr("0.99"))))
+	require.True(t, res.EffectivePrice.LT(res.SwapPrice.Mul(sdk.MustNewDecFromStr("1.01"))))
+
+	// the queued opposite order is matched together and lowers the price impact
+	oppositeCoin := sdk.NewCoin(DenomY, sdk.NewInt(5_000_000))
+	app.SaveAccount(simapp, ctx, addrs[2], sdk.NewCoins(oppositeCoin.Add(types.GetOfferCoinFee(oppositeCoin, swapFeeRate))))
+	_, err = simapp.LiquidityKeeper.SwapWithinBatch(ctx, types.NewMsgSwapWithinBatch(
+		addrs[2], poolID, types.DefaultSwapTypeID, oppositeCoin, DenomX, sdk.MustNewDecFromStr("0.9"), swapFeeRate), 0)
+	require.NoError(t, err)
+	res2, err := querier.SimulateSwap(sdk.WrapSDKContext(ctx), &types.QuerySimulateSwapRequest{
+		PoolId: poolID, OfferCoin: offerCoin.String(), DemandCoinDenom: DenomY, OrderPrice: "1.1"})
+	require.NoError(t, err)
+	require.True(t, res2.PriceImpact.LT(res.PriceImpact))
+	require.True(t, res2.ExchangedDemandCoin.IsGTE(res.ExchangedDemandCoin))
+
+	// the simulation does not write state, and matches the result of the batch execution
+	batch, _ := simapp.LiquidityKeeper.GetPoolBatch(ctx, poolID)
+	require.Len(t, simapp.LiquidityKeeper.GetAllPoolBatchSwapMsgStates(ctx, batch), 1)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(DenomX, x), sdk.NewCoin(DenomY, y)), simapp.LiquidityKeeper.GetReserveCoins(ctx, pool))
+	app.SaveAccount(simapp, ctx, addrs[1], sdk.NewCoins(offerCoin.Add(res2.OfferCoinFee)))
+	msgState, err := simapp.LiquidityKeeper.SwapWithinBatch(ctx, types.NewMsgSwapWithinBatch(
+		addrs[1], poolID, types.DefaultSwapTypeID, offerCoin, DenomY, sdk.MustNewDecFromStr("1.1"), swapFeeRate), 0)
+	require.NoError(t, err)
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+	msgState, _ = simapp.LiquidityKeeper.GetPoolBatchSwapMsgState(ctx, poolID, msgState.MsgIndex)
+	require.Equal(t, res2.ExchangedOfferCoin, msgState.ExchangedOfferCoin)
+	require.Equal(t, res2.ExchangedDemandCoin, simapp.BankKeeper.GetBalance(ctx, addrs[1], DenomY))
+
+	// the simulation fails as the swap msg would fail
+	for _, req := range []*types.QuerySimulateSwapRequest{
+		{PoolId: poolID + 1, OfferCoin: offerCoin.String(), DemandCoinDenom: DenomY},
+		{PoolId: poolID, OfferCoin: "invalid", DemandCoinDenom: DenomY},
+		{PoolId: poolID, OfferCoin: offerCoin.String(), DemandCoinDenom: DenomX},
+		{PoolId: poolID, OfferCoin: offerCoin.String(), DemandCoinDenom: "denomz"},
+		{PoolId: poolID, OfferCoin: offerCoin.String(), DemandCoinDenom: DenomY, OrderPrice: "-1"},
+		{PoolId: poolID, OfferCoin: sdk.NewCoin(DenomX, x).String(), DemandCoinDenom: DenomY},
+	} {
+		_, err = querier.SimulateSwap(sdk.WrapSDKContext(ctx), req)
+		require.Error(t, err)
+	}
+
+	// the swaps of the former pairs of a multi-asset pool are transacted before the pair of the simulated swap
+	amt := sdk.NewInt(1_000_000_000)
+	depositCoins := sdk.NewCoins(sdk.NewCoin(DenomA, amt), sdk.NewCoin(DenomB, amt), sdk.NewCoin(DenomX, amt))
+	app.SaveAccount(simapp, ctx, addrs[0], depositCoins.Add(params.PoolCreationFee...))
+	multiPool, err := simapp.LiquidityKeeper.CreatePool(ctx, types.NewMsgCreatePool(addrs[0], types.MultiAssetPoolTypeID, depositCoins))
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	offerCoinA, offerCoinB := sdk.NewCoin(DenomA, sdk.NewInt(10_000_000)), sdk.NewCoin(DenomB, sdk.NewInt(10_000_000))
+	app.SaveAccount(simapp, ctx, addrs[2], sdk.NewCoins(offerCoinA.Add(types.GetOfferCoinFee(offerCoinA, params.SwapFeeRate))))
+	_, err = simapp.LiquidityKeeper.SwapWithinBatch(ctx, types.NewMsgSwapWithinBatch(addrs[2], multiPool.Id, types.DefaultSwapTypeID,
+		offerCoinA, DenomB, types.GetMaxDeviatedOrderPrice(sdk.OneDec(), types.DirectionXtoY), params.SwapFeeRate), 0)
+	require.NoError(t, err)
+	reserveCoins := simapp.LiquidityKeeper.GetReserveCoins(ctx, multiPool)
+	res, err = querier.SimulateSwap(sdk.WrapSDKContext(ctx), &types.QuerySimulateSwapRequest{
+		PoolId: multiPool.Id, OfferCoin: offerCoinB.String(), DemandCoinDenom: DenomX})
+	require.NoError(t, err)
+	require.Equal(t, offerCoinB, res.ExchangedOfferCoin)
+	require.Equal(t, reserveCoins, simapp.LiquidityKeeper.GetReserveCoins(ctx, multiPool))
+
+	app.SaveAccount(simapp, ctx, addrs[1], sdk.NewCoins(offerCoinB.Add(res.OfferCoinFee)))
+	_, err = simapp.LiquidityKeeper.SwapWithinBatch(ctx, types.NewMsgSwapWithinBatch(addrs[1], multiPool.Id, types.DefaultSwapTypeID,
+		offerCoinB, DenomX, types.GetMaxDeviatedOrderPrice(res.PoolPrice, types.DirectionXtoY), params.SwapFeeRate), 0)
+	require.NoError(t, err)
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+	require.Equal(t, res.ExchangedDemandCoin, simapp.BankKeeper.GetBalance(ctx, addrs[1], DenomX))
+}
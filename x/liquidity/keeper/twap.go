@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gravity-devs/liquidity/v2/x/liquidity/types"
+)
+
+// RecordPoolPrice records the price of the pool after the batch execution, the last recorded price of the pool is
+// accumulated to the cumulative price weighted by the seconds it lasted. The price is not recorded while the pool is depleted.
+func (k Keeper) RecordPoolPrice(ctx sdk.Context, pool types.Pool) {
+	if k.IsDepletedPool(ctx, pool) {
+		return
+	}
+	reserveCoins := k.GetReserveCoins(ctx, pool)
+
+	record := types.PoolPriceRecord{
+		PoolId:          pool.Id,
+		Height:          ctx.BlockHeight(),
+		Time:            ctx.BlockTime(),
+		Price:           k.GetPoolPrice(ctx, pool, reserveCoins),
+		CumulativePrice: sdk.ZeroDec(),
+	}
+	if last, found := k.GetLastPoolPriceRecordAtHeight(ctx, pool.Id, ctx.BlockHeight()-1); found {
+		record.CumulativePrice = last.CumulativePrice.Add(last.Price.Mul(elapsedSeconds(last.Time, record.Time)))
+	}
+	k.SetPoolPriceRecord(ctx, record)
+}
+
+// PrunePoolPriceRecords deletes the price records of the pool older than the lifespan in blocks,
+// the last price record of the pool is always kept as the base of the cumulative price.
+func (k Keeper) PrunePoolPriceRecords(ctx sdk.Context, poolID uint64, lifespan uint32) {
+	last, found := k.GetLastPoolPriceRecordAtHeight(ctx, poolID, ctx.BlockHeight())
+	if !found {
+		return
+	}
+	minHeight := ctx.BlockHeight() - int64(lifespan)
+
+	var records []types.PoolPriceRecord
+	k.IteratePoolPriceRecords(ctx, poolID, func(record types.PoolPriceRecord) bool {
+		if record.Height >= minHeight || record.Height == last.Height {
+			return true
+		}
+		records = append(records, record)
+		return false
+	})
+	for _, record := range records {
+		k.DeletePoolPriceRecord(ctx, record)
+	}
+}
+
+// GetPoolTwap returns the time-weighted average price of the pool between the start and end times.
+// The price of the pool at a time is the price of the last price record at or before the time.
+func (k Keeper) GetPoolTwap(ctx sdk.Context, poolID uint64, startTime, endTime time.Time) (sdk.Dec, error) {
+	if !startTime.Before(endTime) || endTime.After(ctx.BlockTime()) {
+		return sdk.Dec{}, types.ErrBadTwapPeriod
+	}
+
+	start, found := k.GetLastPoolPriceRecordAtTime(ctx, poolID, startTime)
+	if !found {
+		return sdk.Dec{}, types.ErrNoPriceRecord
+	}
+	end, found := k.GetLastPoolPriceRecordAtTime(ctx, poolID, endTime)
+	if !found {
+		return sdk.Dec{}, types.ErrNoPriceRecord
+	}
+
+	startCumulativePrice := start.CumulativePrice.Add(start.Price.Mul(elapsedSeconds(start.Time, startTime)))
+	endCumulativePrice := end.CumulativePrice.Add(end.Price.Mul(elapsedSeconds(end.Time, endTime)))
+	return endCumulativePrice.Sub(startCumulativePrice).Quo(elapsedSeconds(startTime, endTime)), nil
+}
+
+// GetPoolTwapByHeight returns the time-weighted average price of the pool between the times of the last price records
+// at or before the start and end heights. The price of the record is returned when both heights have the same record.
+func (k Keeper) GetPoolTwapByHeight(ctx sdk.Context, poolID uint64, startHeight, endHeight int64) (sdk.Dec, error) {
+	if startHeight <= 0 || startHeight >= endHeight || endHeight > ctx.BlockHeight() {
+		return sdk.Dec{}, types.ErrBadTwapPeriod
+	}
+
+	start, found := k.GetLastPoolPriceRecordAtHeight(ctx, poolID, startHeight)
+	if !found {
+		return sdk.Dec{}, types.ErrNoPriceRecord
+	}
+	end, found := k.GetLastPoolPriceRecordAtHeight(ctx, poolID, endHeight)
+	if !found {
+		return sdk.Dec{}, types.ErrNoPriceRecord
+	}
+
+	elapsed := elapsedSeconds(start.Time, end.Time)
+	if start.Height == end.Height || !elapsed.IsPositive() {
+		return end.Price, nil
+	}
+	return end.CumulativePrice.Sub(start.CumulativePrice).Quo(elapsed), nil
+}
+
+// elapsedSeconds returns the seconds elapsed from the time to the other time with the precision of nanoseconds.
+func elapsedSeconds(from, to time.Time) sdk.Dec {
+	return sdk.NewDecWithPrec(to.Sub(from).Nanoseconds(), 9)
+}
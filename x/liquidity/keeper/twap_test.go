@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravity-devs/liquidity/v2/app"
+	"github.com/gravity-devs/liquidity/v2/x/liquidity"
+	"github.com/gravity-devs/liquidity/v2/x/liquidity/keeper"
+	"github.com/gravity-devs/liquidity/v2/x/liquidity/types"
+)
+
+func TestPoolTwap(t *testing.T) {
+	simapp, ctx := createTestInput()
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+	startTime := time.Date(2022, 9, 1, 0, 0, 0, 0, time.UTC)
+
+	x, y := sdk.NewInt(1_000_000_000), sdk.NewInt(1_000_000_000)
+	addrs := app.AddTestAddrs(simapp, ctx, 2, params.PoolCreationFee)
+	poolID := app.TestCreatePool(t, simapp, ctx, x, y, DenomX, DenomY, addrs[0])
+	pool, _ := simapp.LiquidityKeeper.GetPool(ctx, poolID)
+
+	// the price is 1 for 10 seconds from the height 1
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(startTime)
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+
+	// the price is 2 for 20 seconds from the height 2
+	app.SaveAccount(simapp, ctx, addrs[1], sdk.NewCoins(sdk.NewCoin(DenomX, x)))
+	require.NoError(t, simapp.BankKeeper.SendCoins(ctx, addrs[1], pool.GetReserveAccount(), sdk.NewCoins(sdk.NewCoin(DenomX, x))))
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(startTime.Add(10 * time.Second))
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+
+	ctx = ctx.WithBlockHeight(3).WithBlockTime(startTime.Add(30 * time.Second))
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+
+	records := simapp.LiquidityKeeper.GetAllPoolPriceRecords(ctx, poolID)
+	require.Len(t, records, 3)
+	require.Equal(t, sdk.OneDec(), records[0].Price)
+	require.True(t, records[0].CumulativePrice.IsZero())
+	require.Equal(t, sdk.NewDec(2), records[1].Price)
+	require.Equal(t, sdk.NewDec(10), records[1].CumulativePrice)
+	require.Equal(t, sdk.NewDec(50), records[2].CumulativePrice)
+
+	twap, err := simapp.LiquidityKeeper.GetPoolTwapByHeight(ctx, poolID, 1, 3)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(50).Quo(sdk.NewDec(30)), twap)
+
+	twap, err = simapp.LiquidityKeeper.GetPoolTwapByHeight(ctx, poolID, 2, 3)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(2), twap)
+
+	twap, err = simapp.LiquidityKeeper.GetPoolTwap(ctx, poolID, startTime.Add(5*time.Second), startTime.Add(15*time.Second))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecWithPrec(15, 1), twap)
+
+	_, err = simapp.LiquidityKeeper.GetPoolTwap(ctx, poolID, startTime.Add(-time.Second), startTime.Add(15*time.Second))
+	require.ErrorIs(t, err, types.ErrNoPriceRecord)
+	_, err = simapp.LiquidityKeeper.GetPoolTwap(ctx, poolID, startTime, startTime.Add(time.Minute))
+	require.ErrorIs(t, err, types.ErrBadTwapPeriod)
+	_, err = simapp.LiquidityKeeper.GetPoolTwapByHeight(ctx, poolID, 3, 2)
+	require.ErrorIs(t, err, types.ErrBadTwapPeriod)
+
+	// the query uses the heights when they are given, otherwise the times
+	querier := keeper.Querier{Keeper: simapp.LiquidityKeeper}
+	endTime := startTime.Add(30 * time.Second)
+	res, err := querier.PoolTwap(sdk.WrapSDKContext(ctx), &types.QueryPoolTwapRequest{PoolId: poolID, StartTime: &startTime, EndTime: &endTime})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(50).Quo(sdk.NewDec(30)), res.Twap)
+	_, err = querier.PoolTwap(sdk.WrapSDKContext(ctx), &types.QueryPoolTwapRequest{PoolId: poolID})
+	require.Error(t, err)
+	_, err = querier.PoolTwap(sdk.WrapSDKContext(ctx), &types.QueryPoolTwapRequest{PoolId: poolID + 1, StartHeight: 1, EndHeight: 3})
+	require.Error(t, err)
+
+	// the records older than the lifespan are pruned except the last record
+	params.PriceRecordLifespan = 0
+	simapp.LiquidityKeeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(4).WithBlockTime(startTime.Add(40 * time.Second))
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+
+	records = simapp.LiquidityKeeper.GetAllPoolPriceRecords(ctx, poolID)
+	require.Len(t, records, 1)
+	require.Equal(t, int64(4), records[0].Height)
+	require.Equal(t, sdk.NewDec(70), records[0].CumulativePrice)
+	_, err = simapp.LiquidityKeeper.GetPoolTwapByHeight(ctx, poolID, 1, 4)
+	require.ErrorIs(t, err, types.ErrNoPriceRecord)
+}
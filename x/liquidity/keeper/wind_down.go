@@ -0,0 +1,255 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/gravity-devs/liquidity/v2/x/liquidity/types"
+)
+
+// WindDownPool delists the pool by the authority. The deposits and swaps of the pool are rejected from then on, and the
+// reserve coins of the pool coin holders are paid out over the following batches until the pool is removed.
+func (k Keeper) WindDownPool(ctx sdk.Context, msg *types.MsgWindDownPool) (types.Pool, error) {
+	if msg.Authority != k.authority {
+		return types.Pool{}, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	pool, found := k.GetPool(ctx, msg.PoolId)
+	if !found {
+		return types.Pool{}, types.ErrPoolNotExists
+	}
+	if pool.Status == types.PoolStatusWindDown {
+		return types.Pool{}, sdkerrors.Wrap(types.ErrBadPoolStatus, "pool is already winding down")
+	}
+
+	pool.Status = types.PoolStatusWindDown
+	k.SetPool(ctx, pool)
+	return pool, nil
+}
+
+// WindDownPools pays out the pool coin holders of the winding down pools at their batch execution height, and removes
+// the pools of which the pool coin supply is zero.
+func (k Keeper) WindDownPools(ctx sdk.Context) {
+	var pools []types.Pool
+	k.IterateAllPools(ctx, func(pool types.Pool) bool {
+		if pool.Status == types.PoolStatusWindDown && k.IsPoolBatchHeight(ctx, pool) {
+			pools = append(pools, pool)
+		}
+		return false
+	})
+
+	for _, pool := range pools {
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.PayOutWindDownPool(cacheCtx, pool); err != nil {
+			k.Logger(ctx).Error("wind down payout failed", "poolID", pool.Id, "error", err)
+			continue
+		}
+		writeCache()
+		if _, err := k.RemovePool(ctx, pool); err != nil {
+			k.Logger(ctx).Error("failed to remove the wound down pool", "poolID", pool.Id, "error", err)
+		}
+	}
+}
+
+// PruneDepletedPools records the batch execution height at which each pool is found depleted without pool coin
+// supply, and removes the pools which stay depleted for the DepletedPoolLifespan param. The depleted height is reset
+// when the pool is reinitialized by a deposit.
+func (k Keeper) PruneDepletedPools(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+
+	var pools []types.Pool
+	k.IterateAllPools(ctx, func(pool types.Pool) bool {
+		if k.IsPoolBatchHeight(ctx, pool) {
+			pools = append(pools, pool)
+		}
+		return false
+	})
+
+	for _, pool := range pools {
+		if !k.GetPoolCoinTotalSupply(ctx, pool).IsZero() {
+			if pool.DepletedHeight != 0 {
+				pool.DepletedHeight = 0
+				k.SetPool(ctx, pool)
+			}
+			continue
+		}
+
+		if pool.DepletedHeight == 0 {
+			pool.DepletedHeight = ctx.BlockHeight()
+			k.SetPool(ctx, pool)
+		}
+		if params.DepletedPoolLifespan == 0 || ctx.BlockHeight()-pool.DepletedHeight < int64(params.DepletedPoolLifespan) {
+			continue
+		}
+
+		removed, err := k.RemovePool(ctx, pool)
+		if err != nil {
+			k.Logger(ctx).Error("failed to prune the depleted pool", "poolID", pool.Id, "error", err)
+			continue
+		}
+		if removed {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypePruneDepletedPool,
+					sdk.NewAttribute(types.AttributeValuePoolId, strconv.FormatUint(pool.Id, 10)),
+					sdk.NewAttribute(types.AttributeValueDepletedHeight, strconv.FormatInt(pool.DepletedHeight, 10)),
+				),
+			)
+		}
+	}
+}
+
+// PayOutWindDownPool pays out the proportional reserve coins of the pool coin holders of the winding down pool without
+// the withdraw fee, up to MaxWindDownPayoutNum holders at a time. The pool coins escrowed by the module are withdrawn by
+// their withdraw msgs, and the holders of the pool coins which are not spendable yet are paid out later.
+func (k Keeper) PayOutWindDownPool(ctx sdk.Context, pool types.Pool) error {
+	curve, found := k.GetPoolCurve(pool.TypeId)
+	if !found {
+		return types.ErrPoolTypeNotExists
+	}
+	moduleAcc := k.accountKeeper.GetModuleAddress(types.ModuleName)
+
+	var payoutNum int
+	var nextKey []byte
+	for payoutNum < types.MaxWindDownPayoutNum {
+		res, err := k.bankKeeper.DenomOwners(sdk.WrapSDKContext(ctx), &banktypes.QueryDenomOwnersRequest{
+			Denom:      pool.PoolCoinDenom,
+			Pagination: &query.PageRequest{Key: nextKey, Limit: types.MaxWindDownPayoutNum},
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, owner := range res.DenomOwners {
+			if payoutNum >= types.MaxWindDownPayoutNum {
+				break
+			}
+			holder, err := sdk.AccAddressFromBech32(owner.Address)
+			if err != nil {
+				return err
+			}
+			if holder.Equals(moduleAcc) {
+				continue
+			}
+			poolCoin := sdk.NewCoin(pool.PoolCoinDenom, k.bankKeeper.SpendableCoins(ctx, holder).AmountOf(pool.PoolCoinDenom))
+			if !poolCoin.IsPositive() {
+				continue
+			}
+			if err := k.payOutPoolCoinHolder(ctx, pool, curve, holder, poolCoin); err != nil {
+				return err
+			}
+			payoutNum++
+		}
+
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			break
+		}
+		nextKey = res.Pagination.NextKey
+	}
+	return nil
+}
+
+// payOutPoolCoinHolder burns the pool coin of the holder and sends the proportional reserve coins to the holder.
+func (k Keeper) payOutPoolCoinHolder(ctx sdk.Context, pool types.Pool, curve types.PoolCurve, holder sdk.AccAddress, poolCoin sdk.Coin) error {
+	poolCoinTotalSupply := k.GetPoolCoinTotalSupply(ctx, pool)
+	reserveCoins := k.GetReserveCoins(ctx, pool)
+	reserveCoins.Sort()
+
+	withdrawCoins, _ := curve.Withdraw(reserveCoins, poolCoinTotalSupply, poolCoin.Amount, sdk.ZeroDec())
+	withdrawCoins = sdk.NewCoins(withdrawCoins...)
+
+	poolCoins := sdk.NewCoins(poolCoin)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, holder, types.ModuleName, poolCoins); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, poolCoins); err != nil {
+		return err
+	}
+	if !withdrawCoins.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, pool.GetReserveAccount(), holder, withdrawCoins); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWindDownPayout,
+			sdk.NewAttribute(types.AttributeValuePoolId, strconv.FormatUint(pool.Id, 10)),
+			sdk.NewAttribute(types.AttributeValueHolder, holder.String()),
+			sdk.NewAttribute(types.AttributeValuePoolCoinDenom, poolCoin.Denom),
+			sdk.NewAttribute(types.AttributeValuePoolCoinAmount, poolCoin.Amount.String()),
+			sdk.NewAttribute(types.AttributeValueWithdrawCoins, withdrawCoins.String()),
+		),
+	)
+	return nil
+}
+
+// RemovePool removes the pool of which the pool coin supply is zero, together with its batch, its indexes by the reserve
+// account and by the reserve coin denoms, its price records and its batch records. The dust coins left in the reserve
+// account are sent to the community pool. The pool is not removed while the batch has msgs which are not executed yet.
+// It returns true if the pool is removed.
+func (k Keeper) RemovePool(ctx sdk.Context, pool types.Pool) (bool, error) {
+	if !k.GetPoolCoinTotalSupply(ctx, pool).IsZero() {
+		return false, nil
+	}
+
+	batch, found := k.GetPoolBatch(ctx, pool.Id)
+	if found && k.hasRemainingPoolBatchMsgs(ctx, batch) {
+		return false, nil
+	}
+
+	reserveAcc := pool.GetReserveAccount()
+	dustCoins := k.bankKeeper.GetAllBalances(ctx, reserveAcc)
+	if !dustCoins.IsZero() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, dustCoins, reserveAcc); err != nil {
+			return false, err
+		}
+	}
+
+	if found {
+		k.DeleteAllReadyPoolBatchDepositMsgStates(ctx, batch)
+		k.DeleteAllReadyPoolBatchWithdrawMsgStates(ctx, batch)
+		k.DeleteAllReadyPoolBatchSwapMsgStates(ctx, batch)
+		k.DeleteAllReadyPoolBatchSwapRouteMsgStates(ctx, batch)
+		k.DeletePoolBatch(ctx, batch)
+	}
+	for _, record := range k.GetAllPoolPriceRecords(ctx, pool.Id) {
+		k.DeletePoolPriceRecord(ctx, record)
+	}
+	for _, record := range k.GetAllPoolBatchRecords(ctx, pool.Id) {
+		k.DeletePoolBatchRecord(ctx, record)
+	}
+	k.DeletePoolByReserveAccIndex(ctx, pool)
+	k.DeletePoolByReserveCoinDenomIndex(ctx, pool)
+	k.DeletePool(ctx, pool)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemovePool,
+			sdk.NewAttribute(types.AttributeValuePoolId, strconv.FormatUint(pool.Id, 10)),
+			sdk.NewAttribute(types.AttributeValuePoolCoinDenom, pool.PoolCoinDenom),
+			sdk.NewAttribute(types.AttributeValueReserveAccount, pool.ReserveAccountAddress),
+			sdk.NewAttribute(types.AttributeValueDustCoins, dustCoins.String()),
+		),
+	)
+	return true, nil
+}
+
+// hasRemainingPoolBatchMsgs returns true if the batch has msgs which are not ready to be deleted.
+func (k Keeper) hasRemainingPoolBatchMsgs(ctx sdk.Context, batch types.PoolBatch) bool {
+	if len(k.GetAllPoolBatchDepositMsgStatesNotToBeDeleted(ctx, batch)) > 0 ||
+		len(k.GetAllPoolBatchWithdrawMsgStatesNotToBeDeleted(ctx, batch)) > 0 ||
+		len(k.GetAllPoolBatchSwapMsgStatesNotToBeDeleted(ctx, batch)) > 0 {
+		return true
+	}
+	for _, msg := range k.GetAllPoolBatchSwapRouteMsgStates(ctx, batch) {
+		if !msg.ToBeDeleted {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,195 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravity-devs/liquidity/v2/app"
+	"github.com/gravity-devs/liquidity/v2/x/liquidity"
+	"github.com/gravity-devs/liquidity/v2/x/liquidity/keeper"
+	"github.com/gravity-devs/liquidity/v2/x/liquidity/types"
+)
+
+func TestWindDownPool(t *testing.T) {
+	simapp, ctx := createTestInput()
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+	params.WithdrawFeeRate = sdk.NewDecWithPrec(1, 2)
+	simapp.LiquidityKeeper.SetParams(ctx, params)
+	msgServer := keeper.NewMsgServerImpl(simapp.LiquidityKeeper)
+
+	defer func(flag bool) { keeper.BatchLogicInvariantCheckFlag = flag }(keeper.BatchLogicInvariantCheckFlag)
+	keeper.BatchLogicInvariantCheckFlag = true
+
+	x, y := sdk.NewInt(1_000_000_000), sdk.NewInt(1_000_000_000)
+	addrs := app.AddTestAddrs(simapp, ctx, 3, params.PoolCreationFee)
+	poolID := app.TestCreatePool(t, simapp, ctx, x, y, DenomX, DenomY, addrs[0])
+	pool, _ := simapp.LiquidityKeeper.GetPool(ctx, poolID)
+	depositAmt := sdk.NewInt(100_000_000)
+	app.TestDepositPool(t, simapp, ctx, depositAmt, depositAmt, addrs[1:2], poolID, true)
+	authority, err := sdk.AccAddressFromBech32(simapp.LiquidityKeeper.GetAuthority())
+	require.NoError(t, err)
+
+	// more holders than paid out in a batch execution
+	holders := app.AddTestAddrs(simapp, ctx, len(addrs)+types.MaxWindDownPayoutNum, nil)[len(addrs):]
+	for _, holder := range holders {
+		require.NoError(t, simapp.BankKeeper.SendCoins(ctx, addrs[0], holder, sdk.NewCoins(sdk.NewCoin(pool.PoolCoinDenom, sdk.NewInt(10)))))
+	}
+
+	// only the authority can wind down the pool
+	_, err = msgServer.WindDownPool(sdk.WrapSDKContext(ctx), types.NewMsgWindDownPool(addrs[0], poolID))
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+	_, err = msgServer.WindDownPool(sdk.WrapSDKContext(ctx), types.NewMsgWindDownPool(authority, poolID+1))
+	require.ErrorIs(t, err, types.ErrPoolNotExists)
+
+	// the withdrawal submitted before the wind-down is executed without the withdraw fee
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	poolCoin := sdk.NewCoin(pool.PoolCoinDenom, sdk.NewInt(100_000))
+	withdrawMsgState, err := simapp.LiquidityKeeper.WithdrawWithinBatch(ctx, types.NewMsgWithdrawWithinBatch(addrs[0], poolID, poolCoin))
+	require.NoError(t, err)
+	_, err = msgServer.WindDownPool(sdk.WrapSDKContext(ctx), types.NewMsgWindDownPool(authority, poolID))
+	require.NoError(t, err)
+	pool, _ = simapp.LiquidityKeeper.GetPool(ctx, poolID)
+	require.Equal(t, types.PoolStatusWindDown, pool.Status)
+	_, err = msgServer.WindDownPool(sdk.WrapSDKContext(ctx), types.NewMsgWindDownPool(authority, poolID))
+	require.ErrorIs(t, err, types.ErrBadPoolStatus)
+
+	// the deposits and swaps are rejected, and the status of the winding down pool can not be changed
+	depositCoins := sdk.NewCoins(sdk.NewCoin(DenomX, sdk.NewInt(1_000_000)), sdk.NewCoin(DenomY, sdk.NewInt(1_000_000)))
+	app.SaveAccount(simapp, ctx, addrs[2], depositCoins)
+	_, err = simapp.LiquidityKeeper.DepositWithinBatch(ctx, types.NewMsgDepositWithinBatch(addrs[2], poolID, depositCoins))
+	require.ErrorIs(t, err, types.ErrPoolStatusNotAllowed)
+	offerCoin := sdk.NewCoin(DenomX, sdk.NewInt(1_000_000))
+	swapMsg := types.NewMsgSwapWithinBatch(addrs[2], poolID, types.DefaultSwapTypeID, offerCoin, DenomY, sdk.MustNewDecFromStr("1.1"), params.SwapFeeRate)
+	_, err = simapp.LiquidityKeeper.SwapWithinBatch(ctx, swapMsg, 0)
+	require.ErrorIs(t, err, types.ErrPoolStatusNotAllowed)
+	_, err = msgServer.SetPoolStatus(sdk.WrapSDKContext(ctx), &types.MsgSetPoolStatus{Authority: authority.String(), PoolId: poolID})
+	require.ErrorIs(t, err, types.ErrBadPoolStatus)
+	_, err = msgServer.SetCircuitBreaker(sdk.WrapSDKContext(ctx), &types.MsgSetCircuitBreaker{Authority: authority.String(), PoolId: poolID})
+	require.ErrorIs(t, err, types.ErrBadPoolStatus)
+
+	// the holders are paid out over the batches, the last holders are paid out in the next batch
+	batch, _ := simapp.LiquidityKeeper.GetPoolBatch(ctx, poolID)
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+	withdrawMsgState, found := simapp.LiquidityKeeper.GetPoolBatchWithdrawMsgState(ctx, poolID, withdrawMsgState.MsgIndex)
+	require.True(t, found)
+	require.True(t, withdrawMsgState.Succeeded)
+	record, found := simapp.LiquidityKeeper.GetPoolBatchRecord(ctx, poolID, batch.Index)
+	require.True(t, found)
+	require.Equal(t, poolCoin, record.BurnedPoolCoin)
+	require.True(t, record.WithdrawFeeCoins.IsZero())
+	_, found = simapp.LiquidityKeeper.GetPool(ctx, poolID)
+	require.True(t, found)
+	require.True(t, simapp.LiquidityKeeper.GetPoolCoinTotalSupply(ctx, pool).IsPositive())
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+
+	// the reserve coins are paid out without the withdraw fee and the pool is removed
+	require.True(t, simapp.LiquidityKeeper.GetPoolCoinTotalSupply(ctx, pool).IsZero())
+	require.True(t, simapp.BankKeeper.GetAllBalances(ctx, pool.GetReserveAccount()).IsZero())
+	for _, holder := range append(addrs[:2], holders...) {
+		require.True(t, simapp.BankKeeper.GetBalance(ctx, holder, pool.PoolCoinDenom).IsZero())
+		require.True(t, simapp.BankKeeper.GetBalance(ctx, holder, DenomX).IsPositive())
+	}
+	require.True(t, simapp.BankKeeper.GetBalance(ctx, addrs[1], DenomX).Amount.GTE(depositAmt))
+	require.True(t, simapp.BankKeeper.GetBalance(ctx, addrs[1], DenomY).Amount.GTE(depositAmt))
+
+	_, found = simapp.LiquidityKeeper.GetPool(ctx, poolID)
+	require.False(t, found)
+	_, found = simapp.LiquidityKeeper.GetPoolBatch(ctx, poolID)
+	require.False(t, found)
+	_, found = simapp.LiquidityKeeper.GetPoolByReserveAccIndex(ctx, pool.GetReserveAccount())
+	require.False(t, found)
+	require.Empty(t, simapp.LiquidityKeeper.GetAllPoolPriceRecords(ctx, poolID))
+	require.Empty(t, simapp.LiquidityKeeper.GetAllWithdrawMsgStates(ctx))
+
+	// the removed pool can be created again
+	creator := app.AddRandomTestAddr(simapp, ctx, params.PoolCreationFee)
+	app.TestCreatePool(t, simapp, ctx, x, y, DenomX, DenomY, creator)
+}
+
+func TestPruneDepletedPools(t *testing.T) {
+	simapp, ctx := createTestInput()
+	params := simapp.LiquidityKeeper.GetParams(ctx)
+	params.DepletedPoolLifespan = 10
+	simapp.LiquidityKeeper.SetParams(ctx, params)
+
+	x, y := sdk.NewInt(1_000_000_000), sdk.NewInt(1_000_000_000)
+	creators := []sdk.AccAddress{
+		app.AddRandomTestAddr(simapp, ctx, params.PoolCreationFee),
+		app.AddRandomTestAddr(simapp, ctx, params.PoolCreationFee),
+	}
+	poolIDs := []uint64{
+		app.TestCreatePool(t, simapp, ctx, x, y, DenomX, DenomY, creators[0]),
+		app.TestCreatePool(t, simapp, ctx, x, y, DenomA, DenomB, creators[1]),
+	}
+
+	// both pools are depleted by withdrawing the whole pool coin supply
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	for i, poolID := range poolIDs {
+		pool, _ := simapp.LiquidityKeeper.GetPool(ctx, poolID)
+		poolCoin := simapp.BankKeeper.GetBalance(ctx, creators[i], pool.PoolCoinDenom)
+		_, err := simapp.LiquidityKeeper.WithdrawWithinBatch(ctx, types.NewMsgWithdrawWithinBatch(creators[i], poolID, poolCoin))
+		require.NoError(t, err)
+	}
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+	depletedHeight := ctx.BlockHeight()
+	for _, poolID := range poolIDs {
+		pool, found := simapp.LiquidityKeeper.GetPool(ctx, poolID)
+		require.True(t, found)
+		require.True(t, simapp.LiquidityKeeper.IsDepletedPool(ctx, pool))
+		require.Equal(t, depletedHeight, pool.DepletedHeight)
+		require.Len(t, simapp.LiquidityKeeper.GetAllPoolBatchRecords(ctx, poolID), 1)
+	}
+
+	// the dust coins sent to the reserve account of the depleted pool
+	pool, _ := simapp.LiquidityKeeper.GetPool(ctx, poolIDs[0])
+	dustCoins := sdk.NewCoins(sdk.NewCoin(DenomX, sdk.NewInt(10)))
+	require.NoError(t, app.FundAccount(simapp, ctx, pool.GetReserveAccount(), dustCoins))
+	communityPool := simapp.DistrKeeper.GetFeePoolCommunityCoins(ctx)
+
+	// the second pool is reinitialized by a deposit within the lifespan
+	for ctx.BlockHeight() < depletedHeight+int64(params.DepletedPoolLifespan)-1 {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+		if ctx.BlockHeight() == depletedHeight+1 {
+			depositor := app.AddRandomTestAddr(simapp, ctx, nil)
+			app.TestDepositPool(t, simapp, ctx, x, y, []sdk.AccAddress{depositor}, poolIDs[1], false)
+		}
+		liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+	}
+	_, found := simapp.LiquidityKeeper.GetPool(ctx, poolIDs[0])
+	require.True(t, found)
+	revivedPool, found := simapp.LiquidityKeeper.GetPool(ctx, poolIDs[1])
+	require.True(t, found)
+	require.False(t, simapp.LiquidityKeeper.IsDepletedPool(ctx, revivedPool))
+	require.Zero(t, revivedPool.DepletedHeight)
+
+	// the depleted pool is pruned after the lifespan and the dust coins are sent to the community pool
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	liquidity.BeginBlocker(ctx, simapp.LiquidityKeeper)
+	liquidity.EndBlocker(ctx, simapp.LiquidityKeeper)
+	_, found = simapp.LiquidityKeeper.GetPool(ctx, poolIDs[0])
+	require.False(t, found)
+	_, found = simapp.LiquidityKeeper.GetPoolBatch(ctx, poolIDs[0])
+	require.False(t, found)
+	_, found = simapp.LiquidityKeeper.GetPoolByReserveAccIndex(ctx, pool.GetReserveAccount())
+	require.False(t, found)
+	require.Empty(t, simapp.LiquidityKeeper.GetAllPoolBatchRecords(ctx, poolIDs[0]))
+	for _, denom := range pool.ReserveCoinDenoms {
+		require.False(t, ctx.KVStore(simapp.GetKey(types.StoreKey)).Has(types.GetPoolByReserveCoinDenomIndexKey(denom, poolIDs[0])))
+	}
+	require.True(t, simapp.BankKeeper.GetAllBalances(ctx, pool.GetReserveAccount()).IsZero())
+	require.Equal(t, communityPool.Add(sdk.NewDecCoinsFromCoins(dustCoins...)...), simapp.DistrKeeper.GetFeePoolCommunityCoins(ctx))
+	_, found = simapp.LiquidityKeeper.GetPool(ctx, poolIDs[1])
+	require.True(t, found)
+
+	// the pruned pool can be created again
+	creator := app.AddRandomTestAddr(simapp, ctx, params.PoolCreationFee)
+	app.TestCreatePool(t, simapp, ctx, x, y, DenomX, DenomY, creator)
+}
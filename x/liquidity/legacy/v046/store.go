@@ -0,0 +1,118 @@
+package v046
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/gravity-devs/liquidity/v2/x/liquidity/types"
+)
+
+// MigrateStore performs in-place store migrations from consensus version 2 to 3.
+// The migration includes:
+//
+// - Set the default value of the new SwapOrderLifespan param.
+// - Set the default value of the new PriceRecordLifespan param.
+// - Add the StableSwap and multi-asset pool types to the PoolTypes param and set the default value of the new StableSwapAmplification param.
+// - Set the default value of the new SwapFeeTiers param.
+// - Set the default value of the new Guardians param.
+// - Set the default value of the new DepletedPoolLifespan param.
+// - Set the default value of the new MaxBatchInterval param.
+// - Set the default value of the new BatchRecordLifespan param.
+// - Move the params from the x/params subspace to the module store.
+// - Initialize the new SwapRouteMsgIndex of the pool batches.
+// - Index the existing batch msg states by the address of the depositor, withdrawer or swap requester.
+// - Index the existing pools by their reserve coin denoms.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, paramSpace paramstypes.Subspace) error {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	if !paramSpace.Has(ctx, types.KeySwapOrderLifespan) {
+		paramSpace.Set(ctx, types.KeySwapOrderLifespan, types.DefaultSwapOrderLifespan)
+	}
+	if !paramSpace.Has(ctx, types.KeyPriceRecordLifespan) {
+		paramSpace.Set(ctx, types.KeyPriceRecordLifespan, types.DefaultPriceRecordLifespan)
+	}
+	var poolTypes []types.PoolType
+	paramSpace.GetIfExists(ctx, types.KeyPoolTypes, &poolTypes)
+	if len(poolTypes) == 1 {
+		paramSpace.Set(ctx, types.KeyPoolTypes, append(poolTypes, types.DefaultPoolTypes[1:]...))
+	}
+	if !paramSpace.Has(ctx, types.KeyStableSwapAmplification) {
+		paramSpace.Set(ctx, types.KeyStableSwapAmplification, types.DefaultStableSwapAmplification)
+	}
+	if !paramSpace.Has(ctx, types.KeySwapFeeTiers) {
+		paramSpace.Set(ctx, types.KeySwapFeeTiers, types.DefaultSwapFeeTiers)
+	}
+	if !paramSpace.Has(ctx, types.KeyGuardians) {
+		paramSpace.Set(ctx, types.KeyGuardians, types.DefaultGuardians)
+	}
+	if !paramSpace.Has(ctx, types.KeyDepletedPoolLifespan) {
+		paramSpace.Set(ctx, types.KeyDepletedPoolLifespan, types.DefaultDepletedPoolLifespan)
+	}
+	if !paramSpace.Has(ctx, types.KeyMaxBatchInterval) {
+		paramSpace.Set(ctx, types.KeyMaxBatchInterval, types.DefaultMaxBatchInterval)
+	}
+	if !paramSpace.Has(ctx, types.KeyBatchRecordLifespan) {
+		paramSpace.Set(ctx, types.KeyBatchRecordLifespan, types.DefaultBatchRecordLifespan)
+	}
+
+	var params types.Params
+	paramSpace.GetParamSet(ctx, &params)
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(storeKey)
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+
+	iterator := sdk.KVStorePrefixIterator(store, types.PoolBatchKeyPrefix)
+	var batches []types.PoolBatch
+	for ; iterator.Valid(); iterator.Next() {
+		batches = append(batches, types.MustUnmarshalPoolBatch(cdc, iterator.Value()))
+	}
+	iterator.Close()
+
+	for _, batch := range batches {
+		if batch.SwapRouteMsgIndex == 0 {
+			batch.SwapRouteMsgIndex = 1
+			store.Set(types.GetPoolBatchKey(batch.PoolId), types.MustMarshalPoolBatch(cdc, batch))
+		}
+	}
+
+	var indexKeys [][]byte
+	iterator = sdk.KVStorePrefixIterator(store, types.PoolBatchDepositMsgStateIndexKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		state := types.MustUnmarshalDepositMsgState(cdc, iterator.Value())
+		indexKeys = append(indexKeys, types.GetBatchMsgStateByAddressIndexKey(state.Msg.GetDepositor(), iterator.Key()))
+	}
+	iterator.Close()
+	iterator = sdk.KVStorePrefixIterator(store, types.PoolBatchWithdrawMsgStateIndexKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		state := types.MustUnmarshalWithdrawMsgState(cdc, iterator.Value())
+		indexKeys = append(indexKeys, types.GetBatchMsgStateByAddressIndexKey(state.Msg.GetWithdrawer(), iterator.Key()))
+	}
+	iterator.Close()
+	iterator = sdk.KVStorePrefixIterator(store, types.PoolBatchSwapMsgStateIndexKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		state := types.MustUnmarshalSwapMsgState(cdc, iterator.Value())
+		indexKeys = append(indexKeys, types.GetBatchMsgStateByAddressIndexKey(state.Msg.GetSwapRequester(), iterator.Key()))
+	}
+	iterator.Close()
+
+	iterator = sdk.KVStorePrefixIterator(store, types.PoolKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		pool := types.MustUnmarshalPool(cdc, iterator.Value())
+		for _, denom := range pool.ReserveCoinDenoms {
+			indexKeys = append(indexKeys, types.GetPoolByReserveCoinDenomIndexKey(denom, pool.Id))
+		}
+	}
+	iterator.Close()
+
+	for _, key := range indexKeys {
+		store.Set(key, []byte{})
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package v046_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	v046liquidity "github.com/gravity-devs/liquidity/v2/x/liquidity/legacy/v046"
+	"github.com/gravity-devs/liquidity/v2/x/liquidity/types"
+)
+
+func TestStoreMigration(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	liquidityKey := sdk.NewKVStoreKey(types.StoreKey)
+	paramsKey := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+	cms := store.NewCommitMultiStore(dbm.NewMemDB())
+	cms.MountStoreWithDB(liquidityKey, storetypes.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(paramsKey, storetypes.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(paramsTKey, storetypes.StoreTypeTransient, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+	ctx := sdk.NewContext(cms, tmproto.Header{}, false, log.NewNopLogger())
+	paramSpace := paramstypes.NewSubspace(encCfg.Codec, encCfg.Amino, paramsKey, paramsTKey, types.ModuleName)
+
+	require.False(t, paramSpace.Has(ctx, types.KeySwapOrderLifespan))
+	require.False(t, paramSpace.Has(ctx, types.KeyPriceRecordLifespan))
+	require.False(t, paramSpace.Has(ctx, types.KeyStableSwapAmplification))
+	require.False(t, paramSpace.Has(ctx, types.KeySwapFeeTiers))
+	require.False(t, paramSpace.Has(ctx, types.KeyGuardians))
+	require.False(t, paramSpace.Has(ctx, types.KeyDepletedPoolLifespan))
+	require.False(t, paramSpace.Has(ctx, types.KeyMaxBatchInterval))
+	require.False(t, paramSpace.Has(ctx, types.KeyBatchRecordLifespan))
+	paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+
+	// the params stored before the new params were introduced
+	legacyParams := types.DefaultParams()
+	legacyParams.PoolTypes = []types.PoolType{types.DefaultPoolType}
+	for _, pair := range legacyParams.ParamSetPairs() {
+		switch string(pair.Key) {
+		case string(types.KeySwapOrderLifespan), string(types.KeyPriceRecordLifespan), string(types.KeyStableSwapAmplification),
+			string(types.KeySwapFeeTiers), string(types.KeyGuardians), string(types.KeyDepletedPoolLifespan),
+			string(types.KeyMaxBatchInterval), string(types.KeyBatchRecordLifespan):
+			continue
+		}
+		paramSpace.Set(ctx, pair.Key, reflect.ValueOf(pair.Value).Elem().Interface())
+	}
+
+	// a pool batch stored before the swap route was introduced
+	kvStore := ctx.KVStore(liquidityKey)
+	batch := types.NewPoolBatch(1, 1)
+	batch.SwapRouteMsgIndex = 0
+	kvStore.Set(types.GetPoolBatchKey(1), types.MustMarshalPoolBatch(encCfg.Codec, batch))
+
+	// a swap msg state stored before the batch msg states were indexed by address
+	requester := sdk.AccAddress([]byte("requester"))
+	swapMsgState := types.SwapMsgState{MsgIndex: 1, Msg: &types.MsgSwapWithinBatch{SwapRequesterAddress: requester.String(), PoolId: 1}}
+	kvStore.Set(types.GetPoolBatchSwapMsgStateIndexKey(1, 1), types.MustMarshalSwapMsgState(encCfg.Codec, swapMsgState))
+
+	// a pool stored before the pools were indexed by reserve coin denom
+	pool := types.Pool{Id: 1, TypeId: types.DefaultPoolTypeID, ReserveCoinDenoms: []string{"denomX", "denomY"}}
+	kvStore.Set(types.GetPoolKey(1), types.MustMarshalPool(encCfg.Codec, pool))
+
+	// Run migrations.
+	err := v046liquidity.MigrateStore(ctx, liquidityKey, encCfg.Codec, paramSpace)
+	require.NoError(t, err)
+
+	// Make sure the swap route msg index of the pool batch is initialized.
+	batch = types.MustUnmarshalPoolBatch(encCfg.Codec, kvStore.Get(types.GetPoolBatchKey(1)))
+	require.Equal(t, uint64(1), batch.SwapRouteMsgIndex)
+
+	// Make sure the swap msg state is indexed by the swap requester.
+	require.True(t, kvStore.Has(types.GetBatchMsgStateByAddressIndexKey(requester, types.GetPoolBatchSwapMsgStateIndexKey(1, 1))))
+
+	// Make sure the pool is indexed by its reserve coin denoms.
+	require.True(t, kvStore.Has(types.GetPoolByReserveCoinDenomIndexKey("denomX", 1)))
+	require.True(t, kvStore.Has(types.GetPoolByReserveCoinDenomIndexKey("denomY", 1)))
+
+	// Make sure the new params are set.
+	require.True(t, paramSpace.Has(ctx, types.KeySwapOrderLifespan))
+	var swapOrderLifespan uint32
+	paramSpace.Get(ctx, types.KeySwapOrderLifespan, &swapOrderLifespan)
+	require.Equal(t, types.DefaultSwapOrderLifespan, swapOrderLifespan)
+	require.True(t, paramSpace.Has(ctx, types.KeyPriceRecordLifespan))
+	var priceRecordLifespan uint32
+	paramSpace.Get(ctx, types.KeyPriceRecordLifespan, &priceRecordLifespan)
+	require.Equal(t, types.DefaultPriceRecordLifespan, priceRecordLifespan)
+	var stableSwapAmplification uint32
+	paramSpace.Get(ctx, types.KeyStableSwapAmplification, &stableSwapAmplification)
+	require.Equal(t, types.DefaultStableSwapAmplification, stableSwapAmplification)
+	var swapFeeTiers []sdk.Dec
+	paramSpace.Get(ctx, types.KeySwapFeeTiers, &swapFeeTiers)
+	require.Equal(t, types.DefaultSwapFeeTiers, swapFeeTiers)
+	var guardians []string
+	paramSpace.Get(ctx, types.KeyGuardians, &guardians)
+	require.Equal(t, types.DefaultGuardians, guardians)
+	var depletedPoolLifespan uint32
+	paramSpace.Get(ctx, types.KeyDepletedPoolLifespan, &depletedPoolLifespan)
+	require.Equal(t, types.DefaultDepletedPoolLifespan, depletedPoolLifespan)
+	var maxBatchInterval uint32
+	paramSpace.Get(ctx, types.KeyMaxBatchInterval, &maxBatchInterval)
+	require.Equal(t, types.DefaultMaxBatchInterval, maxBatchInterval)
+	var batchRecordLifespan uint32
+	paramSpace.Get(ctx, types.KeyBatchRecordLifespan, &batchRecordLifespan)
+	require.Equal(t, types.DefaultBatchRecordLifespan, batchRecordLifespan)
+
+	// Make sure the StableSwap pool type is added.
+	var poolTypes []types.PoolType
+	paramSpace.Get(ctx, types.KeyPoolTypes, &poolTypes)
+	require.Equal(t, types.DefaultPoolTypes, poolTypes)
+
+	// Make sure the params are moved to the module store.
+	var params types.Params
+	paramSpace.GetParamSet(ctx, &params)
+	var storedParams types.Params
+	encCfg.Codec.MustUnmarshal(kvStore.Get(types.ParamsKey), &storedParams)
+	require.Equal(t, params, storedParams)
+	require.Equal(t, types.DefaultPoolTypes, storedParams.PoolTypes)
+}
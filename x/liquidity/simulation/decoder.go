@@ -25,6 +25,9 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 		case bytes.Equal(kvA.Key[:1], types.PoolByReserveAccIndexKeyPrefix):
 			return fmt.Sprintf("%v\n%v", sdk.AccAddress(kvA.Value), sdk.AccAddress(kvB.Value))
 
+		case bytes.Equal(kvA.Key[:1], types.PoolByReserveCoinDenomIndexKeyPrefix):
+			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
+
 		case bytes.Equal(kvA.Key[:1], types.PoolBatchKeyPrefix):
 			var batchA, batchB types.PoolBatch
 			cdc.MustUnmarshal(kvA.Value, &batchA)
@@ -49,6 +52,30 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &msgStateB)
 			return fmt.Sprintf("%v\n%v", msgStateA, msgStateB)
 
+		case bytes.Equal(kvA.Key[:1], types.PoolBatchSwapRouteMsgStateIndexKeyPrefix):
+			var msgStateA, msgStateB types.SwapRouteMsgState
+			cdc.MustUnmarshal(kvA.Value, &msgStateA)
+			cdc.MustUnmarshal(kvB.Value, &msgStateB)
+			return fmt.Sprintf("%v\n%v", msgStateA, msgStateB)
+
+		case bytes.Equal(kvA.Key[:1], types.BatchMsgStateByAddressIndexKeyPrefix):
+			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
+
+		case bytes.Equal(kvA.Key[:1], types.PoolPriceRecordKeyPrefix):
+			var recordA, recordB types.PoolPriceRecord
+			cdc.MustUnmarshal(kvA.Value, &recordA)
+			cdc.MustUnmarshal(kvB.Value, &recordB)
+			return fmt.Sprintf("%v\n%v", recordA, recordB)
+
+		case bytes.Equal(kvA.Key[:1], types.PoolPriceRecordByTimeIndexKeyPrefix):
+			return fmt.Sprintf("%v\n%v", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.PoolBatchRecordKeyPrefix):
+			var recordA, recordB types.PoolBatchRecord
+			cdc.MustUnmarshal(kvA.Value, &recordA)
+			cdc.MustUnmarshal(kvB.Value, &recordB)
+			return fmt.Sprintf("%v\n%v", recordA, recordB)
+
 		default:
 			panic(fmt.Sprintf("invalid liquidity key prefix %X", kvA.Key[:1]))
 		}
@@ -19,7 +19,7 @@ var (
 	pk1               = ed25519.GenPrivKey().PubKey()
 	reserveAccAddr1   = sdk.AccAddress(pk1.Address())
 	reserveCoinDenoms = []string{"dzkiv", "imwo"}
-	poolName          = types.PoolName(reserveCoinDenoms, uint32(1))
+	poolName          = types.PoolName(reserveCoinDenoms, uint32(1), nil)
 	poolCoinDenom     = types.GetPoolCoinDenom(poolName)
 )
 
@@ -59,15 +59,41 @@ func TestDecodeLiquidityStore(t *testing.T) {
 		ToBeDeleted: true,
 		Msg:         &types.MsgSwapWithinBatch{PoolId: uint64(1)},
 	}
+	swapRouteMsgState := types.SwapRouteMsgState{
+		MsgHeight:   int64(50),
+		MsgIndex:    uint64(1),
+		Executed:    true,
+		Succeeded:   true,
+		ToBeDeleted: true,
+		Msg:         &types.MsgSwapRoute{PoolIds: []uint64{1, 2}},
+	}
+	priceRecord := types.PoolPriceRecord{
+		PoolId:          uint64(1),
+		Height:          int64(50),
+		Price:           sdk.OneDec(),
+		CumulativePrice: sdk.NewDec(10),
+	}
+	batchRecord := types.PoolBatchRecord{
+		PoolId:            uint64(1),
+		BatchIndex:        uint64(1),
+		Height:            int64(50),
+		SucceededMsgCount: uint64(2),
+	}
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: types.PoolKeyPrefix, Value: cdc.MustMarshal(&pool)},
 			{Key: types.PoolByReserveAccIndexKeyPrefix, Value: reserveAccAddr1.Bytes()},
+			{Key: types.PoolByReserveCoinDenomIndexKeyPrefix, Value: []byte{}},
 			{Key: types.PoolBatchKeyPrefix, Value: cdc.MustMarshal(&batch)},
 			{Key: types.PoolBatchDepositMsgStateIndexKeyPrefix, Value: cdc.MustMarshal(&depositMsgState)},
 			{Key: types.PoolBatchWithdrawMsgStateIndexKeyPrefix, Value: cdc.MustMarshal(&withdrawMsgState)},
 			{Key: types.PoolBatchSwapMsgStateIndexKeyPrefix, Value: cdc.MustMarshal(&swapMsgState)},
+			{Key: types.PoolBatchSwapRouteMsgStateIndexKeyPrefix, Value: cdc.MustMarshal(&swapRouteMsgState)},
+			{Key: types.BatchMsgStateByAddressIndexKeyPrefix, Value: []byte{}},
+			{Key: types.PoolPriceRecordKeyPrefix, Value: cdc.MustMarshal(&priceRecord)},
+			{Key: types.PoolPriceRecordByTimeIndexKeyPrefix, Value: sdk.Uint64ToBigEndian(50)},
+			{Key: types.PoolBatchRecordKeyPrefix, Value: cdc.MustMarshal(&batchRecord)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -78,10 +104,16 @@ func TestDecodeLiquidityStore(t *testing.T) {
 	}{
 		{"Pool", fmt.Sprintf("%v\n%v", pool, pool)},
 		{"PoolByReserveAccIndex", fmt.Sprintf("%v\n%v", reserveAccAddr1, reserveAccAddr1)},
+		{"PoolByReserveCoinDenomIndex", "[]\n[]"},
 		{"PoolBatchKey", fmt.Sprintf("%v\n%v", batch, batch)},
 		{"PoolBatchDepositMsgStateIndex", fmt.Sprintf("%v\n%v", depositMsgState, depositMsgState)},
 		{"PoolBatchWithdrawMsgStateIndex", fmt.Sprintf("%v\n%v", withdrawMsgState, withdrawMsgState)},
 		{"PoolBatchSwapMsgStateIndex", fmt.Sprintf("%v\n%v", swapMsgState, swapMsgState)},
+		{"PoolBatchSwapRouteMsgStateIndex", fmt.Sprintf("%v\n%v", swapRouteMsgState, swapRouteMsgState)},
+		{"BatchMsgStateByAddressIndex", "[]\n[]"},
+		{"PoolPriceRecord", fmt.Sprintf("%v\n%v", priceRecord, priceRecord)},
+		{"PoolPriceRecordByTimeIndex", "50\n50"},
+		{"PoolBatchRecord", fmt.Sprintf("%v\n%v", batchRecord, batchRecord)},
 		{"other", ""},
 	}
 	for i, tt := range tests {
@@ -17,15 +17,16 @@ import (
 
 // Simulation parameter constants
 const (
-	LiquidityPoolTypes     = "liquidity_pool_types"
-	MinInitDepositAmount   = "min_init_deposit_amount"
-	InitPoolCoinMintAmount = "init_pool_coin_mint_amount"
-	MaxReserveCoinAmount   = "max_reserve_coin_amount"
-	PoolCreationFee        = "pool_creation_fee"
-	SwapFeeRate            = "swap_fee_rate"
-	WithdrawFeeRate        = "withdraw_fee_rate"
-	MaxOrderAmountRatio    = "max_order_amount_ratio"
-	UnitBatchHeight        = "unit_batch_height"
+	LiquidityPoolTypes      = "liquidity_pool_types"
+	MinInitDepositAmount    = "min_init_deposit_amount"
+	InitPoolCoinMintAmount  = "init_pool_coin_mint_amount"
+	MaxReserveCoinAmount    = "max_reserve_coin_amount"
+	PoolCreationFee         = "pool_creation_fee"
+	SwapFeeRate             = "swap_fee_rate"
+	WithdrawFeeRate         = "withdraw_fee_rate"
+	MaxOrderAmountRatio     = "max_order_amount_ratio"
+	UnitBatchHeight         = "unit_batch_height"
+	StableSwapAmplification = "stable_swap_amplification"
 )
 
 // GenLiquidityPoolTypes return default PoolType temporarily, It will be randomized in the liquidity v2
@@ -90,6 +91,11 @@ func GenUnitBatchHeight(r *rand.Rand) uint32 {
 	return uint32(simulation.RandIntBetween(r, int(types.DefaultUnitBatchHeight), 20))
 }
 
+// GenStableSwapAmplification randomized StableSwapAmplification ranging from 1 to 1000
+func GenStableSwapAmplification(r *rand.Rand) uint32 {
+	return uint32(simulation.RandIntBetween(r, 1, 1000))
+}
+
 // RandomizedGenState generates a random GenesisState for liquidity
 func RandomizedGenState(simState *module.SimulationState) {
 	var liquidityPoolTypes []types.PoolType
@@ -146,17 +152,24 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { unitBatchHeight = GenUnitBatchHeight(r) },
 	)
 
+	var stableSwapAmplification uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, StableSwapAmplification, &stableSwapAmplification, simState.Rand,
+		func(r *rand.Rand) { stableSwapAmplification = GenStableSwapAmplification(r) },
+	)
+
 	liquidityGenesis := types.GenesisState{
 		Params: types.Params{
-			PoolTypes:              liquidityPoolTypes,
-			MinInitDepositAmount:   minInitDepositAmount,
-			InitPoolCoinMintAmount: initPoolCoinMintAmount,
-			MaxReserveCoinAmount:   maxReserveCoinAmount,
-			PoolCreationFee:        poolCreationFee,
-			SwapFeeRate:            swapFeeRate,
-			WithdrawFeeRate:        withdrawFeeRate,
-			MaxOrderAmountRatio:    maxOrderAmountRatio,
-			UnitBatchHeight:        unitBatchHeight,
+			PoolTypes:               liquidityPoolTypes,
+			MinInitDepositAmount:    minInitDepositAmount,
+			InitPoolCoinMintAmount:  initPoolCoinMintAmount,
+			MaxReserveCoinAmount:    maxReserveCoinAmount,
+			PoolCreationFee:         poolCreationFee,
+			SwapFeeRate:             swapFeeRate,
+			WithdrawFeeRate:         withdrawFeeRate,
+			MaxOrderAmountRatio:     maxOrderAmountRatio,
+			UnitBatchHeight:         unitBatchHeight,
+			StableSwapAmplification: stableSwapAmplification,
 		},
 		PoolRecords: []types.PoolRecord{},
 	}
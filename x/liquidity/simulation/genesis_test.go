@@ -54,6 +54,7 @@ func TestRandomizedGenState(t *testing.T) {
 	require.Equal(t, dec5, liquidityGenesis.Params.WithdrawFeeRate)
 	require.Equal(t, dec6, liquidityGenesis.Params.MaxOrderAmountRatio)
 	require.Equal(t, uint32(6), liquidityGenesis.Params.UnitBatchHeight)
+	require.Equal(t, uint32(136), liquidityGenesis.Params.StableSwapAmplification)
 }
 
 // TestRandomizedGenState tests abnormal scenarios of applying RandomizedGenState.
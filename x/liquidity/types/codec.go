@@ -12,8 +12,17 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreatePool{}, "liquidity/MsgCreatePool", nil)
 	cdc.RegisterConcrete(&MsgDepositWithinBatch{}, "liquidity/MsgDepositWithinBatch", nil)
+	cdc.RegisterConcrete(&MsgDepositSingleAssetWithinBatch{}, "liquidity/MsgDepositSingleAssetWithinBatch", nil)
 	cdc.RegisterConcrete(&MsgWithdrawWithinBatch{}, "liquidity/MsgWithdrawWithinBatch", nil)
 	cdc.RegisterConcrete(&MsgSwapWithinBatch{}, "liquidity/MsgSwapWithinBatch", nil)
+	cdc.RegisterConcrete(&MsgSwapRoute{}, "liquidity/MsgSwapRoute", nil)
+	cdc.RegisterConcrete(&MsgCancelDeposit{}, "liquidity/MsgCancelDeposit", nil)
+	cdc.RegisterConcrete(&MsgCancelWithdraw{}, "liquidity/MsgCancelWithdraw", nil)
+	cdc.RegisterConcrete(&MsgCancelSwap{}, "liquidity/MsgCancelSwap", nil)
+	cdc.RegisterConcrete(&MsgSetPoolStatus{}, "liquidity/MsgSetPoolStatus", nil)
+	cdc.RegisterConcrete(&MsgSetCircuitBreaker{}, "liquidity/MsgSetCircuitBreaker", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "liquidity/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgWindDownPool{}, "liquidity/MsgWindDownPool", nil)
 }
 
 // RegisterInterfaces registers the x/liquidity interface types with the
@@ -22,8 +31,17 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCreatePool{},
 		&MsgDepositWithinBatch{},
+		&MsgDepositSingleAssetWithinBatch{},
 		&MsgWithdrawWithinBatch{},
 		&MsgSwapWithinBatch{},
+		&MsgSwapRoute{},
+		&MsgCancelDeposit{},
+		&MsgCancelWithdraw{},
+		&MsgCancelSwap{},
+		&MsgSetPoolStatus{},
+		&MsgSetCircuitBreaker{},
+		&MsgUpdateParams{},
+		&MsgWindDownPool{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
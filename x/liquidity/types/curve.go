@@ -0,0 +1,393 @@
+package types
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// maxCurveIterations is the maximum number of iterations to solve the StableSwap invariant and its prices.
+const maxCurveIterations = 255
+
+var (
+	// curveTolerance is the tolerance of the StableSwap invariant solved by the Newton's method.
+	curveTolerance = sdk.NewDecWithPrec(1, 15)
+	// maxReserveRatio is the upper bound of the reserve ratio searched for a price on the StableSwap curve.
+	maxReserveRatio = sdk.NewDec(1_000_000_000_000_000_000)
+)
+
+// PoolCurve is the curve of a pool type, which calculates the pool coin minted by a deposit, the reserve coins
+// withdrawn by a withdrawal, the swap prices of a batch and the invariant of the reserve coins. The curves are
+// registered to the keeper by the pool type id.
+type PoolCurve interface {
+	// SwapCurve returns the swap curve of the pool price function with the amplification coefficient of the pool for a
+	// pair of the reserve coins X and Y with the weights of the pair, which are equal for the equally weighted pools.
+	SwapCurve(amplification, weightX, weightY uint32) SwapCurve
+	// Deposit returns the amount of pool coin minted for the deposit coins and the accepted deposit coins,
+	// the rest of the deposit coins is refunded.
+	Deposit(reserveCoins sdk.Coins, poolCoinSupply sdk.Int, depositCoins sdk.Coins) (mintAmt sdk.Int, acceptedCoins sdk.Coins)
+	// Withdraw returns the reserve coins withdrawn for the burned pool coin amount and the withdraw fee coins
+	// left in the pool.
+	Withdraw(reserveCoins sdk.Coins, poolCoinSupply, poolCoinAmt sdk.Int, withdrawFeeRate sdk.Dec) (withdrawCoins, withdrawFeeCoins sdk.Coins)
+	// Invariant returns the invariant of the reserve coins with the reserve coin weights of the pool, empty for the
+	// equally weighted pools, which is proportional to the size of the pool. It never decreases by the swaps of a batch,
+	// and the invariant per pool coin never decreases by deposits and withdrawals.
+	Invariant(amplification uint32, reserveCoins sdk.Coins, reserveCoinWeights []uint32) sdk.Dec
+}
+
+// SwapCurve is the pool price function of a pool type. With the equivalent swap price model (ESPM), the pool provides
+// the liquidity of a batch at the swap price so that the pool price after the batch is the swap price.
+type SwapCurve interface {
+	// Price returns the pool price of the reserve amounts, which is the marginal price of Y in X.
+	Price(x, y sdk.Dec) sdk.Dec
+	// PoolY returns the amount of Y the pool sells at the swap price when the price is increasing.
+	PoolY(x, y, swapPrice sdk.Dec) sdk.Dec
+	// PoolX returns the amount of X the pool sells at the swap price when the price is decreasing.
+	PoolX(x, y, swapPrice sdk.Dec) sdk.Dec
+	// SwapPrice returns the swap price between the min and max prices at which the executable amounts are
+	// exactly matched with the pool, or one of the bounds when there is no such price between them.
+	SwapPrice(x, y, ex, ey, minPrice, maxPrice sdk.Dec) sdk.Dec
+}
+
+// ConstantProductCurve is the curve of the standard liquidity pool with the pool price function X/Y.
+type ConstantProductCurve struct{}
+
+var (
+	_ PoolCurve = ConstantProductCurve{}
+	_ SwapCurve = ConstantProductCurve{}
+)
+
+// SwapCurve implements PoolCurve with the weighted product curve of the pair when the weights are not equal.
+func (c ConstantProductCurve) SwapCurve(_, weightX, weightY uint32) SwapCurve {
+	if weightX != weightY {
+		return WeightedProductCurve{WeightX: weightX, WeightY: weightY}
+	}
+	return c
+}
+
+// Deposit implements PoolCurve with a proportional deposit.
+func (ConstantProductCurve) Deposit(reserveCoins sdk.Coins, poolCoinSupply sdk.Int, depositCoins sdk.Coins) (sdk.Int, sdk.Coins) {
+	return ProportionalDeposit(reserveCoins, poolCoinSupply, depositCoins)
+}
+
+// Withdraw implements PoolCurve with a proportional withdrawal.
+func (ConstantProductCurve) Withdraw(reserveCoins sdk.Coins, poolCoinSupply, poolCoinAmt sdk.Int, withdrawFeeRate sdk.Dec) (sdk.Coins, sdk.Coins) {
+	return ProportionalWithdraw(reserveCoins, poolCoinSupply, poolCoinAmt, withdrawFeeRate)
+}
+
+// Invariant implements PoolCurve, the square root of the constant product sqrt(X * Y), or the weighted geometric mean
+// of the reserve amounts of a weighted pool.
+func (ConstantProductCurve) Invariant(_ uint32, reserveCoins sdk.Coins, reserveCoinWeights []uint32) sdk.Dec {
+	return WeightedGeometricMean(reserveCoins, reserveCoinWeights)
+}
+
+// Price implements SwapCurve, P = X / Y.
+func (ConstantProductCurve) Price(x, y sdk.Dec) sdk.Dec {
+	return x.Quo(y)
+}
+
+// PoolY implements SwapCurve, PoolY = (P_s * Y - X) / 2P_s.
+func (ConstantProductCurve) PoolY(x, y, swapPrice sdk.Dec) sdk.Dec {
+	return swapPrice.Mul(y).Sub(x).Quo(swapPrice.MulInt64(2))
+}
+
+// PoolX implements SwapCurve, PoolX = (X - P_s * Y) / 2.
+func (ConstantProductCurve) PoolX(x, y, swapPrice sdk.Dec) sdk.Dec {
+	return x.Sub(swapPrice.Mul(y)).QuoInt64(2)
+}
+
+// SwapPrice implements SwapCurve, P_s = (X + 2EX) / (Y + 2EY) regardless of the price bounds.
+func (ConstantProductCurve) SwapPrice(x, y, ex, ey, _, _ sdk.Dec) sdk.Dec {
+	return x.Add(ex.MulInt64(2)).Quo(y.Add(ey.MulInt64(2)))
+}
+
+// WeightedProductCurve is the swap curve of a pair of the reserve coins of a weighted pool with the invariant
+// X^W_X * Y^W_Y, of which the pool price function is (X / W_X) / (Y / W_Y). The value of the reserve coins of the pair
+// stays in the ratio of the weights at the pool price.
+type WeightedProductCurve struct {
+	WeightX uint32
+	WeightY uint32
+}
+
+var _ SwapCurve = WeightedProductCurve{}
+
+// weights returns the weights of the pair as decimals and their sum.
+func (c WeightedProductCurve) weights() (wx, wy, w sdk.Dec) {
+	wx, wy = sdk.NewDec(int64(c.WeightX)), sdk.NewDec(int64(c.WeightY))
+	return wx, wy, wx.Add(wy)
+}
+
+// Price implements SwapCurve, P = (X * W_Y) / (Y * W_X).
+func (c WeightedProductCurve) Price(x, y sdk.Dec) sdk.Dec {
+	wx, wy, _ := c.weights()
+	return x.Mul(wy).Quo(y.Mul(wx))
+}
+
+// PoolY implements SwapCurve, PoolY = (P_s * W_X * Y - W_Y * X) / (P_s * (W_X + W_Y)).
+func (c WeightedProductCurve) PoolY(x, y, swapPrice sdk.Dec) sdk.Dec {
+	wx, wy, w := c.weights()
+	return swapPrice.Mul(wx).Mul(y).Sub(wy.Mul(x)).Quo(swapPrice.Mul(w))
+}
+
+// PoolX implements SwapCurve, PoolX = (W_Y * X - P_s * W_X * Y) / (W_X + W_Y).
+func (c WeightedProductCurve) PoolX(x, y, swapPrice sdk.Dec) sdk.Dec {
+	wx, wy, w := c.weights()
+	return wy.Mul(x).Sub(swapPrice.Mul(wx).Mul(y)).Quo(w)
+}
+
+// SwapPrice implements SwapCurve, P_s = ((W_X + W_Y) * EX + W_Y * X) / ((W_X + W_Y) * EY + W_X * Y) regardless of
+// the price bounds.
+func (c WeightedProductCurve) SwapPrice(x, y, ex, ey, _, _ sdk.Dec) sdk.Dec {
+	wx, wy, w := c.weights()
+	return w.Mul(ex).Add(wy.Mul(x)).Quo(w.Mul(ey).Add(wx.Mul(y)))
+}
+
+// StableSwapCurve is the curve of the Curve StableSwap invariant for two reserve coins,
+// 4A(X + Y) + D = 4AD + D^3 / 4XY, where A is the amplification coefficient.
+// The pool price is close to 1 while the reserves are balanced, and the curve approaches the constant product
+// as the reserves get imbalanced.
+type StableSwapCurve struct {
+	Amplification uint32
+}
+
+var (
+	_ PoolCurve = StableSwapCurve{}
+	_ SwapCurve = StableSwapCurve{}
+)
+
+// SwapCurve implements PoolCurve with the amplification of the pool. The StableSwap pools are equally weighted.
+func (StableSwapCurve) SwapCurve(amplification, _, _ uint32) SwapCurve {
+	return StableSwapCurve{Amplification: amplification}
+}
+
+// Deposit implements PoolCurve with a proportional deposit.
+func (StableSwapCurve) Deposit(reserveCoins sdk.Coins, poolCoinSupply sdk.Int, depositCoins sdk.Coins) (sdk.Int, sdk.Coins) {
+	return ProportionalDeposit(reserveCoins, poolCoinSupply, depositCoins)
+}
+
+// Withdraw implements PoolCurve with a proportional withdrawal.
+func (StableSwapCurve) Withdraw(reserveCoins sdk.Coins, poolCoinSupply, poolCoinAmt sdk.Int, withdrawFeeRate sdk.Dec) (sdk.Coins, sdk.Coins) {
+	return ProportionalWithdraw(reserveCoins, poolCoinSupply, poolCoinAmt, withdrawFeeRate)
+}
+
+// Invariant implements PoolCurve, the StableSwap invariant D with the amplification of the pool.
+func (StableSwapCurve) Invariant(amplification uint32, reserveCoins sdk.Coins, _ []uint32) sdk.Dec {
+	if !reserveCoins[0].IsPositive() || !reserveCoins[1].IsPositive() {
+		return sdk.ZeroDec()
+	}
+	c := StableSwapCurve{Amplification: amplification}
+	return c.D(sdk.NewDecFromInt(reserveCoins[0].Amount), sdk.NewDecFromInt(reserveCoins[1].Amount))
+}
+
+// D returns the StableSwap invariant D of the reserve amounts.
+func (c StableSwapCurve) D(x, y sdk.Dec) sdk.Dec {
+	s := x.Add(y)
+	if !s.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	ann := sdk.NewDec(int64(c.Amplification) * 4)
+	d := s
+	for i := 0; i < maxCurveIterations; i++ {
+		dp := d.Mul(d).Quo(x.MulInt64(2)).Mul(d).Quo(y.MulInt64(2)) // D^3 / 4XY
+		prev := d
+		// D = (4A * S + 2D_P) * D / ((4A - 1) * D + 3D_P)
+		d = ann.Mul(s).Add(dp.MulInt64(2)).Mul(d).Quo(ann.Sub(sdk.OneDec()).Mul(d).Add(dp.MulInt64(3)))
+		if d.Sub(prev).Abs().LTE(curveTolerance) {
+			break
+		}
+	}
+	return d
+}
+
+// Price implements SwapCurve, P = (4A + D_P / Y) / (4A + D_P / X) where D_P = D^3 / 4XY.
+func (c StableSwapCurve) Price(x, y sdk.Dec) sdk.Dec {
+	ann := sdk.NewDec(int64(c.Amplification) * 4)
+	d := c.D(x, y)
+	dp := d.Mul(d).Quo(x.MulInt64(2)).Mul(d).Quo(y.MulInt64(2))
+	return ann.Add(dp.Quo(y)).Quo(ann.Add(dp.Quo(x)))
+}
+
+// ReserveRatio returns the reserve ratio X/Y at which the pool price is the given price. The pool price
+// increases with the reserve ratio, so it is found by bisection. The curve is symmetric, the ratio of
+// a price under 1 is the inverse of the ratio of the inverse price.
+func (c StableSwapCurve) ReserveRatio(price sdk.Dec) sdk.Dec {
+	if price.LT(sdk.OneDec()) {
+		return sdk.OneDec().Quo(c.ReserveRatio(sdk.OneDec().Quo(price)))
+	}
+	// the StableSwap curve is flatter than the constant product, so the ratio is not less than the price
+	lo, hi := price, price.MulInt64(2)
+	for c.Price(hi, sdk.OneDec()).LT(price) {
+		if hi.GTE(maxReserveRatio) {
+			return hi
+		}
+		lo, hi = hi, hi.MulInt64(2)
+	}
+	for i := 0; i < maxCurveIterations; i++ {
+		mid := lo.Add(hi).QuoInt64(2)
+		if mid.Equal(lo) || mid.Equal(hi) {
+			break
+		}
+		if c.Price(mid, sdk.OneDec()).LT(price) {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return hi
+}
+
+// PoolY implements SwapCurve. The pool sells Y at the swap price until its reserve ratio R is the ratio
+// of the swap price, (X + P_s * PoolY) / (Y - PoolY) = R.
+func (c StableSwapCurve) PoolY(x, y, swapPrice sdk.Dec) sdk.Dec {
+	ratio := c.ReserveRatio(swapPrice)
+	return ratio.Mul(y).Sub(x).Quo(swapPrice.Add(ratio)) // (R * Y - X) / (P_s + R)
+}
+
+// PoolX implements SwapCurve. The pool sells X at the swap price until its reserve ratio R is the ratio
+// of the swap price, (X - PoolX) / (Y + PoolX / P_s) = R.
+func (c StableSwapCurve) PoolX(x, y, swapPrice sdk.Dec) sdk.Dec {
+	ratio := c.ReserveRatio(swapPrice)
+	return swapPrice.Mul(x.Sub(ratio.Mul(y))).Quo(swapPrice.Add(ratio)) // P_s * (X - R * Y) / (P_s + R)
+}
+
+// SwapPrice implements SwapCurve. The swap price P_s satisfies EX = P_s * (EY + PoolY), which is the same
+// equation for both price directions since P_s * PoolY = -PoolX. Its right-hand side increases with the price,
+// so the reserve ratio of the swap price is found by bisection between the ratios of the price bounds.
+func (c StableSwapCurve) SwapPrice(x, y, ex, ey, minPrice, maxPrice sdk.Dec) sdk.Dec {
+	excess := func(ratio sdk.Dec) (sdk.Dec, sdk.Dec) {
+		price := c.Price(ratio, sdk.OneDec())
+		poolY := ratio.Mul(y).Sub(x).Quo(price.Add(ratio))
+		return price, price.Mul(ey.Add(poolY)).Sub(ex)
+	}
+
+	lo, hi := c.ReserveRatio(minPrice), c.ReserveRatio(maxPrice)
+	if _, e := excess(lo); !e.IsNegative() {
+		return minPrice
+	}
+	if _, e := excess(hi); e.IsNegative() {
+		return maxPrice
+	}
+	for i := 0; i < maxCurveIterations; i++ {
+		mid := lo.Add(hi).QuoInt64(2)
+		if mid.Equal(lo) || mid.Equal(hi) {
+			break
+		}
+		if _, e := excess(mid); e.IsNegative() {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	price, _ := excess(hi)
+	return price
+}
+
+// MultiAssetCurve is the curve of the multi-asset liquidity pool, of which the invariant is the weighted geometric
+// mean of the reserve amounts. The swap of each pair of the reserve coins follows the constant product of the pair,
+// or the weighted product of a weighted pool, since the other reserve coins of the pool stay the same by the swap.
+type MultiAssetCurve struct{}
+
+var _ PoolCurve = MultiAssetCurve{}
+
+// SwapCurve implements PoolCurve with the constant product or the weighted product of a pair of the reserve coins.
+func (MultiAssetCurve) SwapCurve(_, weightX, weightY uint32) SwapCurve {
+	return ConstantProductCurve{}.SwapCurve(0, weightX, weightY)
+}
+
+// Deposit implements PoolCurve with a proportional deposit.
+func (MultiAssetCurve) Deposit(reserveCoins sdk.Coins, poolCoinSupply sdk.Int, depositCoins sdk.Coins) (sdk.Int, sdk.Coins) {
+	return ProportionalDeposit(reserveCoins, poolCoinSupply, depositCoins)
+}
+
+// Withdraw implements PoolCurve with a proportional withdrawal.
+func (MultiAssetCurve) Withdraw(reserveCoins sdk.Coins, poolCoinSupply, poolCoinAmt sdk.Int, withdrawFeeRate sdk.Dec) (sdk.Coins, sdk.Coins) {
+	return ProportionalWithdraw(reserveCoins, poolCoinSupply, poolCoinAmt, withdrawFeeRate)
+}
+
+// Invariant implements PoolCurve, the geometric mean of the reserve amounts (X_1 * X_2 * ... * X_n)^(1/n), or the
+// weighted geometric mean of the reserve amounts of a weighted pool.
+func (MultiAssetCurve) Invariant(_ uint32, reserveCoins sdk.Coins, reserveCoinWeights []uint32) sdk.Dec {
+	return WeightedGeometricMean(reserveCoins, reserveCoinWeights)
+}
+
+// WeightedGeometricMean returns the weighted geometric mean of the reserve amounts
+// (X_1^W_1 * X_2^W_2 * ... * X_n^W_n)^(1/(W_1 + W_2 + ... + W_n)), where the weight of each reserve coin is 1 when no
+// weights are given. The mean is the integer root of the product of the big integers scaled by the precision of
+// sdk.Dec, which avoids the overflow of the product of the decimals.
+func WeightedGeometricMean(reserveCoins sdk.Coins, reserveCoinWeights []uint32) sdk.Dec {
+	product, totalWeight := big.NewInt(1), uint64(0)
+	for i, coin := range reserveCoins {
+		weight := uint64(1)
+		if len(reserveCoinWeights) > 0 {
+			weight = uint64(reserveCoinWeights[i])
+		}
+		product.Mul(product, new(big.Int).Exp(coin.Amount.BigInt(), new(big.Int).SetUint64(weight), nil))
+		totalWeight += weight
+	}
+	scale := new(big.Int).Exp(big.NewInt(10), new(big.Int).SetUint64(sdk.Precision*totalWeight), nil)
+	return sdk.NewDecFromBigIntWithPrec(integerRoot(product.Mul(product, scale), totalWeight), sdk.Precision)
+}
+
+// integerRoot returns the largest integer r with r^n <= a of a non-negative integer a, by the Newton's method
+// decreasing from an initial guess over the root.
+func integerRoot(a *big.Int, n uint64) *big.Int {
+	if a.Sign() == 0 || n == 1 {
+		return a
+	}
+	bn, bn1 := new(big.Int).SetUint64(n), new(big.Int).SetUint64(n-1)
+	// 2^ceil(bitlen(a) / n) is not less than the root
+	x := new(big.Int).Lsh(big.NewInt(1), uint((uint64(a.BitLen())+n-1)/n))
+	for {
+		// y = ((n - 1) * x + a / x^(n - 1)) / n
+		y := new(big.Int).Exp(x, bn1, nil)
+		y.Quo(a, y)
+		y.Add(y, new(big.Int).Mul(bn1, x))
+		y.Quo(y, bn)
+		if y.Cmp(x) >= 0 {
+			return x
+		}
+		x = y
+	}
+}
+
+// ProportionalDeposit returns the amount of pool coin minted for the deposit coins and the accepted deposit coins
+// in the ratio of the reserve coins. The minted amount is limited by the deposit coin of the least ratio to its
+// reserve coin, and the accepted amounts are truncated to keep the pool coin value.
+func ProportionalDeposit(reserveCoins sdk.Coins, poolCoinSupply sdk.Int, depositCoins sdk.Coins) (sdk.Int, sdk.Coins) {
+	poolCoinTotalSupply := sdk.NewDecFromInt(poolCoinSupply)
+	var poolCoinMintAmt sdk.Dec
+	for i, reserveCoin := range reserveCoins {
+		amt := poolCoinTotalSupply.MulTruncate(sdk.NewDecFromInt(depositCoins.AmountOf(reserveCoin.Denom))).QuoTruncate(sdk.NewDecFromInt(reserveCoin.Amount))
+		if i == 0 {
+			poolCoinMintAmt = amt
+		} else {
+			poolCoinMintAmt = sdk.MinDec(poolCoinMintAmt, amt)
+		}
+	}
+	mintRate := poolCoinMintAmt.TruncateDec().QuoTruncate(poolCoinTotalSupply)
+	acceptedCoins := sdk.NewCoins()
+	for _, reserveCoin := range reserveCoins {
+		acceptedCoins = acceptedCoins.Add(sdk.NewCoin(reserveCoin.Denom, sdk.NewDecFromInt(reserveCoin.Amount).Mul(mintRate).TruncateInt()))
+	}
+	return poolCoinMintAmt.TruncateInt(), acceptedCoins
+}
+
+// ProportionalWithdraw returns the reserve coins withdrawn in proportion to the burned pool coin amount less the
+// withdraw fee, and the withdraw fee coins left in the pool. All reserve coins are withdrawn without the withdraw fee
+// when the whole pool coin supply is burned.
+func ProportionalWithdraw(reserveCoins sdk.Coins, poolCoinSupply, poolCoinAmt sdk.Int, withdrawFeeRate sdk.Dec) (sdk.Coins, sdk.Coins) {
+	if poolCoinAmt.Equal(poolCoinSupply) {
+		return reserveCoins, sdk.NewCoins()
+	}
+	withdrawProportion := sdk.OneDec().Sub(withdrawFeeRate)
+	withdrawCoins := sdk.NewCoins()
+	withdrawFeeCoins := sdk.NewCoins()
+	for _, reserveCoin := range reserveCoins {
+		// WithdrawAmount = ReserveAmount * PoolCoinAmount * WithdrawFeeProportion / TotalSupply
+		withdrawAmtWithFee := sdk.NewDecFromInt(reserveCoin.Amount.Mul(poolCoinAmt)).TruncateInt().Quo(poolCoinSupply)
+		withdrawAmt := sdk.NewDecFromInt(reserveCoin.Amount.Mul(poolCoinAmt)).MulTruncate(withdrawProportion).TruncateInt().Quo(poolCoinSupply)
+		withdrawCoins = append(withdrawCoins, sdk.NewCoin(reserveCoin.Denom, withdrawAmt))
+		withdrawFeeCoins = append(withdrawFeeCoins, sdk.NewCoin(reserveCoin.Denom, withdrawAmtWithFee.Sub(withdrawAmt)))
+	}
+	return withdrawCoins, withdrawFeeCoins
+}
@@ -0,0 +1,165 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravity-devs/liquidity/v2/x/liquidity/types"
+)
+
+func TestStableSwapCurve(t *testing.T) {
+	curve := types.StableSwapCurve{Amplification: 100}
+	tolerance := sdk.NewDecWithPrec(1, 12)
+
+	// the pool price of the balanced reserves is 1
+	x, y := sdk.NewDec(1_000_000_000), sdk.NewDec(1_000_000_000)
+	require.True(t, curve.D(x, y).Sub(x.Add(y)).Abs().LTE(tolerance))
+	require.True(t, curve.Price(x, y).Sub(sdk.OneDec()).Abs().LTE(tolerance))
+
+	// the invariant satisfies 4A(X + Y) + D = 4AD + D^3 / 4XY with imbalanced reserves
+	x = sdk.NewDec(1_500_000_000)
+	d := curve.D(x, y)
+	ann := sdk.NewDec(400)
+	lhs := ann.Mul(x.Add(y)).Add(d)
+	rhs := ann.Mul(d).Add(d.Mul(d).Quo(x.MulInt64(2)).Mul(d).Quo(y.MulInt64(2)))
+	require.True(t, lhs.Sub(rhs).Abs().Quo(lhs).LTE(tolerance))
+
+	// the pool price stays much closer to 1 than the reserve ratio, and the ratio of the price is found back
+	price := curve.Price(x, y)
+	require.True(t, price.GT(sdk.OneDec()))
+	require.True(t, price.LT(sdk.MustNewDecFromStr("1.01")))
+	require.True(t, curve.ReserveRatio(price).Sub(x.Quo(y)).Abs().LTE(tolerance))
+	require.True(t, curve.ReserveRatio(sdk.OneDec().Quo(price)).Sub(y.Quo(x)).Abs().LTE(tolerance))
+
+	// the pool sells Y until its price is the swap price, and buys it back at the inverse price
+	swapPrice := sdk.MustNewDecFromStr("1.02")
+	poolY := curve.PoolY(x, y, swapPrice)
+	require.True(t, poolY.IsPositive())
+	require.True(t, curve.Price(x.Add(swapPrice.Mul(poolY)), y.Sub(poolY)).Sub(swapPrice).Abs().LTE(tolerance))
+	require.True(t, curve.PoolX(x, y, swapPrice).Add(swapPrice.Mul(poolY)).Abs().LTE(sdk.OneDec()))
+	require.True(t, curve.PoolX(x, y, sdk.OneDec()).IsPositive())
+
+	// an amplification of 1 still has a flatter curve than the constant product
+	require.True(t, types.StableSwapCurve{Amplification: 1}.Price(x, y).LT(types.ConstantProductCurve{}.Price(x, y)))
+}
+
+func TestOrderBookMatchStableSwap(t *testing.T) {
+	x, y := sdk.NewDec(1_000_000_000), sdk.NewDec(1_000_000_000)
+	orderPrice := sdk.MustNewDecFromStr("1.1")
+
+	// a single order over the current price is matched with much less slippage on the StableSwap curve
+	results := make([]types.BatchResult, 2)
+	for i, curve := range []types.SwapCurve{types.ConstantProductCurve{}, types.StableSwapCurve{Amplification: 100}} {
+		swapMsgStates := []*types.SwapMsgState{
+			newSwapMsgState(1, sdk.NewCoin(DenomX, sdk.NewInt(10_000_000)), DenomY, orderPrice),
+		}
+		orderMap, _, _ := types.MakeOrderMap(swapMsgStates, DenomX, DenomY, false)
+		result, staying := orderMap.SortOrderBook().Match(curve, x, y)
+		require.False(t, staying)
+		require.Equal(t, types.Increasing, result.PriceDirection)
+		require.Equal(t, types.ExactMatch, result.MatchType)
+		require.True(t, result.SwapPrice.GT(sdk.OneDec()))
+		require.True(t, result.SwapPrice.LT(orderPrice))
+		// the order is fully matched with the pool
+		require.True(t, result.EX.Sub(result.PoolY.Mul(result.SwapPrice)).Abs().LTE(sdk.OneDec()))
+		results[i] = result
+	}
+	require.True(t, results[1].SwapPrice.LT(results[0].SwapPrice))
+	require.True(t, results[1].SwapPrice.LT(sdk.MustNewDecFromStr("1.001")))
+}
+
+func TestProportionalDepositWithdraw(t *testing.T) {
+	reserveCoins := sdk.NewCoins(sdk.NewCoin(DenomX, sdk.NewInt(1_000_000)), sdk.NewCoin(DenomY, sdk.NewInt(2_000_000)))
+	supply := sdk.NewInt(1_000_000)
+
+	// the deposit is accepted in the ratio of the reserve coins and the rest is refunded
+	depositCoins := sdk.NewCoins(sdk.NewCoin(DenomX, sdk.NewInt(100_000)), sdk.NewCoin(DenomY, sdk.NewInt(300_000)))
+	mintAmt, acceptedCoins := types.ProportionalDeposit(reserveCoins, supply, depositCoins)
+	require.Equal(t, sdk.NewInt(100_000), mintAmt)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(DenomX, sdk.NewInt(100_000)), sdk.NewCoin(DenomY, sdk.NewInt(200_000))), acceptedCoins)
+
+	// the withdraw fee is left in the pool
+	withdrawCoins, withdrawFeeCoins := types.ProportionalWithdraw(reserveCoins, supply, sdk.NewInt(100_000), sdk.NewDecWithPrec(1, 2))
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(DenomX, sdk.NewInt(99_000)), sdk.NewCoin(DenomY, sdk.NewInt(198_000))), withdrawCoins)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(DenomX, sdk.NewInt(1_000)), sdk.NewCoin(DenomY, sdk.NewInt(2_000))), withdrawFeeCoins)
+
+	// all reserve coins are withdrawn without the withdraw fee by the whole supply
+	withdrawCoins, withdrawFeeCoins = types.ProportionalWithdraw(reserveCoins, supply, supply, sdk.NewDecWithPrec(1, 2))
+	require.Equal(t, reserveCoins, withdrawCoins)
+	require.True(t, withdrawFeeCoins.IsZero())
+}
+
+func TestPoolCurveInvariant(t *testing.T) {
+	amplification := types.DefaultStableSwapAmplification
+	reserveCoins := sdk.NewCoins(sdk.NewCoin(DenomX, sdk.NewInt(1_000_000)), sdk.NewCoin(DenomY, sdk.NewInt(4_000_000)))
+	doubled := sdk.NewCoins(sdk.NewCoin(DenomX, sdk.NewInt(2_000_000)), sdk.NewCoin(DenomY, sdk.NewInt(8_000_000)))
+
+	for _, curve := range []types.PoolCurve{types.ConstantProductCurve{}, types.StableSwapCurve{}, types.MultiAssetCurve{}} {
+		// the invariant is proportional to the size of the pool
+		invariant := curve.Invariant(amplification, reserveCoins, nil)
+		require.True(t, invariant.IsPositive())
+		require.True(t, curve.Invariant(amplification, doubled, nil).Sub(invariant.MulInt64(2)).Abs().LTE(sdk.NewDecWithPrec(1, 6)))
+	}
+	require.Equal(t, sdk.NewDec(2_000_000), types.ConstantProductCurve{}.Invariant(amplification, reserveCoins, nil))
+
+	// the invariant of the multi-asset curve is the geometric mean of the reserve amounts
+	multiAssetReserveCoins := reserveCoins.Add(sdk.NewCoin("denomZ", sdk.NewInt(8_000_000)))
+	require.True(t, types.MultiAssetCurve{}.Invariant(amplification, multiAssetReserveCoins, nil).Sub(sdk.NewDec(3_174_802)).Abs().LTE(sdk.OneDec()))
+	require.Equal(t, types.ConstantProductCurve{}, types.MultiAssetCurve{}.SwapCurve(amplification, 1, 1))
+	require.Equal(t, types.StableSwapCurve{Amplification: amplification}, types.StableSwapCurve{}.SwapCurve(amplification, 1, 1))
+}
+
+func TestWeightedProductCurve(t *testing.T) {
+	curve := types.ConstantProductCurve{}.SwapCurve(0, 80, 20)
+	require.Equal(t, types.WeightedProductCurve{WeightX: 80, WeightY: 20}, curve)
+	tolerance := sdk.NewDecWithPrec(1, 12)
+
+	// the value of X is 80% of the pool at the pool price
+	x, y := sdk.NewDec(4_000_000_000), sdk.NewDec(1_000_000_000)
+	require.Equal(t, sdk.OneDec(), curve.Price(x, y))
+
+	// the pool sells Y until its price is the swap price, and buys it back at the inverse price
+	swapPrice := sdk.MustNewDecFromStr("1.02")
+	poolY := curve.PoolY(x, y, swapPrice)
+	require.True(t, poolY.IsPositive())
+	require.True(t, curve.Price(x.Add(swapPrice.Mul(poolY)), y.Sub(poolY)).Sub(swapPrice).Abs().LTE(tolerance))
+	require.True(t, curve.PoolX(x, y, swapPrice).Add(swapPrice.Mul(poolY)).Abs().LTE(tolerance))
+
+	// the swap price of the executable amounts is exactly matched with the pool
+	ex, ey := sdk.NewDec(10_000_000), sdk.NewDec(1_000_000)
+	swapPrice = curve.SwapPrice(x, y, ex, ey, sdk.ZeroDec(), sdk.ZeroDec())
+	require.True(t, ex.Sub(swapPrice.Mul(ey.Add(curve.PoolY(x, y, swapPrice)))).Abs().LTE(sdk.OneDec()))
+
+	// the weighted pool moves its price less than the equally weighted pool of the same value by the same swap
+	equalCurve := types.ConstantProductCurve{}.SwapCurve(0, 20, 20)
+	require.Equal(t, types.ConstantProductCurve{}, equalCurve)
+	require.True(t, swapPrice.LT(equalCurve.SwapPrice(sdk.NewDec(1_000_000_000), y, ex, ey, sdk.ZeroDec(), sdk.ZeroDec())))
+
+	// the weighted invariant does not decrease by the swap
+	weights := []uint32{80, 20}
+	poolY = curve.PoolY(x, y, swapPrice)
+	reserveCoins := sdk.NewCoins(sdk.NewCoin(DenomX, x.TruncateInt()), sdk.NewCoin(DenomY, y.TruncateInt()))
+	afterReserveCoins := sdk.NewCoins(sdk.NewCoin(DenomX, x.Add(swapPrice.Mul(poolY)).TruncateInt()), sdk.NewCoin(DenomY, y.Sub(poolY).TruncateInt()))
+	require.True(t, types.ConstantProductCurve{}.Invariant(0, afterReserveCoins, weights).GTE(
+		types.ConstantProductCurve{}.Invariant(0, reserveCoins, weights)))
+}
+
+func TestWeightedGeometricMean(t *testing.T) {
+	reserveCoins := sdk.NewCoins(sdk.NewCoin(DenomX, sdk.NewInt(1_000_000)), sdk.NewCoin(DenomY, sdk.NewInt(100_000_000)))
+	require.Equal(t, sdk.NewDec(10_000_000), types.WeightedGeometricMean(reserveCoins, nil))
+
+	// 10^(6 * 0.8 + 8 * 0.2) = 10^6.4
+	mean := types.WeightedGeometricMean(reserveCoins, []uint32{80, 20})
+	require.True(t, mean.Sub(sdk.MustNewDecFromStr("2511886.431509580111")).Abs().LTE(sdk.NewDecWithPrec(1, 6)))
+
+	// the mean of the large amounts of the most reserve coins is exact
+	largeCoins := sdk.NewCoins()
+	for i := 0; i < int(types.MaxReserveCoinNum); i++ {
+		largeCoins = largeCoins.Add(sdk.NewCoin(fmt.Sprintf("denom%d", i), sdk.NewInt(1_000_000_000_000_000_000)))
+	}
+	require.Equal(t, sdk.NewDec(1_000_000_000_000_000_000), types.WeightedGeometricMean(largeCoins, nil))
+	require.True(t, types.WeightedGeometricMean(sdk.Coins{sdk.NewCoin(DenomX, sdk.ZeroInt()), sdk.NewCoin(DenomY, sdk.OneInt())}, nil).IsZero())
+}
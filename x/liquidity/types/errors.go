@@ -47,4 +47,23 @@ var (
 	ErrDepletedPool                 = sdkerrors.Register(ModuleName, 39, "the pool is depleted of reserve coin, reinitializing is required by deposit")
 	ErrCircuitBreakerEnabled        = sdkerrors.Register(ModuleName, 40, "circuit breaker is triggered")
 	ErrOverflowAmount               = sdkerrors.Register(ModuleName, 41, "invalid amount that can cause overflow")
+	ErrBatchMsgNotExists            = sdkerrors.Register(ModuleName, 42, "batch msg not exists")
+	ErrBatchMsgNotCancelable        = sdkerrors.Register(ModuleName, 43, "batch msg is already executed or to be deleted")
+	ErrNotBatchMsgRequester         = sdkerrors.Register(ModuleName, 44, "only the requester of the batch msg can cancel it")
+	ErrLessThanMinPoolCoinAmount    = sdkerrors.Register(ModuleName, 45, "minted pool coin amount is less than the minimum pool coin amount of the deposit")
+	ErrLessThanMinWithdrawCoins     = sdkerrors.Register(ModuleName, 46, "withdrawn coins are less than the minimum withdraw coins of the withdrawal")
+	ErrBadSwapRoute                 = sdkerrors.Register(ModuleName, 47, "invalid swap route")
+	ErrSwapRouteNotMatched          = sdkerrors.Register(ModuleName, 48, "swap of the route is not fully matched")
+	ErrLessThanMinDemandCoin        = sdkerrors.Register(ModuleName, 49, "exchanged demand coin is less than the minimum demand coin of the swap route")
+	ErrNoPriceRecord                = sdkerrors.Register(ModuleName, 50, "no price record of the pool at or before the given height or time")
+	ErrBadTwapPeriod                = sdkerrors.Register(ModuleName, 51, "invalid period of the time-weighted average price")
+	ErrBadPriceRecord               = sdkerrors.Register(ModuleName, 52, "invalid price record of the pool")
+	ErrBadReserveCoinWeights        = sdkerrors.Register(ModuleName, 53, "invalid reserve coin weights of the pool")
+	ErrSwapFeeTierNotExists         = sdkerrors.Register(ModuleName, 54, "swap fee rate is not one of the swap fee tiers of the params")
+	ErrPoolStatusNotAllowed         = sdkerrors.Register(ModuleName, 55, "msg is not allowed by the status of the pool")
+	ErrBadPoolStatus                = sdkerrors.Register(ModuleName, 56, "invalid status of the pool")
+	ErrInvalidAuthority             = sdkerrors.Register(ModuleName, 57, "invalid authority")
+	ErrBadParams                    = sdkerrors.Register(ModuleName, 58, "invalid params")
+	ErrBadBatchInterval             = sdkerrors.Register(ModuleName, 59, "batch interval of the pool exceeds the max batch interval of the params")
+	ErrBadBatchRecord               = sdkerrors.Register(ModuleName, 60, "invalid batch record of the pool")
 )
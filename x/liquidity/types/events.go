@@ -2,13 +2,29 @@ package types
 
 // Event types for the liquidity module.
 const (
-	EventTypeCreatePool          = TypeMsgCreatePool
-	EventTypeDepositWithinBatch  = TypeMsgDepositWithinBatch
-	EventTypeWithdrawWithinBatch = TypeMsgWithdrawWithinBatch
-	EventTypeSwapWithinBatch     = TypeMsgSwapWithinBatch
-	EventTypeDepositToPool       = "deposit_to_pool"
-	EventTypeWithdrawFromPool    = "withdraw_from_pool"
-	EventTypeSwapTransacted      = "swap_transacted"
+	EventTypeCreatePool                    = TypeMsgCreatePool
+	EventTypeDepositWithinBatch            = TypeMsgDepositWithinBatch
+	EventTypeDepositSingleAssetWithinBatch = TypeMsgDepositSingleAssetWithinBatch
+	EventTypeWithdrawWithinBatch           = TypeMsgWithdrawWithinBatch
+	EventTypeSwapWithinBatch               = TypeMsgSwapWithinBatch
+	EventTypeSwapRoute                     = TypeMsgSwapRoute
+	EventTypeCancelDeposit                 = TypeMsgCancelDeposit
+	EventTypeCancelWithdraw                = TypeMsgCancelWithdraw
+	EventTypeCancelSwap                    = TypeMsgCancelSwap
+	EventTypeSetPoolStatus                 = TypeMsgSetPoolStatus
+	EventTypeSetCircuitBreaker             = TypeMsgSetCircuitBreaker
+	EventTypeUpdateParams                  = TypeMsgUpdateParams
+	EventTypeWindDownPool                  = TypeMsgWindDownPool
+	EventTypeDepositToPool                 = "deposit_to_pool"
+	EventTypeWithdrawFromPool              = "withdraw_from_pool"
+	EventTypeSwapTransacted                = "swap_transacted"
+	EventTypeSwapCarriedOver               = "swap_carried_over"
+	EventTypeSwapExpired                   = "swap_expired"
+	EventTypeWithdrawTargetCoin            = "withdraw_target_coin"
+	EventTypeSwapRouteTransacted           = "swap_route_transacted"
+	EventTypeWindDownPayout                = "wind_down_payout"
+	EventTypeRemovePool                    = "remove_pool"
+	EventTypePruneDepletedPool             = "prune_depleted_pool"
 
 	AttributeValuePoolId         = "pool_id"      //nolint:revive
 	AttributeValuePoolTypeId     = "pool_type_id" //nolint:revive
@@ -18,6 +34,7 @@ const (
 	AttributeValuePoolCoinAmount = "pool_coin_amount"
 	AttributeValueBatchIndex     = "batch_index"
 	AttributeValueMsgIndex       = "msg_index"
+	AttributeValueSwapMsgIndex   = "swap_msg_index"
 
 	AttributeValueDepositCoins = "deposit_coins"
 
@@ -27,14 +44,20 @@ const (
 	AttributeValueExchangedCoinFeeAmount = "exchanged_coin_fee_amount"
 	AttributeValueDemandCoinDenom        = "demand_coin_denom"
 	AttributeValueOrderPrice             = "order_price"
+	AttributeValuePoolIds                = "pool_ids" //nolint:revive
+	AttributeValueMinDemandCoin          = "min_demand_coin"
+	AttributeValueExchangedDemandCoin    = "exchanged_demand_coin"
 
 	AttributeValueDepositor        = "depositor"
 	AttributeValueRefundedCoins    = "refunded_coins"
 	AttributeValueAcceptedCoins    = "accepted_coins"
 	AttributeValueSuccess          = "success"
+	AttributeValueReason           = "reason"
 	AttributeValueWithdrawer       = "withdrawer"
+	AttributeValueHolder           = "holder"
 	AttributeValueWithdrawCoins    = "withdraw_coins"
 	AttributeValueWithdrawFeeCoins = "withdraw_fee_coins"
+	AttributeValueTargetCoin       = "target_coin"
 	AttributeValueSwapRequester    = "swap_requester"
 	AttributeValueSwapTypeId       = "swap_type_id" //nolint:revive
 	AttributeValueSwapPrice        = "swap_price"
@@ -46,6 +69,13 @@ const (
 	AttributeValueReservedOfferCoinFeeAmount = "reserved_offer_coin_fee_amount"
 	AttributeValueOrderExpiryHeight          = "order_expiry_height"
 
+	AttributeValueStatus    = "status"
+	AttributeValueAuthority = "authority"
+	AttributeValueEnabled   = "enabled"
+
+	AttributeValueDustCoins      = "dust_coins"
+	AttributeValueDepletedHeight = "depleted_height"
+
 	AttributeValueCategory = ModuleName
 
 	Success = "success"
@@ -40,5 +40,20 @@ func (record PoolRecord) Validate() error {
 		(len(record.SwapMsgStates) != 0 && record.PoolBatch.SwapMsgIndex != record.SwapMsgStates[len(record.SwapMsgStates)-1].MsgIndex+1) {
 		return ErrBadBatchMsgIndex
 	}
+	if len(record.SwapRouteMsgStates) != 0 && record.PoolBatch.SwapRouteMsgIndex != record.SwapRouteMsgStates[len(record.SwapRouteMsgStates)-1].MsgIndex+1 {
+		return ErrBadBatchMsgIndex
+	}
+	for i, priceRecord := range record.PriceRecords {
+		if priceRecord.PoolId != record.Pool.Id || priceRecord.Price.IsNil() || priceRecord.CumulativePrice.IsNil() ||
+			(i > 0 && priceRecord.Height <= record.PriceRecords[i-1].Height) {
+			return ErrBadPriceRecord
+		}
+	}
+	for i, batchRecord := range record.BatchRecords {
+		if batchRecord.PoolId != record.Pool.Id || batchRecord.BatchIndex > record.PoolBatch.Index ||
+			(i > 0 && batchRecord.BatchIndex <= record.BatchRecords[i-1].BatchIndex) {
+			return ErrBadBatchRecord
+		}
+	}
 	return nil
 }
@@ -25,12 +25,15 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // records the state of each pool after genesis export or import, used to check variables
 type PoolRecord struct {
-	Pool              Pool               `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool" yaml:"pool"`
-	PoolMetadata      PoolMetadata       `protobuf:"bytes,2,opt,name=pool_metadata,json=poolMetadata,proto3" json:"pool_metadata" yaml:"pool_metadata"`
-	PoolBatch         PoolBatch          `protobuf:"bytes,3,opt,name=pool_batch,json=poolBatch,proto3" json:"pool_batch" yaml:"pool_batch"`
-	DepositMsgStates  []DepositMsgState  `protobuf:"bytes,4,rep,name=deposit_msg_states,json=depositMsgStates,proto3" json:"deposit_msg_states" yaml:"deposit_msg_states"`
-	WithdrawMsgStates []WithdrawMsgState `protobuf:"bytes,5,rep,name=withdraw_msg_states,json=withdrawMsgStates,proto3" json:"withdraw_msg_states" yaml:"withdraw_msg_states"`
-	SwapMsgStates     []SwapMsgState     `protobuf:"bytes,6,rep,name=swap_msg_states,json=swapMsgStates,proto3" json:"swap_msg_states" yaml:"swap_msg_states"`
+	Pool               Pool                `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool" yaml:"pool"`
+	PoolMetadata       PoolMetadata        `protobuf:"bytes,2,opt,name=pool_metadata,json=poolMetadata,proto3" json:"pool_metadata" yaml:"pool_metadata"`
+	PoolBatch          PoolBatch           `protobuf:"bytes,3,opt,name=pool_batch,json=poolBatch,proto3" json:"pool_batch" yaml:"pool_batch"`
+	DepositMsgStates   []DepositMsgState   `protobuf:"bytes,4,rep,name=deposit_msg_states,json=depositMsgStates,proto3" json:"deposit_msg_states" yaml:"deposit_msg_states"`
+	WithdrawMsgStates  []WithdrawMsgState  `protobuf:"bytes,5,rep,name=withdraw_msg_states,json=withdrawMsgStates,proto3" json:"withdraw_msg_states" yaml:"withdraw_msg_states"`
+	SwapMsgStates      []SwapMsgState      `protobuf:"bytes,6,rep,name=swap_msg_states,json=swapMsgStates,proto3" json:"swap_msg_states" yaml:"swap_msg_states"`
+	SwapRouteMsgStates []SwapRouteMsgState `protobuf:"bytes,7,rep,name=swap_route_msg_states,json=swapRouteMsgStates,proto3" json:"swap_route_msg_states" yaml:"swap_route_msg_states"`
+	PriceRecords       []PoolPriceRecord   `protobuf:"bytes,8,rep,name=price_records,json=priceRecords,proto3" json:"price_records" yaml:"price_records"`
+	BatchRecords       []PoolBatchRecord   `protobuf:"bytes,9,rep,name=batch_records,json=batchRecords,proto3" json:"batch_records" yaml:"batch_records"`
 }
 
 func (m *PoolRecord) Reset()         { *m = PoolRecord{} }
@@ -108,6 +111,27 @@ func (m *PoolRecord) GetSwapMsgStates() []SwapMsgState {
 	return nil
 }
 
+func (m *PoolRecord) GetSwapRouteMsgStates() []SwapRouteMsgState {
+	if m != nil {
+		return m.SwapRouteMsgStates
+	}
+	return nil
+}
+
+func (m *PoolRecord) GetPriceRecords() []PoolPriceRecord {
+	if m != nil {
+		return m.PriceRecords
+	}
+	return nil
+}
+
+func (m *PoolRecord) GetBatchRecords() []PoolBatchRecord {
+	if m != nil {
+		return m.BatchRecords
+	}
+	return nil
+}
+
 // GenesisState defines the liquidity module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters for the liquidity module.
@@ -158,39 +182,45 @@ func init() {
 }
 
 var fileDescriptor_7dc104913a173687 = []byte{
-	// 508 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0xed, 0x36, 0x8d, 0xe0, 0x92, 0x0a, 0x7a, 0x8d, 0x90, 0x1b, 0x55, 0x4e, 0x39, 0x21,
-	0x11, 0x55, 0xd4, 0x56, 0xdb, 0xad, 0xa3, 0x85, 0xc4, 0x80, 0x22, 0x21, 0x77, 0x40, 0x62, 0x89,
-	0x2e, 0xf1, 0xc9, 0x39, 0x29, 0xce, 0x1d, 0x7e, 0xd7, 0x98, 0x2c, 0x0c, 0x4c, 0x8c, 0x7c, 0x84,
-	0x0e, 0x7c, 0x0f, 0xd6, 0x8e, 0x1d, 0x99, 0x2a, 0x94, 0x2c, 0xcc, 0x7c, 0x02, 0xe4, 0xf3, 0xe1,
-	0x98, 0x80, 0x92, 0x4e, 0x3e, 0x9d, 0xff, 0xff, 0xff, 0xef, 0x3d, 0xdd, 0x7b, 0xe8, 0x58, 0xb1,
-	0x49, 0xc4, 0xd2, 0x84, 0x4f, 0x94, 0x3f, 0xe6, 0xef, 0xaf, 0x78, 0xc4, 0xd5, 0xcc, 0x9f, 0x9e,
-	0x0e, 0x98, 0xa2, 0xa7, 0x7e, 0xcc, 0x26, 0x0c, 0x38, 0x78, 0x32, 0x15, 0x4a, 0xe0, 0xc3, 0xa5,
-	0xd6, 0x2b, 0xb5, 0x9e, 0xd1, 0xb6, 0x5f, 0xac, 0x4d, 0x5a, 0xea, 0x75, 0x56, 0xbb, 0x15, 0x8b,
-	0x58, 0xe8, 0xa3, 0x9f, 0x9f, 0x8a, 0x5b, 0xf2, 0x75, 0x07, 0xa1, 0x37, 0x42, 0x8c, 0x43, 0x36,
-	0x14, 0x69, 0x84, 0x5f, 0xa3, 0x9a, 0x14, 0x62, 0xec, 0xd8, 0x47, 0x76, 0xb7, 0x71, 0x46, 0xbc,
-	0x75, 0x7c, 0x2f, 0xf7, 0x05, 0xfb, 0x37, 0x77, 0x1d, 0xeb, 0xd7, 0x5d, 0xa7, 0x31, 0xa3, 0xc9,
-	0xf8, 0x82, 0xe4, 0x6e, 0x12, 0xea, 0x10, 0x9c, 0xa0, 0xdd, 0xfc, 0xdb, 0x4f, 0x98, 0xa2, 0x11,
-	0x55, 0xd4, 0xd9, 0xd2, 0xa9, 0xc7, 0x9b, 0x53, 0x7b, 0xc6, 0x11, 0x1c, 0x9a, 0xf4, 0xd6, 0x32,
-	0xbd, 0x8c, 0x23, 0x61, 0x53, 0x56, 0xb4, 0x98, 0x22, 0xa4, 0xff, 0x0f, 0xa8, 0x1a, 0x8e, 0x9c,
-	0x6d, 0xcd, 0x7a, 0x7e, 0x8f, 0x0e, 0x72, 0x79, 0x70, 0x60, 0x40, 0x7b, 0x15, 0x90, 0x0e, 0x22,
-	0xe1, 0x43, 0xf9, 0x47, 0x85, 0x3f, 0x22, 0x1c, 0x31, 0x29, 0x80, 0xab, 0x7e, 0x02, 0x71, 0x1f,
-	0x14, 0x55, 0x0c, 0x9c, 0xda, 0xd1, 0x76, 0xb7, 0x71, 0x76, 0xb2, 0x1e, 0xf5, 0xb2, 0xf0, 0xf5,
-	0x20, 0xbe, 0xcc, 0x5d, 0xc1, 0x53, 0x03, 0x3c, 0x28, 0x80, 0xff, 0xc6, 0x92, 0xf0, 0x71, 0xf4,
-	0xb7, 0x07, 0xf0, 0x27, 0x1b, 0xed, 0x67, 0x5c, 0x8d, 0xa2, 0x94, 0x66, 0xd5, 0x0a, 0x76, 0x74,
-	0x05, 0xde, 0xfa, 0x0a, 0xde, 0x1a, 0x63, 0x59, 0x02, 0x31, 0x25, 0xb4, 0x8b, 0x12, 0xfe, 0x13,
-	0x4c, 0xc2, 0xbd, 0x6c, 0xc5, 0x05, 0x38, 0x45, 0x8f, 0x20, 0xa3, 0xb2, 0xca, 0xaf, 0x6b, 0xfe,
-	0x86, 0x87, 0xbd, 0xcc, 0xa8, 0x2c, 0xd9, 0xae, 0x61, 0x3f, 0x29, 0xd8, 0x2b, 0x81, 0x24, 0xdc,
-	0x85, 0x8a, 0x1a, 0xc8, 0x37, 0x1b, 0x35, 0x5f, 0x15, 0xab, 0xa1, 0x6f, 0x70, 0x80, 0xea, 0x92,
-	0xa6, 0x34, 0x01, 0x33, 0xaa, 0xcf, 0x36, 0x3c, 0xb4, 0xd6, 0x06, 0xb5, 0x9c, 0x1a, 0x1a, 0x27,
-	0xa6, 0x48, 0x0f, 0x50, 0x3f, 0xd5, 0xb3, 0x0f, 0xce, 0x96, 0xee, 0xa2, 0xbb, 0x79, 0x64, 0x8a,
-	0x65, 0x09, 0x5a, 0xa6, 0x87, 0xe6, 0x72, 0x66, 0x80, 0x84, 0x0d, 0x59, 0x2a, 0xe0, 0xe2, 0xc1,
-	0xe7, 0xeb, 0x8e, 0xf5, 0xf3, 0xba, 0x63, 0x05, 0xbd, 0x9b, 0xb9, 0x6b, 0xdf, 0xce, 0x5d, 0xfb,
-	0xc7, 0xdc, 0xb5, 0xbf, 0x2c, 0x5c, 0xeb, 0x76, 0xe1, 0x5a, 0xdf, 0x17, 0xae, 0xf5, 0xee, 0x3c,
-	0xe6, 0x6a, 0x74, 0x35, 0xf0, 0x86, 0x22, 0xf1, 0xe3, 0x94, 0x4e, 0xb9, 0x9a, 0x9d, 0x44, 0x6c,
-	0x0a, 0x95, 0x9d, 0xfe, 0x50, 0x39, 0xab, 0x99, 0x64, 0x30, 0xa8, 0xeb, 0xf5, 0x3d, 0xff, 0x1d,
-	0x00, 0x00, 0xff, 0xff, 0x59, 0xae, 0xc9, 0xf1, 0x4e, 0x04, 0x00, 0x00,
+	// 594 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x31, 0x6f, 0xda, 0x40,
+	0x14, 0xc7, 0xed, 0x24, 0xa5, 0xe4, 0x00, 0xb5, 0xb9, 0xd0, 0x8a, 0x20, 0x64, 0xe8, 0x29, 0x52,
+	0x69, 0xd4, 0xd8, 0x4a, 0xb2, 0x65, 0xb4, 0x2a, 0x75, 0xa8, 0x90, 0xa2, 0xcb, 0x50, 0xa9, 0x0b,
+	0x3a, 0xf0, 0xc9, 0x58, 0xc2, 0xdc, 0xd5, 0x77, 0x40, 0x59, 0x3a, 0x74, 0xca, 0x58, 0xa9, 0x5f,
+	0x20, 0xdf, 0xa4, 0x6b, 0xc6, 0x8c, 0x9d, 0xa2, 0x0a, 0x96, 0xce, 0xfd, 0x04, 0x95, 0xcf, 0x57,
+	0xdb, 0x21, 0x11, 0x30, 0x71, 0xc2, 0xff, 0xff, 0xff, 0xf7, 0x9e, 0xee, 0xdd, 0x03, 0x47, 0x92,
+	0x8e, 0x3c, 0x1a, 0x85, 0xc1, 0x48, 0x3a, 0xc3, 0xe0, 0xf3, 0x38, 0xf0, 0x02, 0x39, 0x73, 0x26,
+	0x27, 0x3d, 0x2a, 0xc9, 0x89, 0xe3, 0xd3, 0x11, 0x15, 0x81, 0xb0, 0x79, 0xc4, 0x24, 0x83, 0x8d,
+	0x4c, 0x6b, 0xa7, 0x5a, 0x5b, 0x6b, 0xeb, 0x6f, 0x57, 0x26, 0x65, 0x7a, 0x95, 0x55, 0x7f, 0xb3,
+	0x52, 0xcd, 0x49, 0x44, 0x42, 0x8d, 0xad, 0x57, 0x7d, 0xe6, 0x33, 0x75, 0x74, 0xe2, 0x53, 0xf2,
+	0x2f, 0xfa, 0x51, 0x04, 0xe0, 0x82, 0xb1, 0x21, 0xa6, 0x7d, 0x16, 0x79, 0xf0, 0x03, 0xd8, 0xe1,
+	0x8c, 0x0d, 0x6b, 0x66, 0xcb, 0x6c, 0x97, 0x4e, 0x91, 0xbd, 0xaa, 0x54, 0x3b, 0xf6, 0xb9, 0xfb,
+	0x37, 0x77, 0x4d, 0xe3, 0xef, 0x5d, 0xb3, 0x34, 0x23, 0xe1, 0xf0, 0x1c, 0xc5, 0x6e, 0x84, 0x55,
+	0x08, 0x0c, 0x41, 0x25, 0xfe, 0xed, 0x86, 0x54, 0x12, 0x8f, 0x48, 0x52, 0xdb, 0x52, 0xa9, 0x47,
+	0xeb, 0x53, 0x3b, 0xda, 0xe1, 0x36, 0x74, 0x7a, 0x35, 0x4b, 0x4f, 0xe3, 0x10, 0x2e, 0xf3, 0x9c,
+	0x16, 0x12, 0x00, 0xd4, 0xf7, 0x1e, 0x91, 0xfd, 0x41, 0x6d, 0x5b, 0xb1, 0x5e, 0x6f, 0xd0, 0x41,
+	0x2c, 0x77, 0x0f, 0x34, 0x68, 0x2f, 0x07, 0x52, 0x41, 0x08, 0xef, 0xf2, 0xff, 0x2a, 0xf8, 0x15,
+	0x40, 0x8f, 0x72, 0x26, 0x02, 0xd9, 0x0d, 0x85, 0xdf, 0x15, 0x92, 0x48, 0x2a, 0x6a, 0x3b, 0xad,
+	0xed, 0x76, 0xe9, 0xf4, 0x78, 0x35, 0xea, 0x5d, 0xe2, 0xeb, 0x08, 0xff, 0x32, 0x76, 0xb9, 0xaf,
+	0x34, 0xf0, 0x20, 0x01, 0x3e, 0x8c, 0x45, 0xf8, 0xb9, 0x77, 0xdf, 0x23, 0xe0, 0x37, 0x13, 0xec,
+	0x4f, 0x03, 0x39, 0xf0, 0x22, 0x32, 0xcd, 0x57, 0xf0, 0x44, 0x55, 0x60, 0xaf, 0xae, 0xe0, 0xa3,
+	0x36, 0xa6, 0x25, 0x20, 0x5d, 0x42, 0x3d, 0x29, 0xe1, 0x91, 0x60, 0x84, 0xf7, 0xa6, 0x4b, 0x2e,
+	0x01, 0x23, 0xf0, 0x4c, 0x4c, 0x09, 0xcf, 0xf3, 0x0b, 0xad, 0xed, 0xf5, 0x17, 0x7b, 0x39, 0x25,
+	0x3c, 0x65, 0x5b, 0x9a, 0xfd, 0x32, 0x61, 0x2f, 0x05, 0x22, 0x5c, 0x11, 0x39, 0xb5, 0x80, 0x57,
+	0x26, 0x78, 0xa1, 0x34, 0x11, 0x1b, 0x4b, 0x9a, 0x47, 0x3f, 0x55, 0x68, 0x67, 0x3d, 0x1a, 0xc7,
+	0xce, 0x94, 0x7f, 0xa8, 0xf9, 0x8d, 0x1c, 0x7f, 0x39, 0x1b, 0x61, 0x28, 0x96, 0x8d, 0x02, 0x72,
+	0x50, 0xe1, 0x51, 0xd0, 0xa7, 0xdd, 0x48, 0x3d, 0x19, 0x51, 0x2b, 0x6e, 0x72, 0xfd, 0xf1, 0xa4,
+	0x5d, 0xc4, 0xb6, 0xe4, 0xa1, 0x3d, 0x18, 0xec, 0x7c, 0x62, 0x3c, 0xd8, 0x99, 0x54, 0x11, 0xd5,
+	0x28, 0xa6, 0xc4, 0xdd, 0x4d, 0x89, 0x6a, 0x6a, 0x1f, 0x27, 0xde, 0x4b, 0x44, 0xb8, 0xdc, 0xcb,
+	0xa4, 0x02, 0xfd, 0x34, 0x41, 0xf9, 0x7d, 0xb2, 0xb4, 0x54, 0xd7, 0xd0, 0x05, 0x85, 0x64, 0x99,
+	0xe8, 0xcd, 0x70, 0xb8, 0x86, 0xad, 0xb4, 0xee, 0x4e, 0x8c, 0xc4, 0xda, 0x09, 0x09, 0x50, 0xef,
+	0x35, 0xed, 0x62, 0x4b, 0x75, 0xd1, 0x5e, 0xdf, 0x85, 0x6e, 0xa0, 0xaa, 0x1b, 0x28, 0x67, 0x4f,
+	0x54, 0x20, 0x5c, 0xe2, 0xa9, 0x42, 0x9c, 0x17, 0xaf, 0xae, 0x9b, 0xc6, 0x9f, 0xeb, 0xa6, 0xe1,
+	0x76, 0x6e, 0xe6, 0x96, 0x79, 0x3b, 0xb7, 0xcc, 0xdf, 0x73, 0xcb, 0xfc, 0xbe, 0xb0, 0x8c, 0xdb,
+	0x85, 0x65, 0xfc, 0x5a, 0x58, 0xc6, 0xa7, 0x33, 0x3f, 0x90, 0x83, 0x71, 0xcf, 0xee, 0xb3, 0xd0,
+	0xf1, 0x23, 0x32, 0x09, 0xe4, 0xec, 0xd8, 0xa3, 0x13, 0x91, 0xdb, 0x9f, 0x5f, 0x72, 0x67, 0x39,
+	0xe3, 0x54, 0xf4, 0x0a, 0x6a, 0x5b, 0x9e, 0xfd, 0x1b, 0x00, 0x80, 0x61, 0xeb, 0x84, 0xe8, 0x05,
+	0x00, 0x00,
 }
 
 func (m *PoolRecord) Marshal() (dAtA []byte, err error) {
@@ -213,6 +243,48 @@ func (m *PoolRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BatchRecords) > 0 {
+		for iNdEx := len(m.BatchRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BatchRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.PriceRecords) > 0 {
+		for iNdEx := len(m.PriceRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.SwapRouteMsgStates) > 0 {
+		for iNdEx := len(m.SwapRouteMsgStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SwapRouteMsgStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.SwapMsgStates) > 0 {
 		for iNdEx := len(m.SwapMsgStates) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -376,6 +448,24 @@ func (m *PoolRecord) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.SwapRouteMsgStates) > 0 {
+		for _, e := range m.SwapRouteMsgStates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PriceRecords) > 0 {
+		for _, e := range m.PriceRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BatchRecords) > 0 {
+		for _, e := range m.BatchRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -632,6 +722,108 @@ func (m *PoolRecord) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapRouteMsgStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapRouteMsgStates = append(m.SwapRouteMsgStates, SwapRouteMsgState{})
+			if err := m.SwapRouteMsgStates[len(m.SwapRouteMsgStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceRecords = append(m.PriceRecords, PoolPriceRecord{})
+			if err := m.PriceRecords[len(m.PriceRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchRecords = append(m.BatchRecords, PoolBatchRecord{})
+			if err := m.BatchRecords[len(m.BatchRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
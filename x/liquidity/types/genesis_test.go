@@ -150,3 +150,28 @@ func TestPoolRecord_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestPoolRecord_ValidateBatchRecords(t *testing.T) {
+	poolRecord := types.PoolRecord{
+		Pool: types.Pool{Id: 1},
+		PoolBatch: types.PoolBatch{
+			PoolId:           1,
+			Index:            3,
+			DepositMsgIndex:  1,
+			WithdrawMsgIndex: 1,
+			SwapMsgIndex:     1,
+		},
+		BatchRecords: []types.PoolBatchRecord{{PoolId: 1, BatchIndex: 1}, {PoolId: 1, BatchIndex: 3}},
+	}
+	require.NoError(t, poolRecord.Validate())
+
+	for _, batchRecords := range [][]types.PoolBatchRecord{
+		{{PoolId: 2, BatchIndex: 1}},
+		{{PoolId: 1, BatchIndex: 4}},
+		{{PoolId: 1, BatchIndex: 3}, {PoolId: 1, BatchIndex: 1}},
+		{{PoolId: 1, BatchIndex: 1}, {PoolId: 1, BatchIndex: 1}},
+	} {
+		poolRecord.BatchRecords = batchRecords
+		require.ErrorIs(t, poolRecord.Validate(), types.ErrBadBatchRecord)
+	}
+}
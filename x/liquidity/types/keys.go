@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 )
@@ -26,14 +28,25 @@ var (
 	// param key for global Liquidity Pool IDs
 	GlobalLiquidityPoolIDKey = []byte("globalLiquidityPoolId")
 
-	PoolKeyPrefix                  = []byte{0x11}
-	PoolByReserveAccIndexKeyPrefix = []byte{0x12}
+	// ParamsKey is the key of the params of the liquidity module in the module store
+	ParamsKey = []byte{0x01}
+
+	PoolKeyPrefix                        = []byte{0x11}
+	PoolByReserveAccIndexKeyPrefix       = []byte{0x12}
+	PoolByReserveCoinDenomIndexKeyPrefix = []byte{0x13}
 
 	PoolBatchKeyPrefix = []byte{0x22}
 
-	PoolBatchDepositMsgStateIndexKeyPrefix  = []byte{0x31}
-	PoolBatchWithdrawMsgStateIndexKeyPrefix = []byte{0x32}
-	PoolBatchSwapMsgStateIndexKeyPrefix     = []byte{0x33}
+	PoolBatchDepositMsgStateIndexKeyPrefix   = []byte{0x31}
+	PoolBatchWithdrawMsgStateIndexKeyPrefix  = []byte{0x32}
+	PoolBatchSwapMsgStateIndexKeyPrefix      = []byte{0x33}
+	PoolBatchSwapRouteMsgStateIndexKeyPrefix = []byte{0x34}
+	BatchMsgStateByAddressIndexKeyPrefix     = []byte{0x35}
+
+	PoolPriceRecordKeyPrefix            = []byte{0x41}
+	PoolPriceRecordByTimeIndexKeyPrefix = []byte{0x42}
+
+	PoolBatchRecordKeyPrefix = []byte{0x51}
 )
 
 // GetPoolKey returns kv indexing key of the pool
@@ -49,6 +62,16 @@ func GetPoolByReserveAccIndexKey(reserveAcc sdk.AccAddress) []byte {
 	return append(PoolByReserveAccIndexKeyPrefix, address.MustLengthPrefix(reserveAcc.Bytes())...)
 }
 
+// GetPoolsByReserveCoinDenomIndexPrefix returns prefix of the pool ids indexed by the reserve coin denom for iteration
+func GetPoolsByReserveCoinDenomIndexPrefix(denom string) []byte {
+	return append(PoolByReserveCoinDenomIndexKeyPrefix, address.MustLengthPrefix([]byte(denom))...)
+}
+
+// GetPoolByReserveCoinDenomIndexKey returns kv indexing key of the pool id indexed by the reserve coin denom
+func GetPoolByReserveCoinDenomIndexKey(denom string, poolID uint64) []byte {
+	return append(GetPoolsByReserveCoinDenomIndexPrefix(denom), sdk.Uint64ToBigEndian(poolID)...)
+}
+
 // GetPoolBatchKey returns kv indexing key of the pool batch indexed by pool id
 func GetPoolBatchKey(poolID uint64) []byte {
 	key := make([]byte, 9)
@@ -107,3 +130,78 @@ func GetPoolBatchSwapMsgStateIndexKey(poolID, msgIndex uint64) []byte {
 	copy(key[9:17], sdk.Uint64ToBigEndian(msgIndex))
 	return key
 }
+
+// GetPoolBatchSwapRouteMsgStatesPrefix returns prefix of swap route message states in the pool's latest batch for iteration
+func GetPoolBatchSwapRouteMsgStatesPrefix(poolID uint64) []byte {
+	key := make([]byte, 9)
+	key[0] = PoolBatchSwapRouteMsgStateIndexKeyPrefix[0]
+	copy(key[1:9], sdk.Uint64ToBigEndian(poolID))
+	return key
+}
+
+// GetPoolBatchSwapRouteMsgStateIndexKey returns kv indexing key of the latest index value of the msg index
+func GetPoolBatchSwapRouteMsgStateIndexKey(poolID, msgIndex uint64) []byte {
+	key := make([]byte, 17)
+	key[0] = PoolBatchSwapRouteMsgStateIndexKeyPrefix[0]
+	copy(key[1:9], sdk.Uint64ToBigEndian(poolID))
+	copy(key[9:17], sdk.Uint64ToBigEndian(msgIndex))
+	return key
+}
+
+// GetBatchMsgStatesByAddressIndexPrefix returns prefix of the batch msg states of the address for iteration
+func GetBatchMsgStatesByAddressIndexPrefix(addr sdk.AccAddress) []byte {
+	return append(BatchMsgStateByAddressIndexKeyPrefix, address.MustLengthPrefix(addr.Bytes())...)
+}
+
+// GetBatchMsgStateByAddressIndexKey returns kv indexing key of the key of the batch msg state indexed by the address of
+// the depositor, withdrawer or swap requester of the msg
+func GetBatchMsgStateByAddressIndexKey(addr sdk.AccAddress, msgStateKey []byte) []byte {
+	return append(GetBatchMsgStatesByAddressIndexPrefix(addr), msgStateKey...)
+}
+
+// GetPoolPriceRecordsPrefix returns prefix of the price records of the pool for iteration
+func GetPoolPriceRecordsPrefix(poolID uint64) []byte {
+	key := make([]byte, 9)
+	key[0] = PoolPriceRecordKeyPrefix[0]
+	copy(key[1:9], sdk.Uint64ToBigEndian(poolID))
+	return key
+}
+
+// GetPoolPriceRecordKey returns kv indexing key of the price record of the pool at the height
+func GetPoolPriceRecordKey(poolID uint64, height int64) []byte {
+	key := make([]byte, 17)
+	key[0] = PoolPriceRecordKeyPrefix[0]
+	copy(key[1:9], sdk.Uint64ToBigEndian(poolID))
+	copy(key[9:17], sdk.Uint64ToBigEndian(uint64(height)))
+	return key
+}
+
+// GetPoolPriceRecordByTimeIndexPrefix returns prefix of the price record heights of the pool indexed by time for iteration
+func GetPoolPriceRecordByTimeIndexPrefix(poolID uint64) []byte {
+	key := make([]byte, 9)
+	key[0] = PoolPriceRecordByTimeIndexKeyPrefix[0]
+	copy(key[1:9], sdk.Uint64ToBigEndian(poolID))
+	return key
+}
+
+// GetPoolPriceRecordByTimeIndexKey returns kv indexing key of the price record height of the pool indexed by time
+func GetPoolPriceRecordByTimeIndexKey(poolID uint64, t time.Time) []byte {
+	return append(GetPoolPriceRecordByTimeIndexPrefix(poolID), sdk.FormatTimeBytes(t)...)
+}
+
+// GetPoolBatchRecordsPrefix returns prefix of the batch records of the pool for iteration
+func GetPoolBatchRecordsPrefix(poolID uint64) []byte {
+	key := make([]byte, 9)
+	key[0] = PoolBatchRecordKeyPrefix[0]
+	copy(key[1:9], sdk.Uint64ToBigEndian(poolID))
+	return key
+}
+
+// GetPoolBatchRecordKey returns kv indexing key of the record of the executed batch of the pool
+func GetPoolBatchRecordKey(poolID, batchIndex uint64) []byte {
+	key := make([]byte, 17)
+	key[0] = PoolBatchRecordKeyPrefix[0]
+	copy(key[1:9], sdk.Uint64ToBigEndian(poolID))
+	copy(key[9:17], sdk.Uint64ToBigEndian(batchIndex))
+	return key
+}
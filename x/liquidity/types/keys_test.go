@@ -2,7 +2,9 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/gravity-devs/liquidity/v2/x/liquidity/types"
@@ -32,6 +34,12 @@ func (s *keysTestSuite) TestGetLiquidityPoolByReserveAccIndexKey() {
 	s.Require().Equal([]byte{0x12, 0x20, 0x87, 0xec, 0x7d, 0x8f, 0xca, 0xee, 0xb0, 0xaa, 0x2, 0x1d, 0xc7, 0xd0, 0x69, 0xb, 0x1e, 0xb8, 0xfb, 0x3e, 0x8e, 0xb1, 0x22, 0x7f, 0x78, 0xae, 0x6c, 0x5e, 0x8a, 0x96, 0xc6, 0x7, 0xc4, 0x98}, types.GetPoolByReserveAccIndexKey(len32acc))
 }
 
+func (s *keysTestSuite) TestGetPoolByReserveCoinDenomIndexKey() {
+	s.Require().Equal([]byte{0x13, 0x5, 0x75, 0x61, 0x74, 0x6f, 0x6d}, types.GetPoolsByReserveCoinDenomIndexPrefix("uatom"))
+	s.Require().Equal([]byte{0x13, 0x5, 0x75, 0x61, 0x74, 0x6f, 0x6d, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa},
+		types.GetPoolByReserveCoinDenomIndexKey("uatom", 10))
+}
+
 func (s *keysTestSuite) TestGetLiquidityPoolBatchKey() {
 	s.Require().Equal([]byte{0x22, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa}, types.GetPoolBatchKey(10))
 	s.Require().Equal([]byte{0x22, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0}, types.GetPoolBatchKey(0))
@@ -72,3 +80,29 @@ func (s *keysTestSuite) TestGetLiquidityPoolBatchSwapMsgIndex() {
 	s.Require().Equal([]byte{0x33, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0},
 		types.GetPoolBatchSwapMsgStateIndexKey(0, 0))
 }
+
+func (s *keysTestSuite) TestGetPoolPriceRecordKey() {
+	s.Require().Equal([]byte{0x41, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa}, types.GetPoolPriceRecordsPrefix(10))
+	s.Require().Equal([]byte{0x41, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa},
+		types.GetPoolPriceRecordKey(10, 10))
+}
+
+func (s *keysTestSuite) TestGetPoolPriceRecordByTimeIndexKey() {
+	t := time.Date(2022, 9, 1, 0, 0, 0, 0, time.UTC)
+	s.Require().Equal([]byte{0x42, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa}, types.GetPoolPriceRecordByTimeIndexPrefix(10))
+	s.Require().Equal(append([]byte{0x42, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa}, []byte("2022-09-01T00:00:00.000000000")...),
+		types.GetPoolPriceRecordByTimeIndexKey(10, t))
+}
+
+func (s *keysTestSuite) TestGetPoolBatchRecordKey() {
+	s.Require().Equal([]byte{0x51, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa}, types.GetPoolBatchRecordsPrefix(10))
+	s.Require().Equal([]byte{0x51, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x5},
+		types.GetPoolBatchRecordKey(10, 5))
+}
+
+func (s *keysTestSuite) TestGetBatchMsgStateByAddressIndexKey() {
+	addr := sdk.AccAddress([]byte{0x1, 0x2, 0x3, 0x4})
+	s.Require().Equal([]byte{0x35, 0x4, 0x1, 0x2, 0x3, 0x4}, types.GetBatchMsgStatesByAddressIndexPrefix(addr))
+	s.Require().Equal([]byte{0x35, 0x4, 0x1, 0x2, 0x3, 0x4, 0x31, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0xa, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x5},
+		types.GetBatchMsgStateByAddressIndexKey(addr, types.GetPoolBatchDepositMsgStateIndexKey(10, 5)))
+}
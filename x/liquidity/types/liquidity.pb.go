@@ -9,16 +9,20 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -26,130 +30,41 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-// Structure for the pool type to distinguish the characteristics of the reserve pools.
-type PoolType struct {
-	// This is the id of the pool_type that is used as pool_type_id for pool creation.
-	// In this version, only pool-type-id 1 is supported.
-	// {"id":1,"name":"ConstantProductLiquidityPool","min_reserve_coin_num":2,"max_reserve_coin_num":2,"description":""}
-	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
-	// name of the pool type.
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
-	// minimum number of reserveCoins for LiquidityPoolType, only 2 reserve coins are supported.
-	MinReserveCoinNum uint32 `protobuf:"varint,3,opt,name=min_reserve_coin_num,json=minReserveCoinNum,proto3" json:"min_reserve_coin_num,omitempty" yaml:"min_reserve_coin_num"`
-	// maximum number of reserveCoins for LiquidityPoolType, only 2 reserve coins are supported.
-	MaxReserveCoinNum uint32 `protobuf:"varint,4,opt,name=max_reserve_coin_num,json=maxReserveCoinNum,proto3" json:"max_reserve_coin_num,omitempty" yaml:"max_reserve_coin_num"`
-	// description of the pool type.
-	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
-}
-
-func (m *PoolType) Reset()         { *m = PoolType{} }
-func (m *PoolType) String() string { return proto.CompactTextString(m) }
-func (*PoolType) ProtoMessage()    {}
-func (*PoolType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_714a3e326c5b7d34, []int{0}
-}
-func (m *PoolType) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *PoolType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_PoolType.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *PoolType) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PoolType.Merge(m, src)
-}
-func (m *PoolType) XXX_Size() int {
-	return m.Size()
-}
-func (m *PoolType) XXX_DiscardUnknown() {
-	xxx_messageInfo_PoolType.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_PoolType proto.InternalMessageInfo
-
-// Params defines the parameters for the liquidity module.
-type Params struct {
-	// list of available pool types
-	PoolTypes []PoolType `protobuf:"bytes,1,rep,name=pool_types,json=poolTypes,proto3" json:"pool_types" yaml:"pool_types"`
-	// Minimum number of coins to be deposited to the liquidity pool on pool creation.
-	MinInitDepositAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=min_init_deposit_amount,json=minInitDepositAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_init_deposit_amount" yaml:"min_init_deposit_amount"`
-	// Initial mint amount of pool coins upon pool creation.
-	InitPoolCoinMintAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=init_pool_coin_mint_amount,json=initPoolCoinMintAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"init_pool_coin_mint_amount" yaml:"init_pool_coin_mint_amount"`
-	// Limit the size of each liquidity pool to minimize risk. In development, set to 0 for no limit. In production, set a limit.
-	MaxReserveCoinAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=max_reserve_coin_amount,json=maxReserveCoinAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_reserve_coin_amount" yaml:"max_reserve_coin_amount"`
-	// Fee paid to create a Liquidity Pool. Set a fee to prevent spamming.
-	PoolCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=pool_creation_fee,json=poolCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool_creation_fee" yaml:"pool_creation_fee"`
-	// Swap fee rate for every executed swap.
-	SwapFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=swap_fee_rate,json=swapFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee_rate" yaml:"swap_fee_rate"`
-	// Reserve coin withdrawal with less proportion by withdrawFeeRate.
-	WithdrawFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=withdraw_fee_rate,json=withdrawFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"withdraw_fee_rate" yaml:"withdraw_fee_rate"`
-	// Maximum ratio of reserve coins that can be ordered at a swap order.
-	MaxOrderAmountRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=max_order_amount_ratio,json=maxOrderAmountRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_order_amount_ratio" yaml:"max_order_amount_ratio"`
-	// The smallest unit batch height for every liquidity pool.
-	UnitBatchHeight uint32 `protobuf:"varint,9,opt,name=unit_batch_height,json=unitBatchHeight,proto3" json:"unit_batch_height,omitempty" yaml:"unit_batch_height"`
-	// Circuit breaker enables or disables transaction messages in liquidity module.
-	CircuitBreakerEnabled bool `protobuf:"varint,10,opt,name=circuit_breaker_enabled,json=circuitBreakerEnabled,proto3" json:"circuit_breaker_enabled,omitempty" yaml:"circuit_breaker_enabled"`
-}
-
-func (m *Params) Reset()      { *m = Params{} }
-func (*Params) ProtoMessage() {}
-func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_714a3e326c5b7d34, []int{1}
-}
-func (m *Params) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *Params) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Params.Merge(m, src)
-}
-func (m *Params) XXX_Size() int {
-	return m.Size()
-}
-func (m *Params) XXX_DiscardUnknown() {
-	xxx_messageInfo_Params.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_Params proto.InternalMessageInfo
-
 // Pool defines the liquidity pool that contains pool information.
 type Pool struct {
 	// id of the pool
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id" yaml:"id"`
 	// id of the pool_type
 	TypeId uint32 `protobuf:"varint,2,opt,name=type_id,json=typeId,proto3" json:"type_id,omitempty" yaml:"type_id"`
-	// denoms of reserve coin pair of the pool
+	// denoms of reserve coins of the pool, sorted alphabetically
 	ReserveCoinDenoms []string `protobuf:"bytes,3,rep,name=reserve_coin_denoms,json=reserveCoinDenoms,proto3" json:"reserve_coin_denoms,omitempty" yaml:"reserve_coin_denoms"`
 	// reserve account address of the pool
 	ReserveAccountAddress string `protobuf:"bytes,4,opt,name=reserve_account_address,json=reserveAccountAddress,proto3" json:"reserve_account_address,omitempty" yaml:"reserve_account_address"`
 	// denom of pool coin of the pool
 	PoolCoinDenom string `protobuf:"bytes,5,opt,name=pool_coin_denom,json=poolCoinDenom,proto3" json:"pool_coin_denom,omitempty" yaml:"pool_coin_denom"`
+	// weights of reserve coins of the pool in the order of reserve_coin_denoms, summing up to 100.
+	// empty means the reserve coins are equally weighted.
+	ReserveCoinWeights []uint32 `protobuf:"varint,6,rep,packed,name=reserve_coin_weights,json=reserveCoinWeights,proto3" json:"reserve_coin_weights,omitempty" yaml:"reserve_coin_weights"`
+	// swap fee rate of the pool chosen from the swap fee tiers of the params at pool creation.
+	// zero means the swap fee rate of the params is applied to the pool.
+	SwapFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=swap_fee_rate,json=swapFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee_rate" yaml:"swap_fee_rate"`
+	// status of the pool which restricts the msgs of the pool, set by the governance or a guardian
+	Status PoolStatus `protobuf:"varint,8,opt,name=status,proto3,enum=tendermint.liquidity.v1beta1.PoolStatus" json:"status,omitempty" yaml:"status"`
+	// height of the batch execution at which the pool was found depleted without pool coin supply, zero if the pool
+	// is not depleted
+	DepletedHeight int64 `protobuf:"varint,9,opt,name=depleted_height,json=depletedHeight,proto3" json:"depleted_height,omitempty" yaml:"depleted_height"`
+	// number of blocks in a batch of the pool chosen at pool creation, zero for the unit batch height of the params
+	BatchInterval uint32 `protobuf:"varint,10,opt,name=batch_interval,json=batchInterval,proto3" json:"batch_interval,omitempty" yaml:"batch_interval"`
+	// amplification coefficient of the StableSwap pool fixed from the params at pool creation, so that the
+	// governance change of the param does not reprice the existing pools. zero for the other pool types.
+	StableSwapAmplification uint32 `protobuf:"varint,11,opt,name=stable_swap_amplification,json=stableSwapAmplification,proto3" json:"stable_swap_amplification,omitempty" yaml:"stable_swap_amplification"`
 }
 
 func (m *Pool) Reset()         { *m = Pool{} }
 func (m *Pool) String() string { return proto.CompactTextString(m) }
 func (*Pool) ProtoMessage()    {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_714a3e326c5b7d34, []int{2}
+	return fileDescriptor_714a3e326c5b7d34, []int{0}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -192,7 +107,7 @@ func (m *PoolMetadata) Reset()         { *m = PoolMetadata{} }
 func (m *PoolMetadata) String() string { return proto.CompactTextString(m) }
 func (*PoolMetadata) ProtoMessage()    {}
 func (*PoolMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_714a3e326c5b7d34, []int{3}
+	return fileDescriptor_714a3e326c5b7d34, []int{1}
 }
 func (m *PoolMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -238,13 +153,15 @@ type PoolBatch struct {
 	SwapMsgIndex uint64 `protobuf:"varint,6,opt,name=swap_msg_index,json=swapMsgIndex,proto3" json:"swap_msg_index,omitempty" yaml:"swap_msg_index"`
 	// true if executed, false if not executed
 	Executed bool `protobuf:"varint,7,opt,name=executed,proto3" json:"executed,omitempty" yaml:"executed"`
+	// last index of SwapRouteMsgStates
+	SwapRouteMsgIndex uint64 `protobuf:"varint,8,opt,name=swap_route_msg_index,json=swapRouteMsgIndex,proto3" json:"swap_route_msg_index,omitempty" yaml:"swap_route_msg_index"`
 }
 
 func (m *PoolBatch) Reset()         { *m = PoolBatch{} }
 func (m *PoolBatch) String() string { return proto.CompactTextString(m) }
 func (*PoolBatch) ProtoMessage()    {}
 func (*PoolBatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_714a3e326c5b7d34, []int{4}
+	return fileDescriptor_714a3e326c5b7d34, []int{2}
 }
 func (m *PoolBatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -287,13 +204,15 @@ type DepositMsgState struct {
 	ToBeDeleted bool `protobuf:"varint,5,opt,name=to_be_deleted,json=toBeDeleted,proto3" json:"to_be_deleted,omitempty" yaml:"to_be_deleted"`
 	// MsgDepositWithinBatch
 	Msg *MsgDepositWithinBatch `protobuf:"bytes,6,opt,name=msg,proto3" json:"msg,omitempty" yaml:"msg"`
+	// index of the swap message of the single asset deposit in this liquidity pool, zero if the deposit is not a single asset deposit
+	SwapMsgIndex uint64 `protobuf:"varint,7,opt,name=swap_msg_index,json=swapMsgIndex,proto3" json:"swap_msg_index,omitempty" yaml:"swap_msg_index"`
 }
 
 func (m *DepositMsgState) Reset()         { *m = DepositMsgState{} }
 func (m *DepositMsgState) String() string { return proto.CompactTextString(m) }
 func (*DepositMsgState) ProtoMessage()    {}
 func (*DepositMsgState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_714a3e326c5b7d34, []int{5}
+	return fileDescriptor_714a3e326c5b7d34, []int{3}
 }
 func (m *DepositMsgState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -336,13 +255,17 @@ type WithdrawMsgState struct {
 	ToBeDeleted bool `protobuf:"varint,5,opt,name=to_be_deleted,json=toBeDeleted,proto3" json:"to_be_deleted,omitempty" yaml:"to_be_deleted"`
 	// MsgWithdrawWithinBatch
 	Msg *MsgWithdrawWithinBatch `protobuf:"bytes,6,opt,name=msg,proto3" json:"msg,omitempty" yaml:"msg"`
+	// index of the swap message to the target denom of the withdrawal in this liquidity pool, zero if the withdrawal has no target denom
+	SwapMsgIndex uint64 `protobuf:"varint,7,opt,name=swap_msg_index,json=swapMsgIndex,proto3" json:"swap_msg_index,omitempty" yaml:"swap_msg_index"`
+	// coin of the target denom withdrawn to the withdrawer, including the demand coin exchanged by the swap message
+	TargetCoin types.Coin `protobuf:"bytes,8,opt,name=target_coin,json=targetCoin,proto3" json:"target_coin" yaml:"target_coin"`
 }
 
 func (m *WithdrawMsgState) Reset()         { *m = WithdrawMsgState{} }
 func (m *WithdrawMsgState) String() string { return proto.CompactTextString(m) }
 func (*WithdrawMsgState) ProtoMessage()    {}
 func (*WithdrawMsgState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_714a3e326c5b7d34, []int{6}
+	return fileDescriptor_714a3e326c5b7d34, []int{4}
 }
 func (m *WithdrawMsgState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -393,13 +316,18 @@ type SwapMsgState struct {
 	ReservedOfferCoinFee types.Coin `protobuf:"bytes,9,opt,name=reserved_offer_coin_fee,json=reservedOfferCoinFee,proto3" json:"reserved_offer_coin_fee" yaml:"reserved_offer_coin_fee"`
 	// MsgSwapWithinBatch
 	Msg *MsgSwapWithinBatch `protobuf:"bytes,10,opt,name=msg,proto3" json:"msg,omitempty" yaml:"msg"`
+	// demand coin exchanged until now, excluding the exchanged coin fee
+	ExchangedDemandCoin types.Coin `protobuf:"bytes,11,opt,name=exchanged_demand_coin,json=exchangedDemandCoin,proto3" json:"exchanged_demand_coin" yaml:"exchanged_demand_coin"`
+	// true if the swap message is made by the module for another batch message such as a single asset deposit,
+	// the exchanged demand coin is kept in the escrow instead of being sent to the swap requester
+	Internal bool `protobuf:"varint,12,opt,name=internal,proto3" json:"internal,omitempty" yaml:"internal"`
 }
 
 func (m *SwapMsgState) Reset()         { *m = SwapMsgState{} }
 func (m *SwapMsgState) String() string { return proto.CompactTextString(m) }
 func (*SwapMsgState) ProtoMessage()    {}
 func (*SwapMsgState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_714a3e326c5b7d34, []int{7}
+	return fileDescriptor_714a3e326c5b7d34, []int{5}
 }
 func (m *SwapMsgState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -428,15 +356,180 @@ func (m *SwapMsgState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SwapMsgState proto.InternalMessageInfo
 
+// SwapRouteMsgState defines the state of the swap route message that contains state information as it is processed
+// in the batch of the first pool of the route.
+type SwapRouteMsgState struct {
+	// height where this message is appended to the batch
+	MsgHeight int64 `protobuf:"varint,1,opt,name=msg_height,json=msgHeight,proto3" json:"msg_height,omitempty" yaml:"msg_height"`
+	// index of this swap route message in the first pool of the route
+	MsgIndex uint64 `protobuf:"varint,2,opt,name=msg_index,json=msgIndex,proto3" json:"msg_index,omitempty" yaml:"msg_index"`
+	// true if executed on this batch, false if not executed
+	Executed bool `protobuf:"varint,3,opt,name=executed,proto3" json:"executed,omitempty" yaml:"executed"`
+	// true if executed successfully on this batch, false if failed
+	Succeeded bool `protobuf:"varint,4,opt,name=succeeded,proto3" json:"succeeded,omitempty" yaml:"succeeded"`
+	// true if ready to be deleted on kvstore, false if not ready to be deleted
+	ToBeDeleted bool `protobuf:"varint,5,opt,name=to_be_deleted,json=toBeDeleted,proto3" json:"to_be_deleted,omitempty" yaml:"to_be_deleted"`
+	// MsgSwapRoute
+	Msg *MsgSwapRoute `protobuf:"bytes,6,opt,name=msg,proto3" json:"msg,omitempty" yaml:"msg"`
+	// demand coin exchanged by the last swap of the route, excluding the exchanged coin fee
+	ExchangedDemandCoin types.Coin `protobuf:"bytes,7,opt,name=exchanged_demand_coin,json=exchangedDemandCoin,proto3" json:"exchanged_demand_coin" yaml:"exchanged_demand_coin"`
+}
+
+func (m *SwapRouteMsgState) Reset()         { *m = SwapRouteMsgState{} }
+func (m *SwapRouteMsgState) String() string { return proto.CompactTextString(m) }
+func (*SwapRouteMsgState) ProtoMessage()    {}
+func (*SwapRouteMsgState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_714a3e326c5b7d34, []int{6}
+}
+func (m *SwapRouteMsgState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapRouteMsgState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapRouteMsgState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapRouteMsgState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapRouteMsgState.Merge(m, src)
+}
+func (m *SwapRouteMsgState) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapRouteMsgState) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapRouteMsgState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapRouteMsgState proto.InternalMessageInfo
+
+// PoolPriceRecord defines the price of the pool recorded at a batch execution height and the price accumulator,
+// the sum of the prices weighted by the seconds each price lasted since the first record of the pool.
+type PoolPriceRecord struct {
+	// id of the pool
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// block height of the batch execution
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+	// block time of the batch execution
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time" yaml:"time"`
+	// price of the pool after the batch execution, the ratio of the reserve coins X/Y
+	Price github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=price,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price" yaml:"price"`
+	// sum of the previous prices of the pool multiplied by the seconds each price lasted
+	CumulativePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=cumulative_price,json=cumulativePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cumulative_price" yaml:"cumulative_price"`
+}
+
+func (m *PoolPriceRecord) Reset()         { *m = PoolPriceRecord{} }
+func (m *PoolPriceRecord) String() string { return proto.CompactTextString(m) }
+func (*PoolPriceRecord) ProtoMessage()    {}
+func (*PoolPriceRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_714a3e326c5b7d34, []int{7}
+}
+func (m *PoolPriceRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolPriceRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolPriceRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolPriceRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolPriceRecord.Merge(m, src)
+}
+func (m *PoolPriceRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolPriceRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolPriceRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolPriceRecord proto.InternalMessageInfo
+
+// PoolBatchRecord defines the result of an executed batch of the pool, recorded at the batch execution height and kept
+// for the BatchRecordLifespan param after the executed msg states of the batch are deleted.
+type PoolBatchRecord struct {
+	// id of the pool
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// index of the executed batch
+	BatchIndex uint64 `protobuf:"varint,2,opt,name=batch_index,json=batchIndex,proto3" json:"batch_index,omitempty" yaml:"batch_index"`
+	// block height of the batch execution
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty" yaml:"height"`
+	// reserve coins of the pool before the batch execution
+	ReserveCoinsBefore github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=reserve_coins_before,json=reserveCoinsBefore,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reserve_coins_before" yaml:"reserve_coins_before"`
+	// reserve coins of the pool after the batch execution
+	ReserveCoinsAfter github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=reserve_coins_after,json=reserveCoinsAfter,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reserve_coins_after" yaml:"reserve_coins_after"`
+	// total coins accepted by the succeeded deposits of the batch
+	DepositedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=deposited_coins,json=depositedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposited_coins" yaml:"deposited_coins"`
+	// total coins paid out by the succeeded withdrawals of the batch
+	WithdrawnCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=withdrawn_coins,json=withdrawnCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"withdrawn_coins" yaml:"withdrawn_coins"`
+	// total pool coin minted by the succeeded deposits of the batch
+	MintedPoolCoin types.Coin `protobuf:"bytes,8,opt,name=minted_pool_coin,json=mintedPoolCoin,proto3" json:"minted_pool_coin" yaml:"minted_pool_coin"`
+	// total pool coin burned by the succeeded withdrawals of the batch
+	BurnedPoolCoin types.Coin `protobuf:"bytes,9,opt,name=burned_pool_coin,json=burnedPoolCoin,proto3" json:"burned_pool_coin" yaml:"burned_pool_coin"`
+	// total offer coin fees and exchanged coin fees collected by the pool from the swaps transacted in the batch
+	SwapFeeCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=swap_fee_coins,json=swapFeeCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"swap_fee_coins" yaml:"swap_fee_coins"`
+	// total withdraw fee coins left in the pool by the succeeded withdrawals of the batch
+	WithdrawFeeCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,11,rep,name=withdraw_fee_coins,json=withdrawFeeCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"withdraw_fee_coins" yaml:"withdraw_fee_coins"`
+	// number of the msgs which succeeded in the batch, including the swap msgs transacted in the batch
+	SucceededMsgCount uint64 `protobuf:"varint,12,opt,name=succeeded_msg_count,json=succeededMsgCount,proto3" json:"succeeded_msg_count,omitempty" yaml:"succeeded_msg_count"`
+	// number of the msgs which failed and were refunded in the batch, including the swap msgs expired without any match
+	FailedMsgCount uint64 `protobuf:"varint,13,opt,name=failed_msg_count,json=failedMsgCount,proto3" json:"failed_msg_count,omitempty" yaml:"failed_msg_count"`
+}
+
+func (m *PoolBatchRecord) Reset()         { *m = PoolBatchRecord{} }
+func (m *PoolBatchRecord) String() string { return proto.CompactTextString(m) }
+func (*PoolBatchRecord) ProtoMessage()    {}
+func (*PoolBatchRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_714a3e326c5b7d34, []int{8}
+}
+func (m *PoolBatchRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolBatchRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolBatchRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolBatchRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolBatchRecord.Merge(m, src)
+}
+func (m *PoolBatchRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolBatchRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolBatchRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolBatchRecord proto.InternalMessageInfo
+
 func init() {
-	proto.RegisterType((*PoolType)(nil), "tendermint.liquidity.v1beta1.PoolType")
-	proto.RegisterType((*Params)(nil), "tendermint.liquidity.v1beta1.Params")
 	proto.RegisterType((*Pool)(nil), "tendermint.liquidity.v1beta1.Pool")
 	proto.RegisterType((*PoolMetadata)(nil), "tendermint.liquidity.v1beta1.PoolMetadata")
 	proto.RegisterType((*PoolBatch)(nil), "tendermint.liquidity.v1beta1.PoolBatch")
 	proto.RegisterType((*DepositMsgState)(nil), "tendermint.liquidity.v1beta1.DepositMsgState")
 	proto.RegisterType((*WithdrawMsgState)(nil), "tendermint.liquidity.v1beta1.WithdrawMsgState")
 	proto.RegisterType((*SwapMsgState)(nil), "tendermint.liquidity.v1beta1.SwapMsgState")
+	proto.RegisterType((*SwapRouteMsgState)(nil), "tendermint.liquidity.v1beta1.SwapRouteMsgState")
+	proto.RegisterType((*PoolPriceRecord)(nil), "tendermint.liquidity.v1beta1.PoolPriceRecord")
+	proto.RegisterType((*PoolBatchRecord)(nil), "tendermint.liquidity.v1beta1.PoolBatchRecord")
 }
 
 func init() {
@@ -444,139 +537,167 @@ func init() {
 }
 
 var fileDescriptor_714a3e326c5b7d34 = []byte{
-	// 1950 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcd, 0x6f, 0xdb, 0xc8,
-	0x15, 0x37, 0x25, 0x59, 0x96, 0xc6, 0x5f, 0x31, 0xed, 0x38, 0x4a, 0xb2, 0x91, 0x94, 0x69, 0xb3,
-	0x35, 0xb6, 0xb1, 0x2c, 0x4b, 0xb2, 0x63, 0xbb, 0xbd, 0x90, 0xfe, 0xd8, 0xb5, 0x50, 0x77, 0x53,
-	0x26, 0xed, 0x36, 0x9b, 0x5d, 0x68, 0x29, 0x72, 0x24, 0xb1, 0x16, 0x49, 0x85, 0x1c, 0xd9, 0x72,
-	0x8b, 0x05, 0x7a, 0xdc, 0x43, 0x0b, 0x14, 0x42, 0x0f, 0x45, 0x0b, 0xb4, 0x0b, 0x03, 0xc5, 0x02,
-	0x2d, 0xf6, 0xd4, 0xf6, 0x0f, 0xe8, 0x2d, 0xc7, 0x1c, 0x8b, 0x1e, 0xd4, 0x36, 0xb9, 0x14, 0x45,
-	0xd1, 0x83, 0xfe, 0x82, 0x62, 0x3e, 0x28, 0xd2, 0x36, 0x6d, 0x6d, 0x00, 0x1d, 0xe3, 0x8b, 0xa9,
-	0x37, 0xef, 0xe3, 0xf7, 0xde, 0xfb, 0xcd, 0x9b, 0x21, 0xc1, 0x7d, 0x8c, 0x2c, 0x1d, 0x39, 0xa6,
-	0x61, 0xe1, 0x95, 0xa6, 0xf1, 0xac, 0x6d, 0xe8, 0x06, 0x3e, 0x59, 0x39, 0x5a, 0xad, 0x22, 0xac,
-	0xae, 0xfa, 0x92, 0x5c, 0xcb, 0xb1, 0xb1, 0x2d, 0xbe, 0xe5, 0x6b, 0xe7, 0xfc, 0x35, 0xae, 0x7d,
-	0xeb, 0xde, 0x95, 0xbe, 0x70, 0x87, 0x39, 0xb9, 0xb5, 0x50, 0xb7, 0xeb, 0x36, 0x7d, 0x5c, 0x21,
-	0x4f, 0x5c, 0x7a, 0x43, 0xb3, 0x5d, 0xd3, 0x76, 0x2b, 0x6c, 0x41, 0xb3, 0x0d, 0x8b, 0x2f, 0xb0,
-	0x7f, 0xda, 0x72, 0x1d, 0x59, 0xcb, 0x76, 0x0b, 0x59, 0x6a, 0xcb, 0x38, 0x2a, 0xac, 0xd8, 0x2d,
-	0x6c, 0xd8, 0x96, 0xbb, 0xa2, 0x5a, 0x96, 0x8d, 0x55, 0xfa, 0xcc, 0x14, 0xe1, 0x67, 0x51, 0x90,
-	0x78, 0x68, 0xdb, 0xcd, 0xc7, 0x27, 0x2d, 0x24, 0xe6, 0x40, 0xc4, 0xd0, 0x53, 0x42, 0x56, 0x58,
-	0x9a, 0x96, 0xd3, 0x5d, 0x69, 0xa6, 0x1c, 0x85, 0xab, 0xf0, 0x34, 0x12, 0x6f, 0x1b, 0x16, 0x2e,
-	0x16, 0xfa, 0xbd, 0x4c, 0xf2, 0x44, 0x35, 0x9b, 0x5b, 0xd0, 0xd0, 0xa1, 0x12, 0x31, 0x74, 0x71,
-	0x0f, 0xc4, 0x2c, 0xd5, 0x44, 0xa9, 0x48, 0x56, 0x58, 0x4a, 0xca, 0x85, 0xae, 0x94, 0x2d, 0xa7,
-	0xe1, 0xb6, 0x6d, 0xb9, 0x58, 0xb5, 0xf0, 0x43, 0xc7, 0xd6, 0xdb, 0x1a, 0xfe, 0x8e, 0x97, 0x1a,
-	0x89, 0x02, 0xfb, 0xbd, 0xcc, 0x24, 0xf3, 0x41, 0x0c, 0xa1, 0x42, 0xed, 0x45, 0x15, 0x2c, 0x98,
-	0x86, 0x55, 0x71, 0x90, 0x8b, 0x9c, 0x23, 0x54, 0x21, 0xe9, 0x54, 0xac, 0xb6, 0x99, 0x8a, 0x52,
-	0x24, 0x79, 0x86, 0xa4, 0x70, 0x06, 0xc9, 0x6d, 0xe6, 0x25, 0xcc, 0x0c, 0x2a, 0x73, 0xa6, 0x61,
-	0x29, 0x4c, 0xba, 0x6d, 0x1b, 0xd6, 0x77, 0xdb, 0x26, 0x0d, 0xa1, 0x76, 0x2e, 0x86, 0x88, 0x0d,
-	0x0f, 0x11, 0x62, 0x46, 0x42, 0xa8, 0x9d, 0x73, 0x21, 0x36, 0xc0, 0xa4, 0x8e, 0x5c, 0xcd, 0x31,
-	0x68, 0xb1, 0x53, 0xe3, 0xb4, 0x28, 0x8b, 0xfd, 0x5e, 0x46, 0x64, 0x8e, 0x02, 0x8b, 0x50, 0x09,
-	0xaa, 0x6e, 0xc5, 0xfe, 0xfd, 0x79, 0x46, 0x80, 0x7f, 0x9e, 0x04, 0xf1, 0x87, 0xaa, 0xa3, 0x9a,
-	0xae, 0xf8, 0x09, 0x00, 0x2d, 0xdb, 0x6e, 0x56, 0xf0, 0x49, 0x0b, 0xb9, 0x29, 0x21, 0x1b, 0x5d,
-	0x9a, 0x2c, 0xbc, 0x9d, 0xbb, 0x8a, 0x4e, 0x39, 0xaf, 0x89, 0xf2, 0xcd, 0xe7, 0xbd, 0xcc, 0x58,
-	0xbf, 0x97, 0x99, 0x63, 0x51, 0x7d, 0x3f, 0x50, 0x49, 0xb6, 0xb8, 0x92, 0x2b, 0xfe, 0x4e, 0x00,
-	0x37, 0x48, 0xf1, 0x0c, 0xcb, 0xc0, 0x15, 0x1d, 0xb5, 0x6c, 0xd7, 0xc0, 0x15, 0xd5, 0xb4, 0xdb,
-	0x16, 0xe6, 0xed, 0x6c, 0x74, 0xa5, 0xeb, 0xe5, 0x24, 0x5c, 0xcd, 0xd3, 0x3f, 0x78, 0x1a, 0x99,
-	0x70, 0xf5, 0xc3, 0xdc, 0xbe, 0x85, 0x89, 0xff, 0xbf, 0xf7, 0x32, 0x6f, 0xd7, 0x0d, 0xdc, 0x68,
-	0x57, 0x73, 0x9a, 0x6d, 0xae, 0x30, 0x36, 0xf2, 0x7f, 0xcb, 0xae, 0x7e, 0xb8, 0x42, 0x23, 0x12,
-	0xed, 0x7e, 0x2f, 0x93, 0xf6, 0x7b, 0x15, 0x12, 0x0e, 0x2a, 0xa4, 0xf9, 0xfb, 0x96, 0x81, 0x77,
-	0x98, 0x5c, 0xa2, 0x62, 0xf1, 0x0b, 0x01, 0xdc, 0xa2, 0xea, 0x34, 0x03, 0x5a, 0x79, 0x92, 0xba,
-	0x07, 0x32, 0x4a, 0x41, 0x1e, 0x8e, 0x0c, 0xe4, 0x5d, 0x4e, 0xed, 0x4b, 0x23, 0x42, 0x65, 0x91,
-	0x2c, 0x92, 0x3a, 0x93, 0x8e, 0x1f, 0x18, 0x96, 0x87, 0xf4, 0xf7, 0xa4, 0x96, 0xe7, 0x59, 0xc2,
-	0x61, 0xc6, 0x28, 0x4c, 0xab, 0x2b, 0xdd, 0x2e, 0xcf, 0x7a, 0x30, 0x47, 0x57, 0xd1, 0xf0, 0xa0,
-	0xa4, 0xa2, 0x67, 0xd8, 0xc9, 0x71, 0xbe, 0x10, 0xc0, 0x1c, 0x4b, 0xcd, 0x41, 0x74, 0x08, 0x54,
-	0x6a, 0x08, 0xa5, 0xc6, 0x29, 0xbb, 0x6e, 0xe6, 0x58, 0xa8, 0x5c, 0x55, 0x75, 0xd1, 0x80, 0x54,
-	0xc4, 0x58, 0xfe, 0x4c, 0xe8, 0x4a, 0x9b, 0xe5, 0x6f, 0x3e, 0xfd, 0x09, 0xd4, 0x91, 0x65, 0x9b,
-	0x70, 0x2b, 0x0b, 0xdb, 0x2a, 0xb6, 0x4d, 0x78, 0x3f, 0x0b, 0x79, 0xc0, 0xad, 0xac, 0x9f, 0x1b,
-	0xfc, 0xf4, 0xe3, 0xd3, 0x48, 0x92, 0x64, 0x46, 0xac, 0x5d, 0xce, 0xc6, 0x54, 0x80, 0x8d, 0xc1,
-	0xf0, 0xf0, 0x0f, 0xff, 0xc8, 0x2c, 0x7d, 0x85, 0xbc, 0xa9, 0x2f, 0x65, 0x96, 0xd8, 0x6f, 0x73,
-	0xf3, 0x3d, 0x84, 0xc4, 0x9f, 0x0a, 0x60, 0xda, 0x3d, 0x56, 0x5b, 0xc4, 0x55, 0xc5, 0x51, 0x31,
-	0x4a, 0xc5, 0x69, 0xc1, 0x3f, 0xea, 0x4a, 0xf3, 0xe5, 0x09, 0x98, 0xcf, 0xe5, 0xf3, 0x45, 0xaf,
-	0xd0, 0x3b, 0x48, 0x7b, 0x8d, 0x42, 0xef, 0x20, 0xad, 0xdf, 0xcb, 0x2c, 0x30, 0xd8, 0x67, 0x42,
-	0x40, 0x65, 0x92, 0xfc, 0xde, 0x43, 0x48, 0x51, 0x31, 0x12, 0x7f, 0x26, 0x80, 0xb9, 0x63, 0x03,
-	0x37, 0x74, 0x47, 0x3d, 0xf6, 0x61, 0x4c, 0x50, 0x18, 0x9f, 0x8c, 0x08, 0x06, 0xaf, 0xde, 0x85,
-	0x30, 0x50, 0x99, 0xf5, 0x64, 0x1e, 0x9c, 0x5f, 0x0b, 0x60, 0x91, 0xf0, 0xc2, 0x76, 0x74, 0xe4,
-	0x70, 0x42, 0x10, 0x5d, 0xc3, 0x4e, 0x25, 0x28, 0x26, 0x34, 0x22, 0x4c, 0x77, 0x7c, 0x0e, 0x5e,
-	0x8c, 0x05, 0x95, 0x79, 0x53, 0xed, 0xbc, 0x4f, 0xe4, 0x8c, 0x7c, 0x0a, 0x91, 0x8a, 0x4f, 0xc0,
-	0x5c, 0x9b, 0x6c, 0xb0, 0xaa, 0x8a, 0xb5, 0x46, 0xa5, 0x81, 0x8c, 0x7a, 0x03, 0xa7, 0x92, 0x74,
-	0x04, 0x2f, 0x87, 0x9d, 0x37, 0x3c, 0xef, 0x0b, 0x36, 0x50, 0x99, 0x25, 0x32, 0x99, 0x88, 0xde,
-	0xa3, 0x12, 0xd1, 0x04, 0x37, 0x34, 0xc3, 0xd1, 0xda, 0x44, 0xd3, 0x41, 0xea, 0x21, 0x72, 0x2a,
-	0xc8, 0x52, 0xab, 0x4d, 0xa4, 0xa7, 0x40, 0x56, 0x58, 0x4a, 0xc8, 0x6b, 0x5d, 0xe9, 0x5a, 0x79,
-	0x02, 0xd6, 0xd4, 0xa6, 0x8b, 0xe0, 0x69, 0x24, 0x56, 0xb5, 0xed, 0xa6, 0xbf, 0x95, 0x2e, 0xb1,
-	0x85, 0xca, 0x75, 0xbe, 0x22, 0xb3, 0x85, 0x5d, 0x26, 0xdf, 0x4a, 0xfc, 0xea, 0xf3, 0xcc, 0x18,
-	0x1d, 0xdb, 0xbf, 0x8d, 0x81, 0x18, 0x19, 0x0a, 0x62, 0x69, 0x70, 0x7a, 0xc6, 0xe4, 0xaf, 0x9f,
-	0xcb, 0x66, 0xbd, 0xf4, 0x9f, 0x5e, 0x26, 0x62, 0xe8, 0x17, 0xcf, 0xd0, 0x6f, 0x83, 0x09, 0x52,
-	0xd5, 0x8a, 0xa1, 0xd3, 0xb9, 0x3b, 0x2d, 0x7f, 0x2d, 0xac, 0x10, 0x33, 0xcc, 0x88, 0x6b, 0x42,
-	0x25, 0x4e, 0x9e, 0xf6, 0x75, 0xb1, 0x06, 0xe6, 0xcf, 0x0c, 0x00, 0xba, 0x43, 0xdd, 0x54, 0x34,
-	0x1b, 0x5d, 0x4a, 0xca, 0xeb, 0x64, 0x38, 0xce, 0x3f, 0x65, 0xdb, 0xf6, 0x87, 0xf0, 0x3e, 0x7b,
-	0x78, 0x02, 0x3f, 0xee, 0xf7, 0x32, 0xb7, 0x98, 0xc3, 0x10, 0x63, 0xa8, 0xcc, 0x39, 0xfe, 0xe8,
-	0xd8, 0xa1, 0x32, 0x7a, 0x5c, 0x78, 0xba, 0xaa, 0xa6, 0xd1, 0x46, 0xab, 0xba, 0xee, 0x20, 0xd7,
-	0xe5, 0x23, 0xae, 0xde, 0x95, 0xe4, 0xf2, 0x0a, 0x64, 0x64, 0x59, 0x5d, 0xd7, 0xf5, 0x67, 0xc8,
-	0xc5, 0xc7, 0xed, 0xc3, 0xa3, 0xfc, 0x8f, 0x7e, 0xac, 0x9d, 0xd4, 0xac, 0x62, 0x4d, 0xaf, 0x3d,
-	0xdb, 0x6c, 0x14, 0x8e, 0x1d, 0x77, 0xa3, 0xa8, 0x39, 0x25, 0xa7, 0x66, 0x12, 0xfa, 0xcd, 0x10,
-	0xfa, 0x49, 0x9a, 0x26, 0x31, 0x67, 0x7e, 0x43, 0x2e, 0x89, 0x06, 0x95, 0xeb, 0x7c, 0x45, 0x62,
-	0x0b, 0xdc, 0x50, 0xfc, 0xb9, 0x00, 0x66, 0xfd, 0xb9, 0x4d, 0x53, 0xe1, 0x47, 0x30, 0xea, 0x4a,
-	0xef, 0x95, 0xf7, 0xe8, 0xe8, 0xd9, 0x29, 0xae, 0x49, 0xf9, 0xed, 0xed, 0xd5, 0xf5, 0xdd, 0xdd,
-	0xb5, 0xcd, 0x8d, 0xbd, 0xcd, 0xbc, 0x9c, 0x2f, 0x95, 0xb6, 0x77, 0x0b, 0x9b, 0xeb, 0x52, 0x29,
-	0xbf, 0x26, 0x4b, 0x9b, 0xdb, 0xc5, 0x8d, 0xd5, 0xdd, 0xe2, 0xc6, 0x46, 0xf1, 0xc1, 0xda, 0xe6,
-	0xe6, 0xce, 0xe6, 0xfa, 0x5e, 0x61, 0xef, 0x41, 0x7e, 0xbb, 0xb0, 0x97, 0x2f, 0x48, 0x85, 0xa2,
-	0x54, 0x22, 0xf7, 0x97, 0xc5, 0xe0, 0x24, 0x1b, 0xc4, 0x82, 0xca, 0x74, 0x8b, 0x9f, 0x0c, 0xb4,
-	0x64, 0x94, 0x20, 0x02, 0x25, 0xc8, 0x5f, 0x63, 0x60, 0x8a, 0x10, 0xe4, 0x00, 0x61, 0x55, 0x57,
-	0xb1, 0x2a, 0xbe, 0x0b, 0x26, 0xa8, 0xf5, 0x80, 0x2d, 0xb9, 0x30, 0xb6, 0x78, 0x3a, 0x7e, 0xf7,
-	0xb9, 0x00, 0x2a, 0x71, 0xf2, 0xb4, 0xaf, 0x8b, 0xff, 0x15, 0xc0, 0xa2, 0x8f, 0x03, 0xdb, 0x58,
-	0x6d, 0x56, 0xdc, 0x76, 0xab, 0xd5, 0x3c, 0xa1, 0x5c, 0xba, 0x72, 0xaa, 0xff, 0x46, 0xe8, 0x4a,
-	0x6e, 0xb9, 0x16, 0x18, 0xea, 0x23, 0x29, 0x50, 0xd8, 0x99, 0x00, 0x3f, 0x3d, 0x8d, 0x24, 0xbc,
-	0x03, 0x81, 0x9f, 0x07, 0x77, 0xce, 0x57, 0x31, 0x88, 0x1e, 0x2a, 0xf3, 0x5e, 0x31, 0x1f, 0x13,
-	0xf1, 0x23, 0x2a, 0x15, 0xff, 0x27, 0x80, 0xe9, 0x20, 0x61, 0x19, 0xcf, 0xaf, 0xcc, 0xf2, 0x4b,
-	0xa1, 0x2b, 0x55, 0xcb, 0x8f, 0x83, 0x67, 0x97, 0xb7, 0x1b, 0x42, 0x81, 0xde, 0xcf, 0x9e, 0xd7,
-	0x7c, 0x72, 0x56, 0xb3, 0x70, 0xd5, 0x21, 0xb7, 0x70, 0x71, 0x53, 0xb9, 0xaf, 0x77, 0xc0, 0x4d,
-	0x05, 0xb6, 0x9e, 0x1b, 0xe0, 0xd0, 0x1f, 0x63, 0x20, 0x49, 0x38, 0x44, 0x27, 0xde, 0xe8, 0x08,
-	0xf4, 0x00, 0x8c, 0x1b, 0x96, 0x8e, 0x3a, 0x94, 0x2e, 0x31, 0xf9, 0xee, 0x05, 0x37, 0xfd, 0x5e,
-	0x66, 0xca, 0xbb, 0x18, 0xe9, 0xa8, 0x03, 0x15, 0xa6, 0x2f, 0x1e, 0x80, 0xa9, 0x2a, 0xaa, 0x1b,
-	0x96, 0x37, 0xc3, 0xc9, 0x6d, 0x2c, 0x2a, 0xbf, 0x43, 0x46, 0x6c, 0x9c, 0x56, 0x13, 0x9e, 0x46,
-	0xc6, 0x3d, 0x0f, 0xf3, 0xcc, 0x43, 0xd0, 0x00, 0x2a, 0x93, 0xf4, 0x27, 0x1f, 0xde, 0x4f, 0xc0,
-	0x9c, 0x77, 0x29, 0x34, 0xdd, 0x7a, 0x85, 0x61, 0x8a, 0x51, 0x4c, 0xcb, 0x61, 0x98, 0x52, 0xde,
-	0x8d, 0xfa, 0x9c, 0x0d, 0x54, 0x66, 0xb9, 0xec, 0xc0, 0xad, 0xef, 0x53, 0xa4, 0x1f, 0x01, 0x71,
-	0x70, 0x6c, 0xfa, 0xbe, 0xc7, 0x2f, 0x29, 0x5b, 0xbf, 0x97, 0xb9, 0x79, 0xee, 0xac, 0x0d, 0x38,
-	0xbf, 0xe6, 0x09, 0x07, 0xde, 0x1f, 0x82, 0x19, 0x7a, 0x37, 0xf0, 0x3d, 0xc7, 0xa9, 0xe7, 0x77,
-	0xc2, 0x3c, 0x5f, 0x0f, 0x5c, 0x26, 0x02, 0x5e, 0xa7, 0x88, 0x60, 0xe0, 0x71, 0x03, 0x24, 0x50,
-	0x07, 0x69, 0x6d, 0x8c, 0x74, 0x7a, 0x89, 0x48, 0xc8, 0x6f, 0x75, 0xa5, 0x78, 0x39, 0x86, 0x9d,
-	0x36, 0xea, 0xf7, 0x32, 0xb3, 0xcc, 0x87, 0xa7, 0x02, 0x95, 0x81, 0x76, 0x80, 0x2d, 0x7f, 0x8a,
-	0x82, 0xd9, 0x9d, 0x41, 0x1d, 0x1e, 0x61, 0x72, 0x2f, 0x78, 0x17, 0x00, 0x12, 0x93, 0xf7, 0x4b,
-	0xa0, 0xfd, 0x5a, 0x0a, 0xef, 0x17, 0x7f, 0x73, 0xf0, 0xd5, 0xa1, 0x92, 0x34, 0xdd, 0x3a, 0xef,
-	0x95, 0x0c, 0x92, 0x7e, 0xb6, 0x8c, 0x37, 0xf7, 0xc2, 0xb2, 0xbd, 0xe6, 0x7b, 0xe1, 0x89, 0x26,
-	0xcc, 0xb0, 0x24, 0xa3, 0xaf, 0x93, 0xa4, 0xf8, 0x2d, 0x90, 0x74, 0xdb, 0x9a, 0x86, 0x90, 0x8e,
-	0x74, 0xca, 0x90, 0x84, 0x7c, 0x27, 0x68, 0xca, 0xa3, 0x0e, 0x74, 0xa0, 0xe2, 0xeb, 0x8b, 0xbb,
-	0x60, 0x1a, 0xdb, 0x95, 0x2a, 0xaa, 0xe8, 0xa8, 0x89, 0x48, 0xec, 0x71, 0xea, 0xe0, 0x6e, 0xd0,
-	0x01, 0xdf, 0xc3, 0x67, 0xf4, 0xa0, 0x32, 0x89, 0x6d, 0x19, 0xed, 0xb0, 0x5f, 0xe2, 0xf7, 0x41,
-	0xd4, 0x74, 0xeb, 0xb4, 0xd3, 0x93, 0x85, 0xe2, 0xd5, 0xaf, 0x65, 0x07, 0x6e, 0x9d, 0x77, 0xe2,
-	0x03, 0x03, 0x37, 0x0c, 0x8b, 0x6e, 0x60, 0x79, 0xa6, 0xdf, 0xcb, 0x80, 0x41, 0x7d, 0xa0, 0x42,
-	0xfc, 0xc1, 0xbf, 0x44, 0xc1, 0xb5, 0x0f, 0x7c, 0x82, 0xbd, 0x69, 0xdb, 0x88, 0xdb, 0xf6, 0x83,
-	0x60, 0xdb, 0x4a, 0x43, 0xdb, 0xe6, 0xb5, 0x62, 0x68, 0xdf, 0x7e, 0x99, 0x00, 0x53, 0x8f, 0xd8,
-	0x16, 0x7e, 0xd3, 0xb3, 0x11, 0xf7, 0x4c, 0x05, 0xf3, 0xec, 0xe5, 0x02, 0x75, 0x5a, 0x86, 0x73,
-	0xe2, 0xd5, 0x34, 0x4e, 0x6b, 0xba, 0x1a, 0x5e, 0x53, 0x7e, 0xb5, 0x0d, 0xb1, 0x83, 0xca, 0x1c,
-	0x95, 0xee, 0x52, 0x21, 0x2f, 0xf2, 0x17, 0x02, 0x58, 0x40, 0x1d, 0xad, 0xa1, 0x5a, 0x75, 0xa4,
-	0x57, 0xec, 0x5a, 0x0d, 0x39, 0xf4, 0xe4, 0xa6, 0xd3, 0xf7, 0xca, 0xcb, 0xc5, 0x87, 0x5d, 0xa9,
-	0x54, 0xfe, 0xc6, 0x90, 0xab, 0xc5, 0xfa, 0xa5, 0x57, 0xa0, 0xdb, 0x5e, 0xe9, 0x2f, 0xc6, 0x86,
-	0x8a, 0x38, 0x10, 0xbf, 0x4f, 0xa4, 0xc4, 0x8c, 0x22, 0x75, 0x90, 0xa9, 0x1a, 0x96, 0x61, 0xd5,
-	0x83, 0x48, 0x13, 0x23, 0x41, 0x5a, 0x1a, 0x86, 0x34, 0x2c, 0x36, 0x54, 0xc4, 0x81, 0xd8, 0x47,
-	0xfa, 0xa5, 0xff, 0xba, 0x10, 0x4c, 0x8b, 0x7e, 0x6f, 0x48, 0x0e, 0x03, 0xfb, 0xb4, 0x2b, 0x15,
-	0xca, 0xf7, 0x86, 0x80, 0x5d, 0xbb, 0x04, 0xea, 0xd9, 0xb7, 0x87, 0xf3, 0xc1, 0xa1, 0xb2, 0xe0,
-	0xad, 0x0c, 0xc0, 0xee, 0x21, 0x24, 0x2a, 0x6c, 0x34, 0x00, 0x0a, 0x2d, 0x3f, 0x74, 0x34, 0x90,
-	0xdd, 0x3e, 0x6c, 0x2c, 0xc8, 0xdf, 0x7b, 0xfe, 0xaf, 0xf4, 0xd8, 0xf3, 0x97, 0x69, 0xe1, 0xc5,
-	0xcb, 0xb4, 0xf0, 0xcf, 0x97, 0x69, 0xe1, 0x17, 0xaf, 0xd2, 0x63, 0x2f, 0x5e, 0xa5, 0xc7, 0xfe,
-	0xf6, 0x2a, 0x3d, 0xf6, 0x61, 0x31, 0x70, 0x25, 0xac, 0x3b, 0xea, 0x91, 0x81, 0x4f, 0x96, 0x75,
-	0x74, 0xe4, 0x06, 0x3e, 0x05, 0x77, 0x02, 0xcf, 0xf4, 0x8e, 0x58, 0x8d, 0xd3, 0x6f, 0xb6, 0xc5,
-	0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x61, 0x5d, 0x42, 0xcc, 0x87, 0x16, 0x00, 0x00,
-}
-
-func (this *PoolType) Equal(that interface{}) bool {
+	// 2396 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcd, 0x6f, 0xdb, 0xc8,
+	0x15, 0x0f, 0x6d, 0x59, 0x96, 0x46, 0xb6, 0x6c, 0xd3, 0x76, 0xa2, 0x7c, 0xac, 0xe5, 0x9d, 0x36,
+	0x5b, 0x37, 0x1f, 0xb2, 0x3e, 0x6c, 0xc7, 0x4e, 0x7b, 0xa1, 0xfc, 0x91, 0x84, 0xd8, 0x6c, 0xd2,
+	0xb1, 0x37, 0x69, 0x36, 0x09, 0xb8, 0x94, 0x38, 0x92, 0xd9, 0x48, 0xa4, 0x42, 0x52, 0xfe, 0x68,
+	0x51, 0x60, 0x51, 0xa0, 0xa7, 0xb6, 0x8b, 0x05, 0x51, 0x14, 0x0b, 0xf4, 0xd0, 0x45, 0x0e, 0x2d,
+	0x1a, 0x60, 0x4f, 0xed, 0x3f, 0xd0, 0x9e, 0x72, 0xdc, 0xe3, 0x76, 0x0f, 0xda, 0x36, 0xb9, 0x14,
+	0x45, 0x51, 0x14, 0x3a, 0x17, 0x68, 0x31, 0x33, 0xfc, 0x92, 0xc2, 0x58, 0x72, 0xe2, 0xe6, 0x14,
+	0x5f, 0x44, 0x3e, 0xbe, 0xf7, 0xe6, 0x7d, 0xfc, 0xde, 0x1b, 0xce, 0xa3, 0xc1, 0x05, 0x0b, 0x6b,
+	0x0a, 0x36, 0xea, 0xaa, 0x66, 0xcd, 0xd7, 0xd4, 0x87, 0x4d, 0x55, 0x51, 0xad, 0xfd, 0xf9, 0x9d,
+	0x5c, 0x09, 0x5b, 0x72, 0xce, 0xa7, 0x64, 0x1a, 0x86, 0x6e, 0xe9, 0xfc, 0x19, 0x9f, 0x3b, 0xe3,
+	0x3f, 0x73, 0xb8, 0x4f, 0x9d, 0x3d, 0x50, 0x97, 0xb5, 0xc7, 0x94, 0x9c, 0x9a, 0xaa, 0xea, 0x55,
+	0x9d, 0x5e, 0xce, 0x93, 0x2b, 0x87, 0x9a, 0xae, 0xea, 0x7a, 0xb5, 0x86, 0xe7, 0xe9, 0x5d, 0xa9,
+	0x59, 0x99, 0xb7, 0xd4, 0x3a, 0x36, 0x2d, 0xb9, 0xde, 0x70, 0x18, 0x4e, 0x94, 0x75, 0xb3, 0xae,
+	0x9b, 0x12, 0x93, 0x2c, 0xeb, 0xaa, 0xe6, 0x3c, 0x60, 0x3f, 0xe5, 0x8b, 0x55, 0xac, 0x5d, 0xd4,
+	0x1b, 0x58, 0x93, 0x1b, 0xea, 0x4e, 0x7e, 0x5e, 0x6f, 0x58, 0xaa, 0xae, 0x99, 0xf3, 0xb2, 0xa6,
+	0xe9, 0x96, 0x4c, 0xaf, 0x19, 0x23, 0xfc, 0x79, 0x1c, 0x44, 0x6e, 0xea, 0x7a, 0x8d, 0x5f, 0x00,
+	0x03, 0xaa, 0x92, 0xe2, 0x66, 0xb9, 0xb9, 0x48, 0xf1, 0x9b, 0xb6, 0x90, 0x14, 0x07, 0x61, 0x0e,
+	0x3e, 0x1a, 0x88, 0x36, 0x55, 0xcd, 0x5a, 0x5a, 0xf8, 0x47, 0x2b, 0x3d, 0xa0, 0x2a, 0xed, 0x56,
+	0x3a, 0xbe, 0x2f, 0xd7, 0x6b, 0x97, 0xa1, 0xaa, 0x40, 0x34, 0xa0, 0x2a, 0xfc, 0x77, 0xc1, 0xb0,
+	0xb5, 0xdf, 0xc0, 0x92, 0xaa, 0xa4, 0x06, 0x66, 0xb9, 0xb9, 0xd1, 0xe2, 0x37, 0xba, 0x44, 0x0b,
+	0xf9, 0x76, 0x2b, 0x9d, 0x64, 0x42, 0x0e, 0x27, 0x44, 0x51, 0x72, 0x75, 0x4d, 0xe1, 0x2b, 0x60,
+	0xd2, 0xc0, 0x26, 0x36, 0x76, 0xb0, 0x44, 0x5c, 0x90, 0x14, 0xac, 0xe9, 0x75, 0x33, 0x35, 0x38,
+	0x3b, 0x38, 0x17, 0x2f, 0x2e, 0xd9, 0xc2, 0xb4, 0x38, 0x79, 0x17, 0x52, 0xe2, 0xf7, 0xe1, 0x05,
+	0x76, 0x71, 0x07, 0xde, 0x6f, 0xb7, 0xd2, 0xa7, 0x98, 0xc2, 0x10, 0x61, 0x88, 0x26, 0x1c, 0xea,
+	0xaa, 0xae, 0x6a, 0x6b, 0x94, 0xc6, 0xff, 0x86, 0x03, 0x27, 0x5c, 0x5e, 0xb9, 0x5c, 0xd6, 0x9b,
+	0x9a, 0x25, 0xc9, 0x8a, 0x62, 0x60, 0xd3, 0x4c, 0x45, 0x66, 0xb9, 0xb9, 0x78, 0xb1, 0x6a, 0x0b,
+	0x45, 0x71, 0x1e, 0xb2, 0xa8, 0xe6, 0x96, 0x14, 0xe5, 0x21, 0x36, 0xad, 0xdd, 0xe6, 0x83, 0x9d,
+	0xec, 0x0f, 0x7e, 0x58, 0xde, 0xaf, 0x68, 0x85, 0x8a, 0x52, 0x79, 0xb8, 0xb2, 0x9d, 0xdf, 0x35,
+	0xcc, 0xe5, 0x42, 0xd9, 0x58, 0x30, 0x2a, 0xf5, 0x02, 0x7c, 0x34, 0x90, 0x34, 0x95, 0x07, 0x19,
+	0xa1, 0x5c, 0x16, 0x98, 0xb2, 0x76, 0x2b, 0x3d, 0xd3, 0x69, 0x59, 0xd7, 0x6a, 0x10, 0x4d, 0x3b,
+	0x4f, 0x04, 0xf6, 0xc0, 0x11, 0xe4, 0x7f, 0xc1, 0x81, 0xb1, 0x86, 0xae, 0xd7, 0x02, 0xae, 0xa4,
+	0x86, 0xa8, 0x65, 0xd8, 0x16, 0xae, 0x8a, 0x1b, 0x90, 0x3c, 0x5c, 0x2b, 0x2c, 0x0a, 0xd9, 0xd5,
+	0xd5, 0xdc, 0xd2, 0xfa, 0xfa, 0xe2, 0xca, 0xf2, 0xc6, 0x4a, 0xb6, 0x98, 0x5d, 0x58, 0x58, 0x5d,
+	0xcf, 0xaf, 0x2c, 0x09, 0x0b, 0xd9, 0xc5, 0xa2, 0xb0, 0xb2, 0x5a, 0x58, 0xce, 0xad, 0x17, 0x96,
+	0x97, 0x0b, 0x97, 0x16, 0x57, 0x56, 0xd6, 0x56, 0x96, 0x36, 0xf2, 0x1b, 0x97, 0xb2, 0xab, 0xf9,
+	0x8d, 0x6c, 0x5e, 0xc8, 0x17, 0x84, 0x05, 0xd8, 0x6e, 0xa5, 0x8f, 0x33, 0xfb, 0xba, 0xd6, 0x82,
+	0x68, 0x94, 0x50, 0xbc, 0x90, 0xf1, 0xf7, 0xc1, 0x54, 0x47, 0x70, 0x77, 0xb1, 0x5a, 0xdd, 0xb6,
+	0xcc, 0x54, 0x74, 0x76, 0x70, 0x6e, 0xb4, 0x78, 0xde, 0x16, 0xe2, 0xe2, 0xf0, 0xdd, 0xe5, 0xec,
+	0x85, 0x7c, 0x96, 0xa4, 0xe3, 0x74, 0x48, 0x3a, 0x1c, 0x09, 0x88, 0xf8, 0x40, 0x3e, 0x6e, 0x33,
+	0x22, 0xff, 0x11, 0x07, 0x46, 0xcd, 0x5d, 0xb9, 0x21, 0x55, 0x30, 0x96, 0x0c, 0xd9, 0xc2, 0xa9,
+	0x61, 0xea, 0xec, 0x3d, 0x5b, 0x98, 0x14, 0x87, 0x61, 0x36, 0x93, 0xcd, 0x92, 0xf0, 0x0e, 0x93,
+	0xf0, 0xae, 0xe1, 0xf2, 0x93, 0x56, 0xfa, 0xd8, 0x57, 0xad, 0xf4, 0x3b, 0x55, 0xd5, 0xda, 0x6e,
+	0x96, 0x32, 0x65, 0xbd, 0x3e, 0xcf, 0x32, 0xe5, 0xfc, 0x5c, 0x34, 0x95, 0x07, 0xf3, 0x04, 0x53,
+	0x26, 0xe1, 0x6e, 0xb7, 0xd2, 0x53, 0xcc, 0xa0, 0x8e, 0x25, 0x20, 0x4a, 0x90, 0xfb, 0x0d, 0x8c,
+	0x91, 0x6c, 0x61, 0xbe, 0x06, 0xa2, 0xa6, 0x25, 0x5b, 0x4d, 0x33, 0x15, 0x9b, 0xe5, 0xe6, 0x92,
+	0xf9, 0xb9, 0xcc, 0x41, 0xe5, 0x9c, 0x21, 0x35, 0xb2, 0x49, 0xf9, 0x8b, 0xe7, 0x6c, 0xe1, 0xb8,
+	0x38, 0x05, 0x6f, 0xde, 0xb8, 0xf1, 0xae, 0xb4, 0xb9, 0x25, 0x6c, 0xbd, 0xbf, 0x29, 0x09, 0xab,
+	0x5b, 0xd7, 0x6e, 0xad, 0x93, 0xf8, 0x8e, 0x3a, 0x2b, 0x53, 0x56, 0x88, 0x9c, 0x35, 0xf8, 0x4d,
+	0x30, 0xa6, 0xe0, 0x46, 0x0d, 0x5b, 0x58, 0x91, 0xb6, 0x69, 0x10, 0x52, 0xf1, 0x59, 0x6e, 0x6e,
+	0x90, 0x28, 0x1b, 0x15, 0x07, 0x61, 0x16, 0x3e, 0x1a, 0x18, 0xa2, 0x95, 0xe6, 0xe7, 0xa8, 0x4b,
+	0x00, 0xa2, 0xa4, 0x4b, 0xb9, 0x4a, 0x09, 0x3c, 0x02, 0xc9, 0x92, 0x6c, 0x95, 0xb7, 0x25, 0x55,
+	0xb3, 0xb0, 0xb1, 0x23, 0xd7, 0x52, 0x80, 0xd6, 0xe0, 0x79, 0x5b, 0x18, 0x13, 0x23, 0x30, 0x97,
+	0xed, 0x28, 0xc2, 0x69, 0xa6, 0xb5, 0x53, 0x02, 0xa2, 0x51, 0x4a, 0xb8, 0xe6, 0xdc, 0xf3, 0x26,
+	0x38, 0x69, 0x5a, 0x72, 0xa9, 0x86, 0x25, 0x1a, 0x3c, 0xb9, 0xde, 0xa8, 0xa9, 0x15, 0xb5, 0x4c,
+	0x7b, 0x46, 0x2a, 0x41, 0xd5, 0x5f, 0xb2, 0x85, 0x71, 0x71, 0x08, 0xe6, 0xb2, 0x9d, 0xfa, 0x67,
+	0x3d, 0xcf, 0xc3, 0xa5, 0x21, 0x3a, 0xc1, 0x9e, 0x6d, 0xee, 0xca, 0x0d, 0x21, 0xf8, 0xe4, 0x72,
+	0xec, 0xd3, 0xcf, 0xd2, 0xdc, 0xdf, 0x3f, 0x4b, 0x73, 0xf0, 0x4f, 0x11, 0x30, 0x42, 0x42, 0x7d,
+	0x1d, 0x5b, 0xb2, 0x22, 0x5b, 0x32, 0x7f, 0x05, 0x0c, 0x53, 0xac, 0x7a, 0xbd, 0x29, 0x13, 0xd6,
+	0x9b, 0x5c, 0x1e, 0xbf, 0xd7, 0x38, 0x04, 0x88, 0xa2, 0xe4, 0xea, 0x9a, 0xc2, 0xff, 0x93, 0x03,
+	0xc7, 0x7d, 0xd4, 0x5b, 0xba, 0x25, 0xd7, 0x24, 0xb3, 0xd9, 0x68, 0xd4, 0xf6, 0x69, 0xe7, 0x4a,
+	0xe4, 0x4f, 0x66, 0x18, 0x98, 0x32, 0x25, 0xd9, 0xc4, 0x5e, 0xde, 0x09, 0x6a, 0x8b, 0xbf, 0xe6,
+	0x6c, 0xc1, 0x14, 0x2b, 0x3f, 0x62, 0x2d, 0x08, 0x5e, 0x9e, 0x3d, 0x9a, 0x72, 0xbc, 0x30, 0x0b,
+	0xe5, 0x3a, 0xe9, 0x02, 0x44, 0x63, 0x2e, 0x4b, 0xff, 0xe0, 0x8f, 0x1f, 0x0d, 0xc4, 0x08, 0xec,
+	0xc9, 0xc2, 0x04, 0xf7, 0xed, 0x56, 0xfa, 0xad, 0xee, 0x9a, 0x0d, 0x5a, 0x0f, 0xd1, 0xa4, 0x5b,
+	0xba, 0x5b, 0x84, 0xbc, 0x49, 0xa9, 0xfc, 0xbf, 0x38, 0x30, 0x1a, 0xac, 0x47, 0xd6, 0x55, 0x0f,
+	0xf4, 0xf2, 0x73, 0xce, 0x16, 0x4a, 0xe2, 0xd6, 0xdd, 0x80, 0x9b, 0x6e, 0xef, 0x0d, 0x35, 0xf4,
+	0xc2, 0x6c, 0x37, 0xe7, 0x9d, 0x4e, 0xce, 0xbc, 0xcb, 0x79, 0xff, 0xd1, 0x40, 0xdc, 0xf5, 0xc9,
+	0x74, 0x9c, 0x9a, 0x7a, 0xbe, 0x67, 0x98, 0xf0, 0xf1, 0xd7, 0xe9, 0xb9, 0x3e, 0x8a, 0x9c, 0xea,
+	0x41, 0x23, 0x81, 0xc6, 0x62, 0x06, 0x30, 0xf4, 0xf1, 0x10, 0x88, 0x13, 0x0c, 0x15, 0x09, 0xb0,
+	0x8f, 0x0e, 0x40, 0x97, 0xc0, 0x90, 0xaa, 0x29, 0x78, 0x8f, 0xc2, 0x25, 0x52, 0x7c, 0xfb, 0x39,
+	0x35, 0xed, 0x56, 0x7a, 0x84, 0xc9, 0x52, 0x3e, 0x88, 0x18, 0x3f, 0x7f, 0x1d, 0x8c, 0x94, 0x70,
+	0x55, 0xd5, 0xdc, 0xc2, 0x1f, 0x74, 0x0b, 0x7f, 0x5c, 0x8c, 0xd2, 0x68, 0x06, 0x6b, 0x7f, 0xd2,
+	0xa9, 0xd2, 0x80, 0x00, 0x44, 0x09, 0x7a, 0xeb, 0x54, 0xfd, 0x1d, 0x30, 0xa1, 0xe0, 0x86, 0x6e,
+	0xaa, 0x96, 0x54, 0x37, 0xab, 0x12, 0xb3, 0x29, 0x42, 0x6d, 0xba, 0x18, 0x66, 0x53, 0xca, 0xeb,
+	0x26, 0x9d, 0x32, 0x10, 0x8d, 0x39, 0xb4, 0xeb, 0x66, 0xf5, 0x1a, 0xb5, 0xf4, 0x1e, 0xe0, 0x77,
+	0x55, 0x6b, 0x5b, 0x31, 0xe4, 0xdd, 0x80, 0xee, 0xa1, 0x17, 0x84, 0xad, 0xdd, 0x4a, 0x9f, 0x64,
+	0xba, 0x9f, 0x17, 0x82, 0x68, 0xdc, 0x25, 0x7a, 0xda, 0x6f, 0x82, 0x24, 0xed, 0x0a, 0xbe, 0xe6,
+	0x28, 0xd5, 0x7c, 0x2e, 0x4c, 0xf3, 0x74, 0xa0, 0x83, 0x07, 0xb4, 0x8e, 0x10, 0x82, 0xa7, 0x71,
+	0x19, 0xc4, 0xf0, 0x1e, 0x2e, 0x37, 0x2d, 0xac, 0xd0, 0x0d, 0x24, 0x56, 0x3c, 0x63, 0x0b, 0x51,
+	0x31, 0x62, 0x19, 0x4d, 0xdc, 0x6e, 0xa5, 0xc7, 0x98, 0x0e, 0x97, 0x05, 0x22, 0x8f, 0x9b, 0x97,
+	0xc1, 0x14, 0x55, 0x6d, 0xe8, 0x4d, 0x0b, 0x07, 0x2c, 0x8a, 0x51, 0x8b, 0xb2, 0x61, 0x16, 0x9d,
+	0x0e, 0x58, 0xd4, 0x25, 0x06, 0xd1, 0x04, 0x21, 0x23, 0x42, 0x75, 0x8d, 0x0b, 0x00, 0xf2, 0xa7,
+	0x11, 0x30, 0xb6, 0xe6, 0x85, 0x9a, 0xec, 0x22, 0x98, 0xbf, 0x02, 0x00, 0x11, 0x77, 0x20, 0xc1,
+	0x51, 0x48, 0xcc, 0x85, 0x43, 0x62, 0x82, 0x2d, 0xec, 0xb3, 0x43, 0x14, 0xaf, 0x9b, 0x55, 0x07,
+	0x0e, 0x45, 0x10, 0xf7, 0xcd, 0x67, 0xd0, 0x3c, 0x1b, 0x66, 0xfe, 0xb8, 0xaf, 0xc5, 0xb1, 0x39,
+	0x56, 0x0f, 0x8b, 0xe3, 0xe0, 0xa1, 0xe2, 0xf8, 0x1d, 0x10, 0x37, 0x9b, 0xe5, 0x32, 0xc6, 0x0a,
+	0x56, 0x28, 0x08, 0x63, 0xc5, 0xb7, 0x82, 0xa2, 0xce, 0xaa, 0x1e, 0x0f, 0x44, 0x3e, 0x3f, 0xbf,
+	0x0e, 0x46, 0x2d, 0x5d, 0x2a, 0x61, 0x49, 0xc1, 0x74, 0x5b, 0xa3, 0x48, 0x8b, 0x15, 0xdf, 0x0e,
+	0x2a, 0x70, 0xda, 0x44, 0x07, 0x1f, 0x44, 0x09, 0x4b, 0x2f, 0xe2, 0x35, 0x76, 0xc7, 0xbf, 0x0f,
+	0x06, 0xeb, 0x66, 0x95, 0x82, 0x29, 0x91, 0x2f, 0x1c, 0xbc, 0x8d, 0x5f, 0x37, 0xab, 0x4e, 0x26,
+	0x6e, 0xab, 0xd6, 0xb6, 0xaa, 0xd1, 0x1e, 0x51, 0x4c, 0xb6, 0x5b, 0x69, 0xe0, 0xc5, 0x07, 0x22,
+	0xa2, 0x2f, 0x04, 0xae, 0xc3, 0xaf, 0x06, 0x57, 0xf8, 0xe9, 0x10, 0x18, 0xbf, 0xed, 0x57, 0xc5,
+	0x1b, 0x20, 0x1c, 0x31, 0x10, 0x6e, 0x05, 0x81, 0xb0, 0xd0, 0x13, 0x08, 0x6e, 0x2a, 0x5e, 0x3f,
+	0x12, 0xf8, 0x8f, 0x39, 0x90, 0xb0, 0x64, 0xa3, 0x8a, 0x2d, 0xba, 0xf1, 0xd1, 0xb6, 0x73, 0xe0,
+	0xde, 0x8c, 0x6c, 0x61, 0x51, 0x9c, 0xeb, 0x77, 0x67, 0x7e, 0xfe, 0x15, 0x82, 0x77, 0xa2, 0xe7,
+	0xaf, 0x09, 0x11, 0x60, 0x77, 0x84, 0x0b, 0xfe, 0x27, 0x0e, 0x46, 0x36, 0x99, 0x85, 0x6f, 0x60,
+	0x79, 0xc4, 0xb0, 0x94, 0xc1, 0xa4, 0x6e, 0x28, 0xd8, 0x90, 0xf0, 0x5e, 0x43, 0x35, 0xf6, 0xdd,
+	0x98, 0x46, 0x69, 0x4c, 0x73, 0xe1, 0x31, 0x75, 0x0e, 0xb8, 0x21, 0x72, 0x10, 0x4d, 0x50, 0xea,
+	0x3a, 0x25, 0x3a, 0x41, 0xfe, 0x1d, 0x07, 0xa6, 0xf0, 0x5e, 0x79, 0x5b, 0xd6, 0xaa, 0x58, 0x91,
+	0xf4, 0x4a, 0x05, 0x1b, 0x0c, 0x58, 0xc3, 0xbd, 0x80, 0xf5, 0x81, 0x2d, 0x2c, 0x88, 0xdf, 0xea,
+	0x01, 0xac, 0xa5, 0x17, 0xe2, 0xea, 0xb4, 0x1b, 0xfa, 0xe7, 0xd7, 0x86, 0x88, 0xf7, 0xc8, 0x37,
+	0x08, 0x95, 0x88, 0x51, 0x4b, 0x0d, 0x5c, 0x97, 0x55, 0x4d, 0xd5, 0xaa, 0x41, 0x4b, 0x63, 0x47,
+	0x62, 0xe9, 0x42, 0x2f, 0x4b, 0xc3, 0xd6, 0xa6, 0x67, 0x54, 0x87, 0xec, 0x5b, 0xfa, 0xb9, 0x3f,
+	0x34, 0x08, 0xba, 0x45, 0xce, 0x93, 0xa9, 0x78, 0x2f, 0x63, 0xef, 0xda, 0x42, 0x5e, 0x3c, 0xdb,
+	0xc3, 0xd8, 0xc5, 0x17, 0x98, 0xda, 0x39, 0x43, 0xe8, 0x5e, 0x1c, 0x22, 0xf7, 0x68, 0xee, 0x87,
+	0x75, 0x03, 0x63, 0x1e, 0xb1, 0xee, 0x07, 0xa8, 0x69, 0xd9, 0x9e, 0xdd, 0x8f, 0x54, 0x7b, 0xcf,
+	0xce, 0xf7, 0x98, 0x03, 0xd3, 0x7e, 0x6e, 0x15, 0x5c, 0x97, 0x35, 0x85, 0xa5, 0x2b, 0xd1, 0x47,
+	0x04, 0x42, 0xd2, 0xd5, 0x75, 0x42, 0x28, 0xbc, 0x30, 0x5d, 0x67, 0xba, 0x81, 0x15, 0x58, 0x1c,
+	0xa2, 0x49, 0x8f, 0xbe, 0x46, 0xc9, 0x34, 0x61, 0x2b, 0x20, 0x46, 0x8f, 0xb5, 0x9a, 0x5c, 0x4b,
+	0x8d, 0xb8, 0xa5, 0x3e, 0x2c, 0x0e, 0x55, 0xe4, 0x9a, 0x19, 0x68, 0x13, 0x2e, 0x0f, 0x44, 0x1e,
+	0x3b, 0xfc, 0x4b, 0x04, 0x4c, 0x6c, 0x06, 0xde, 0xe0, 0xde, 0xf4, 0xc0, 0x23, 0xee, 0x81, 0xef,
+	0x06, 0xb7, 0xe6, 0x73, 0x7d, 0x81, 0x93, 0xe6, 0xe2, 0xb0, 0xb0, 0x1c, 0x7e, 0x39, 0x58, 0xae,
+	0x76, 0xc2, 0x72, 0x65, 0xf1, 0xe8, 0x60, 0x09, 0x1f, 0x0f, 0x82, 0x31, 0x72, 0x1c, 0xbd, 0x69,
+	0xa8, 0x65, 0x8c, 0x70, 0x59, 0x37, 0x14, 0xfe, 0x7c, 0xf7, 0xa1, 0x94, 0x3f, 0xe0, 0xe0, 0xf9,
+	0x6d, 0x10, 0x75, 0x20, 0x38, 0x40, 0x21, 0x38, 0xe1, 0x8f, 0x99, 0x5c, 0xac, 0x39, 0x0c, 0xfc,
+	0x15, 0x10, 0x21, 0x93, 0x62, 0x0a, 0x90, 0x44, 0xfe, 0x54, 0x86, 0x8d, 0x91, 0x33, 0xee, 0x18,
+	0x39, 0xb3, 0xe5, 0x8e, 0x91, 0x8b, 0x27, 0x1c, 0x87, 0x12, 0x4e, 0xee, 0xd4, 0x3a, 0x86, 0x9f,
+	0x7c, 0x9d, 0xe6, 0x10, 0x55, 0xc0, 0x6f, 0x83, 0xa1, 0x06, 0xb1, 0xd7, 0x19, 0x8f, 0x22, 0x5b,
+	0x98, 0x20, 0x23, 0x9f, 0x4c, 0xee, 0x55, 0xa6, 0x72, 0xce, 0xe9, 0x98, 0x2a, 0x86, 0x88, 0x2d,
+	0xc0, 0xff, 0x8c, 0x03, 0xe3, 0xe5, 0x66, 0xbd, 0x59, 0x93, 0x2d, 0x75, 0x07, 0x4b, 0x6c, 0x55,
+	0x36, 0xfa, 0xfc, 0xd0, 0x16, 0xa6, 0xc4, 0x18, 0x5c, 0x5a, 0xca, 0x66, 0x33, 0xd9, 0x57, 0x59,
+	0xf8, 0x04, 0x5b, 0xb8, 0x7b, 0x19, 0x88, 0xc6, 0x7c, 0x12, 0x4d, 0x0f, 0xfc, 0xd5, 0x04, 0x4b,
+	0x16, 0xed, 0x89, 0x2f, 0x93, 0xac, 0x4b, 0x20, 0xe1, 0x4e, 0xd8, 0xfc, 0x62, 0x3f, 0xee, 0xbf,
+	0x81, 0x05, 0x1e, 0x42, 0x04, 0x9c, 0xd9, 0x1b, 0xa9, 0x6f, 0x3f, 0xcb, 0x83, 0xbd, 0xb2, 0xfc,
+	0x93, 0x81, 0xce, 0xe9, 0xac, 0x29, 0x95, 0x70, 0x45, 0x37, 0x48, 0xb2, 0x7a, 0x8c, 0x78, 0xfe,
+	0xf8, 0x5a, 0x47, 0x3c, 0x21, 0x63, 0x61, 0xd7, 0xd4, 0xc3, 0x4d, 0x7a, 0x82, 0x23, 0x64, 0xb3,
+	0x48, 0x15, 0xf0, 0xff, 0xe5, 0x3a, 0x3f, 0x1e, 0x98, 0x92, 0x5c, 0xb1, 0xb0, 0x91, 0x1a, 0xea,
+	0x15, 0x83, 0x3f, 0xf4, 0x19, 0x83, 0x5c, 0xdf, 0x31, 0xc8, 0x1f, 0x10, 0x83, 0x53, 0x61, 0x31,
+	0xa0, 0x96, 0x1e, 0x2e, 0x04, 0xc1, 0xaf, 0x1a, 0xa6, 0x40, 0xe4, 0xf9, 0x36, 0x07, 0xdc, 0x09,
+	0x0e, 0x56, 0x9c, 0x21, 0x5f, 0xb4, 0x9f, 0x21, 0xdf, 0x87, 0x22, 0xea, 0x0f, 0x01, 0x7d, 0x03,
+	0x20, 0xdc, 0xf7, 0xe3, 0x1d, 0x93, 0x27, 0xd7, 0xc6, 0xc3, 0xf9, 0x9d, 0xf4, 0xa4, 0xe9, 0x3d,
+	0x99, 0x6b, 0x8e, 0xb9, 0x93, 0x25, 0xcd, 0x71, 0x7a, 0xb8, 0x97, 0xd3, 0xbf, 0xe7, 0x6c, 0xe1,
+	0x9e, 0xf8, 0x5e, 0x3f, 0x4e, 0xf7, 0xe9, 0xf2, 0x81, 0x0e, 0x77, 0xd9, 0x77, 0x48, 0x87, 0x3d,
+	0x69, 0xe6, 0xf0, 0x57, 0x1c, 0x18, 0x27, 0x1b, 0x25, 0x56, 0x24, 0x6f, 0x00, 0xdc, 0xfb, 0x65,
+	0xf9, 0x97, 0x9c, 0x2d, 0x18, 0x22, 0x7e, 0x0d, 0x13, 0xeb, 0xb0, 0x5d, 0xd2, 0xe9, 0xb7, 0xdd,
+	0x66, 0x43, 0x94, 0x64, 0xa4, 0x9b, 0xce, 0xc0, 0x9a, 0xff, 0x92, 0x03, 0xe3, 0xa5, 0xa6, 0xa1,
+	0x75, 0x38, 0xd7, 0xf3, 0xe5, 0xda, 0xe6, 0x6c, 0xa1, 0x21, 0x96, 0xff, 0xef, 0xce, 0x1d, 0xe0,
+	0x5a, 0xb7, 0xd1, 0x10, 0x25, 0x19, 0xc9, 0x73, 0xed, 0xcf, 0x1c, 0x48, 0x7a, 0xdf, 0x9f, 0x18,
+	0x4e, 0x41, 0x2f, 0x9c, 0x7e, 0xc4, 0x91, 0xb7, 0x93, 0x77, 0x7a, 0xe1, 0xb4, 0xf0, 0x22, 0xfc,
+	0x4d, 0x77, 0x7d, 0xf6, 0x7a, 0x99, 0xa1, 0xba, 0xf3, 0x8d, 0x8c, 0x81, 0xef, 0xdf, 0x5c, 0x60,
+	0x22, 0xec, 0x3b, 0x92, 0xe8, 0xe5, 0xc8, 0x6f, 0x39, 0x5b, 0xb8, 0x25, 0x5e, 0xed, 0xe9, 0x48,
+	0x1f, 0xd5, 0xb6, 0x14, 0xee, 0x6a, 0xf7, 0xe4, 0xf9, 0x25, 0xdd, 0xf5, 0xa6, 0xd4, 0x9e, 0xcb,
+	0xef, 0x81, 0x49, 0xef, 0xed, 0x97, 0x0e, 0x70, 0xe8, 0x77, 0x5a, 0x7a, 0xa0, 0x88, 0x14, 0x67,
+	0xfc, 0x8e, 0x1e, 0xc2, 0x44, 0xc6, 0xc0, 0x2e, 0xf5, 0xba, 0x59, 0x5d, 0x25, 0x34, 0x7e, 0x1d,
+	0x8c, 0x57, 0x64, 0xb5, 0xd6, 0xa1, 0x6c, 0x94, 0x2a, 0x3b, 0xed, 0xc3, 0xa9, 0x9b, 0x03, 0xa2,
+	0x24, 0x23, 0xb9, 0x6a, 0x8a, 0xdf, 0x7b, 0xf2, 0xb7, 0x99, 0x63, 0x4f, 0x9e, 0xce, 0x70, 0x5f,
+	0x3c, 0x9d, 0xe1, 0xfe, 0xfa, 0x74, 0x86, 0xfb, 0xe4, 0xd9, 0xcc, 0xb1, 0x2f, 0x9e, 0xcd, 0x1c,
+	0xfb, 0xf2, 0xd9, 0xcc, 0xb1, 0x0f, 0x0a, 0x01, 0x87, 0xab, 0x86, 0xbc, 0xa3, 0x5a, 0xfb, 0x17,
+	0x15, 0xbc, 0x63, 0x06, 0xfe, 0xf3, 0x60, 0x2f, 0x70, 0x4d, 0x23, 0x50, 0x8a, 0xd2, 0xd7, 0xc2,
+	0xc2, 0xff, 0x06, 0x00, 0x55, 0xa1, 0xea, 0x1b, 0xf6, 0x20, 0x00, 0x00,
+}
+
+func (this *Pool) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
 	}
 
-	that1, ok := that.(*PoolType)
+	that1, ok := that.(*Pool)
 	if !ok {
-		that2, ok := that.(PoolType)
+		that2, ok := that.(Pool)
 		if ok {
 			that1 = &that2
 		} else {
@@ -591,118 +712,44 @@ func (this *PoolType) Equal(that interface{}) bool {
 	if this.Id != that1.Id {
 		return false
 	}
-	if this.Name != that1.Name {
-		return false
-	}
-	if this.MinReserveCoinNum != that1.MinReserveCoinNum {
-		return false
-	}
-	if this.MaxReserveCoinNum != that1.MaxReserveCoinNum {
-		return false
-	}
-	if this.Description != that1.Description {
-		return false
-	}
-	return true
-}
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
+	if this.TypeId != that1.TypeId {
 		return false
 	}
-	if len(this.PoolTypes) != len(that1.PoolTypes) {
+	if len(this.ReserveCoinDenoms) != len(that1.ReserveCoinDenoms) {
 		return false
 	}
-	for i := range this.PoolTypes {
-		if !this.PoolTypes[i].Equal(&that1.PoolTypes[i]) {
+	for i := range this.ReserveCoinDenoms {
+		if this.ReserveCoinDenoms[i] != that1.ReserveCoinDenoms[i] {
 			return false
 		}
 	}
-	if !this.MinInitDepositAmount.Equal(that1.MinInitDepositAmount) {
-		return false
-	}
-	if !this.InitPoolCoinMintAmount.Equal(that1.InitPoolCoinMintAmount) {
+	if this.ReserveAccountAddress != that1.ReserveAccountAddress {
 		return false
 	}
-	if !this.MaxReserveCoinAmount.Equal(that1.MaxReserveCoinAmount) {
+	if this.PoolCoinDenom != that1.PoolCoinDenom {
 		return false
 	}
-	if len(this.PoolCreationFee) != len(that1.PoolCreationFee) {
+	if len(this.ReserveCoinWeights) != len(that1.ReserveCoinWeights) {
 		return false
 	}
-	for i := range this.PoolCreationFee {
-		if !this.PoolCreationFee[i].Equal(&that1.PoolCreationFee[i]) {
+	for i := range this.ReserveCoinWeights {
+		if this.ReserveCoinWeights[i] != that1.ReserveCoinWeights[i] {
 			return false
 		}
 	}
 	if !this.SwapFeeRate.Equal(that1.SwapFeeRate) {
 		return false
 	}
-	if !this.WithdrawFeeRate.Equal(that1.WithdrawFeeRate) {
-		return false
-	}
-	if !this.MaxOrderAmountRatio.Equal(that1.MaxOrderAmountRatio) {
-		return false
-	}
-	if this.UnitBatchHeight != that1.UnitBatchHeight {
-		return false
-	}
-	if this.CircuitBreakerEnabled != that1.CircuitBreakerEnabled {
-		return false
-	}
-	return true
-}
-func (this *Pool) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*Pool)
-	if !ok {
-		that2, ok := that.(Pool)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Id != that1.Id {
-		return false
-	}
-	if this.TypeId != that1.TypeId {
+	if this.Status != that1.Status {
 		return false
 	}
-	if len(this.ReserveCoinDenoms) != len(that1.ReserveCoinDenoms) {
+	if this.DepletedHeight != that1.DepletedHeight {
 		return false
 	}
-	for i := range this.ReserveCoinDenoms {
-		if this.ReserveCoinDenoms[i] != that1.ReserveCoinDenoms[i] {
-			return false
-		}
-	}
-	if this.ReserveAccountAddress != that1.ReserveAccountAddress {
+	if this.BatchInterval != that1.BatchInterval {
 		return false
 	}
-	if this.PoolCoinDenom != that1.PoolCoinDenom {
+	if this.StableSwapAmplification != that1.StableSwapAmplification {
 		return false
 	}
 	return true
@@ -782,9 +829,12 @@ func (this *PoolBatch) Equal(that interface{}) bool {
 	if this.Executed != that1.Executed {
 		return false
 	}
+	if this.SwapRouteMsgIndex != that1.SwapRouteMsgIndex {
+		return false
+	}
 	return true
 }
-func (m *PoolType) Marshal() (dAtA []byte, err error) {
+func (m *Pool) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -794,39 +844,91 @@ func (m *PoolType) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PoolType) MarshalTo(dAtA []byte) (int, error) {
+func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PoolType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.Description)))
+	if m.StableSwapAmplification != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.StableSwapAmplification))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x58
 	}
-	if m.MaxReserveCoinNum != 0 {
-		i = encodeVarintLiquidity(dAtA, i, uint64(m.MaxReserveCoinNum))
+	if m.BatchInterval != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.BatchInterval))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x50
 	}
-	if m.MinReserveCoinNum != 0 {
-		i = encodeVarintLiquidity(dAtA, i, uint64(m.MinReserveCoinNum))
+	if m.DepletedHeight != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.DepletedHeight))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x48
+	}
+	if m.Status != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.SwapFeeRate.Size()
+		i -= size
+		if _, err := m.SwapFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.ReserveCoinWeights) > 0 {
+		dAtA2 := make([]byte, len(m.ReserveCoinWeights)*10)
+		var j1 int
+		for _, num := range m.ReserveCoinWeights {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintLiquidity(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.PoolCoinDenom) > 0 {
+		i -= len(m.PoolCoinDenom)
+		copy(dAtA[i:], m.PoolCoinDenom)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.PoolCoinDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ReserveAccountAddress) > 0 {
+		i -= len(m.ReserveAccountAddress)
+		copy(dAtA[i:], m.ReserveAccountAddress)
+		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.ReserveAccountAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ReserveCoinDenoms) > 0 {
+		for iNdEx := len(m.ReserveCoinDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReserveCoinDenoms[iNdEx])
+			copy(dAtA[i:], m.ReserveCoinDenoms[iNdEx])
+			i = encodeVarintLiquidity(dAtA, i, uint64(len(m.ReserveCoinDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.Name)))
+	if m.TypeId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.TypeId))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
 	if m.Id != 0 {
 		i = encodeVarintLiquidity(dAtA, i, uint64(m.Id))
@@ -836,189 +938,7 @@ func (m *PoolType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CircuitBreakerEnabled {
-		i--
-		if m.CircuitBreakerEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.UnitBatchHeight != 0 {
-		i = encodeVarintLiquidity(dAtA, i, uint64(m.UnitBatchHeight))
-		i--
-		dAtA[i] = 0x48
-	}
-	{
-		size := m.MaxOrderAmountRatio.Size()
-		i -= size
-		if _, err := m.MaxOrderAmountRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidity(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size := m.WithdrawFeeRate.Size()
-		i -= size
-		if _, err := m.WithdrawFeeRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidity(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.SwapFeeRate.Size()
-		i -= size
-		if _, err := m.SwapFeeRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidity(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	if len(m.PoolCreationFee) > 0 {
-		for iNdEx := len(m.PoolCreationFee) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PoolCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintLiquidity(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	{
-		size := m.MaxReserveCoinAmount.Size()
-		i -= size
-		if _, err := m.MaxReserveCoinAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidity(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.InitPoolCoinMintAmount.Size()
-		i -= size
-		if _, err := m.InitPoolCoinMintAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidity(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.MinInitDepositAmount.Size()
-		i -= size
-		if _, err := m.MinInitDepositAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintLiquidity(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.PoolTypes) > 0 {
-		for iNdEx := len(m.PoolTypes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PoolTypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintLiquidity(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Pool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Pool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PoolCoinDenom) > 0 {
-		i -= len(m.PoolCoinDenom)
-		copy(dAtA[i:], m.PoolCoinDenom)
-		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.PoolCoinDenom)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.ReserveAccountAddress) > 0 {
-		i -= len(m.ReserveAccountAddress)
-		copy(dAtA[i:], m.ReserveAccountAddress)
-		i = encodeVarintLiquidity(dAtA, i, uint64(len(m.ReserveAccountAddress)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ReserveCoinDenoms) > 0 {
-		for iNdEx := len(m.ReserveCoinDenoms) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ReserveCoinDenoms[iNdEx])
-			copy(dAtA[i:], m.ReserveCoinDenoms[iNdEx])
-			i = encodeVarintLiquidity(dAtA, i, uint64(len(m.ReserveCoinDenoms[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.TypeId != 0 {
-		i = encodeVarintLiquidity(dAtA, i, uint64(m.TypeId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Id != 0 {
-		i = encodeVarintLiquidity(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *PoolMetadata) Marshal() (dAtA []byte, err error) {
+func (m *PoolMetadata) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1090,6 +1010,11 @@ func (m *PoolBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SwapRouteMsgIndex != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.SwapRouteMsgIndex))
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.Executed {
 		i--
 		if m.Executed {
@@ -1153,6 +1078,11 @@ func (m *DepositMsgState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SwapMsgIndex != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.SwapMsgIndex))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.Msg != nil {
 		{
 			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
@@ -1228,6 +1158,21 @@ func (m *WithdrawMsgState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.TargetCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.SwapMsgIndex != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.SwapMsgIndex))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.Msg != nil {
 		{
 			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
@@ -1303,6 +1248,26 @@ func (m *SwapMsgState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Internal {
+		i--
+		if m.Internal {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size, err := m.ExchangedDemandCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
 	if m.Msg != nil {
 		{
 			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
@@ -1393,82 +1358,315 @@ func (m *SwapMsgState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintLiquidity(dAtA []byte, offset int, v uint64) int {
-	offset -= sovLiquidity(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *SwapRouteMsgState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *PoolType) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovLiquidity(uint64(m.Id))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovLiquidity(uint64(l))
-	}
-	if m.MinReserveCoinNum != 0 {
-		n += 1 + sovLiquidity(uint64(m.MinReserveCoinNum))
-	}
-	if m.MaxReserveCoinNum != 0 {
-		n += 1 + sovLiquidity(uint64(m.MaxReserveCoinNum))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovLiquidity(uint64(l))
-	}
-	return n
+
+func (m *SwapRouteMsgState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *SwapRouteMsgState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.PoolTypes) > 0 {
-		for _, e := range m.PoolTypes {
-			l = e.Size()
-			n += 1 + l + sovLiquidity(uint64(l))
+	{
+		size, err := m.ExchangedDemandCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
 	}
-	l = m.MinInitDepositAmount.Size()
-	n += 1 + l + sovLiquidity(uint64(l))
-	l = m.InitPoolCoinMintAmount.Size()
-	n += 1 + l + sovLiquidity(uint64(l))
-	l = m.MaxReserveCoinAmount.Size()
-	n += 1 + l + sovLiquidity(uint64(l))
-	if len(m.PoolCreationFee) > 0 {
-		for _, e := range m.PoolCreationFee {
-			l = e.Size()
-			n += 1 + l + sovLiquidity(uint64(l))
+	i--
+	dAtA[i] = 0x3a
+	if m.Msg != nil {
+		{
+			size, err := m.Msg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLiquidity(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x32
 	}
-	l = m.SwapFeeRate.Size()
-	n += 1 + l + sovLiquidity(uint64(l))
-	l = m.WithdrawFeeRate.Size()
-	n += 1 + l + sovLiquidity(uint64(l))
-	l = m.MaxOrderAmountRatio.Size()
-	n += 1 + l + sovLiquidity(uint64(l))
-	if m.UnitBatchHeight != 0 {
-		n += 1 + sovLiquidity(uint64(m.UnitBatchHeight))
+	if m.ToBeDeleted {
+		i--
+		if m.ToBeDeleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.CircuitBreakerEnabled {
-		n += 2
+	if m.Succeeded {
+		i--
+		if m.Succeeded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	return n
+	if m.Executed {
+		i--
+		if m.Executed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MsgIndex != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.MsgIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MsgHeight != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.MsgHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolPriceRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
 
+func (m *PoolPriceRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolPriceRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CumulativePrice.Size()
+		i -= size
+		if _, err := m.CumulativePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintLiquidity(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolBatchRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolBatchRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolBatchRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FailedMsgCount != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.FailedMsgCount))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.SucceededMsgCount != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.SucceededMsgCount))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.WithdrawFeeCoins) > 0 {
+		for iNdEx := len(m.WithdrawFeeCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithdrawFeeCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.SwapFeeCoins) > 0 {
+		for iNdEx := len(m.SwapFeeCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SwapFeeCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	{
+		size, err := m.BurnedPoolCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.MintedPoolCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintLiquidity(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.WithdrawnCoins) > 0 {
+		for iNdEx := len(m.WithdrawnCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithdrawnCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.DepositedCoins) > 0 {
+		for iNdEx := len(m.DepositedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DepositedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ReserveCoinsAfter) > 0 {
+		for iNdEx := len(m.ReserveCoinsAfter) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReserveCoinsAfter[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ReserveCoinsBefore) > 0 {
+		for iNdEx := len(m.ReserveCoinsBefore) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReserveCoinsBefore[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiquidity(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BatchIndex != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.BatchIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintLiquidity(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLiquidity(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLiquidity(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
 func (m *Pool) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1495,6 +1693,27 @@ func (m *Pool) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovLiquidity(uint64(l))
 	}
+	if len(m.ReserveCoinWeights) > 0 {
+		l = 0
+		for _, e := range m.ReserveCoinWeights {
+			l += sovLiquidity(uint64(e))
+		}
+		n += 1 + sovLiquidity(uint64(l)) + l
+	}
+	l = m.SwapFeeRate.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovLiquidity(uint64(m.Status))
+	}
+	if m.DepletedHeight != 0 {
+		n += 1 + sovLiquidity(uint64(m.DepletedHeight))
+	}
+	if m.BatchInterval != 0 {
+		n += 1 + sovLiquidity(uint64(m.BatchInterval))
+	}
+	if m.StableSwapAmplification != 0 {
+		n += 1 + sovLiquidity(uint64(m.StableSwapAmplification))
+	}
 	return n
 }
 
@@ -1545,6 +1764,9 @@ func (m *PoolBatch) Size() (n int) {
 	if m.Executed {
 		n += 2
 	}
+	if m.SwapRouteMsgIndex != 0 {
+		n += 1 + sovLiquidity(uint64(m.SwapRouteMsgIndex))
+	}
 	return n
 }
 
@@ -1573,6 +1795,9 @@ func (m *DepositMsgState) Size() (n int) {
 		l = m.Msg.Size()
 		n += 1 + l + sovLiquidity(uint64(l))
 	}
+	if m.SwapMsgIndex != 0 {
+		n += 1 + sovLiquidity(uint64(m.SwapMsgIndex))
+	}
 	return n
 }
 
@@ -1601,6 +1826,11 @@ func (m *WithdrawMsgState) Size() (n int) {
 		l = m.Msg.Size()
 		n += 1 + l + sovLiquidity(uint64(l))
 	}
+	if m.SwapMsgIndex != 0 {
+		n += 1 + sovLiquidity(uint64(m.SwapMsgIndex))
+	}
+	l = m.TargetCoin.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
 	return n
 }
 
@@ -1638,6 +1868,126 @@ func (m *SwapMsgState) Size() (n int) {
 		l = m.Msg.Size()
 		n += 1 + l + sovLiquidity(uint64(l))
 	}
+	l = m.ExchangedDemandCoin.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	if m.Internal {
+		n += 2
+	}
+	return n
+}
+
+func (m *SwapRouteMsgState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgHeight != 0 {
+		n += 1 + sovLiquidity(uint64(m.MsgHeight))
+	}
+	if m.MsgIndex != 0 {
+		n += 1 + sovLiquidity(uint64(m.MsgIndex))
+	}
+	if m.Executed {
+		n += 2
+	}
+	if m.Succeeded {
+		n += 2
+	}
+	if m.ToBeDeleted {
+		n += 2
+	}
+	if m.Msg != nil {
+		l = m.Msg.Size()
+		n += 1 + l + sovLiquidity(uint64(l))
+	}
+	l = m.ExchangedDemandCoin.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	return n
+}
+
+func (m *PoolPriceRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PoolId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovLiquidity(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.CumulativePrice.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	return n
+}
+
+func (m *PoolBatchRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovLiquidity(uint64(m.PoolId))
+	}
+	if m.BatchIndex != 0 {
+		n += 1 + sovLiquidity(uint64(m.BatchIndex))
+	}
+	if m.Height != 0 {
+		n += 1 + sovLiquidity(uint64(m.Height))
+	}
+	if len(m.ReserveCoinsBefore) > 0 {
+		for _, e := range m.ReserveCoinsBefore {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	if len(m.ReserveCoinsAfter) > 0 {
+		for _, e := range m.ReserveCoinsAfter {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	if len(m.DepositedCoins) > 0 {
+		for _, e := range m.DepositedCoins {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	if len(m.WithdrawnCoins) > 0 {
+		for _, e := range m.WithdrawnCoins {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	l = m.MintedPoolCoin.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	l = m.BurnedPoolCoin.Size()
+	n += 1 + l + sovLiquidity(uint64(l))
+	if len(m.SwapFeeCoins) > 0 {
+		for _, e := range m.SwapFeeCoins {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	if len(m.WithdrawFeeCoins) > 0 {
+		for _, e := range m.WithdrawFeeCoins {
+			l = e.Size()
+			n += 1 + l + sovLiquidity(uint64(l))
+		}
+	}
+	if m.SucceededMsgCount != 0 {
+		n += 1 + sovLiquidity(uint64(m.SucceededMsgCount))
+	}
+	if m.FailedMsgCount != 0 {
+		n += 1 + sovLiquidity(uint64(m.FailedMsgCount))
+	}
 	return n
 }
 
@@ -1647,7 +1997,7 @@ func sovLiquidity(x uint64) (n int) {
 func sozLiquidity(x uint64) (n int) {
 	return sovLiquidity(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *PoolType) Unmarshal(dAtA []byte) error {
+func (m *Pool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1670,10 +2020,10 @@ func (m *PoolType) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolType: wiretype end group for non-group")
+			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolType: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1690,14 +2040,97 @@ func (m *PoolType) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint32(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeId", wireType)
+			}
+			m.TypeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TypeId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveCoinDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReserveCoinDenoms = append(m.ReserveCoinDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveAccountAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReserveAccountAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCoinDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1725,13 +2158,142 @@ func (m *PoolType) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			m.PoolCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLiquidity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ReserveCoinWeights = append(m.ReserveCoinWeights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowLiquidity
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthLiquidity
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthLiquidity
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ReserveCoinWeights) == 0 {
+					m.ReserveCoinWeights = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowLiquidity
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ReserveCoinWeights = append(m.ReserveCoinWeights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveCoinWeights", wireType)
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= PoolStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinReserveCoinNum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepletedHeight", wireType)
 			}
-			m.MinReserveCoinNum = 0
+			m.DepletedHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -1741,16 +2303,16 @@ func (m *PoolType) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinReserveCoinNum |= uint32(b&0x7F) << shift
+				m.DepletedHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxReserveCoinNum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchInterval", wireType)
 			}
-			m.MaxReserveCoinNum = 0
+			m.BatchInterval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -1760,16 +2322,16 @@ func (m *PoolType) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxReserveCoinNum |= uint32(b&0x7F) << shift
+				m.BatchInterval |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableSwapAmplification", wireType)
 			}
-			var stringLen uint64
+			m.StableSwapAmplification = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -1779,24 +2341,11 @@ func (m *PoolType) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StableSwapAmplification |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidity(dAtA[iNdEx:])
@@ -1818,7 +2367,7 @@ func (m *PoolType) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *PoolMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1841,17 +2390,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolTypes", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var msglen int
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -1861,31 +2410,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PoolTypes = append(m.PoolTypes, PoolType{})
-			if err := m.PoolTypes[len(m.PoolTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinInitDepositAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCoinTotalSupply", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -1895,31 +2429,30 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinInitDepositAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PoolCoinTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitPoolCoinMintAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveCoins", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -1929,31 +2462,81 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitPoolCoinMintAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReserveCoins = append(m.ReserveCoins, types.Coin{})
+			if err := m.ReserveCoins[len(m.ReserveCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxReserveCoinAmount", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidity(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidity
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -1963,31 +2546,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MaxReserveCoinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolCreationFee", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var msglen int
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -1997,31 +2565,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Index |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PoolCreationFee = append(m.PoolCreationFee, types.Coin{})
-			if err := m.PoolCreationFee[len(m.PoolCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapFeeRate", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeginHeight", wireType)
 			}
-			var stringLen uint64
+			m.BeginHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2031,31 +2584,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BeginHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.SwapFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawFeeRate", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositMsgIndex", wireType)
 			}
-			var stringLen uint64
+			m.DepositMsgIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2065,31 +2603,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DepositMsgIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.WithdrawFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxOrderAmountRatio", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawMsgIndex", wireType)
 			}
-			var stringLen uint64
+			m.WithdrawMsgIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2099,31 +2622,35 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.WithdrawMsgIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapMsgIndex", wireType)
 			}
-			if err := m.MaxOrderAmountRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.SwapMsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SwapMsgIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 9:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnitBatchHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
 			}
-			m.UnitBatchHeight = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2133,16 +2660,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnitBatchHeight |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+			m.Executed = bool(v != 0)
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerEnabled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapRouteMsgIndex", wireType)
 			}
-			var v int
+			m.SwapRouteMsgIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2152,12 +2680,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.SwapRouteMsgIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.CircuitBreakerEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidity(dAtA[iNdEx:])
@@ -2179,7 +2706,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Pool) Unmarshal(dAtA []byte) error {
+func (m *DepositMsgState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2202,17 +2729,17 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Pool: wiretype end group for non-group")
+			return fmt.Errorf("proto: DepositMsgState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Pool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DepositMsgState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgHeight", wireType)
 			}
-			m.Id = 0
+			m.MsgHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2222,16 +2749,16 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.MsgHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TypeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
 			}
-			m.TypeId = 0
+			m.MsgIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2241,16 +2768,16 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TypeId |= uint32(b&0x7F) << shift
+				m.MsgIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReserveCoinDenoms", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2260,29 +2787,17 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ReserveCoinDenoms = append(m.ReserveCoinDenoms, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+			m.Executed = bool(v != 0)
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReserveAccountAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2292,29 +2807,37 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidity
+			m.Succeeded = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToBeDeleted", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ReserveAccountAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			m.ToBeDeleted = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolCoinDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2324,24 +2847,47 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolCoinDenom = string(dAtA[iNdEx:postIndex])
+			if m.Msg == nil {
+				m.Msg = &MsgDepositWithinBatch{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapMsgIndex", wireType)
+			}
+			m.SwapMsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SwapMsgIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidity(dAtA[iNdEx:])
@@ -2363,7 +2909,7 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolMetadata) Unmarshal(dAtA []byte) error {
+func (m *WithdrawMsgState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2386,17 +2932,17 @@ func (m *PoolMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: WithdrawMsgState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WithdrawMsgState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgHeight", wireType)
 			}
-			m.PoolId = 0
+			m.MsgHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2406,14 +2952,93 @@ func (m *PoolMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.MsgHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			}
+			m.MsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Executed = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Succeeded = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToBeDeleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ToBeDeleted = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolCoinTotalSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2430,23 +3055,45 @@ func (m *PoolMetadata) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthLiquidity
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.PoolCoinTotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &MsgWithdrawWithinBatch{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapMsgIndex", wireType)
+			}
+			m.SwapMsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SwapMsgIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReserveCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetCoin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2473,8 +3120,7 @@ func (m *PoolMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReserveCoins = append(m.ReserveCoins, types.Coin{})
-			if err := m.ReserveCoins[len(m.ReserveCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TargetCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2499,7 +3145,7 @@ func (m *PoolMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PoolBatch) Unmarshal(dAtA []byte) error {
+func (m *SwapMsgState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2522,17 +3168,17 @@ func (m *PoolBatch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PoolBatch: wiretype end group for non-group")
+			return fmt.Errorf("proto: SwapMsgState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PoolBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SwapMsgState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgHeight", wireType)
 			}
-			m.PoolId = 0
+			m.MsgHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2542,16 +3188,16 @@ func (m *PoolBatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.MsgHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
 			}
-			m.Index = 0
+			m.MsgIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2561,16 +3207,16 @@ func (m *PoolBatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
+				m.MsgIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BeginHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
 			}
-			m.BeginHeight = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2580,16 +3226,17 @@ func (m *PoolBatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BeginHeight |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Executed = bool(v != 0)
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositMsgIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
 			}
-			m.DepositMsgIndex = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2599,16 +3246,17 @@ func (m *PoolBatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DepositMsgIndex |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Succeeded = bool(v != 0)
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawMsgIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToBeDeleted", wireType)
 			}
-			m.WithdrawMsgIndex = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2618,16 +3266,17 @@ func (m *PoolBatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.WithdrawMsgIndex |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.ToBeDeleted = bool(v != 0)
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapMsgIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderExpiryHeight", wireType)
 			}
-			m.SwapMsgIndex = 0
+			m.OrderExpiryHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2637,14 +3286,182 @@ func (m *PoolBatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SwapMsgIndex |= uint64(b&0x7F) << shift
+				m.OrderExpiryHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedOfferCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangedOfferCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingOfferCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingOfferCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedOfferCoinFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReservedOfferCoinFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &MsgSwapWithinBatch{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedDemandCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangedDemandCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Internal", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -2661,7 +3478,7 @@ func (m *PoolBatch) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Executed = bool(v != 0)
+			m.Internal = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidity(dAtA[iNdEx:])
@@ -2683,7 +3500,7 @@ func (m *PoolBatch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DepositMsgState) Unmarshal(dAtA []byte) error {
+func (m *SwapRouteMsgState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2706,10 +3523,10 @@ func (m *DepositMsgState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DepositMsgState: wiretype end group for non-group")
+			return fmt.Errorf("proto: SwapRouteMsgState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DepositMsgState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SwapRouteMsgState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2809,10 +3626,46 @@ func (m *DepositMsgState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.ToBeDeleted = bool(v != 0)
-		case 6:
+			m.ToBeDeleted = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Msg == nil {
+				m.Msg = &MsgSwapRoute{}
+			}
+			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedDemandCoin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2839,10 +3692,7 @@ func (m *DepositMsgState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Msg == nil {
-				m.Msg = &MsgDepositWithinBatch{}
-			}
-			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExchangedDemandCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2867,7 +3717,7 @@ func (m *DepositMsgState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WithdrawMsgState) Unmarshal(dAtA []byte) error {
+func (m *PoolPriceRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2890,17 +3740,17 @@ func (m *WithdrawMsgState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WithdrawMsgState: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolPriceRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WithdrawMsgState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolPriceRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.MsgHeight = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2910,16 +3760,16 @@ func (m *WithdrawMsgState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MsgHeight |= int64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.MsgIndex = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2929,16 +3779,16 @@ func (m *WithdrawMsgState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MsgIndex |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2948,17 +3798,30 @@ func (m *WithdrawMsgState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Executed = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -2968,37 +3831,31 @@ func (m *WithdrawMsgState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Succeeded = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToBeDeleted", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidity
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowLiquidity
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
 			}
-			m.ToBeDeleted = bool(v != 0)
-		case 6:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3008,25 +3865,23 @@ func (m *WithdrawMsgState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthLiquidity
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthLiquidity
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Msg == nil {
-				m.Msg = &MsgWithdrawWithinBatch{}
-			}
-			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CumulativePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3051,7 +3906,7 @@ func (m *WithdrawMsgState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SwapMsgState) Unmarshal(dAtA []byte) error {
+func (m *PoolBatchRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3074,17 +3929,17 @@ func (m *SwapMsgState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SwapMsgState: wiretype end group for non-group")
+			return fmt.Errorf("proto: PoolBatchRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SwapMsgState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PoolBatchRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.MsgHeight = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3094,16 +3949,16 @@ func (m *SwapMsgState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MsgHeight |= int64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchIndex", wireType)
 			}
-			m.MsgIndex = 0
+			m.BatchIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3113,16 +3968,16 @@ func (m *SwapMsgState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MsgIndex |= uint64(b&0x7F) << shift
+				m.BatchIndex |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var v int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3132,17 +3987,16 @@ func (m *SwapMsgState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Executed = bool(v != 0)
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveCoinsBefore", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3152,17 +4006,31 @@ func (m *SwapMsgState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Succeeded = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReserveCoinsBefore = append(m.ReserveCoinsBefore, types.Coin{})
+			if err := m.ReserveCoinsBefore[len(m.ReserveCoinsBefore)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToBeDeleted", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveCoinsAfter", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3172,17 +4040,31 @@ func (m *SwapMsgState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ToBeDeleted = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReserveCoinsAfter = append(m.ReserveCoinsAfter, types.Coin{})
+			if err := m.ReserveCoinsAfter[len(m.ReserveCoinsAfter)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderExpiryHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositedCoins", wireType)
 			}
-			m.OrderExpiryHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLiquidity
@@ -3192,14 +4074,29 @@ func (m *SwapMsgState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderExpiryHeight |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositedCoins = append(m.DepositedCoins, types.Coin{})
+			if err := m.DepositedCoins[len(m.DepositedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedOfferCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawnCoins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3226,13 +4123,14 @@ func (m *SwapMsgState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangedOfferCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.WithdrawnCoins = append(m.WithdrawnCoins, types.Coin{})
+			if err := m.WithdrawnCoins[len(m.WithdrawnCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemainingOfferCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MintedPoolCoin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3259,13 +4157,13 @@ func (m *SwapMsgState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RemainingOfferCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MintedPoolCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReservedOfferCoinFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedPoolCoin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3292,13 +4190,13 @@ func (m *SwapMsgState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReservedOfferCoinFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BurnedPoolCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFeeCoins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3325,13 +4223,83 @@ func (m *SwapMsgState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Msg == nil {
-				m.Msg = &MsgSwapWithinBatch{}
+			m.SwapFeeCoins = append(m.SwapFeeCoins, types.Coin{})
+			if err := m.SwapFeeCoins[len(m.SwapFeeCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Msg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawFeeCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidity
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawFeeCoins = append(m.WithdrawFeeCoins, types.Coin{})
+			if err := m.WithdrawFeeCoins[len(m.WithdrawFeeCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SucceededMsgCount", wireType)
+			}
+			m.SucceededMsgCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SucceededMsgCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedMsgCount", wireType)
+			}
+			m.FailedMsgCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedMsgCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLiquidity(dAtA[iNdEx:])
@@ -6,16 +6,103 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-// PoolName returns unique name of the pool consists of given reserve coin denoms and type id.
-func PoolName(reserveCoinDenoms []string, poolTypeID uint32) string {
-	return strings.Join(append(SortDenoms(reserveCoinDenoms), strconv.FormatUint(uint64(poolTypeID), 10)), "/")
+// PoolName returns unique name of the pool consists of given reserve coin denoms and type id. The reserve coin weights
+// of a weighted pool are appended to the name joined by colons, so that pools of the same reserve coins with different
+// weights have different names.
+func PoolName(reserveCoinDenoms []string, poolTypeID uint32, reserveCoinWeights []uint32) string {
+	name := strings.Join(append(SortDenoms(reserveCoinDenoms), strconv.FormatUint(uint64(poolTypeID), 10)), "/")
+	if len(reserveCoinWeights) == 0 {
+		return name
+	}
+	weights := make([]string, len(reserveCoinWeights))
+	for i, weight := range reserveCoinWeights {
+		weights[i] = strconv.FormatUint(uint64(weight), 10)
+	}
+	return name + "/" + strings.Join(weights, ":")
 }
 
 // Name returns the pool's name.
 func (pool Pool) Name() string {
-	return PoolName(pool.ReserveCoinDenoms, pool.TypeId)
+	return PoolName(pool.ReserveCoinDenoms, pool.TypeId, pool.ReserveCoinWeights)
+}
+
+// ValidateReserveCoinWeights validates the weights of the reserve coins of a weighted pool, which must be positive,
+// sum up to TotalReserveCoinWeight and not be all equal, since equally weighted pools have no weights.
+func ValidateReserveCoinWeights(reserveCoinWeights []uint32, reserveCoinNum int) error {
+	if len(reserveCoinWeights) != reserveCoinNum {
+		return ErrBadReserveCoinWeights
+	}
+	sum, equal := uint32(0), true
+	for _, weight := range reserveCoinWeights {
+		if weight == 0 || weight >= TotalReserveCoinWeight {
+			return ErrBadReserveCoinWeights
+		}
+		sum += weight
+		equal = equal && weight == reserveCoinWeights[0]
+	}
+	if sum != TotalReserveCoinWeight || equal {
+		return ErrBadReserveCoinWeights
+	}
+	return nil
+}
+
+// ReserveCoinWeight returns the weight of the reserve coin of the denom, 1 for all reserve coins of an equally
+// weighted pool and 0 if the denom is not one of the reserve coin denoms of the pool.
+func (pool Pool) ReserveCoinWeight(denom string) uint32 {
+	for i, reserveCoinDenom := range pool.ReserveCoinDenoms {
+		if reserveCoinDenom == denom {
+			if len(pool.ReserveCoinWeights) == 0 {
+				return 1
+			}
+			return pool.ReserveCoinWeights[i]
+		}
+	}
+	return 0
+}
+
+// HasReserveCoinDenom returns true if the denom is one of the reserve coin denoms of the pool.
+func (pool Pool) HasReserveCoinDenom(denom string) bool {
+	for _, reserveCoinDenom := range pool.ReserveCoinDenoms {
+		if reserveCoinDenom == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidatePoolStatus returns an error if the status is not one of the pool statuses.
+func ValidatePoolStatus(status PoolStatus) error {
+	if _, ok := PoolStatus_name[int32(status)]; !ok {
+		return ErrBadPoolStatus
+	}
+	return nil
+}
+
+// ParsePoolStatus parses the pool status from its name without the prefix in lower case, e.g. "deposits-paused".
+func ParsePoolStatus(s string) (PoolStatus, error) {
+	status, ok := PoolStatus_value["POOL_STATUS_"+strings.ToUpper(strings.ReplaceAll(s, "-", "_"))]
+	if !ok {
+		return 0, sdkerrors.Wrapf(ErrBadPoolStatus, "unknown status %s", s)
+	}
+	return PoolStatus(status), nil
+}
+
+// DepositAllowed returns true if the deposits to the pool are allowed by the status.
+func (status PoolStatus) DepositAllowed() bool {
+	return status == PoolStatusActive
+}
+
+// SwapAllowed returns true if the swaps through the pool are allowed by the status.
+func (status PoolStatus) SwapAllowed() bool {
+	return status == PoolStatusActive || status == PoolStatusDepositsPaused
+}
+
+// WithdrawAllowed returns true if the withdrawals from the pool are allowed by the status.
+func (status PoolStatus) WithdrawAllowed() bool {
+	return status != PoolStatusFrozen
 }
 
 // Validate validates Pool.
@@ -32,9 +119,21 @@ func (pool Pool) Validate() error {
 	if uint32(len(pool.ReserveCoinDenoms)) > MaxReserveCoinNum || uint32(len(pool.ReserveCoinDenoms)) < MinReserveCoinNum {
 		return ErrNumOfReserveCoinDenoms
 	}
-	sortedDenomA, sortedDenomB := AlphabeticalDenomPair(pool.ReserveCoinDenoms[0], pool.ReserveCoinDenoms[1])
-	if sortedDenomA != pool.ReserveCoinDenoms[0] || sortedDenomB != pool.ReserveCoinDenoms[1] {
-		return ErrBadOrderingReserveCoinDenoms
+	for i := 1; i < len(pool.ReserveCoinDenoms); i++ {
+		if pool.ReserveCoinDenoms[i-1] >= pool.ReserveCoinDenoms[i] {
+			return ErrBadOrderingReserveCoinDenoms
+		}
+	}
+	if len(pool.ReserveCoinWeights) > 0 {
+		if err := ValidateReserveCoinWeights(pool.ReserveCoinWeights, len(pool.ReserveCoinDenoms)); err != nil {
+			return err
+		}
+	}
+	if !pool.SwapFeeRate.IsNil() && (pool.SwapFeeRate.IsNegative() || pool.SwapFeeRate.GT(sdk.OneDec())) {
+		return ErrSwapFeeTierNotExists
+	}
+	if err := ValidatePoolStatus(pool.Status); err != nil {
+		return err
 	}
 	if pool.ReserveAccountAddress == "" {
 		return ErrEmptyReserveAccountAddress
@@ -54,13 +153,14 @@ func (pool Pool) Validate() error {
 // NewPoolBatch creates a new PoolBatch object.
 func NewPoolBatch(poolID, batchIndex uint64) PoolBatch {
 	return PoolBatch{
-		PoolId:           poolID,
-		Index:            batchIndex,
-		BeginHeight:      0,
-		DepositMsgIndex:  1,
-		WithdrawMsgIndex: 1,
-		SwapMsgIndex:     1,
-		Executed:         false,
+		PoolId:            poolID,
+		Index:             batchIndex,
+		BeginHeight:       0,
+		DepositMsgIndex:   1,
+		WithdrawMsgIndex:  1,
+		SwapMsgIndex:      1,
+		Executed:          false,
+		SwapRouteMsgIndex: 1,
 	}
 }
 
@@ -188,3 +288,63 @@ func MustUnmarshalSwapMsgState(cdc codec.BinaryCodec, value []byte) SwapMsgState
 	}
 	return msg
 }
+
+// MustMarshalSwapRouteMsgState returns the SwapRouteMsgState bytes. Panics if fails.
+func MustMarshalSwapRouteMsgState(cdc codec.BinaryCodec, msg SwapRouteMsgState) []byte {
+	return cdc.MustMarshal(&msg)
+}
+
+// UnmarshalSwapRouteMsgState returns the SwapRouteMsgState from bytes.
+func UnmarshalSwapRouteMsgState(cdc codec.BinaryCodec, value []byte) (msg SwapRouteMsgState, err error) {
+	err = cdc.Unmarshal(value, &msg)
+	return msg, err
+}
+
+// MustUnmarshalSwapRouteMsgState returns the SwapRouteMsgState from bytes. Panics if fails.
+func MustUnmarshalSwapRouteMsgState(cdc codec.BinaryCodec, value []byte) SwapRouteMsgState {
+	msg, err := UnmarshalSwapRouteMsgState(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+	return msg
+}
+
+// MustMarshalPoolPriceRecord returns the PoolPriceRecord bytes. Panics if fails.
+func MustMarshalPoolPriceRecord(cdc codec.BinaryCodec, record PoolPriceRecord) []byte {
+	return cdc.MustMarshal(&record)
+}
+
+// UnmarshalPoolPriceRecord returns the PoolPriceRecord from bytes.
+func UnmarshalPoolPriceRecord(cdc codec.BinaryCodec, value []byte) (record PoolPriceRecord, err error) {
+	err = cdc.Unmarshal(value, &record)
+	return record, err
+}
+
+// MustUnmarshalPoolPriceRecord returns the PoolPriceRecord from bytes. Panics if fails.
+func MustUnmarshalPoolPriceRecord(cdc codec.BinaryCodec, value []byte) PoolPriceRecord {
+	record, err := UnmarshalPoolPriceRecord(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+	return record
+}
+
+// MustMarshalPoolBatchRecord returns the PoolBatchRecord bytes. Panics if fails.
+func MustMarshalPoolBatchRecord(cdc codec.BinaryCodec, record PoolBatchRecord) []byte {
+	return cdc.MustMarshal(&record)
+}
+
+// UnmarshalPoolBatchRecord returns the PoolBatchRecord from bytes.
+func UnmarshalPoolBatchRecord(cdc codec.BinaryCodec, value []byte) (record PoolBatchRecord, err error) {
+	err = cdc.Unmarshal(value, &record)
+	return record, err
+}
+
+// MustUnmarshalPoolBatchRecord returns the PoolBatchRecord from bytes. Panics if fails.
+func MustUnmarshalPoolBatchRecord(cdc codec.BinaryCodec, value []byte) PoolBatchRecord {
+	record, err := UnmarshalPoolBatchRecord(cdc, value)
+	if err != nil {
+		panic(err)
+	}
+	return record
+}
@@ -52,9 +52,12 @@ func TestLiquidityPoolBatch(t *testing.T) {
 	pool.TypeId = 1
 	require.Equal(t, types.ErrNumOfReserveCoinDenoms, pool.Validate())
 
-	pool.ReserveCoinDenoms = []string{DenomY, DenomX, DenomX}
+	pool.ReserveCoinDenoms = []string{"a", "b", "c", "d", "e", "f", "g", "h", "i"}
 	require.Equal(t, types.ErrNumOfReserveCoinDenoms, pool.Validate())
 
+	pool.ReserveCoinDenoms = []string{DenomX, DenomX, DenomY}
+	require.Equal(t, types.ErrBadOrderingReserveCoinDenoms, pool.Validate())
+
 	pool.ReserveCoinDenoms = []string{DenomY, DenomX}
 	require.Equal(t, types.ErrBadOrderingReserveCoinDenoms, pool.Validate())
 
@@ -75,10 +78,34 @@ func TestLiquidityPoolBatch(t *testing.T) {
 
 	pool.PoolCoinDenom = pool.Name()
 	require.NoError(t, pool.Validate())
-	require.Equal(t, pool.Name(), types.PoolName(pool.ReserveCoinDenoms, pool.TypeId))
+	require.Equal(t, pool.Name(), types.PoolName(pool.ReserveCoinDenoms, pool.TypeId, pool.ReserveCoinWeights))
+	require.Equal(t, uint32(1), pool.ReserveCoinWeight(DenomY))
+	require.Equal(t, uint32(0), pool.ReserveCoinWeight("denomZ"))
+
+	// the weights of a weighted pool are a part of its name
+	weightedPool := pool
+	weightedPool.ReserveCoinWeights = []uint32{50, 50}
+	require.Equal(t, types.ErrBadReserveCoinWeights, weightedPool.Validate())
+	weightedPool.ReserveCoinWeights = []uint32{80, 20}
+	require.Equal(t, types.ErrBadReserveAccountAddress, weightedPool.Validate())
+	require.Equal(t, pool.Name()+"/80:20", weightedPool.Name())
+	require.Equal(t, uint32(20), weightedPool.ReserveCoinWeight(DenomY))
 	require.Equal(t, pool.Id, pool.GetId())
 	require.Equal(t, pool.PoolCoinDenom, pool.GetPoolCoinDenom())
 
+	// the swap fee rate of the pool is not a part of its name
+	pool.SwapFeeRate = sdk.NewDec(2)
+	require.Equal(t, types.ErrSwapFeeTierNotExists, pool.Validate())
+	pool.SwapFeeRate = sdk.NewDecWithPrec(1, 2)
+	require.NoError(t, pool.Validate())
+	pool.SwapFeeRate = sdk.ZeroDec()
+
+	// the status of the pool is not a part of its name
+	pool.Status = types.PoolStatus(5)
+	require.Equal(t, types.ErrBadPoolStatus, pool.Validate())
+	pool.Status = types.PoolStatusFrozen
+	require.NoError(t, pool.Validate())
+
 	cdc := simapp.AppCodec()
 	poolByte := types.MustMarshalPool(cdc, pool)
 	require.Equal(t, pool, types.MustUnmarshalPool(cdc, poolByte))
@@ -104,11 +131,14 @@ func TestLiquidityPoolBatch(t *testing.T) {
 	require.Equal(t, batch, batchMarshaled)
 
 	batchDepositMsg := types.DepositMsgState{}
-	batchWithdrawMsg := types.WithdrawMsgState{}
+	batchWithdrawMsg := types.WithdrawMsgState{
+		TargetCoin: sdk.NewCoin("test", sdk.NewInt(1000)),
+	}
 	batchSwapMsg := types.SwapMsgState{
 		ExchangedOfferCoin:   sdk.NewCoin("test", sdk.NewInt(1000)),
 		RemainingOfferCoin:   sdk.NewCoin("test", sdk.NewInt(1000)),
 		ReservedOfferCoinFee: types.GetOfferCoinFee(sdk.NewCoin("test", sdk.NewInt(2000)), params.SwapFeeRate),
+		ExchangedDemandCoin:  sdk.NewCoin("demand", sdk.NewInt(1000)),
 	}
 	b := types.MustMarshalDepositMsgState(cdc, batchDepositMsg)
 	require.Equal(t, batchDepositMsg, types.MustUnmarshalDepositMsgState(cdc, b))
@@ -131,3 +161,27 @@ func TestLiquidityPoolBatch(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, batchSwapMsg, SwapMsgMarshaled)
 }
+
+func TestPoolStatus(t *testing.T) {
+	for _, tc := range []struct {
+		name                                         string
+		status                                       types.PoolStatus
+		depositAllowed, swapAllowed, withdrawAllowed bool
+	}{
+		{"active", types.PoolStatusActive, true, true, true},
+		{"deposits-paused", types.PoolStatusDepositsPaused, false, true, true},
+		{"withdraw-only", types.PoolStatusWithdrawOnly, false, false, true},
+		{"frozen", types.PoolStatusFrozen, false, false, false},
+		{"wind-down", types.PoolStatusWindDown, false, false, true},
+	} {
+		status, err := types.ParsePoolStatus(tc.name)
+		require.NoError(t, err)
+		require.Equal(t, tc.status, status)
+		require.Equal(t, tc.depositAllowed, status.DepositAllowed())
+		require.Equal(t, tc.swapAllowed, status.SwapAllowed())
+		require.Equal(t, tc.withdrawAllowed, status.WithdrawAllowed())
+	}
+
+	_, err := types.ParsePoolStatus("paused")
+	require.ErrorIs(t, err, types.ErrBadPoolStatus)
+}
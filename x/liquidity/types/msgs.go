@@ -2,23 +2,42 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 var (
 	_ sdk.Msg = (*MsgCreatePool)(nil)
 	_ sdk.Msg = (*MsgDepositWithinBatch)(nil)
+	_ sdk.Msg = (*MsgDepositSingleAssetWithinBatch)(nil)
 	_ sdk.Msg = (*MsgWithdrawWithinBatch)(nil)
 	_ sdk.Msg = (*MsgSwapWithinBatch)(nil)
+	_ sdk.Msg = (*MsgSwapRoute)(nil)
+	_ sdk.Msg = (*MsgCancelDeposit)(nil)
+	_ sdk.Msg = (*MsgCancelWithdraw)(nil)
+	_ sdk.Msg = (*MsgCancelSwap)(nil)
+	_ sdk.Msg = (*MsgSetPoolStatus)(nil)
+	_ sdk.Msg = (*MsgSetCircuitBreaker)(nil)
+	_ sdk.Msg = (*MsgUpdateParams)(nil)
+	_ sdk.Msg = (*MsgWindDownPool)(nil)
 )
 
 // Message types for the liquidity module
 //
 //nolint:gosec
 const (
-	TypeMsgCreatePool          = "create_pool"
-	TypeMsgDepositWithinBatch  = "deposit_within_batch"
-	TypeMsgWithdrawWithinBatch = "withdraw_within_batch"
-	TypeMsgSwapWithinBatch     = "swap_within_batch"
+	TypeMsgCreatePool                    = "create_pool"
+	TypeMsgDepositWithinBatch            = "deposit_within_batch"
+	TypeMsgDepositSingleAssetWithinBatch = "deposit_single_asset_within_batch"
+	TypeMsgWithdrawWithinBatch           = "withdraw_within_batch"
+	TypeMsgSwapWithinBatch               = "swap_within_batch"
+	TypeMsgSwapRoute                     = "swap_route"
+	TypeMsgCancelDeposit                 = "cancel_deposit"
+	TypeMsgCancelWithdraw                = "cancel_withdraw"
+	TypeMsgCancelSwap                    = "cancel_swap"
+	TypeMsgSetPoolStatus                 = "set_pool_status"
+	TypeMsgSetCircuitBreaker             = "set_circuit_breaker"
+	TypeMsgUpdateParams                  = "update_params"
+	TypeMsgWindDownPool                  = "wind_down_pool"
 )
 
 // NewMsgCreatePool creates a new MsgCreatePool.
@@ -27,6 +46,7 @@ func NewMsgCreatePool(poolCreator sdk.AccAddress, poolTypeID uint32, depositCoin
 		PoolCreatorAddress: poolCreator.String(),
 		PoolTypeId:         poolTypeID,
 		DepositCoins:       depositCoins,
+		SwapFeeRate:        sdk.ZeroDec(),
 	}
 }
 
@@ -47,6 +67,14 @@ func (msg MsgCreatePool) ValidateBasic() error {
 	if n := uint32(len(msg.DepositCoins)); n > MaxReserveCoinNum || n < MinReserveCoinNum {
 		return ErrNumOfReserveCoin
 	}
+	if len(msg.ReserveCoinWeights) > 0 {
+		if err := ValidateReserveCoinWeights(msg.ReserveCoinWeights, len(msg.DepositCoins)); err != nil {
+			return err
+		}
+	}
+	if msg.GetSwapFeeRate().IsNegative() || msg.GetSwapFeeRate().GT(sdk.OneDec()) {
+		return ErrSwapFeeTierNotExists
+	}
 	return nil
 }
 
@@ -70,12 +98,21 @@ func (msg MsgCreatePool) GetPoolCreator() sdk.AccAddress {
 	return addr
 }
 
+// GetSwapFeeRate returns the swap fee rate of the pool to be created, zero when it is not set.
+func (msg MsgCreatePool) GetSwapFeeRate() sdk.Dec {
+	if msg.SwapFeeRate.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return msg.SwapFeeRate
+}
+
 // NewMsgDepositWithinBatch creates a new MsgDepositWithinBatch.
 func NewMsgDepositWithinBatch(depositor sdk.AccAddress, poolID uint64, depositCoins sdk.Coins) *MsgDepositWithinBatch {
 	return &MsgDepositWithinBatch{
-		DepositorAddress: depositor.String(),
-		PoolId:           poolID,
-		DepositCoins:     depositCoins,
+		DepositorAddress:  depositor.String(),
+		PoolId:            poolID,
+		DepositCoins:      depositCoins,
+		MinPoolCoinAmount: sdk.ZeroInt(),
 	}
 }
 
@@ -96,6 +133,9 @@ func (msg MsgDepositWithinBatch) ValidateBasic() error {
 	if n := uint32(len(msg.DepositCoins)); n > MaxReserveCoinNum || n < MinReserveCoinNum {
 		return ErrNumOfReserveCoin
 	}
+	if msg.GetMinPoolCoinAmount().IsNegative() {
+		return ErrBadPoolCoinAmount
+	}
 	return nil
 }
 
@@ -119,6 +159,74 @@ func (msg MsgDepositWithinBatch) GetDepositor() sdk.AccAddress {
 	return addr
 }
 
+// GetMinPoolCoinAmount returns the minimum amount of pool coin to be minted for the deposit, zero when it is not set.
+func (msg MsgDepositWithinBatch) GetMinPoolCoinAmount() sdk.Int {
+	if msg.MinPoolCoinAmount.IsNil() {
+		return sdk.ZeroInt()
+	}
+	return msg.MinPoolCoinAmount
+}
+
+// NewMsgDepositSingleAssetWithinBatch creates a new MsgDepositSingleAssetWithinBatch.
+func NewMsgDepositSingleAssetWithinBatch(depositor sdk.AccAddress, poolID uint64, depositCoin sdk.Coin) *MsgDepositSingleAssetWithinBatch {
+	return &MsgDepositSingleAssetWithinBatch{
+		DepositorAddress:  depositor.String(),
+		PoolId:            poolID,
+		DepositCoin:       depositCoin,
+		MinPoolCoinAmount: sdk.ZeroInt(),
+	}
+}
+
+func (msg MsgDepositSingleAssetWithinBatch) Route() string { return RouterKey }
+
+func (msg MsgDepositSingleAssetWithinBatch) Type() string {
+	return TypeMsgDepositSingleAssetWithinBatch
+}
+
+func (msg MsgDepositSingleAssetWithinBatch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DepositorAddress); err != nil {
+		return ErrInvalidDepositorAddr
+	}
+	if err := msg.DepositCoin.Validate(); err != nil {
+		return err
+	}
+	if !msg.DepositCoin.IsPositive() {
+		return ErrBadDepositCoinsAmount
+	}
+	if msg.GetMinPoolCoinAmount().IsNegative() {
+		return ErrBadPoolCoinAmount
+	}
+	return nil
+}
+
+func (msg MsgDepositSingleAssetWithinBatch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgDepositSingleAssetWithinBatch) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.DepositorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgDepositSingleAssetWithinBatch) GetDepositor() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.DepositorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// GetMinPoolCoinAmount returns the minimum amount of pool coin to be minted for the deposit, zero when it is not set.
+func (msg MsgDepositSingleAssetWithinBatch) GetMinPoolCoinAmount() sdk.Int {
+	if msg.MinPoolCoinAmount.IsNil() {
+		return sdk.ZeroInt()
+	}
+	return msg.MinPoolCoinAmount
+}
+
 // NewMsgWithdrawWithinBatch creates a new MsgWithdrawWithinBatch.
 func NewMsgWithdrawWithinBatch(withdrawer sdk.AccAddress, poolID uint64, poolCoin sdk.Coin) *MsgWithdrawWithinBatch {
 	return &MsgWithdrawWithinBatch{
@@ -142,6 +250,17 @@ func (msg MsgWithdrawWithinBatch) ValidateBasic() error {
 	if !msg.PoolCoin.IsPositive() {
 		return ErrBadPoolCoinAmount
 	}
+	if err := msg.MinWithdrawCoins.Validate(); err != nil {
+		return err
+	}
+	if uint32(len(msg.MinWithdrawCoins)) > MaxReserveCoinNum {
+		return ErrNumOfReserveCoin
+	}
+	if msg.TargetDenom != "" {
+		if err := sdk.ValidateDenom(msg.TargetDenom); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -228,3 +347,346 @@ func (msg MsgSwapWithinBatch) GetSwapRequester() sdk.AccAddress {
 	}
 	return addr
 }
+
+// NewMsgSwapRoute creates a new MsgSwapRoute.
+func NewMsgSwapRoute(
+	swapRequester sdk.AccAddress,
+	poolIDs []uint64,
+	offerCoin sdk.Coin,
+	minDemandCoin sdk.Coin,
+	swapFeeRate sdk.Dec,
+) *MsgSwapRoute {
+	return &MsgSwapRoute{
+		SwapRequesterAddress: swapRequester.String(),
+		PoolIds:              poolIDs,
+		OfferCoin:            offerCoin,
+		OfferCoinFee:         GetOfferCoinFee(offerCoin, swapFeeRate),
+		MinDemandCoin:        minDemandCoin,
+	}
+}
+
+func (msg MsgSwapRoute) Route() string { return RouterKey }
+
+func (msg MsgSwapRoute) Type() string { return TypeMsgSwapRoute }
+
+func (msg MsgSwapRoute) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.SwapRequesterAddress); err != nil {
+		return ErrInvalidSwapRequesterAddr
+	}
+	if len(msg.PoolIds) < 2 || len(msg.PoolIds) > MaxSwapRoutePoolNum {
+		return ErrBadSwapRoute
+	}
+	poolIDs := make(map[uint64]bool)
+	for _, poolID := range msg.PoolIds {
+		if poolIDs[poolID] {
+			return ErrBadSwapRoute
+		}
+		poolIDs[poolID] = true
+	}
+	if err := msg.OfferCoin.Validate(); err != nil {
+		return err
+	}
+	if !msg.OfferCoin.IsPositive() {
+		return ErrBadOfferCoinAmount
+	}
+	if !msg.OfferCoin.Amount.GTE(MinOfferCoinAmount) {
+		return ErrLessThanMinOfferAmount
+	}
+	if err := msg.OfferCoinFee.Validate(); err != nil {
+		return err
+	}
+	if msg.OfferCoinFee.Denom != msg.OfferCoin.Denom {
+		return ErrBadOfferCoinFee
+	}
+	if err := msg.MinDemandCoin.Validate(); err != nil {
+		return err
+	}
+	if msg.MinDemandCoin.Denom == msg.OfferCoin.Denom {
+		return ErrBadSwapRoute
+	}
+	return nil
+}
+
+func (msg MsgSwapRoute) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSwapRoute) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.SwapRequesterAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgSwapRoute) GetSwapRequester() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.SwapRequesterAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgCancelDeposit creates a new MsgCancelDeposit.
+func NewMsgCancelDeposit(depositor sdk.AccAddress, poolID, msgIndex uint64) *MsgCancelDeposit {
+	return &MsgCancelDeposit{
+		DepositorAddress: depositor.String(),
+		PoolId:           poolID,
+		MsgIndex:         msgIndex,
+	}
+}
+
+func (msg MsgCancelDeposit) Route() string { return RouterKey }
+
+func (msg MsgCancelDeposit) Type() string { return TypeMsgCancelDeposit }
+
+func (msg MsgCancelDeposit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DepositorAddress); err != nil {
+		return ErrInvalidDepositorAddr
+	}
+	if msg.MsgIndex == 0 {
+		return ErrBadBatchMsgIndex
+	}
+	return nil
+}
+
+func (msg MsgCancelDeposit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCancelDeposit) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.DepositorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgCancelDeposit) GetDepositor() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.DepositorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgCancelWithdraw creates a new MsgCancelWithdraw.
+func NewMsgCancelWithdraw(withdrawer sdk.AccAddress, poolID, msgIndex uint64) *MsgCancelWithdraw {
+	return &MsgCancelWithdraw{
+		WithdrawerAddress: withdrawer.String(),
+		PoolId:            poolID,
+		MsgIndex:          msgIndex,
+	}
+}
+
+func (msg MsgCancelWithdraw) Route() string { return RouterKey }
+
+func (msg MsgCancelWithdraw) Type() string { return TypeMsgCancelWithdraw }
+
+func (msg MsgCancelWithdraw) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.WithdrawerAddress); err != nil {
+		return ErrInvalidWithdrawerAddr
+	}
+	if msg.MsgIndex == 0 {
+		return ErrBadBatchMsgIndex
+	}
+	return nil
+}
+
+func (msg MsgCancelWithdraw) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCancelWithdraw) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.WithdrawerAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgCancelWithdraw) GetWithdrawer() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.WithdrawerAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgCancelSwap creates a new MsgCancelSwap.
+func NewMsgCancelSwap(swapRequester sdk.AccAddress, poolID, msgIndex uint64) *MsgCancelSwap {
+	return &MsgCancelSwap{
+		SwapRequesterAddress: swapRequester.String(),
+		PoolId:               poolID,
+		MsgIndex:             msgIndex,
+	}
+}
+
+func (msg MsgCancelSwap) Route() string { return RouterKey }
+
+func (msg MsgCancelSwap) Type() string { return TypeMsgCancelSwap }
+
+func (msg MsgCancelSwap) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.SwapRequesterAddress); err != nil {
+		return ErrInvalidSwapRequesterAddr
+	}
+	if msg.MsgIndex == 0 {
+		return ErrBadBatchMsgIndex
+	}
+	return nil
+}
+
+func (msg MsgCancelSwap) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCancelSwap) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.SwapRequesterAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgCancelSwap) GetSwapRequester() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.SwapRequesterAddress)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgSetPoolStatus creates a new MsgSetPoolStatus.
+func NewMsgSetPoolStatus(authority sdk.AccAddress, poolID uint64, status PoolStatus) *MsgSetPoolStatus {
+	return &MsgSetPoolStatus{
+		Authority: authority.String(),
+		PoolId:    poolID,
+		Status:    status,
+	}
+}
+
+func (msg MsgSetPoolStatus) Route() string { return RouterKey }
+
+func (msg MsgSetPoolStatus) Type() string { return TypeMsgSetPoolStatus }
+
+func (msg MsgSetPoolStatus) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return ErrInvalidAuthority
+	}
+	if msg.PoolId == 0 {
+		return ErrPoolNotExists
+	}
+	if msg.Status == PoolStatusWindDown {
+		return sdkerrors.Wrap(ErrBadPoolStatus, "pool is wound down only by MsgWindDownPool")
+	}
+	return ValidatePoolStatus(msg.Status)
+}
+
+func (msg MsgSetPoolStatus) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetPoolStatus) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgSetCircuitBreaker creates a new MsgSetCircuitBreaker, the pool id of zero is for all pools.
+func NewMsgSetCircuitBreaker(authority sdk.AccAddress, poolID uint64, enabled bool) *MsgSetCircuitBreaker {
+	return &MsgSetCircuitBreaker{
+		Authority: authority.String(),
+		PoolId:    poolID,
+		Enabled:   enabled,
+	}
+}
+
+func (msg MsgSetCircuitBreaker) Route() string { return RouterKey }
+
+func (msg MsgSetCircuitBreaker) Type() string { return TypeMsgSetCircuitBreaker }
+
+func (msg MsgSetCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return ErrInvalidAuthority
+	}
+	return nil
+}
+
+func (msg MsgSetCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetCircuitBreaker) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgUpdateParams creates a new MsgUpdateParams.
+func NewMsgUpdateParams(authority sdk.AccAddress, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority.String(),
+		Params:    params,
+	}
+}
+
+func (msg MsgUpdateParams) Route() string { return RouterKey }
+
+func (msg MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return ErrInvalidAuthority
+	}
+	if err := msg.Params.Validate(); err != nil {
+		return sdkerrors.Wrap(ErrBadParams, err.Error())
+	}
+	return nil
+}
+
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgWindDownPool creates a new MsgWindDownPool.
+func NewMsgWindDownPool(authority sdk.AccAddress, poolID uint64) *MsgWindDownPool {
+	return &MsgWindDownPool{
+		Authority: authority.String(),
+		PoolId:    poolID,
+	}
+}
+
+func (msg MsgWindDownPool) Route() string { return RouterKey }
+
+func (msg MsgWindDownPool) Type() string { return TypeMsgWindDownPool }
+
+func (msg MsgWindDownPool) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return ErrInvalidAuthority
+	}
+	return nil
+}
+
+func (msg MsgWindDownPool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgWindDownPool) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
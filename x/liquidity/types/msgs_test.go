@@ -1,9 +1,12 @@
 package types_test
 
 import (
+	"fmt"
+
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/crypto"
 
@@ -20,6 +23,21 @@ const (
 
 func TestMsgCreatePool(t *testing.T) {
 	poolCreator := sdk.AccAddress(crypto.AddressHash([]byte("testAccount")))
+	multiAssetCoins := sdk.NewCoins(sdk.NewCoin(DenomX, sdk.NewInt(1000)), sdk.NewCoin(DenomY, sdk.NewInt(1000)), sdk.NewCoin("denomZ", sdk.NewInt(1000)))
+	tooManyCoins := sdk.NewCoins()
+	for i := 0; i <= int(types.MaxReserveCoinNum); i++ {
+		tooManyCoins = tooManyCoins.Add(sdk.NewCoin(fmt.Sprintf("denom%d", i), sdk.NewInt(1000)))
+	}
+	weightedMsg := func(reserveCoinWeights ...uint32) *types.MsgCreatePool {
+		msg := types.NewMsgCreatePool(poolCreator, DefaultPoolTypeId, sdk.NewCoins(sdk.NewCoin(DenomX, sdk.NewInt(4000)), sdk.NewCoin(DenomY, sdk.NewInt(1000))))
+		msg.ReserveCoinWeights = reserveCoinWeights
+		return msg
+	}
+	swapFeeRateMsg := func(swapFeeRate sdk.Dec) *types.MsgCreatePool {
+		msg := types.NewMsgCreatePool(poolCreator, DefaultPoolTypeId, sdk.NewCoins(sdk.NewCoin(DenomX, sdk.NewInt(1000)), sdk.NewCoin(DenomY, sdk.NewInt(1000))))
+		msg.SwapFeeRate = swapFeeRate
+		return msg
+	}
 
 	cases := []struct {
 		expectedErr string // empty means no error expected
@@ -41,9 +59,45 @@ func TestMsgCreatePool(t *testing.T) {
 			"invalid number of reserve coin",
 			types.NewMsgCreatePool(poolCreator, DefaultPoolTypeId, sdk.NewCoins(sdk.NewCoin(DenomY, sdk.NewInt(1000)))),
 		},
+		{
+			"",
+			types.NewMsgCreatePool(poolCreator, types.MultiAssetPoolTypeID, multiAssetCoins),
+		},
 		{
 			"invalid number of reserve coin",
-			types.NewMsgCreatePool(poolCreator, DefaultPoolTypeId, sdk.NewCoins(sdk.NewCoin(DenomX, sdk.NewInt(1000)), sdk.NewCoin(DenomY, sdk.NewInt(1000)), sdk.NewCoin("denomZ", sdk.NewInt(1000)))),
+			types.NewMsgCreatePool(poolCreator, types.MultiAssetPoolTypeID, tooManyCoins),
+		},
+		{
+			"",
+			weightedMsg(80, 20),
+		},
+		{
+			"invalid reserve coin weights of the pool",
+			weightedMsg(100),
+		},
+		{
+			"invalid reserve coin weights of the pool",
+			weightedMsg(100, 0),
+		},
+		{
+			"invalid reserve coin weights of the pool",
+			weightedMsg(80, 30),
+		},
+		{
+			"invalid reserve coin weights of the pool",
+			weightedMsg(50, 50),
+		},
+		{
+			"",
+			swapFeeRateMsg(sdk.NewDecWithPrec(1, 2)),
+		},
+		{
+			"swap fee rate is not one of the swap fee tiers of the params",
+			swapFeeRateMsg(sdk.NewDec(-1)),
+		},
+		{
+			"swap fee rate is not one of the swap fee tiers of the params",
+			swapFeeRateMsg(sdk.NewDec(2)),
 		},
 	}
 
@@ -88,6 +142,23 @@ func TestMsgDepositWithinBatch(t *testing.T) {
 			"invalid number of reserve coin",
 			types.NewMsgDepositWithinBatch(depositor, DefaultPoolId, sdk.NewCoins(sdk.NewCoin(DenomY, sdk.NewInt(1000)))),
 		},
+		{
+			"",
+			&types.MsgDepositWithinBatch{
+				DepositorAddress: depositor.String(),
+				PoolId:           DefaultPoolId,
+				DepositCoins:     sdk.NewCoins(sdk.NewCoin(DenomX, sdk.NewInt(1000)), sdk.NewCoin(DenomY, sdk.NewInt(1000))),
+			},
+		},
+		{
+			"invalid pool coin amount",
+			&types.MsgDepositWithinBatch{
+				DepositorAddress:  depositor.String(),
+				PoolId:            DefaultPoolId,
+				DepositCoins:      sdk.NewCoins(sdk.NewCoin(DenomX, sdk.NewInt(1000)), sdk.NewCoin(DenomY, sdk.NewInt(1000))),
+				MinPoolCoinAmount: sdk.NewInt(-1),
+			},
+		},
 	}
 
 	for _, tc := range cases {
@@ -108,6 +179,62 @@ func TestMsgDepositWithinBatch(t *testing.T) {
 	}
 }
 
+func TestMsgDepositSingleAssetWithinBatch(t *testing.T) {
+	depositor := sdk.AccAddress(crypto.AddressHash([]byte("testAccount")))
+
+	cases := []struct {
+		expectedErr string // empty means no error expected
+		msg         *types.MsgDepositSingleAssetWithinBatch
+	}{
+		{
+			"",
+			types.NewMsgDepositSingleAssetWithinBatch(depositor, DefaultPoolId, sdk.NewCoin(DenomX, sdk.NewInt(1000))),
+		},
+		{
+			"invalid pool depositor address",
+			types.NewMsgDepositSingleAssetWithinBatch(sdk.AccAddress{}, DefaultPoolId, sdk.NewCoin(DenomX, sdk.NewInt(1000))),
+		},
+		{
+			"invalid deposit coins amount",
+			types.NewMsgDepositSingleAssetWithinBatch(depositor, DefaultPoolId, sdk.NewCoin(DenomX, sdk.ZeroInt())),
+		},
+		{
+			"",
+			&types.MsgDepositSingleAssetWithinBatch{
+				DepositorAddress: depositor.String(),
+				PoolId:           DefaultPoolId,
+				DepositCoin:      sdk.NewCoin(DenomX, sdk.NewInt(1000)),
+			},
+		},
+		{
+			"invalid pool coin amount",
+			&types.MsgDepositSingleAssetWithinBatch{
+				DepositorAddress:  depositor.String(),
+				PoolId:            DefaultPoolId,
+				DepositCoin:       sdk.NewCoin(DenomX, sdk.NewInt(1000)),
+				MinPoolCoinAmount: sdk.NewInt(-1),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		require.IsType(t, &types.MsgDepositSingleAssetWithinBatch{}, tc.msg)
+		require.Equal(t, types.TypeMsgDepositSingleAssetWithinBatch, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetDepositor(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
 func TestMsgWithdrawWithinBatch(t *testing.T) {
 	withdrawer := sdk.AccAddress(crypto.AddressHash([]byte("testAccount")))
 	poolCoinDenom := "poolCoinDenom"
@@ -128,6 +255,42 @@ func TestMsgWithdrawWithinBatch(t *testing.T) {
 			"invalid pool coin amount",
 			types.NewMsgWithdrawWithinBatch(withdrawer, DefaultPoolId, sdk.NewCoin(poolCoinDenom, sdk.NewInt(0))),
 		},
+		{
+			"",
+			&types.MsgWithdrawWithinBatch{
+				WithdrawerAddress: withdrawer.String(),
+				PoolId:            DefaultPoolId,
+				PoolCoin:          sdk.NewCoin(poolCoinDenom, sdk.NewInt(1000)),
+				MinWithdrawCoins:  sdk.NewCoins(sdk.NewCoin(DenomX, sdk.NewInt(100)), sdk.NewCoin(DenomY, sdk.NewInt(100))),
+			},
+		},
+		{
+			"coin 0denomX amount is not positive",
+			&types.MsgWithdrawWithinBatch{
+				WithdrawerAddress: withdrawer.String(),
+				PoolId:            DefaultPoolId,
+				PoolCoin:          sdk.NewCoin(poolCoinDenom, sdk.NewInt(1000)),
+				MinWithdrawCoins:  sdk.Coins{sdk.NewCoin(DenomX, sdk.ZeroInt())},
+			},
+		},
+		{
+			"",
+			&types.MsgWithdrawWithinBatch{
+				WithdrawerAddress: withdrawer.String(),
+				PoolId:            DefaultPoolId,
+				PoolCoin:          sdk.NewCoin(poolCoinDenom, sdk.NewInt(1000)),
+				TargetDenom:       DenomX,
+			},
+		},
+		{
+			"invalid denom: 1denomX",
+			&types.MsgWithdrawWithinBatch{
+				WithdrawerAddress: withdrawer.String(),
+				PoolId:            DefaultPoolId,
+				PoolCoin:          sdk.NewCoin(poolCoinDenom, sdk.NewInt(1000)),
+				TargetDenom:       "1denomX",
+			},
+		},
 	}
 
 	for _, tc := range cases {
@@ -198,19 +361,255 @@ func TestMsgSwapWithinBatch(t *testing.T) {
 	}
 }
 
+func TestMsgSwapRoute(t *testing.T) {
+	swapRequester := sdk.AccAddress(crypto.AddressHash([]byte("testAccount")))
+	offerCoin := sdk.NewCoin(DenomX, sdk.NewInt(1000))
+	minDemandCoin := sdk.NewCoin("denomZ", sdk.NewInt(900))
+
+	cases := []struct {
+		expectedErr string // empty means no error expected
+		msg         *types.MsgSwapRoute
+	}{
+		{
+			"",
+			types.NewMsgSwapRoute(swapRequester, []uint64{1, 2}, offerCoin, minDemandCoin, types.DefaultSwapFeeRate),
+		},
+		{
+			"invalid pool swap requester address",
+			types.NewMsgSwapRoute(sdk.AccAddress{}, []uint64{1, 2}, offerCoin, minDemandCoin, types.DefaultSwapFeeRate),
+		},
+		{
+			"invalid swap route",
+			types.NewMsgSwapRoute(swapRequester, []uint64{1}, offerCoin, minDemandCoin, types.DefaultSwapFeeRate),
+		},
+		{
+			"invalid swap route",
+			types.NewMsgSwapRoute(swapRequester, []uint64{1, 2, 3, 4, 5}, offerCoin, minDemandCoin, types.DefaultSwapFeeRate),
+		},
+		{
+			"invalid swap route",
+			types.NewMsgSwapRoute(swapRequester, []uint64{1, 2, 1}, offerCoin, minDemandCoin, types.DefaultSwapFeeRate),
+		},
+		{
+			"invalid swap route",
+			types.NewMsgSwapRoute(swapRequester, []uint64{1, 2}, offerCoin, sdk.NewCoin(DenomX, sdk.NewInt(900)), types.DefaultSwapFeeRate),
+		},
+		{
+			"invalid offer coin amount",
+			types.NewMsgSwapRoute(swapRequester, []uint64{1, 2}, sdk.NewCoin(DenomX, sdk.NewInt(0)), minDemandCoin, types.DefaultSwapFeeRate),
+		},
+		{
+			"offer amount should be over 100 micro",
+			types.NewMsgSwapRoute(swapRequester, []uint64{1, 2}, sdk.NewCoin(DenomX, sdk.NewInt(1)), minDemandCoin, types.DefaultSwapFeeRate),
+		},
+	}
+
+	for _, tc := range cases {
+		require.IsType(t, &types.MsgSwapRoute{}, tc.msg)
+		require.Equal(t, types.TypeMsgSwapRoute, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, tc.msg.GetSwapRequester(), signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgCancelBatchMsgs(t *testing.T) {
+	requester := sdk.AccAddress(crypto.AddressHash([]byte("testAccount")))
+
+	cases := []struct {
+		expectedErr string // empty means no error expected
+		msg         sdk.Msg
+		msgType     string
+	}{
+		{"", types.NewMsgCancelDeposit(requester, DefaultPoolId, 1), types.TypeMsgCancelDeposit},
+		{"invalid pool depositor address", types.NewMsgCancelDeposit(sdk.AccAddress{}, DefaultPoolId, 1), types.TypeMsgCancelDeposit},
+		{"bad msg index of the batch", types.NewMsgCancelDeposit(requester, DefaultPoolId, 0), types.TypeMsgCancelDeposit},
+		{"", types.NewMsgCancelWithdraw(requester, DefaultPoolId, 1), types.TypeMsgCancelWithdraw},
+		{"invalid pool withdrawer address", types.NewMsgCancelWithdraw(sdk.AccAddress{}, DefaultPoolId, 1), types.TypeMsgCancelWithdraw},
+		{"bad msg index of the batch", types.NewMsgCancelWithdraw(requester, DefaultPoolId, 0), types.TypeMsgCancelWithdraw},
+		{"", types.NewMsgCancelSwap(requester, DefaultPoolId, 1), types.TypeMsgCancelSwap},
+		{"invalid pool swap requester address", types.NewMsgCancelSwap(sdk.AccAddress{}, DefaultPoolId, 1), types.TypeMsgCancelSwap},
+		{"bad msg index of the batch", types.NewMsgCancelSwap(requester, DefaultPoolId, 0), types.TypeMsgCancelSwap},
+	}
+
+	for _, tc := range cases {
+		legacyMsg, ok := tc.msg.(legacytx.LegacyMsg)
+		require.True(t, ok)
+		require.Equal(t, tc.msgType, legacyMsg.Type())
+		require.Equal(t, types.RouterKey, legacyMsg.Route())
+		require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(legacyMsg)), legacyMsg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, requester, signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgSetPoolStatus(t *testing.T) {
+	authority := sdk.AccAddress(crypto.AddressHash([]byte("testAccount")))
+
+	cases := []struct {
+		expectedErr string // empty means no error expected
+		msg         *types.MsgSetPoolStatus
+	}{
+		{"", types.NewMsgSetPoolStatus(authority, DefaultPoolId, types.PoolStatusFrozen)},
+		{"", types.NewMsgSetPoolStatus(authority, DefaultPoolId, types.PoolStatusActive)},
+		{"invalid authority", types.NewMsgSetPoolStatus(sdk.AccAddress{}, DefaultPoolId, types.PoolStatusFrozen)},
+		{"pool not exists", types.NewMsgSetPoolStatus(authority, 0, types.PoolStatusFrozen)},
+		{"pool is wound down only by MsgWindDownPool: invalid status of the pool", types.NewMsgSetPoolStatus(authority, DefaultPoolId, types.PoolStatusWindDown)},
+		{"invalid status of the pool", types.NewMsgSetPoolStatus(authority, DefaultPoolId, types.PoolStatus(5))},
+	}
+
+	for _, tc := range cases {
+		require.IsType(t, &types.MsgSetPoolStatus{}, tc.msg)
+		require.Equal(t, types.TypeMsgSetPoolStatus, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, authority, signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgSetCircuitBreaker(t *testing.T) {
+	authority := sdk.AccAddress(crypto.AddressHash([]byte("testAccount")))
+
+	cases := []struct {
+		expectedErr string // empty means no error expected
+		msg         *types.MsgSetCircuitBreaker
+	}{
+		{"", types.NewMsgSetCircuitBreaker(authority, 0, true)},
+		{"", types.NewMsgSetCircuitBreaker(authority, DefaultPoolId, false)},
+		{"invalid authority", types.NewMsgSetCircuitBreaker(sdk.AccAddress{}, 0, true)},
+	}
+
+	for _, tc := range cases {
+		require.Equal(t, types.TypeMsgSetCircuitBreaker, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, authority, signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgUpdateParams(t *testing.T) {
+	authority := sdk.AccAddress(crypto.AddressHash([]byte("testAccount")))
+	invalidParams := types.DefaultParams()
+	invalidParams.UnitBatchHeight = 0
+
+	cases := []struct {
+		expectedErr string // empty means no error expected
+		msg         *types.MsgUpdateParams
+	}{
+		{"", types.NewMsgUpdateParams(authority, types.DefaultParams())},
+		{"invalid authority", types.NewMsgUpdateParams(sdk.AccAddress{}, types.DefaultParams())},
+		{"unit batch height must be positive: 0: invalid params", types.NewMsgUpdateParams(authority, invalidParams)},
+	}
+
+	for _, tc := range cases {
+		require.Equal(t, types.TypeMsgUpdateParams, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, authority, signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
+func TestMsgWindDownPool(t *testing.T) {
+	authority := sdk.AccAddress(crypto.AddressHash([]byte("testAccount")))
+
+	cases := []struct {
+		expectedErr string // empty means no error expected
+		msg         *types.MsgWindDownPool
+	}{
+		{"", types.NewMsgWindDownPool(authority, DefaultPoolId)},
+		{"invalid authority", types.NewMsgWindDownPool(sdk.AccAddress{}, DefaultPoolId)},
+	}
+
+	for _, tc := range cases {
+		require.Equal(t, types.TypeMsgWindDownPool, tc.msg.Type())
+		require.Equal(t, types.RouterKey, tc.msg.Route())
+		require.Equal(t, sdk.MustSortJSON(types.ModuleCdc.MustMarshalJSON(tc.msg)), tc.msg.GetSignBytes())
+
+		err := tc.msg.ValidateBasic()
+		if tc.expectedErr == "" {
+			require.Nil(t, err)
+			signers := tc.msg.GetSigners()
+			require.Len(t, signers, 1)
+			require.Equal(t, authority, signers[0])
+		} else {
+			require.EqualError(t, err, tc.expectedErr)
+		}
+	}
+}
+
 func TestMsgPanics(t *testing.T) {
 	emptyMsgCreatePool := types.MsgCreatePool{}
 	emptyMsgDeposit := types.MsgDepositWithinBatch{}
+	emptyMsgDepositSingleAsset := types.MsgDepositSingleAssetWithinBatch{}
 	emptyMsgWithdraw := types.MsgWithdrawWithinBatch{}
 	emptyMsgSwap := types.MsgSwapWithinBatch{}
-	for _, msg := range []sdk.Msg{&emptyMsgCreatePool, &emptyMsgDeposit, &emptyMsgWithdraw, &emptyMsgSwap} {
+	emptyMsgSwapRoute := types.MsgSwapRoute{}
+	emptyMsgCancelDeposit := types.MsgCancelDeposit{}
+	emptyMsgCancelWithdraw := types.MsgCancelWithdraw{}
+	emptyMsgCancelSwap := types.MsgCancelSwap{}
+	emptyMsgSetPoolStatus := types.MsgSetPoolStatus{}
+	emptyMsgSetCircuitBreaker := types.MsgSetCircuitBreaker{}
+	emptyMsgUpdateParams := types.MsgUpdateParams{}
+	emptyMsgWindDownPool := types.MsgWindDownPool{}
+	for _, msg := range []sdk.Msg{&emptyMsgCreatePool, &emptyMsgDeposit, &emptyMsgDepositSingleAsset, &emptyMsgWithdraw, &emptyMsgSwap,
+		&emptyMsgSwapRoute, &emptyMsgCancelDeposit, &emptyMsgCancelWithdraw, &emptyMsgCancelSwap, &emptyMsgSetPoolStatus, &emptyMsgSetCircuitBreaker,
+		&emptyMsgUpdateParams, &emptyMsgWindDownPool} {
 		require.PanicsWithError(t, "empty address string is not allowed", func() { msg.GetSigners() })
 	}
 	for _, tc := range []func() sdk.AccAddress{
 		emptyMsgCreatePool.GetPoolCreator,
 		emptyMsgDeposit.GetDepositor,
+		emptyMsgDepositSingleAsset.GetDepositor,
 		emptyMsgWithdraw.GetWithdrawer,
 		emptyMsgSwap.GetSwapRequester,
+		emptyMsgSwapRoute.GetSwapRequester,
+		emptyMsgCancelDeposit.GetDepositor,
+		emptyMsgCancelWithdraw.GetWithdrawer,
+		emptyMsgCancelSwap.GetSwapRequester,
 	} {
 		require.PanicsWithError(t, "empty address string is not allowed", func() { tc() })
 	}
@@ -16,33 +16,89 @@ const (
 	MinReserveCoinNum uint32 = 2
 
 	// MaxReserveCoinNum is the maximum number of reserve coins in each liquidity pool.
-	MaxReserveCoinNum uint32 = 2
+	MaxReserveCoinNum uint32 = 8
+
+	// PairReserveCoinNum is the number of reserve coins of the pool types of a pair of reserve coins.
+	PairReserveCoinNum uint32 = 2
+
+	// MinMultiAssetReserveCoinNum is the minimum number of reserve coins of the multi-asset pool type.
+	MinMultiAssetReserveCoinNum uint32 = 3
+
+	// TotalReserveCoinWeight is the sum of the reserve coin weights of a weighted liquidity pool.
+	TotalReserveCoinWeight uint32 = 100
+
+	// MaxSwapRoutePoolNum is the maximum number of pools in a swap route.
+	MaxSwapRoutePoolNum = 4
+
+	// MaxWindDownPayoutNum is the maximum number of pool coin holders paid out from a winding down pool at each batch
+	// execution height.
+	MaxWindDownPayoutNum = 100
 
 	// DefaultUnitBatchHeight is the default number of blocks in one batch. This param is used for scalability.
 	DefaultUnitBatchHeight uint32 = 1
 
-	// DefaultPoolTypeID is the default pool type id. The only supported pool type id is 1.
+	// DefaultPoolTypeID is the default pool type id of the standard liquidity pool with the constant product curve.
 	DefaultPoolTypeID uint32 = 1
 
+	// StableSwapPoolTypeID is the pool type id of the StableSwap liquidity pool for pegged-asset pairs.
+	StableSwapPoolTypeID uint32 = 2
+
+	// MultiAssetPoolTypeID is the pool type id of the multi-asset liquidity pool of more than two reserve coins.
+	MultiAssetPoolTypeID uint32 = 3
+
 	// DefaultSwapTypeID is the default swap type id. The only supported swap type (instant swap) id is 1.
 	DefaultSwapTypeID uint32 = 1
 
 	// DefaultCircuitBreakerEnabled is the default circuit breaker status. This param is used for a contingency plan.
 	DefaultCircuitBreakerEnabled = false
+
+	// DefaultSwapOrderLifespan is the default number of blocks a swap order is carried over to the next batches.
+	// Zero means that the orders expire at the next batch execution height.
+	DefaultSwapOrderLifespan uint32 = 0
+
+	// DefaultPriceRecordLifespan is the default number of blocks the price records of each pool are kept,
+	// about a day with 6 seconds of block time.
+	DefaultPriceRecordLifespan uint32 = 14400
+
+	// DefaultStableSwapAmplification is the default amplification coefficient of the StableSwap pool type.
+	DefaultStableSwapAmplification uint32 = 100
+
+	// DefaultDepletedPoolLifespan is the default number of blocks a depleted pool is kept before it is pruned.
+	// Zero means that the depleted pools are never pruned.
+	DefaultDepletedPoolLifespan uint32 = 0
+
+	// DefaultMaxBatchInterval is the default maximum number of blocks in a batch of a pool that can be chosen at pool
+	// creation. Zero means that the pools can not choose their batch interval.
+	DefaultMaxBatchInterval uint32 = 100
+
+	// DefaultBatchRecordLifespan is the default number of blocks the results of the executed batches of each pool are
+	// kept, about a day with 6 seconds of block time. Zero means that the results of the batches are not recorded.
+	DefaultBatchRecordLifespan uint32 = 14400
+
+	// MaxStableSwapAmplification is the maximum amplification coefficient of the StableSwap pool type.
+	MaxStableSwapAmplification uint32 = 1_000_000
 )
 
 // Parameter store keys
 var (
-	KeyPoolTypes              = []byte("PoolTypes")
-	KeyMinInitDepositAmount   = []byte("MinInitDepositAmount")
-	KeyInitPoolCoinMintAmount = []byte("InitPoolCoinMintAmount")
-	KeyMaxReserveCoinAmount   = []byte("MaxReserveCoinAmount")
-	KeySwapFeeRate            = []byte("SwapFeeRate")
-	KeyPoolCreationFee        = []byte("PoolCreationFee")
-	KeyUnitBatchHeight        = []byte("UnitBatchHeight")
-	KeyWithdrawFeeRate        = []byte("WithdrawFeeRate")
-	KeyMaxOrderAmountRatio    = []byte("MaxOrderAmountRatio")
-	KeyCircuitBreakerEnabled  = []byte("CircuitBreakerEnabled")
+	KeyPoolTypes               = []byte("PoolTypes")
+	KeyMinInitDepositAmount    = []byte("MinInitDepositAmount")
+	KeyInitPoolCoinMintAmount  = []byte("InitPoolCoinMintAmount")
+	KeyMaxReserveCoinAmount    = []byte("MaxReserveCoinAmount")
+	KeySwapFeeRate             = []byte("SwapFeeRate")
+	KeyPoolCreationFee         = []byte("PoolCreationFee")
+	KeyUnitBatchHeight         = []byte("UnitBatchHeight")
+	KeyWithdrawFeeRate         = []byte("WithdrawFeeRate")
+	KeyMaxOrderAmountRatio     = []byte("MaxOrderAmountRatio")
+	KeyCircuitBreakerEnabled   = []byte("CircuitBreakerEnabled")
+	KeySwapOrderLifespan       = []byte("SwapOrderLifespan")
+	KeyPriceRecordLifespan     = []byte("PriceRecordLifespan")
+	KeyStableSwapAmplification = []byte("StableSwapAmplification")
+	KeySwapFeeTiers            = []byte("SwapFeeTiers")
+	KeyGuardians               = []byte("Guardians")
+	KeyDepletedPoolLifespan    = []byte("DepletedPoolLifespan")
+	KeyMaxBatchInterval        = []byte("MaxBatchInterval")
+	KeyBatchRecordLifespan     = []byte("BatchRecordLifespan")
 )
 
 var (
@@ -56,11 +112,33 @@ var (
 	DefaultPoolType               = PoolType{
 		Id:                1,
 		Name:              "StandardLiquidityPool",
-		MinReserveCoinNum: MinReserveCoinNum,
-		MaxReserveCoinNum: MaxReserveCoinNum,
+		MinReserveCoinNum: PairReserveCoinNum,
+		MaxReserveCoinNum: PairReserveCoinNum,
 		Description:       "Standard liquidity pool with pool price function X/Y, ESPM constraint, and two kinds of reserve coins",
 	}
-	DefaultPoolTypes = []PoolType{DefaultPoolType}
+	StableSwapPoolType = PoolType{
+		Id:                2,
+		Name:              "StableSwapLiquidityPool",
+		MinReserveCoinNum: PairReserveCoinNum,
+		MaxReserveCoinNum: PairReserveCoinNum,
+		Description:       "StableSwap liquidity pool with the StableSwap invariant of the amplification param, ESPM constraint, and two kinds of reserve coins",
+	}
+	MultiAssetPoolType = PoolType{
+		Id:                3,
+		Name:              "MultiAssetLiquidityPool",
+		MinReserveCoinNum: MinMultiAssetReserveCoinNum,
+		MaxReserveCoinNum: MaxReserveCoinNum,
+		Description:       "Multi-asset liquidity pool with the weighted geometric mean invariant, ESPM constraint, and three to eight kinds of reserve coins",
+	}
+	DefaultPoolTypes = []PoolType{DefaultPoolType, StableSwapPoolType, MultiAssetPoolType}
+	// DefaultSwapFeeTiers are the default swap fee rates that can be chosen for a pool, for pegged-asset pairs,
+	// standard pairs and volatile long-tail pairs.
+	DefaultSwapFeeTiers = []sdk.Dec{
+		sdk.NewDecWithPrec(5, 4), // "0.000500000000000000"
+		sdk.NewDecWithPrec(3, 3), // "0.003000000000000000"
+		sdk.NewDecWithPrec(1, 2), // "0.010000000000000000"
+	}
+	DefaultGuardians []string
 
 	MinOfferCoinAmount = sdk.NewInt(100)
 )
@@ -75,16 +153,24 @@ func ParamKeyTable() paramstypes.KeyTable {
 // DefaultParams returns the default liquidity module parameters.
 func DefaultParams() Params {
 	return Params{
-		PoolTypes:              DefaultPoolTypes,
-		MinInitDepositAmount:   DefaultMinInitDepositAmount,
-		InitPoolCoinMintAmount: DefaultInitPoolCoinMintAmount,
-		MaxReserveCoinAmount:   DefaultMaxReserveCoinAmount,
-		PoolCreationFee:        DefaultPoolCreationFee,
-		SwapFeeRate:            DefaultSwapFeeRate,
-		WithdrawFeeRate:        DefaultWithdrawFeeRate,
-		MaxOrderAmountRatio:    DefaultMaxOrderAmountRatio,
-		UnitBatchHeight:        DefaultUnitBatchHeight,
-		CircuitBreakerEnabled:  DefaultCircuitBreakerEnabled,
+		PoolTypes:               DefaultPoolTypes,
+		MinInitDepositAmount:    DefaultMinInitDepositAmount,
+		InitPoolCoinMintAmount:  DefaultInitPoolCoinMintAmount,
+		MaxReserveCoinAmount:    DefaultMaxReserveCoinAmount,
+		PoolCreationFee:         DefaultPoolCreationFee,
+		SwapFeeRate:             DefaultSwapFeeRate,
+		WithdrawFeeRate:         DefaultWithdrawFeeRate,
+		MaxOrderAmountRatio:     DefaultMaxOrderAmountRatio,
+		UnitBatchHeight:         DefaultUnitBatchHeight,
+		CircuitBreakerEnabled:   DefaultCircuitBreakerEnabled,
+		SwapOrderLifespan:       DefaultSwapOrderLifespan,
+		PriceRecordLifespan:     DefaultPriceRecordLifespan,
+		StableSwapAmplification: DefaultStableSwapAmplification,
+		SwapFeeTiers:            DefaultSwapFeeTiers,
+		Guardians:               DefaultGuardians,
+		DepletedPoolLifespan:    DefaultDepletedPoolLifespan,
+		MaxBatchInterval:        DefaultMaxBatchInterval,
+		BatchRecordLifespan:     DefaultBatchRecordLifespan,
 	}
 }
 
@@ -101,9 +187,27 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 		paramstypes.NewParamSetPair(KeyMaxOrderAmountRatio, &p.MaxOrderAmountRatio, validateMaxOrderAmountRatio),
 		paramstypes.NewParamSetPair(KeyUnitBatchHeight, &p.UnitBatchHeight, validateUnitBatchHeight),
 		paramstypes.NewParamSetPair(KeyCircuitBreakerEnabled, &p.CircuitBreakerEnabled, validateCircuitBreakerEnabled),
+		paramstypes.NewParamSetPair(KeySwapOrderLifespan, &p.SwapOrderLifespan, validateSwapOrderLifespan),
+		paramstypes.NewParamSetPair(KeyPriceRecordLifespan, &p.PriceRecordLifespan, validatePriceRecordLifespan),
+		paramstypes.NewParamSetPair(KeyStableSwapAmplification, &p.StableSwapAmplification, validateStableSwapAmplification),
+		paramstypes.NewParamSetPair(KeySwapFeeTiers, &p.SwapFeeTiers, validateSwapFeeTiers),
+		paramstypes.NewParamSetPair(KeyGuardians, &p.Guardians, validateGuardians),
+		paramstypes.NewParamSetPair(KeyDepletedPoolLifespan, &p.DepletedPoolLifespan, validateDepletedPoolLifespan),
+		paramstypes.NewParamSetPair(KeyMaxBatchInterval, &p.MaxBatchInterval, validateMaxBatchInterval),
+		paramstypes.NewParamSetPair(KeyBatchRecordLifespan, &p.BatchRecordLifespan, validateBatchRecordLifespan),
 	}
 }
 
+// IsGuardian returns true if the address is one of the guardians.
+func (p Params) IsGuardian(addr string) bool {
+	for _, guardian := range p.Guardians {
+		if guardian == addr {
+			return true
+		}
+	}
+	return false
+}
+
 // String returns a human readable string representation of the parameters.
 func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
@@ -126,11 +230,24 @@ func (p Params) Validate() error {
 		{p.MaxOrderAmountRatio, validateMaxOrderAmountRatio},
 		{p.UnitBatchHeight, validateUnitBatchHeight},
 		{p.CircuitBreakerEnabled, validateCircuitBreakerEnabled},
+		{p.SwapOrderLifespan, validateSwapOrderLifespan},
+		{p.PriceRecordLifespan, validatePriceRecordLifespan},
+		{p.StableSwapAmplification, validateStableSwapAmplification},
+		{p.SwapFeeTiers, validateSwapFeeTiers},
+		{p.Guardians, validateGuardians},
+		{p.DepletedPoolLifespan, validateDepletedPoolLifespan},
+		{p.MaxBatchInterval, validateMaxBatchInterval},
+		{p.BatchRecordLifespan, validateBatchRecordLifespan},
 	} {
 		if err := v.validator(v.value); err != nil {
 			return err
 		}
 	}
+
+	// a swap order can not expire before the first batch it can join at the unit batch height
+	if p.SwapOrderLifespan > 0 && p.SwapOrderLifespan < p.UnitBatchHeight {
+		return fmt.Errorf("swap order lifespan %d must not be less than unit batch height %d", p.SwapOrderLifespan, p.UnitBatchHeight)
+	}
 	return nil
 }
 
@@ -153,8 +270,12 @@ func validatePoolTypes(i interface{}) error {
 		}
 	}
 
-	if len(v) > 1 || !v[0].Equal(DefaultPoolType) {
-		return fmt.Errorf("the only supported pool type is 1")
+	// the built-in pool types must not be modified, the pool types after them are custom pool types
+	// of which the curves are registered to the keeper
+	for i, p := range v {
+		if i < len(DefaultPoolTypes) && !p.Equal(DefaultPoolTypes[i]) {
+			return fmt.Errorf("built-in pool type %d must not be modified", p.Id)
+		}
 	}
 
 	return nil
@@ -319,3 +440,113 @@ func validateCircuitBreakerEnabled(i interface{}) error {
 
 	return nil
 }
+
+func validateSwapOrderLifespan(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validatePriceRecordLifespan(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateStableSwapAmplification(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("stable swap amplification must be positive: %d", v)
+	}
+
+	if v > MaxStableSwapAmplification {
+		return fmt.Errorf("stable swap amplification too large: %d", v)
+	}
+
+	return nil
+}
+
+func validateSwapFeeTiers(i interface{}) error {
+	v, ok := i.([]sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for i, tier := range v {
+		if tier.IsNil() {
+			return fmt.Errorf("swap fee tier must not be nil")
+		}
+
+		if !tier.IsPositive() {
+			return fmt.Errorf("swap fee tier must be positive: %s", tier)
+		}
+
+		if tier.GT(sdk.OneDec()) {
+			return fmt.Errorf("swap fee tier too large: %s", tier)
+		}
+
+		if i > 0 && !tier.GT(v[i-1]) {
+			return fmt.Errorf("swap fee tiers must be sorted in ascending order without duplicates: %s", tier)
+		}
+	}
+
+	return nil
+}
+
+func validateGuardians(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, guardian := range v {
+		if _, err := sdk.AccAddressFromBech32(guardian); err != nil {
+			return fmt.Errorf("invalid guardian address %s: %w", guardian, err)
+		}
+
+		if seen[guardian] {
+			return fmt.Errorf("duplicate guardian address: %s", guardian)
+		}
+		seen[guardian] = true
+	}
+
+	return nil
+}
+
+func validateDepletedPoolLifespan(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxBatchInterval(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateBatchRecordLifespan(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -0,0 +1,1530 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tendermint/liquidity/v1beta1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Structure for the pool type to distinguish the characteristics of the reserve pools.
+type PoolType struct {
+	// This is the id of the pool_type that is used as pool_type_id for pool creation.
+	// In this version, pool-type-id 1 (constant product), 2 (StableSwap) and 3 (multi-asset) are supported.
+	// {"id":1,"name":"ConstantProductLiquidityPool","min_reserve_coin_num":2,"max_reserve_coin_num":2,"description":""}
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
+	// name of the pool type.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	// minimum number of reserveCoins for LiquidityPoolType, from 2 to 8 reserve coins are supported.
+	MinReserveCoinNum uint32 `protobuf:"varint,3,opt,name=min_reserve_coin_num,json=minReserveCoinNum,proto3" json:"min_reserve_coin_num,omitempty" yaml:"min_reserve_coin_num"`
+	// maximum number of reserveCoins for LiquidityPoolType, from 2 to 8 reserve coins are supported.
+	MaxReserveCoinNum uint32 `protobuf:"varint,4,opt,name=max_reserve_coin_num,json=maxReserveCoinNum,proto3" json:"max_reserve_coin_num,omitempty" yaml:"max_reserve_coin_num"`
+	// description of the pool type.
+	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+}
+
+func (m *PoolType) Reset()         { *m = PoolType{} }
+func (m *PoolType) String() string { return proto.CompactTextString(m) }
+func (*PoolType) ProtoMessage()    {}
+func (*PoolType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2984c40ecb5fba5, []int{0}
+}
+func (m *PoolType) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolType.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolType.Merge(m, src)
+}
+func (m *PoolType) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolType) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolType.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolType proto.InternalMessageInfo
+
+// Params defines the parameters for the liquidity module.
+type Params struct {
+	// list of available pool types
+	PoolTypes []PoolType `protobuf:"bytes,1,rep,name=pool_types,json=poolTypes,proto3" json:"pool_types" yaml:"pool_types"`
+	// Minimum number of coins to be deposited to the liquidity pool on pool creation.
+	MinInitDepositAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=min_init_deposit_amount,json=minInitDepositAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_init_deposit_amount" yaml:"min_init_deposit_amount"`
+	// Initial mint amount of pool coins upon pool creation.
+	InitPoolCoinMintAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=init_pool_coin_mint_amount,json=initPoolCoinMintAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"init_pool_coin_mint_amount" yaml:"init_pool_coin_mint_amount"`
+	// Limit the size of each liquidity pool to minimize risk. In development, set to 0 for no limit. In production, set a limit.
+	MaxReserveCoinAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=max_reserve_coin_amount,json=maxReserveCoinAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_reserve_coin_amount" yaml:"max_reserve_coin_amount"`
+	// Fee paid to create a Liquidity Pool. Set a fee to prevent spamming.
+	PoolCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=pool_creation_fee,json=poolCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool_creation_fee" yaml:"pool_creation_fee"`
+	// Swap fee rate for every executed swap of the pools without the swap fee rate of the pool.
+	SwapFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=swap_fee_rate,json=swapFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee_rate" yaml:"swap_fee_rate"`
+	// Reserve coin withdrawal with less proportion by withdrawFeeRate.
+	WithdrawFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=withdraw_fee_rate,json=withdrawFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"withdraw_fee_rate" yaml:"withdraw_fee_rate"`
+	// Maximum ratio of reserve coins that can be ordered at a swap order.
+	MaxOrderAmountRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=max_order_amount_ratio,json=maxOrderAmountRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_order_amount_ratio" yaml:"max_order_amount_ratio"`
+	// The smallest unit batch height for every liquidity pool.
+	UnitBatchHeight uint32 `protobuf:"varint,9,opt,name=unit_batch_height,json=unitBatchHeight,proto3" json:"unit_batch_height,omitempty" yaml:"unit_batch_height"`
+	// Circuit breaker enables or disables transaction messages in liquidity module.
+	CircuitBreakerEnabled bool `protobuf:"varint,10,opt,name=circuit_breaker_enabled,json=circuitBreakerEnabled,proto3" json:"circuit_breaker_enabled,omitempty" yaml:"circuit_breaker_enabled"`
+	// Number of blocks a swap order stays in the batches of the pool before it expires. The remaining offer coin of a
+	// partially matched order is carried over to the next batch until then. Zero expires orders at the next batch execution.
+	SwapOrderLifespan uint32 `protobuf:"varint,11,opt,name=swap_order_lifespan,json=swapOrderLifespan,proto3" json:"swap_order_lifespan,omitempty" yaml:"swap_order_lifespan"`
+	// Number of blocks the price records of each pool are kept for the time-weighted average price. The last price
+	// record of each pool is always kept as the base of the price accumulator.
+	PriceRecordLifespan uint32 `protobuf:"varint,12,opt,name=price_record_lifespan,json=priceRecordLifespan,proto3" json:"price_record_lifespan,omitempty" yaml:"price_record_lifespan"`
+	// Amplification coefficient of the StableSwap invariant of the StableSwap pool type. The higher it is, the closer
+	// to 1 the pool price stays while the reserves are imbalanced.
+	StableSwapAmplification uint32 `protobuf:"varint,13,opt,name=stable_swap_amplification,json=stableSwapAmplification,proto3" json:"stable_swap_amplification,omitempty" yaml:"stable_swap_amplification"`
+	// List of swap fee rates approved by governance, one of which can be chosen as the swap fee rate of a pool at
+	// pool creation.
+	SwapFeeTiers []github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,rep,name=swap_fee_tiers,json=swapFeeTiers,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee_tiers" yaml:"swap_fee_tiers"`
+	// List of guardian addresses which can make the status of a pool more restrictive without a governance proposal.
+	Guardians []string `protobuf:"bytes,15,rep,name=guardians,proto3" json:"guardians,omitempty" yaml:"guardians"`
+	// Number of blocks a depleted pool without pool coin supply is kept before it is pruned from the state together
+	// with its batch. Zero means that the depleted pools are never pruned.
+	DepletedPoolLifespan uint32 `protobuf:"varint,16,opt,name=depleted_pool_lifespan,json=depletedPoolLifespan,proto3" json:"depleted_pool_lifespan,omitempty" yaml:"depleted_pool_lifespan"`
+	// Maximum number of blocks in a batch of a pool that can be chosen at pool creation, instead of the unit batch
+	// height. Zero means that the pools can not choose their batch interval.
+	MaxBatchInterval uint32 `protobuf:"varint,17,opt,name=max_batch_interval,json=maxBatchInterval,proto3" json:"max_batch_interval,omitempty" yaml:"max_batch_interval"`
+	// Number of blocks the results of the executed batches of each pool are kept. Zero means that the results of the
+	// batches are not recorded.
+	BatchRecordLifespan uint32 `protobuf:"varint,18,opt,name=batch_record_lifespan,json=batchRecordLifespan,proto3" json:"batch_record_lifespan,omitempty" yaml:"batch_record_lifespan"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2984c40ecb5fba5, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*PoolType)(nil), "tendermint.liquidity.v1beta1.PoolType")
+	proto.RegisterType((*Params)(nil), "tendermint.liquidity.v1beta1.Params")
+}
+
+func init() {
+	proto.RegisterFile("tendermint/liquidity/v1beta1/params.proto", fileDescriptor_e2984c40ecb5fba5)
+}
+
+var fileDescriptor_e2984c40ecb5fba5 = []byte{
+	// 1317 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x73, 0xdb, 0xc4,
+	0x1b, 0x8e, 0x9c, 0x34, 0x89, 0x95, 0xb6, 0x89, 0x95, 0x2f, 0x25, 0x6d, 0x6d, 0xff, 0xf6, 0xd0,
+	0x5f, 0x18, 0x1a, 0x7f, 0xc4, 0x0d, 0x6d, 0x73, 0x93, 0x5b, 0x32, 0xd4, 0x53, 0xa0, 0x88, 0x5e,
+	0x20, 0x14, 0x77, 0x2d, 0x6d, 0xec, 0x25, 0xd2, 0xae, 0x2a, 0xad, 0x1c, 0x9b, 0x0e, 0x33, 0x1c,
+	0x7b, 0xe0, 0xc0, 0xf8, 0x02, 0xc3, 0x85, 0x4e, 0x66, 0x18, 0x66, 0xf8, 0x47, 0xe8, 0xb1, 0x47,
+	0x86, 0x83, 0x81, 0xf6, 0xc2, 0xd9, 0x7f, 0x01, 0xb3, 0xbb, 0x92, 0x3f, 0x12, 0x07, 0x0a, 0x13,
+	0x5f, 0x24, 0xbf, 0x1f, 0xfb, 0x3c, 0xef, 0xfb, 0x3e, 0xaf, 0x24, 0xf5, 0x0d, 0x86, 0x88, 0x8d,
+	0x7c, 0x17, 0x13, 0x96, 0x77, 0xf0, 0xe3, 0x10, 0xdb, 0x98, 0xb5, 0xf3, 0xcd, 0x62, 0x0d, 0x31,
+	0x58, 0xcc, 0x7b, 0xd0, 0x87, 0x6e, 0x90, 0xf3, 0x7c, 0xca, 0xa8, 0x76, 0x79, 0x10, 0x9a, 0xeb,
+	0x87, 0xe6, 0xa2, 0xd0, 0xf5, 0xa5, 0x3a, 0xad, 0x53, 0x11, 0x98, 0xe7, 0x77, 0x32, 0x67, 0x7d,
+	0xd5, 0xa2, 0x81, 0x4b, 0x83, 0xaa, 0x74, 0x58, 0x14, 0x93, 0xc8, 0x21, 0x2f, 0xd6, 0x66, 0x1d,
+	0x91, 0x4d, 0xea, 0x21, 0x02, 0x3d, 0xdc, 0xdc, 0xca, 0x53, 0x8f, 0x61, 0x4a, 0x82, 0x3c, 0x24,
+	0x84, 0x32, 0x28, 0xee, 0x65, 0x20, 0x78, 0x3a, 0xa9, 0xce, 0xde, 0xa7, 0xd4, 0x79, 0xd0, 0xf6,
+	0x90, 0x96, 0x53, 0x13, 0xd8, 0xd6, 0x95, 0xac, 0xb2, 0x71, 0xa1, 0x9c, 0xee, 0x18, 0x17, 0x2b,
+	0x93, 0xa0, 0x08, 0x8e, 0x12, 0xd3, 0x21, 0x26, 0xac, 0xb4, 0xd5, 0xeb, 0x66, 0x92, 0x6d, 0xe8,
+	0x3a, 0x3b, 0x00, 0xdb, 0xc0, 0x4c, 0x60, 0x5b, 0xdb, 0x55, 0xa7, 0x08, 0x74, 0x91, 0x9e, 0xc8,
+	0x2a, 0x1b, 0xc9, 0xf2, 0x56, 0xc7, 0xc8, 0x56, 0xd2, 0xe0, 0x36, 0x25, 0x01, 0x83, 0x84, 0xdd,
+	0xf7, 0xa9, 0x1d, 0x5a, 0xec, 0x5e, 0x5c, 0x11, 0x47, 0x01, 0xbd, 0x6e, 0x66, 0x4e, 0x9e, 0xc1,
+	0x13, 0x81, 0x29, 0xf2, 0x35, 0xa8, 0x2e, 0xb9, 0x98, 0x54, 0x7d, 0x14, 0x20, 0xbf, 0x89, 0xaa,
+	0xbc, 0x9c, 0x2a, 0x09, 0x5d, 0x7d, 0x52, 0x30, 0x29, 0x48, 0x26, 0x5b, 0x23, 0x4c, 0x2e, 0xc9,
+	0x53, 0xc6, 0xa5, 0x01, 0x33, 0xe5, 0x62, 0x62, 0x4a, 0xeb, 0x6d, 0x8a, 0xc9, 0x7b, 0xa1, 0x2b,
+	0x20, 0x60, 0xeb, 0x24, 0xc4, 0xd4, 0x3f, 0x43, 0xc0, 0xd6, 0x58, 0x08, 0xd8, 0x3a, 0x06, 0x71,
+	0x53, 0x9d, 0xb3, 0x51, 0x60, 0xf9, 0x58, 0x34, 0x5b, 0x3f, 0x27, 0x9a, 0xb2, 0xd2, 0xeb, 0x66,
+	0x34, 0x79, 0xd0, 0x90, 0x13, 0x98, 0xc3, 0xa1, 0x3b, 0x53, 0x7f, 0x3e, 0xcb, 0x28, 0xe0, 0x67,
+	0x4d, 0x9d, 0xbe, 0x2f, 0x84, 0xa1, 0x3d, 0x52, 0x55, 0x8f, 0x52, 0xa7, 0xca, 0xda, 0x1e, 0x0a,
+	0x74, 0x25, 0x3b, 0xb9, 0x31, 0xb7, 0x75, 0x35, 0xf7, 0x77, 0x3a, 0xc9, 0xc5, 0x43, 0x2c, 0xaf,
+	0x3d, 0xef, 0x66, 0x26, 0x7a, 0xdd, 0x4c, 0x4a, 0xa2, 0x0e, 0xce, 0x01, 0x66, 0xd2, 0x8b, 0x82,
+	0x02, 0xed, 0x7b, 0x45, 0x5d, 0xe5, 0xcd, 0xc3, 0x04, 0xb3, 0xaa, 0x8d, 0x3c, 0x1a, 0x60, 0x56,
+	0x85, 0x2e, 0x0d, 0x09, 0x8b, 0xc6, 0xd9, 0xe8, 0x18, 0xcb, 0x95, 0x24, 0x28, 0x16, 0xc4, 0x0f,
+	0x1c, 0x25, 0x66, 0x02, 0xfb, 0x20, 0x77, 0x97, 0x30, 0x7e, 0xfe, 0xaf, 0xdd, 0xcc, 0xd5, 0x3a,
+	0x66, 0x8d, 0xb0, 0x96, 0xb3, 0xa8, 0x9b, 0x97, 0x6a, 0x8c, 0x2e, 0x9b, 0x81, 0x7d, 0x90, 0x17,
+	0x88, 0x3c, 0xba, 0xd7, 0xcd, 0xa4, 0x07, 0xb3, 0x1a, 0x03, 0x07, 0x4c, 0x3e, 0xfc, 0xbb, 0x04,
+	0xb3, 0x3b, 0xd2, 0x6e, 0x08, 0xb3, 0xf6, 0xa3, 0xa2, 0xae, 0x8b, 0x70, 0x51, 0x81, 0xe8, 0x3c,
+	0x2f, 0x3d, 0x26, 0x39, 0x29, 0x48, 0x1e, 0x9c, 0x19, 0xc9, 0xff, 0x45, 0xd2, 0x3e, 0x15, 0x11,
+	0x98, 0x2b, 0xdc, 0xc9, 0xfb, 0xcc, 0x27, 0xfe, 0x2e, 0x26, 0x31, 0xd3, 0x1f, 0x78, 0x2f, 0x8f,
+	0xab, 0x24, 0xa2, 0x39, 0x25, 0x68, 0x92, 0x8e, 0x71, 0xa9, 0x32, 0x1f, 0xd3, 0x3c, 0xbb, 0x8e,
+	0x8e, 0x07, 0xe5, 0x1d, 0x1d, 0x51, 0x67, 0xc4, 0xf3, 0x85, 0xa2, 0xa6, 0x64, 0x69, 0x3e, 0x12,
+	0x0f, 0x81, 0xea, 0x3e, 0x42, 0xfa, 0x39, 0xa1, 0xae, 0xb5, 0x9c, 0x84, 0xca, 0xd5, 0x60, 0x80,
+	0xfa, 0xa2, 0xe2, 0xc9, 0xe5, 0xa7, 0x4a, 0xc7, 0xb8, 0x55, 0x79, 0x73, 0xef, 0x09, 0xb0, 0x11,
+	0xa1, 0x2e, 0xd8, 0xc9, 0x82, 0x10, 0x32, 0xea, 0x82, 0x6b, 0x59, 0x10, 0x01, 0xee, 0x64, 0x07,
+	0xb5, 0x81, 0x2f, 0x1e, 0x1e, 0x25, 0x92, 0xbc, 0x32, 0x9e, 0x1d, 0x44, 0x6a, 0xd4, 0x87, 0xd4,
+	0x38, 0x0c, 0x0f, 0x7e, 0xfa, 0x2d, 0xb3, 0xf1, 0x1a, 0x75, 0x8b, 0xb3, 0xcc, 0x79, 0x9e, 0x7f,
+	0x3b, 0x4a, 0xdf, 0x45, 0x48, 0xfb, 0x52, 0x51, 0x2f, 0x04, 0x87, 0xd0, 0xe3, 0x47, 0x55, 0x7d,
+	0xc8, 0x90, 0x3e, 0x2d, 0x1a, 0xfe, 0x49, 0xc7, 0x58, 0xac, 0xcc, 0x80, 0x42, 0xae, 0x50, 0x28,
+	0xc5, 0x8d, 0xbe, 0x83, 0xac, 0x7f, 0xd1, 0xe8, 0x3b, 0xc8, 0xea, 0x75, 0x33, 0x4b, 0x92, 0xf6,
+	0x08, 0x04, 0x30, 0xe7, 0xf8, 0xff, 0x5d, 0x84, 0x4c, 0xc8, 0x90, 0xf6, 0x95, 0xa2, 0xa6, 0x0e,
+	0x31, 0x6b, 0xd8, 0x3e, 0x3c, 0x1c, 0xd0, 0x98, 0x11, 0x34, 0x1e, 0x9d, 0x11, 0x8d, 0xa8, 0x7b,
+	0x27, 0x60, 0x80, 0x39, 0x1f, 0xdb, 0x62, 0x3a, 0xdf, 0x29, 0xea, 0x0a, 0xd7, 0x05, 0xf5, 0x6d,
+	0xe4, 0x47, 0x82, 0xe0, 0xb1, 0x98, 0xea, 0xb3, 0x82, 0x13, 0x3a, 0x23, 0x4e, 0x57, 0x06, 0x1a,
+	0x3c, 0x89, 0x05, 0xcc, 0x45, 0x17, 0xb6, 0xde, 0xe7, 0x76, 0x29, 0x3e, 0x93, 0x5b, 0xb5, 0x8f,
+	0xd4, 0x54, 0xc8, 0x17, 0xac, 0x06, 0x99, 0xd5, 0xa8, 0x36, 0x10, 0xae, 0x37, 0x98, 0x9e, 0x14,
+	0x8f, 0xe0, 0xcd, 0x71, 0xef, 0x9b, 0xa8, 0xee, 0x13, 0x39, 0xc0, 0x9c, 0xe7, 0xb6, 0x32, 0x37,
+	0xbd, 0x23, 0x2c, 0x9a, 0xab, 0xae, 0x5a, 0xd8, 0xb7, 0x42, 0x1e, 0xe9, 0x23, 0x78, 0x80, 0xfc,
+	0x2a, 0x22, 0xb0, 0xe6, 0x20, 0x5b, 0x57, 0xb3, 0xca, 0xc6, 0x6c, 0x79, 0xbb, 0x63, 0x2c, 0x54,
+	0x66, 0xc0, 0x3e, 0x74, 0x02, 0x04, 0x8e, 0x12, 0x53, 0x35, 0x4a, 0x9d, 0xc1, 0x2a, 0x9d, 0x92,
+	0x0b, 0xcc, 0xe5, 0xc8, 0x53, 0x96, 0x8e, 0xb7, 0xa5, 0x5d, 0x7b, 0xa4, 0x2e, 0x0a, 0x51, 0xc8,
+	0xd2, 0x1d, 0xbc, 0x8f, 0x02, 0x0f, 0x12, 0x7d, 0x2e, 0x7e, 0x9d, 0xcc, 0x57, 0xa6, 0x40, 0xb1,
+	0x30, 0x52, 0xcc, 0xfa, 0x90, 0x96, 0x46, 0xd3, 0x80, 0x99, 0xe2, 0x56, 0xd1, 0xae, 0x7b, 0x91,
+	0x4d, 0xc3, 0xea, 0xb2, 0xe7, 0x63, 0x0b, 0x55, 0x7d, 0x64, 0x51, 0xdf, 0x1e, 0x60, 0x9c, 0x17,
+	0x18, 0xdb, 0x1d, 0x43, 0xab, 0xcc, 0x80, 0xe2, 0xf5, 0xeb, 0x85, 0x51, 0x98, 0xcb, 0xd1, 0xa6,
+	0x8d, 0xcb, 0x05, 0xe6, 0xa2, 0xb0, 0x9b, 0xc2, 0xdc, 0x87, 0x0a, 0xd4, 0xb5, 0x80, 0xf1, 0xba,
+	0xaa, 0x82, 0x1c, 0x74, 0x3d, 0x07, 0xef, 0x63, 0x4b, 0x6c, 0x99, 0x7e, 0x41, 0xc0, 0xdd, 0xe0,
+	0xdd, 0x3b, 0x07, 0x8a, 0xc7, 0xc0, 0xb2, 0x51, 0x4d, 0xa7, 0x65, 0x03, 0x73, 0x55, 0xfa, 0x3e,
+	0x3c, 0x84, 0x9e, 0x31, 0xec, 0xd1, 0xbe, 0x51, 0xd4, 0x8b, 0xfd, 0xbd, 0x62, 0x18, 0xf9, 0x81,
+	0x7e, 0x31, 0x3b, 0xb9, 0x91, 0x2c, 0x3f, 0xee, 0x18, 0xff, 0xaf, 0xac, 0xed, 0x09, 0x85, 0x16,
+	0xb6, 0xc1, 0xb5, 0x48, 0xaa, 0xe2, 0x5a, 0x04, 0xfc, 0xe1, 0xb2, 0xf7, 0xf0, 0xbf, 0x8a, 0x76,
+	0xf9, 0xd8, 0x3e, 0x0b, 0x5c, 0x60, 0x9e, 0x8f, 0x16, 0xfa, 0x01, 0xff, 0xab, 0x3d, 0x51, 0x93,
+	0xf5, 0x10, 0xfa, 0x36, 0x86, 0x24, 0xd0, 0xe7, 0x05, 0xa7, 0x87, 0x1d, 0x63, 0xb7, 0x52, 0xdc,
+	0x03, 0xf2, 0xd8, 0x22, 0x2a, 0x6d, 0xb7, 0xdf, 0xba, 0xe5, 0x37, 0x7c, 0x76, 0xa3, 0x7d, 0xbd,
+	0x6d, 0xa1, 0x6d, 0x67, 0x3b, 0xbc, 0x51, 0x0a, 0x3e, 0x23, 0xad, 0xb0, 0xe0, 0x94, 0x4a, 0x87,
+	0xcd, 0xcf, 0x49, 0x3b, 0x24, 0x9c, 0xeb, 0x82, 0xe4, 0x6a, 0x58, 0x96, 0x61, 0xdb, 0x3e, 0x0a,
+	0x82, 0x5e, 0x37, 0xb3, 0x20, 0x49, 0xf4, 0x31, 0x80, 0x39, 0xc0, 0xd3, 0x5c, 0x75, 0xc5, 0x46,
+	0x9e, 0x83, 0x18, 0xb2, 0xe5, 0x7b, 0xa8, 0x3f, 0xf7, 0x85, 0x78, 0x10, 0x8b, 0x95, 0x59, 0x3e,
+	0x88, 0x9b, 0xc7, 0x66, 0x71, 0x25, 0xfe, 0xcc, 0x18, 0x97, 0x0d, 0xcc, 0xa5, 0xd8, 0xc1, 0xdf,
+	0x60, 0xfd, 0xd1, 0x7f, 0xaa, 0x6a, 0x7c, 0x83, 0xe5, 0x72, 0x61, 0xc2, 0x90, 0xdf, 0x84, 0x8e,
+	0x9e, 0x8a, 0x65, 0x3c, 0x6e, 0xe6, 0x6b, 0x83, 0xc5, 0x1f, 0x4d, 0x03, 0xe6, 0x82, 0x0b, 0x5b,
+	0x62, 0x29, 0xef, 0x46, 0x26, 0xae, 0x62, 0x19, 0x74, 0x5c, 0xc5, 0xda, 0x6b, 0xa9, 0x78, 0x6c,
+	0x2e, 0x30, 0x17, 0x85, 0x7d, 0x54, 0xc5, 0x3b, 0xb3, 0xdf, 0x3e, 0xcb, 0x4c, 0xf0, 0x2f, 0xa9,
+	0xf2, 0x07, 0xcf, 0xff, 0x48, 0x4f, 0x3c, 0x7f, 0x99, 0x56, 0x5e, 0xbc, 0x4c, 0x2b, 0xbf, 0xbf,
+	0x4c, 0x2b, 0x5f, 0xbf, 0x4a, 0x4f, 0xbc, 0x78, 0x95, 0x9e, 0xf8, 0xe5, 0x55, 0x7a, 0xe2, 0xe3,
+	0xd2, 0x90, 0x5a, 0xea, 0x3e, 0x6c, 0x62, 0xd6, 0xde, 0xb4, 0x51, 0x33, 0x18, 0xfa, 0x4e, 0x6f,
+	0x0d, 0xdd, 0x0b, 0xf9, 0xd4, 0xa6, 0xc5, 0xe7, 0x72, 0xe9, 0xaf, 0x01, 0x00, 0x2c, 0x25, 0xa4,
+	0xd9, 0xd8, 0x0b, 0x00, 0x00,
+}
+
+func (this *PoolType) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PoolType)
+	if !ok {
+		that2, ok := that.(PoolType)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.MinReserveCoinNum != that1.MinReserveCoinNum {
+		return false
+	}
+	if this.MaxReserveCoinNum != that1.MaxReserveCoinNum {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	return true
+}
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.PoolTypes) != len(that1.PoolTypes) {
+		return false
+	}
+	for i := range this.PoolTypes {
+		if !this.PoolTypes[i].Equal(&that1.PoolTypes[i]) {
+			return false
+		}
+	}
+	if !this.MinInitDepositAmount.Equal(that1.MinInitDepositAmount) {
+		return false
+	}
+	if !this.InitPoolCoinMintAmount.Equal(that1.InitPoolCoinMintAmount) {
+		return false
+	}
+	if !this.MaxReserveCoinAmount.Equal(that1.MaxReserveCoinAmount) {
+		return false
+	}
+	if len(this.PoolCreationFee) != len(that1.PoolCreationFee) {
+		return false
+	}
+	for i := range this.PoolCreationFee {
+		if !this.PoolCreationFee[i].Equal(&that1.PoolCreationFee[i]) {
+			return false
+		}
+	}
+	if !this.SwapFeeRate.Equal(that1.SwapFeeRate) {
+		return false
+	}
+	if !this.WithdrawFeeRate.Equal(that1.WithdrawFeeRate) {
+		return false
+	}
+	if !this.MaxOrderAmountRatio.Equal(that1.MaxOrderAmountRatio) {
+		return false
+	}
+	if this.UnitBatchHeight != that1.UnitBatchHeight {
+		return false
+	}
+	if this.CircuitBreakerEnabled != that1.CircuitBreakerEnabled {
+		return false
+	}
+	if this.SwapOrderLifespan != that1.SwapOrderLifespan {
+		return false
+	}
+	if this.PriceRecordLifespan != that1.PriceRecordLifespan {
+		return false
+	}
+	if this.StableSwapAmplification != that1.StableSwapAmplification {
+		return false
+	}
+	if len(this.SwapFeeTiers) != len(that1.SwapFeeTiers) {
+		return false
+	}
+	for i := range this.SwapFeeTiers {
+		if !this.SwapFeeTiers[i].Equal(that1.SwapFeeTiers[i]) {
+			return false
+		}
+	}
+	if len(this.Guardians) != len(that1.Guardians) {
+		return false
+	}
+	for i := range this.Guardians {
+		if this.Guardians[i] != that1.Guardians[i] {
+			return false
+		}
+	}
+	if this.DepletedPoolLifespan != that1.DepletedPoolLifespan {
+		return false
+	}
+	if this.MaxBatchInterval != that1.MaxBatchInterval {
+		return false
+	}
+	if this.BatchRecordLifespan != that1.BatchRecordLifespan {
+		return false
+	}
+	return true
+}
+func (m *PoolType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MaxReserveCoinNum != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxReserveCoinNum))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MinReserveCoinNum != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinReserveCoinNum))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BatchRecordLifespan != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BatchRecordLifespan))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.MaxBatchInterval != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBatchInterval))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.DepletedPoolLifespan != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DepletedPoolLifespan))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.Guardians) > 0 {
+		for iNdEx := len(m.Guardians) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Guardians[iNdEx])
+			copy(dAtA[i:], m.Guardians[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.Guardians[iNdEx])))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.SwapFeeTiers) > 0 {
+		for iNdEx := len(m.SwapFeeTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.SwapFeeTiers[iNdEx].Size()
+				i -= size
+				if _, err := m.SwapFeeTiers[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.StableSwapAmplification != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.StableSwapAmplification))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.PriceRecordLifespan != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PriceRecordLifespan))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.SwapOrderLifespan != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SwapOrderLifespan))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.CircuitBreakerEnabled {
+		i--
+		if m.CircuitBreakerEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.UnitBatchHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.UnitBatchHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.MaxOrderAmountRatio.Size()
+		i -= size
+		if _, err := m.MaxOrderAmountRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.WithdrawFeeRate.Size()
+		i -= size
+		if _, err := m.WithdrawFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.SwapFeeRate.Size()
+		i -= size
+		if _, err := m.SwapFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.PoolCreationFee) > 0 {
+		for iNdEx := len(m.PoolCreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.MaxReserveCoinAmount.Size()
+		i -= size
+		if _, err := m.MaxReserveCoinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.InitPoolCoinMintAmount.Size()
+		i -= size
+		if _, err := m.InitPoolCoinMintAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MinInitDepositAmount.Size()
+		i -= size
+		if _, err := m.MinInitDepositAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.PoolTypes) > 0 {
+		for iNdEx := len(m.PoolTypes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolTypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PoolType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovParams(uint64(m.Id))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MinReserveCoinNum != 0 {
+		n += 1 + sovParams(uint64(m.MinReserveCoinNum))
+	}
+	if m.MaxReserveCoinNum != 0 {
+		n += 1 + sovParams(uint64(m.MaxReserveCoinNum))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PoolTypes) > 0 {
+		for _, e := range m.PoolTypes {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.MinInitDepositAmount.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.InitPoolCoinMintAmount.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxReserveCoinAmount.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.PoolCreationFee) > 0 {
+		for _, e := range m.PoolCreationFee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.SwapFeeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.WithdrawFeeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxOrderAmountRatio.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.UnitBatchHeight != 0 {
+		n += 1 + sovParams(uint64(m.UnitBatchHeight))
+	}
+	if m.CircuitBreakerEnabled {
+		n += 2
+	}
+	if m.SwapOrderLifespan != 0 {
+		n += 1 + sovParams(uint64(m.SwapOrderLifespan))
+	}
+	if m.PriceRecordLifespan != 0 {
+		n += 1 + sovParams(uint64(m.PriceRecordLifespan))
+	}
+	if m.StableSwapAmplification != 0 {
+		n += 1 + sovParams(uint64(m.StableSwapAmplification))
+	}
+	if len(m.SwapFeeTiers) > 0 {
+		for _, e := range m.SwapFeeTiers {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.Guardians) > 0 {
+		for _, s := range m.Guardians {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.DepletedPoolLifespan != 0 {
+		n += 2 + sovParams(uint64(m.DepletedPoolLifespan))
+	}
+	if m.MaxBatchInterval != 0 {
+		n += 2 + sovParams(uint64(m.MaxBatchInterval))
+	}
+	if m.BatchRecordLifespan != 0 {
+		n += 2 + sovParams(uint64(m.BatchRecordLifespan))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PoolType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinReserveCoinNum", wireType)
+			}
+			m.MinReserveCoinNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinReserveCoinNum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReserveCoinNum", wireType)
+			}
+			m.MaxReserveCoinNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxReserveCoinNum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolTypes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolTypes = append(m.PoolTypes, PoolType{})
+			if err := m.PoolTypes[len(m.PoolTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinInitDepositAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinInitDepositAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitPoolCoinMintAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitPoolCoinMintAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReserveCoinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxReserveCoinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolCreationFee = append(m.PoolCreationFee, types.Coin{})
+			if err := m.PoolCreationFee[len(m.PoolCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WithdrawFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOrderAmountRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxOrderAmountRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnitBatchHeight", wireType)
+			}
+			m.UnitBatchHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnitBatchHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CircuitBreakerEnabled = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapOrderLifespan", wireType)
+			}
+			m.SwapOrderLifespan = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SwapOrderLifespan |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceRecordLifespan", wireType)
+			}
+			m.PriceRecordLifespan = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceRecordLifespan |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableSwapAmplification", wireType)
+			}
+			m.StableSwapAmplification = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StableSwapAmplification |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFeeTiers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.SwapFeeTiers = append(m.SwapFeeTiers, v)
+			if err := m.SwapFeeTiers[len(m.SwapFeeTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardians", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardians = append(m.Guardians, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepletedPoolLifespan", wireType)
+			}
+			m.DepletedPoolLifespan = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepletedPoolLifespan |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchInterval", wireType)
+			}
+			m.MaxBatchInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBatchInterval |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchRecordLifespan", wireType)
+			}
+			m.BatchRecordLifespan = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchRecordLifespan |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -19,6 +19,14 @@ func TestValidateAuxFunctions(t *testing.T) {
 		validateMaxOrderAmountRatio,
 		validateUnitBatchHeight,
 		validateCircuitBreakerEnabled,
+		validateSwapOrderLifespan,
+		validatePriceRecordLifespan,
+		validateStableSwapAmplification,
+		validateSwapFeeTiers,
+		validateGuardians,
+		validateDepletedPoolLifespan,
+		validateMaxBatchInterval,
+		validateBatchRecordLifespan,
 	} {
 		err := v(badType{})
 		require.EqualError(t, err, "invalid parameter type: types.badType")
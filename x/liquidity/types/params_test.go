@@ -26,6 +26,18 @@ func TestParams(t *testing.T) {
   max_reserve_coin_num: 2
   description: Standard liquidity pool with pool price function X/Y, ESPM constraint,
     and two kinds of reserve coins
+- id: 2
+  name: StableSwapLiquidityPool
+  min_reserve_coin_num: 2
+  max_reserve_coin_num: 2
+  description: StableSwap liquidity pool with the StableSwap invariant of the amplification
+    param, ESPM constraint, and two kinds of reserve coins
+- id: 3
+  name: MultiAssetLiquidityPool
+  min_reserve_coin_num: 3
+  max_reserve_coin_num: 8
+  description: Multi-asset liquidity pool with the weighted geometric mean invariant,
+    ESPM constraint, and three to eight kinds of reserve coins
 min_init_deposit_amount: "1000000"
 init_pool_coin_mint_amount: "1000000"
 max_reserve_coin_amount: "0"
@@ -37,6 +49,17 @@ withdraw_fee_rate: "0.000000000000000000"
 max_order_amount_ratio: "0.100000000000000000"
 unit_batch_height: 1
 circuit_breaker_enabled: false
+swap_order_lifespan: 0
+price_record_lifespan: 14400
+stable_swap_amplification: 100
+swap_fee_tiers:
+- "0.000500000000000000"
+- "0.003000000000000000"
+- "0.010000000000000000"
+guardians: []
+depleted_pool_lifespan: 0
+max_batch_interval: 100
+batch_record_lifespan: 14400
 `
 	require.Equal(t, paramsStr, defaultParams.String())
 }
@@ -44,6 +67,17 @@ circuit_breaker_enabled: false
 func TestParams_Validate(t *testing.T) {
 	require.NoError(t, types.DefaultParams().Validate())
 
+	// custom pool types are added after the built-in pool types
+	params := types.DefaultParams()
+	params.PoolTypes = append(params.PoolTypes, types.PoolType{Id: 4, Name: "CustomPool", MinReserveCoinNum: 2, MaxReserveCoinNum: 2})
+	require.NoError(t, params.Validate())
+
+	guardian := sdk.AccAddress("guardian").String()
+	params.Guardians = []string{guardian}
+	require.NoError(t, params.Validate())
+	require.True(t, params.IsGuardian(guardian))
+	require.False(t, params.IsGuardian(sdk.AccAddress("other").String()))
+
 	testCases := []struct {
 		name      string
 		configure func(*types.Params)
@@ -70,7 +104,16 @@ func TestParams_Validate(t *testing.T) {
 				poolType.Name = "CustomPoolType"
 				params.PoolTypes = []types.PoolType{poolType}
 			},
-			"the only supported pool type is 1",
+			"built-in pool type 1 must not be modified",
+		},
+		{
+			"ModifiedStableSwapPoolType",
+			func(params *types.Params) {
+				poolType := types.StableSwapPoolType
+				poolType.Description = ""
+				params.PoolTypes = []types.PoolType{types.DefaultPoolType, poolType}
+			},
+			"built-in pool type 2 must not be modified",
 		},
 		{
 			"NilMinInitDepositAmount",
@@ -222,6 +265,55 @@ func TestParams_Validate(t *testing.T) {
 			},
 			"unit batch height must be positive: 0",
 		},
+		{
+			"NonPositiveStableSwapAmplification",
+			func(params *types.Params) {
+				params.StableSwapAmplification = 0
+			},
+			"stable swap amplification must be positive: 0",
+		},
+		{
+			"TooLargeStableSwapAmplification",
+			func(params *types.Params) {
+				params.StableSwapAmplification = 1_000_001
+			},
+			"stable swap amplification too large: 1000001",
+		},
+		{
+			"NonPositiveSwapFeeTier",
+			func(params *types.Params) {
+				params.SwapFeeTiers = []sdk.Dec{sdk.ZeroDec()}
+			},
+			"swap fee tier must be positive: 0.000000000000000000",
+		},
+		{
+			"TooLargeSwapFeeTier",
+			func(params *types.Params) {
+				params.SwapFeeTiers = []sdk.Dec{sdk.NewDec(2)}
+			},
+			"swap fee tier too large: 2.000000000000000000",
+		},
+		{
+			"UnsortedSwapFeeTiers",
+			func(params *types.Params) {
+				params.SwapFeeTiers = []sdk.Dec{sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(1, 2)}
+			},
+			"swap fee tiers must be sorted in ascending order without duplicates: 0.010000000000000000",
+		},
+		{
+			"InvalidGuardian",
+			func(params *types.Params) {
+				params.Guardians = []string{"cosmos1invalid"}
+			},
+			"invalid guardian address cosmos1invalid: decoding bech32 failed: invalid character not part of charset: 105",
+		},
+		{
+			"DuplicateGuardian",
+			func(params *types.Params) {
+				params.Guardians = []string{guardian, guardian}
+			},
+			"duplicate guardian address: " + guardian,
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -241,3 +333,17 @@ func TestParams_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestParams_ValidateSwapOrderLifespan(t *testing.T) {
+	params := types.DefaultParams()
+	params.UnitBatchHeight = 5
+	params.SwapOrderLifespan = 4
+	require.EqualError(t, params.Validate(), "swap order lifespan 4 must not be less than unit batch height 5")
+
+	params.SwapOrderLifespan = 5
+	require.NoError(t, params.Validate())
+
+	// the swap order without a lifespan expires at the next batch execution
+	params.SwapOrderLifespan = 0
+	require.NoError(t, params.Validate())
+}
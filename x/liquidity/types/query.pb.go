@@ -6,24 +6,30 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -350,6 +356,138 @@ func (m *QueryLiquidityPoolsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+// the request type for the QueryLiquidityPoolsByReserveCoinDenom RPC method. Requestable including specified reserve_coin_denom and pagination offset, limit, key.
+type QueryLiquidityPoolsByReserveCoinDenomRequest struct {
+	// the reserve coin denom of the pools
+	ReserveCoinDenom string `protobuf:"bytes,1,opt,name=reserve_coin_denom,json=reserveCoinDenom,proto3" json:"reserve_coin_denom,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryLiquidityPoolsByReserveCoinDenomRequest) Reset() {
+	*m = QueryLiquidityPoolsByReserveCoinDenomRequest{}
+}
+func (m *QueryLiquidityPoolsByReserveCoinDenomRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryLiquidityPoolsByReserveCoinDenomRequest) ProtoMessage() {}
+func (*QueryLiquidityPoolsByReserveCoinDenomRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{7}
+}
+func (m *QueryLiquidityPoolsByReserveCoinDenomRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLiquidityPoolsByReserveCoinDenomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiquidityPoolsByReserveCoinDenomRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLiquidityPoolsByReserveCoinDenomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidityPoolsByReserveCoinDenomRequest.Merge(m, src)
+}
+func (m *QueryLiquidityPoolsByReserveCoinDenomRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiquidityPoolsByReserveCoinDenomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidityPoolsByReserveCoinDenomRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLiquidityPoolsByReserveCoinDenomRequest proto.InternalMessageInfo
+
+func (m *QueryLiquidityPoolsByReserveCoinDenomRequest) GetReserveCoinDenom() string {
+	if m != nil {
+		return m.ReserveCoinDenom
+	}
+	return ""
+}
+
+func (m *QueryLiquidityPoolsByReserveCoinDenomRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// the request type for the QueryLiquidityPoolsByPair RPC method. Requestable including specified denom_x, denom_y, pool_type_id and pagination offset, limit, key.
+type QueryLiquidityPoolsByPairRequest struct {
+	// a reserve coin denom of the pools
+	DenomX string `protobuf:"bytes,1,opt,name=denom_x,json=denomX,proto3" json:"denom_x,omitempty"`
+	// the other reserve coin denom of the pools
+	DenomY string `protobuf:"bytes,2,opt,name=denom_y,json=denomY,proto3" json:"denom_y,omitempty"`
+	// id of the pool type of the pools, all pool types when it is zero
+	PoolTypeId uint32 `protobuf:"varint,3,opt,name=pool_type_id,json=poolTypeId,proto3" json:"pool_type_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryLiquidityPoolsByPairRequest) Reset()         { *m = QueryLiquidityPoolsByPairRequest{} }
+func (m *QueryLiquidityPoolsByPairRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidityPoolsByPairRequest) ProtoMessage()    {}
+func (*QueryLiquidityPoolsByPairRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{8}
+}
+func (m *QueryLiquidityPoolsByPairRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLiquidityPoolsByPairRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiquidityPoolsByPairRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLiquidityPoolsByPairRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidityPoolsByPairRequest.Merge(m, src)
+}
+func (m *QueryLiquidityPoolsByPairRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiquidityPoolsByPairRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidityPoolsByPairRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLiquidityPoolsByPairRequest proto.InternalMessageInfo
+
+func (m *QueryLiquidityPoolsByPairRequest) GetDenomX() string {
+	if m != nil {
+		return m.DenomX
+	}
+	return ""
+}
+
+func (m *QueryLiquidityPoolsByPairRequest) GetDenomY() string {
+	if m != nil {
+		return m.DenomY
+	}
+	return ""
+}
+
+func (m *QueryLiquidityPoolsByPairRequest) GetPoolTypeId() uint32 {
+	if m != nil {
+		return m.PoolTypeId
+	}
+	return 0
+}
+
+func (m *QueryLiquidityPoolsByPairRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // the response type for the QueryLiquidityPoolsResponse RPC method. This includes a list of all existing liquidity pools and paging results that contain next_key and total count.
 type QueryLiquidityPoolsResponse struct {
 	Pools []Pool `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools"`
@@ -361,7 +499,7 @@ func (m *QueryLiquidityPoolsResponse) Reset()         { *m = QueryLiquidityPools
 func (m *QueryLiquidityPoolsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryLiquidityPoolsResponse) ProtoMessage()    {}
 func (*QueryLiquidityPoolsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8c9321d314a3b1d, []int{7}
+	return fileDescriptor_f8c9321d314a3b1d, []int{9}
 }
 func (m *QueryLiquidityPoolsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -412,7 +550,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8c9321d314a3b1d, []int{8}
+	return fileDescriptor_f8c9321d314a3b1d, []int{10}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -451,7 +589,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8c9321d314a3b1d, []int{9}
+	return fileDescriptor_f8c9321d314a3b1d, []int{11}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -499,7 +637,7 @@ func (m *QueryPoolBatchSwapMsgsRequest) Reset()         { *m = QueryPoolBatchSwa
 func (m *QueryPoolBatchSwapMsgsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolBatchSwapMsgsRequest) ProtoMessage()    {}
 func (*QueryPoolBatchSwapMsgsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8c9321d314a3b1d, []int{10}
+	return fileDescriptor_f8c9321d314a3b1d, []int{12}
 }
 func (m *QueryPoolBatchSwapMsgsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -554,7 +692,7 @@ func (m *QueryPoolBatchSwapMsgRequest) Reset()         { *m = QueryPoolBatchSwap
 func (m *QueryPoolBatchSwapMsgRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolBatchSwapMsgRequest) ProtoMessage()    {}
 func (*QueryPoolBatchSwapMsgRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8c9321d314a3b1d, []int{11}
+	return fileDescriptor_f8c9321d314a3b1d, []int{13}
 }
 func (m *QueryPoolBatchSwapMsgRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -608,7 +746,7 @@ func (m *QueryPoolBatchSwapMsgsResponse) Reset()         { *m = QueryPoolBatchSw
 func (m *QueryPoolBatchSwapMsgsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolBatchSwapMsgsResponse) ProtoMessage()    {}
 func (*QueryPoolBatchSwapMsgsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8c9321d314a3b1d, []int{12}
+	return fileDescriptor_f8c9321d314a3b1d, []int{14}
 }
 func (m *QueryPoolBatchSwapMsgsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -660,7 +798,7 @@ func (m *QueryPoolBatchSwapMsgResponse) Reset()         { *m = QueryPoolBatchSwa
 func (m *QueryPoolBatchSwapMsgResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolBatchSwapMsgResponse) ProtoMessage()    {}
 func (*QueryPoolBatchSwapMsgResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8c9321d314a3b1d, []int{13}
+	return fileDescriptor_f8c9321d314a3b1d, []int{15}
 }
 func (m *QueryPoolBatchSwapMsgResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -708,7 +846,7 @@ func (m *QueryPoolBatchDepositMsgsRequest) Reset()         { *m = QueryPoolBatch
 func (m *QueryPoolBatchDepositMsgsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolBatchDepositMsgsRequest) ProtoMessage()    {}
 func (*QueryPoolBatchDepositMsgsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8c9321d314a3b1d, []int{14}
+	return fileDescriptor_f8c9321d314a3b1d, []int{16}
 }
 func (m *QueryPoolBatchDepositMsgsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -763,7 +901,7 @@ func (m *QueryPoolBatchDepositMsgRequest) Reset()         { *m = QueryPoolBatchD
 func (m *QueryPoolBatchDepositMsgRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolBatchDepositMsgRequest) ProtoMessage()    {}
 func (*QueryPoolBatchDepositMsgRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8c9321d314a3b1d, []int{15}
+	return fileDescriptor_f8c9321d314a3b1d, []int{17}
 }
 func (m *QueryPoolBatchDepositMsgRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -817,7 +955,7 @@ func (m *QueryPoolBatchDepositMsgsResponse) Reset()         { *m = QueryPoolBatc
 func (m *QueryPoolBatchDepositMsgsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolBatchDepositMsgsResponse) ProtoMessage()    {}
 func (*QueryPoolBatchDepositMsgsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8c9321d314a3b1d, []int{16}
+	return fileDescriptor_f8c9321d314a3b1d, []int{18}
 }
 func (m *QueryPoolBatchDepositMsgsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -869,7 +1007,7 @@ func (m *QueryPoolBatchDepositMsgResponse) Reset()         { *m = QueryPoolBatch
 func (m *QueryPoolBatchDepositMsgResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolBatchDepositMsgResponse) ProtoMessage()    {}
 func (*QueryPoolBatchDepositMsgResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8c9321d314a3b1d, []int{17}
+	return fileDescriptor_f8c9321d314a3b1d, []int{19}
 }
 func (m *QueryPoolBatchDepositMsgResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -917,7 +1055,7 @@ func (m *QueryPoolBatchWithdrawMsgsRequest) Reset()         { *m = QueryPoolBatc
 func (m *QueryPoolBatchWithdrawMsgsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolBatchWithdrawMsgsRequest) ProtoMessage()    {}
 func (*QueryPoolBatchWithdrawMsgsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8c9321d314a3b1d, []int{18}
+	return fileDescriptor_f8c9321d314a3b1d, []int{20}
 }
 func (m *QueryPoolBatchWithdrawMsgsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -972,7 +1110,7 @@ func (m *QueryPoolBatchWithdrawMsgRequest) Reset()         { *m = QueryPoolBatch
 func (m *QueryPoolBatchWithdrawMsgRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolBatchWithdrawMsgRequest) ProtoMessage()    {}
 func (*QueryPoolBatchWithdrawMsgRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8c9321d314a3b1d, []int{19}
+	return fileDescriptor_f8c9321d314a3b1d, []int{21}
 }
 func (m *QueryPoolBatchWithdrawMsgRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1026,7 +1164,7 @@ func (m *QueryPoolBatchWithdrawMsgsResponse) Reset()         { *m = QueryPoolBat
 func (m *QueryPoolBatchWithdrawMsgsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolBatchWithdrawMsgsResponse) ProtoMessage()    {}
 func (*QueryPoolBatchWithdrawMsgsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8c9321d314a3b1d, []int{20}
+	return fileDescriptor_f8c9321d314a3b1d, []int{22}
 }
 func (m *QueryPoolBatchWithdrawMsgsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1078,7 +1216,7 @@ func (m *QueryPoolBatchWithdrawMsgResponse) Reset()         { *m = QueryPoolBatc
 func (m *QueryPoolBatchWithdrawMsgResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolBatchWithdrawMsgResponse) ProtoMessage()    {}
 func (*QueryPoolBatchWithdrawMsgResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8c9321d314a3b1d, []int{21}
+	return fileDescriptor_f8c9321d314a3b1d, []int{23}
 }
 func (m *QueryPoolBatchWithdrawMsgResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1114,1775 +1252,6505 @@ func (m *QueryPoolBatchWithdrawMsgResponse) GetWithdraw() WithdrawMsgState {
 	return WithdrawMsgState{}
 }
 
-func init() {
-	proto.RegisterType((*QueryLiquidityPoolRequest)(nil), "tendermint.liquidity.v1beta1.QueryLiquidityPoolRequest")
-	proto.RegisterType((*QueryLiquidityPoolResponse)(nil), "tendermint.liquidity.v1beta1.QueryLiquidityPoolResponse")
-	proto.RegisterType((*QueryLiquidityPoolByPoolCoinDenomRequest)(nil), "tendermint.liquidity.v1beta1.QueryLiquidityPoolByPoolCoinDenomRequest")
-	proto.RegisterType((*QueryLiquidityPoolByReserveAccRequest)(nil), "tendermint.liquidity.v1beta1.QueryLiquidityPoolByReserveAccRequest")
-	proto.RegisterType((*QueryLiquidityPoolBatchRequest)(nil), "tendermint.liquidity.v1beta1.QueryLiquidityPoolBatchRequest")
-	proto.RegisterType((*QueryLiquidityPoolBatchResponse)(nil), "tendermint.liquidity.v1beta1.QueryLiquidityPoolBatchResponse")
-	proto.RegisterType((*QueryLiquidityPoolsRequest)(nil), "tendermint.liquidity.v1beta1.QueryLiquidityPoolsRequest")
-	proto.RegisterType((*QueryLiquidityPoolsResponse)(nil), "tendermint.liquidity.v1beta1.QueryLiquidityPoolsResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "tendermint.liquidity.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "tendermint.liquidity.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryPoolBatchSwapMsgsRequest)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchSwapMsgsRequest")
-	proto.RegisterType((*QueryPoolBatchSwapMsgRequest)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchSwapMsgRequest")
-	proto.RegisterType((*QueryPoolBatchSwapMsgsResponse)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchSwapMsgsResponse")
-	proto.RegisterType((*QueryPoolBatchSwapMsgResponse)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchSwapMsgResponse")
-	proto.RegisterType((*QueryPoolBatchDepositMsgsRequest)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchDepositMsgsRequest")
-	proto.RegisterType((*QueryPoolBatchDepositMsgRequest)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchDepositMsgRequest")
-	proto.RegisterType((*QueryPoolBatchDepositMsgsResponse)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchDepositMsgsResponse")
-	proto.RegisterType((*QueryPoolBatchDepositMsgResponse)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchDepositMsgResponse")
-	proto.RegisterType((*QueryPoolBatchWithdrawMsgsRequest)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchWithdrawMsgsRequest")
-	proto.RegisterType((*QueryPoolBatchWithdrawMsgRequest)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchWithdrawMsgRequest")
-	proto.RegisterType((*QueryPoolBatchWithdrawMsgsResponse)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchWithdrawMsgsResponse")
-	proto.RegisterType((*QueryPoolBatchWithdrawMsgResponse)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchWithdrawMsgResponse")
+// the request type for the QueryPoolTwap RPC method. Requestable including specified pool_id and the start and end
+// heights, or the start and end times when no heights are given.
+type QueryPoolTwapRequest struct {
+	// id of the target pool for query
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// start height of the period, the price record at or before the height is used
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end height of the period, the price record at or before the height is used
+	EndHeight int64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// start time of the period, used when no heights are given
+	StartTime *time.Time `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty"`
+	// end time of the period, used when no heights are given
+	EndTime *time.Time `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty"`
+}
+
+func (m *QueryPoolTwapRequest) Reset()         { *m = QueryPoolTwapRequest{} }
+func (m *QueryPoolTwapRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolTwapRequest) ProtoMessage()    {}
+func (*QueryPoolTwapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{24}
+}
+func (m *QueryPoolTwapRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func init() {
-	proto.RegisterFile("tendermint/liquidity/v1beta1/query.proto", fileDescriptor_f8c9321d314a3b1d)
+func (m *QueryPoolTwapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolTwapRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-var fileDescriptor_f8c9321d314a3b1d = []byte{
-	// 1984 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x9a, 0x5f, 0x8c, 0xdc, 0x46,
-	0x19, 0xc0, 0xe3, 0x8b, 0x77, 0x9b, 0x4c, 0x54, 0x28, 0xd3, 0x54, 0xb4, 0x6e, 0x7a, 0x99, 0x5a,
-	0xd0, 0x84, 0x72, 0x67, 0xe7, 0xf2, 0x47, 0x4d, 0x36, 0xb9, 0x94, 0xbd, 0xbb, 0x5e, 0x49, 0x44,
-	0x4a, 0xd8, 0x14, 0x0a, 0x29, 0xe8, 0x98, 0xb3, 0x27, 0x3e, 0x83, 0x77, 0xc6, 0xf1, 0xcc, 0xee,
-	0xdd, 0x71, 0x9c, 0x14, 0xfe, 0x48, 0xa5, 0x2f, 0x25, 0x5a, 0x04, 0x42, 0x48, 0x44, 0x20, 0x44,
-	0x29, 0x6a, 0x11, 0x02, 0xc1, 0x5b, 0x11, 0x2a, 0x08, 0x5a, 0x1e, 0x90, 0x8a, 0xfa, 0xc2, 0x0b,
-	0x08, 0x12, 0x5e, 0x78, 0x42, 0xbc, 0xf2, 0x84, 0x6c, 0x8f, 0x77, 0xbd, 0xbb, 0xde, 0xdd, 0xb3,
-	0x2f, 0xf4, 0xa8, 0xb2, 0x2f, 0xc9, 0xad, 0x3d, 0xdf, 0x37, 0xdf, 0x9f, 0xdf, 0x37, 0xdf, 0x78,
-	0x6c, 0x70, 0x58, 0x10, 0x6a, 0x93, 0xa0, 0xee, 0x52, 0x61, 0x7a, 0xee, 0xd5, 0x86, 0x6b, 0xbb,
-	0x62, 0xdd, 0x6c, 0xce, 0x2c, 0x13, 0x81, 0x67, 0xcc, 0xab, 0x0d, 0x12, 0xac, 0x1b, 0x7e, 0xc0,
-	0x04, 0x83, 0x07, 0x3a, 0x23, 0x8d, 0xf6, 0x48, 0x43, 0x8e, 0xd4, 0xf6, 0x3b, 0xcc, 0x61, 0xd1,
-	0x40, 0x33, 0xfc, 0x2b, 0x96, 0xd1, 0xa6, 0x86, 0x6a, 0xef, 0x68, 0x89, 0x47, 0x1f, 0x70, 0x18,
-	0x73, 0x3c, 0x62, 0x62, 0xdf, 0x35, 0x31, 0xa5, 0x4c, 0x60, 0xe1, 0x32, 0xca, 0xe5, 0xdd, 0x87,
-	0x2c, 0xc6, 0xeb, 0x8c, 0x2f, 0xc5, 0x93, 0xf8, 0xd8, 0x71, 0x69, 0x74, 0x5f, 0xde, 0x8e, 0xff,
-	0xb3, 0xa6, 0x1d, 0x42, 0xa7, 0x99, 0x4f, 0x28, 0xf6, 0xdd, 0xe6, 0x51, 0x93, 0xf9, 0x91, 0x8a,
-	0x7e, 0x75, 0xfa, 0x71, 0xf0, 0xc0, 0xc7, 0x42, 0xef, 0x3e, 0x92, 0x18, 0x71, 0x91, 0x31, 0xaf,
-	0x46, 0xae, 0x36, 0x08, 0x17, 0xf0, 0xbd, 0xe0, 0x2e, 0x9f, 0x31, 0x6f, 0xc9, 0xb5, 0xef, 0x57,
-	0x90, 0x72, 0x58, 0xad, 0x95, 0xc3, 0x9f, 0xe7, 0x6c, 0xfd, 0x32, 0xd0, 0xb2, 0xa4, 0xb8, 0xcf,
-	0x28, 0x27, 0xf0, 0x0c, 0x50, 0xc3, 0x71, 0x91, 0xcc, 0xbe, 0xa3, 0xba, 0x31, 0x2c, 0x62, 0x46,
-	0x28, 0x39, 0xa7, 0xbe, 0xf1, 0xd7, 0x83, 0xbb, 0x6a, 0x91, 0x94, 0x5e, 0x03, 0x87, 0xfb, 0x75,
-	0xcf, 0x45, 0xff, 0xce, 0x33, 0x97, 0x2e, 0x10, 0xca, 0xea, 0x89, 0x81, 0x8f, 0x80, 0x77, 0x47,
-	0x06, 0x5a, 0xcc, 0xa5, 0x4b, 0x76, 0x78, 0x27, 0x9a, 0x74, 0x6f, 0xed, 0x6e, 0x3f, 0x3d, 0x5c,
-	0xff, 0x30, 0x78, 0x7f, 0x96, 0xce, 0x1a, 0xe1, 0x24, 0x68, 0x92, 0xaa, 0x65, 0x25, 0x0a, 0x0f,
-	0x82, 0x7d, 0x41, 0x7c, 0x71, 0x09, 0x5b, 0x96, 0x54, 0x06, 0x82, 0xf6, 0x38, 0xfd, 0x14, 0x98,
-	0xcc, 0xd0, 0x84, 0x85, 0xb5, 0x32, 0x32, 0x68, 0x57, 0xc0, 0xc1, 0x81, 0xa2, 0x32, 0x72, 0xf3,
-	0xa0, 0xb4, 0x1c, 0x5e, 0x90, 0xa1, 0x3b, 0xb4, 0x85, 0xd0, 0x85, 0xc3, 0x65, 0xfc, 0x62, 0x59,
-	0xdd, 0xce, 0x4a, 0x0e, 0x4f, 0xcc, 0x5b, 0x04, 0xa0, 0x03, 0x8d, 0x9c, 0xe7, 0x11, 0x23, 0x86,
-	0xca, 0x58, 0xc6, 0x9c, 0x18, 0x31, 0xed, 0xed, 0x49, 0xb0, 0x43, 0xa4, 0x6c, 0x2d, 0x25, 0xa9,
-	0xbf, 0xa8, 0x80, 0x07, 0x33, 0xa7, 0x91, 0xae, 0x9c, 0x05, 0xa5, 0xd0, 0x6f, 0x7e, 0xbf, 0x82,
-	0x76, 0xe7, 0xa2, 0x20, 0x16, 0x83, 0x4f, 0x76, 0xd9, 0x39, 0x21, 0xe3, 0x31, 0xca, 0xce, 0x78,
-	0xf2, 0x2e, 0x43, 0xf7, 0x03, 0x18, 0xd9, 0x79, 0x11, 0x07, 0xb8, 0x9e, 0x84, 0x41, 0xff, 0x14,
-	0xb8, 0xb7, 0xeb, 0xaa, 0xb4, 0x7a, 0x0e, 0x94, 0xfd, 0xe8, 0x8a, 0x8c, 0xcc, 0xfb, 0x46, 0x98,
-	0x1d, 0x8d, 0x95, 0x86, 0x4b, 0x49, 0xfd, 0x9a, 0x02, 0x1e, 0x8a, 0x75, 0x27, 0xf9, 0xb9, 0xb4,
-	0x8a, 0xfd, 0x0b, 0xdc, 0xe1, 0xa3, 0x10, 0xe9, 0x49, 0xce, 0x44, 0xe1, 0xe4, 0x3c, 0x0d, 0x0e,
-	0x64, 0x5a, 0x30, 0xd2, 0x80, 0x07, 0xc1, 0xde, 0x3a, 0x77, 0x96, 0x5c, 0x6a, 0x93, 0xb5, 0x68,
-	0x7e, 0xb5, 0xb6, 0xa7, 0xce, 0x9d, 0x73, 0xe1, 0x6f, 0xfd, 0x67, 0x8a, 0x84, 0x3f, 0xc3, 0x31,
-	0x19, 0xbf, 0x45, 0x50, 0xe2, 0xab, 0xd8, 0x4f, 0xb2, 0xfe, 0xe8, 0xf0, 0xf0, 0x49, 0xf1, 0x4b,
-	0x02, 0x0b, 0x92, 0x64, 0x3f, 0x12, 0xbf, 0x7d, 0xd9, 0x27, 0x03, 0x72, 0xd1, 0xb6, 0x78, 0x01,
-	0xa8, 0xe1, 0x94, 0x32, 0xdf, 0xf9, 0x0d, 0x8e, 0xa4, 0xf5, 0xaf, 0x28, 0x00, 0x75, 0xcf, 0xb3,
-	0x40, 0x7c, 0xc6, 0x5d, 0xf1, 0xb6, 0xa6, 0xfd, 0x19, 0xb9, 0xc2, 0x64, 0x18, 0xb1, 0xbd, 0xcc,
-	0xff, 0x4a, 0x01, 0x0f, 0x0f, 0x71, 0x4f, 0x86, 0xf2, 0xa3, 0x60, 0x8f, 0x1d, 0x5f, 0x4e, 0xf2,
-	0x3f, 0x3d, 0x3c, 0x9c, 0x1d, 0x25, 0xe9, 0x88, 0xb6, 0x95, 0xdc, 0x3e, 0x0a, 0xae, 0x0e, 0xce,
-	0x4e, 0xdb, 0xfa, 0x0b, 0xe0, 0x2e, 0x39, 0xb1, 0x64, 0xa1, 0x90, 0xf1, 0x89, 0x0e, 0xfd, 0xab,
-	0x7d, 0x21, 0x7b, 0xc6, 0x15, 0x2b, 0x76, 0x80, 0x57, 0xdf, 0x56, 0x24, 0x3e, 0xd9, 0xeb, 0x79,
-	0xca, 0x8a, 0xed, 0x31, 0xf1, 0x9a, 0x02, 0xf4, 0x61, 0x0e, 0xca, 0xb0, 0xd6, 0xc0, 0xde, 0x55,
-	0x79, 0x3d, 0xa1, 0xc2, 0x18, 0x1e, 0xd8, 0x94, 0x9a, 0x74, 0x64, 0x3b, 0x6a, 0x6e, 0x1f, 0x17,
-	0x8d, 0x21, 0x39, 0x6a, 0x7b, 0x70, 0x11, 0xec, 0x49, 0xa6, 0x96, 0x64, 0x14, 0x73, 0xa0, 0xad,
-	0xe5, 0xe8, 0xb5, 0x05, 0x50, 0x8a, 0xe6, 0x85, 0xd7, 0x55, 0xf0, 0xae, 0xee, 0x06, 0x0a, 0x4f,
-	0x0e, 0x57, 0x3e, 0xb8, 0xb5, 0x6b, 0xa7, 0x0a, 0x48, 0xc6, 0x3e, 0xea, 0x5f, 0xdb, 0xdd, 0xaa,
-	0xfe, 0x65, 0x42, 0x9b, 0xad, 0x11, 0xd1, 0x08, 0x28, 0x47, 0x18, 0x79, 0x2e, 0x17, 0x88, 0x5d,
-	0x41, 0xd8, 0xf3, 0x50, 0x5b, 0x17, 0x8a, 0x7a, 0x33, 0x0a, 0x1d, 0x41, 0x9d, 0x30, 0xa2, 0x80,
-	0xf0, 0x86, 0x27, 0x0c, 0x9d, 0x83, 0xe9, 0x45, 0x97, 0xda, 0x88, 0x35, 0x04, 0xaa, 0xb3, 0x80,
-	0x20, 0xbc, 0x1c, 0xfe, 0x29, 0x56, 0x08, 0x8a, 0x12, 0x82, 0x30, 0xb5, 0x11, 0x09, 0x02, 0x16,
-	0x20, 0x8b, 0xd9, 0x84, 0xc3, 0xb9, 0x15, 0x21, 0x7c, 0x5e, 0x31, 0x4d, 0xc7, 0x15, 0x2b, 0x8d,
-	0x65, 0xc3, 0x62, 0x75, 0x33, 0x73, 0xaf, 0xbc, 0xec, 0xb1, 0x65, 0xd3, 0x26, 0x4d, 0xe2, 0x31,
-	0xdf, 0xb4, 0x99, 0x65, 0x5a, 0x9e, 0x4b, 0xa8, 0x30, 0xea, 0xf6, 0xf9, 0x17, 0x15, 0xb0, 0xfb,
-	0xc4, 0x91, 0x23, 0xf0, 0x86, 0x02, 0xee, 0x3b, 0x47, 0x05, 0x09, 0x28, 0xf6, 0xd0, 0xa5, 0x70,
-	0xbf, 0x16, 0xa0, 0x27, 0xc2, 0xb9, 0xc2, 0x52, 0xbc, 0x07, 0xfb, 0xbe, 0xe7, 0x5a, 0x91, 0xb9,
-	0xe6, 0xe7, 0x38, 0xa3, 0xd0, 0xdf, 0xd0, 0x43, 0x1b, 0xf4, 0xca, 0xd1, 0x29, 0xbd, 0x4e, 0x38,
-	0xc7, 0x0e, 0xd1, 0x2b, 0x7a, 0xe0, 0x5b, 0xb1, 0x81, 0x95, 0xc8, 0x42, 0x34, 0x8b, 0x9e, 0x62,
-	0x62, 0x91, 0x35, 0xa8, 0x8d, 0x6c, 0xc2, 0x2d, 0x34, 0x8b, 0x9e, 0x5e, 0x21, 0xa1, 0x63, 0x01,
-	0x41, 0x94, 0xc9, 0x70, 0xf8, 0xe1, 0xfe, 0x90, 0x0a, 0xa3, 0x82, 0x3e, 0x4f, 0xd6, 0x11, 0x65,
-	0x02, 0x5d, 0x09, 0x25, 0xf4, 0x29, 0xdd, 0x26, 0x02, 0xbb, 0x1e, 0xd7, 0x2b, 0xcf, 0x7e, 0x66,
-	0xf3, 0xcb, 0x6f, 0xfd, 0xe3, 0x1b, 0x13, 0x0f, 0xc3, 0x83, 0x66, 0xcc, 0x69, 0xc6, 0x83, 0x40,
-	0xbc, 0xf1, 0x79, 0xad, 0x04, 0xee, 0xee, 0xca, 0x12, 0x7c, 0x2c, 0x6f, 0x5e, 0x13, 0x20, 0x4e,
-	0xe6, 0x17, 0x94, 0x3c, 0xbc, 0xaa, 0xb6, 0xaa, 0xcf, 0xa9, 0xda, 0xe9, 0x84, 0x87, 0x30, 0x85,
-	0xdd, 0x14, 0x20, 0xb1, 0x82, 0x05, 0xb2, 0x58, 0x10, 0x44, 0x32, 0x36, 0x47, 0x82, 0x45, 0xc3,
-	0xe4, 0x52, 0xb2, 0x83, 0x34, 0x1c, 0x8f, 0x69, 0xd8, 0x37, 0x87, 0x6d, 0x94, 0xec, 0xf7, 0x5e,
-	0xc8, 0x62, 0xe0, 0x0b, 0x09, 0x03, 0xc7, 0xd2, 0x0c, 0x88, 0x75, 0x9f, 0xa0, 0xba, 0xcb, 0xeb,
-	0xe1, 0x82, 0x30, 0x85, 0xa2, 0x5d, 0x1d, 0x11, 0x24, 0xa8, 0x24, 0xae, 0x4d, 0x25, 0x88, 0x70,
-	0x11, 0x58, 0x8c, 0x36, 0xc3, 0x6d, 0x20, 0x27, 0x1f, 0x77, 0xa9, 0xa8, 0x84, 0xa3, 0xb9, 0x4b,
-	0x1d, 0xf4, 0x68, 0x05, 0xb9, 0xb4, 0x89, 0x3d, 0xd7, 0x46, 0x7c, 0x9d, 0x0a, 0xbc, 0xd6, 0x43,
-	0xc3, 0xf9, 0x1f, 0x4b, 0x6c, 0xbf, 0x3f, 0x10, 0xdb, 0xe7, 0xb2, 0x4c, 0xe6, 0x05, 0xb1, 0xed,
-	0x49, 0xde, 0x31, 0x64, 0x33, 0xc2, 0xe9, 0x21, 0x81, 0xc8, 0x9a, 0xcb, 0xc5, 0x16, 0xc8, 0xfd,
-	0x20, 0xfc, 0xc0, 0x08, 0x72, 0xcd, 0x0d, 0x19, 0x9f, 0x4d, 0xf8, 0xcb, 0x32, 0x38, 0x30, 0xec,
-	0xf9, 0x0d, 0x2e, 0xe6, 0x25, 0x33, 0xfb, 0x01, 0x70, 0x1b, 0x84, 0xb7, 0x4a, 0xad, 0xea, 0xef,
-	0x54, 0x6d, 0xfe, 0x9c, 0x40, 0xc1, 0x60, 0xc8, 0x3b, 0x7c, 0x87, 0x49, 0x4d, 0x13, 0xde, 0x79,
-	0xe4, 0xdc, 0x21, 0xd2, 0x7f, 0x11, 0x91, 0x7e, 0x1c, 0xbe, 0xa2, 0x80, 0xbd, 0x4f, 0x31, 0x81,
-	0xa2, 0x74, 0xeb, 0x37, 0xb2, 0xa0, 0x79, 0x5e, 0x49, 0xa8, 0x39, 0xb1, 0x2d, 0x6a, 0xe2, 0x75,
-	0x3f, 0x8e, 0x8b, 0x4b, 0x51, 0xe4, 0x3d, 0x5a, 0x5b, 0xcb, 0xc3, 0xd2, 0xf9, 0x3f, 0x49, 0xee,
-	0xff, 0x30, 0x90, 0xfb, 0x9f, 0x66, 0xb9, 0xf0, 0x1d, 0xa5, 0x20, 0xf8, 0x05, 0x93, 0x9a, 0xbb,
-	0x3e, 0xe6, 0x61, 0x75, 0x54, 0x7d, 0xf4, 0x4c, 0x21, 0xeb, 0xa5, 0x73, 0x61, 0x13, 0xde, 0x28,
-	0x83, 0x07, 0x06, 0x9e, 0x51, 0xc0, 0xf9, 0xfc, 0x45, 0xd3, 0x77, 0xc2, 0xb1, 0x8d, 0x8a, 0xf9,
-	0x52, 0xa9, 0x55, 0x7d, 0xb5, 0x58, 0xc5, 0xc8, 0x03, 0x14, 0x84, 0x2d, 0x8b, 0x35, 0xe8, 0x4e,
-	0xed, 0x14, 0x5e, 0x96, 0x15, 0xf3, 0x83, 0xae, 0x8a, 0xf9, 0x66, 0x16, 0x6e, 0xd7, 0x8a, 0x56,
-	0x4c, 0x86, 0xb7, 0x08, 0xdb, 0x76, 0x40, 0x38, 0x0f, 0x2b, 0xc5, 0xe5, 0x11, 0x45, 0x51, 0x63,
-	0x78, 0x87, 0x16, 0x4a, 0xaf, 0x77, 0x79, 0x0b, 0xe5, 0x34, 0x3c, 0x35, 0xaa, 0x50, 0x52, 0x47,
-	0x70, 0xe6, 0x46, 0xea, 0xc7, 0x26, 0xfc, 0x7b, 0x09, 0xc0, 0xfe, 0xf3, 0x33, 0x78, 0x26, 0x77,
-	0x65, 0xa4, 0x4e, 0xec, 0xb4, 0xd9, 0x82, 0xd2, 0xb2, 0x2e, 0xfe, 0xa8, 0xb6, 0xaa, 0x2d, 0x55,
-	0x5b, 0x4c, 0xef, 0x95, 0xac, 0x46, 0x10, 0x10, 0x2a, 0x50, 0x74, 0x22, 0x17, 0x6e, 0xa3, 0x93,
-	0x25, 0x66, 0xbc, 0x6d, 0xba, 0xb3, 0xb6, 0x4d, 0x33, 0xd0, 0xdc, 0xf2, 0xb6, 0xc9, 0x8c, 0x68,
-	0x81, 0xff, 0x29, 0x81, 0xf7, 0xf4, 0x9d, 0xb0, 0xc1, 0xd3, 0x5b, 0x80, 0x74, 0xd0, 0x81, 0xa3,
-	0x76, 0xa6, 0x98, 0xb0, 0x04, 0xfc, 0x9f, 0x6a, 0xab, 0xfa, 0x92, 0xaa, 0x7d, 0x3a, 0xfb, 0xe1,
-	0x90, 0xaf, 0x62, 0x1f, 0xc9, 0x98, 0x72, 0xe4, 0xd2, 0x11, 0xfc, 0xff, 0xdf, 0x3d, 0x3b, 0x8e,
-	0xb1, 0xff, 0x1f, 0x60, 0xff, 0x18, 0x3c, 0x91, 0x13, 0x7b, 0x33, 0x3e, 0xf8, 0xfd, 0x6e, 0x19,
-	0xdc, 0xd3, 0x4b, 0x22, 0xac, 0x14, 0xc0, 0x37, 0x41, 0xff, 0x74, 0x21, 0x59, 0x49, 0xfe, 0xd7,
-	0x4b, 0xad, 0xea, 0x6f, 0x54, 0xed, 0x13, 0xe9, 0xa5, 0x3d, 0xcd, 0xfb, 0xc0, 0xd5, 0xbc, 0x7d,
-	0x6c, 0x96, 0x14, 0x44, 0xe8, 0xec, 0x21, 0xde, 0x5d, 0x17, 0x3b, 0xc3, 0xfc, 0x4b, 0x92, 0xf9,
-	0xef, 0xf5, 0x30, 0x7f, 0x3d, 0x0b, 0xa0, 0x2f, 0xe6, 0x64, 0xbe, 0xed, 0xf7, 0x6d, 0xa1, 0xfe,
-	0x75, 0x49, 0xfd, 0xaf, 0x07, 0x52, 0xff, 0xc3, 0x2c, 0xa3, 0xaf, 0x2b, 0x1b, 0x7a, 0xc0, 0x98,
-	0xd0, 0x2b, 0x29, 0xfc, 0x53, 0x8a, 0xf3, 0xef, 0x8b, 0xea, 0xdc, 0x41, 0x8e, 0xdb, 0x24, 0x34,
-	0x95, 0xd8, 0x99, 0xee, 0xa2, 0x40, 0x2c, 0x40, 0x36, 0xf1, 0x88, 0x20, 0x7d, 0x1b, 0xbb, 0xcd,
-	0x2d, 0x3f, 0x21, 0x64, 0xd6, 0x84, 0xb9, 0xd1, 0x9e, 0x74, 0x13, 0x3e, 0x5f, 0x06, 0xfb, 0xb3,
-	0x0e, 0xe1, 0xe1, 0xd9, 0x3c, 0x9c, 0xf7, 0xbf, 0x9c, 0xd0, 0x1e, 0x2f, 0x2c, 0x2f, 0x6b, 0xe5,
-	0x5f, 0x6a, 0xab, 0xfa, 0xb2, 0xaa, 0x2d, 0x65, 0x77, 0x09, 0x79, 0x2c, 0x3e, 0x6e, 0x14, 0xe3,
-	0x46, 0xd1, 0xd5, 0x28, 0x2a, 0xf0, 0x64, 0xde, 0xa2, 0x68, 0xbf, 0x1e, 0xfa, 0x49, 0x19, 0xdc,
-	0x9b, 0x81, 0x24, 0x9c, 0x2d, 0x86, 0x72, 0x52, 0x09, 0x67, 0x8b, 0x8a, 0xcb, 0x42, 0xf8, 0x56,
-	0xa9, 0x55, 0xfd, 0xbd, 0xaa, 0x5d, 0x4e, 0x37, 0x8d, 0x1e, 0xfc, 0xb7, 0xd7, 0x37, 0x8c, 0x71,
-	0xe3, 0xb8, 0xa3, 0x1a, 0xc7, 0x22, 0x5c, 0x28, 0x5a, 0x23, 0x5d, 0xbd, 0xe3, 0x85, 0x32, 0xb8,
-	0x2f, 0xf3, 0x65, 0x1d, 0xcc, 0xb5, 0xf8, 0x67, 0xbc, 0xc7, 0xd4, 0x3e, 0x54, 0x5c, 0x81, 0xac,
-	0x9a, 0x7f, 0xab, 0xad, 0xea, 0x2b, 0xaa, 0xf6, 0xd9, 0xec, 0xf6, 0x91, 0xbc, 0x3a, 0x1b, 0xf7,
-	0x8f, 0x71, 0xff, 0xc8, 0x7b, 0x9a, 0xd4, 0x5b, 0x1b, 0x9d, 0xf7, 0xc8, 0x3f, 0x4f, 0x6f, 0xa6,
-	0x52, 0x54, 0xe6, 0xdb, 0x4c, 0xf5, 0xbf, 0x51, 0xd7, 0x1e, 0x2f, 0x2c, 0x2f, 0xab, 0xe1, 0xdb,
-	0xa5, 0x56, 0xf5, 0x75, 0x55, 0x7b, 0x36, 0xdd, 0x43, 0x7a, 0x6b, 0x60, 0xdc, 0x44, 0xc6, 0x4d,
-	0x64, 0xeb, 0x4d, 0xe4, 0x49, 0xf8, 0x44, 0xe1, 0x42, 0xe9, 0xea, 0x22, 0xbf, 0x9d, 0x00, 0xe5,
-	0xf8, 0xbb, 0x37, 0x78, 0x64, 0x2b, 0x98, 0xa7, 0x3f, 0xbb, 0xd3, 0x66, 0x72, 0x48, 0xc8, 0x52,
-	0x78, 0x4b, 0x69, 0x55, 0x7f, 0xa4, 0x68, 0x66, 0xbb, 0x31, 0x78, 0x5e, 0x27, 0xe7, 0x3c, 0x59,
-	0xf2, 0x3b, 0x0b, 0x49, 0x9d, 0xd9, 0x0d, 0x8f, 0x18, 0xba, 0x00, 0x93, 0x83, 0xf0, 0x8e, 0x3f,
-	0xd4, 0x83, 0xb5, 0x42, 0x3c, 0xaf, 0xa5, 0x6e, 0x70, 0x9f, 0x58, 0xe6, 0x91, 0x93, 0x4b, 0xb1,
-	0x42, 0xa3, 0x6e, 0x47, 0xa1, 0xd6, 0x21, 0x1a, 0x12, 0xea, 0xf8, 0x93, 0xc1, 0x0b, 0x6f, 0xdc,
-	0x9c, 0x54, 0xde, 0xbc, 0x39, 0xa9, 0xfc, 0xed, 0xe6, 0xa4, 0x72, 0xfd, 0xd6, 0xe4, 0xae, 0x37,
-	0x6f, 0x4d, 0xee, 0xfa, 0xf3, 0xad, 0xc9, 0x5d, 0x97, 0x8f, 0xa5, 0xac, 0x71, 0x02, 0xdc, 0x74,
-	0xc5, 0xfa, 0xb4, 0x4d, 0x9a, 0x69, 0x5d, 0x69, 0x13, 0xc2, 0x6a, 0xe0, 0xcb, 0xe5, 0xe8, 0x6b,
-	0xde, 0x63, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x82, 0x5f, 0x48, 0x66, 0xc8, 0x2c, 0x00, 0x00,
+func (m *QueryPoolTwapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolTwapRequest.Merge(m, src)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Get existing liquidity pools.
-	LiquidityPools(ctx context.Context, in *QueryLiquidityPoolsRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolsResponse, error)
-	// Get specific liquidity pool.
-	LiquidityPool(ctx context.Context, in *QueryLiquidityPoolRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolResponse, error)
-	// Get specific liquidity pool corresponding to the pool_coin_denom.
-	LiquidityPoolByPoolCoinDenom(ctx context.Context, in *QueryLiquidityPoolByPoolCoinDenomRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolResponse, error)
-	// Get specific liquidity pool corresponding to the reserve account.
-	LiquidityPoolByReserveAcc(ctx context.Context, in *QueryLiquidityPoolByReserveAccRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolResponse, error)
-	// Get the pool's current batch.
-	LiquidityPoolBatch(ctx context.Context, in *QueryLiquidityPoolBatchRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolBatchResponse, error)
-	// Get all swap messages in the pool's current batch.
-	PoolBatchSwapMsgs(ctx context.Context, in *QueryPoolBatchSwapMsgsRequest, opts ...grpc.CallOption) (*QueryPoolBatchSwapMsgsResponse, error)
-	// Get a specific swap message in the pool's current batch.
-	PoolBatchSwapMsg(ctx context.Context, in *QueryPoolBatchSwapMsgRequest, opts ...grpc.CallOption) (*QueryPoolBatchSwapMsgResponse, error)
-	// Get all deposit messages in the pool's current batch.
-	PoolBatchDepositMsgs(ctx context.Context, in *QueryPoolBatchDepositMsgsRequest, opts ...grpc.CallOption) (*QueryPoolBatchDepositMsgsResponse, error)
-	// Get a specific deposit message in the pool's current batch.
-	PoolBatchDepositMsg(ctx context.Context, in *QueryPoolBatchDepositMsgRequest, opts ...grpc.CallOption) (*QueryPoolBatchDepositMsgResponse, error)
-	// Get all withdraw messages in the pool's current batch.
-	PoolBatchWithdrawMsgs(ctx context.Context, in *QueryPoolBatchWithdrawMsgsRequest, opts ...grpc.CallOption) (*QueryPoolBatchWithdrawMsgsResponse, error)
-	// Get a specific withdraw message in the pool's current batch.
-	PoolBatchWithdrawMsg(ctx context.Context, in *QueryPoolBatchWithdrawMsgRequest, opts ...grpc.CallOption) (*QueryPoolBatchWithdrawMsgResponse, error)
-	// Get all parameters of the liquidity module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+func (m *QueryPoolTwapRequest) XXX_Size() int {
+	return m.Size()
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryPoolTwapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolTwapRequest.DiscardUnknown(m)
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
-}
+var xxx_messageInfo_QueryPoolTwapRequest proto.InternalMessageInfo
 
-func (c *queryClient) LiquidityPools(ctx context.Context, in *QueryLiquidityPoolsRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolsResponse, error) {
-	out := new(QueryLiquidityPoolsResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/LiquidityPools", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPoolTwapRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) LiquidityPool(ctx context.Context, in *QueryLiquidityPoolRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolResponse, error) {
-	out := new(QueryLiquidityPoolResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/LiquidityPool", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPoolTwapRequest) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) LiquidityPoolByPoolCoinDenom(ctx context.Context, in *QueryLiquidityPoolByPoolCoinDenomRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolResponse, error) {
-	out := new(QueryLiquidityPoolResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/LiquidityPoolByPoolCoinDenom", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPoolTwapRequest) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) LiquidityPoolByReserveAcc(ctx context.Context, in *QueryLiquidityPoolByReserveAccRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolResponse, error) {
-	out := new(QueryLiquidityPoolResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/LiquidityPoolByReserveAcc", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPoolTwapRequest) GetStartTime() *time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) LiquidityPoolBatch(ctx context.Context, in *QueryLiquidityPoolBatchRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolBatchResponse, error) {
-	out := new(QueryLiquidityPoolBatchResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/LiquidityPoolBatch", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPoolTwapRequest) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) PoolBatchSwapMsgs(ctx context.Context, in *QueryPoolBatchSwapMsgsRequest, opts ...grpc.CallOption) (*QueryPoolBatchSwapMsgsResponse, error) {
-	out := new(QueryPoolBatchSwapMsgsResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/PoolBatchSwapMsgs", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// the response type for the QueryPoolTwap RPC method. This includes the time-weighted average price of the pool.
+type QueryPoolTwapResponse struct {
+	// time-weighted average price of the pool, the ratio of the reserve coins X/Y
+	Twap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=twap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"twap" yaml:"twap"`
 }
 
-func (c *queryClient) PoolBatchSwapMsg(ctx context.Context, in *QueryPoolBatchSwapMsgRequest, opts ...grpc.CallOption) (*QueryPoolBatchSwapMsgResponse, error) {
-	out := new(QueryPoolBatchSwapMsgResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/PoolBatchSwapMsg", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryPoolTwapResponse) Reset()         { *m = QueryPoolTwapResponse{} }
+func (m *QueryPoolTwapResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolTwapResponse) ProtoMessage()    {}
+func (*QueryPoolTwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{25}
 }
-
-func (c *queryClient) PoolBatchDepositMsgs(ctx context.Context, in *QueryPoolBatchDepositMsgsRequest, opts ...grpc.CallOption) (*QueryPoolBatchDepositMsgsResponse, error) {
-	out := new(QueryPoolBatchDepositMsgsResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/PoolBatchDepositMsgs", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryPoolTwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) PoolBatchDepositMsg(ctx context.Context, in *QueryPoolBatchDepositMsgRequest, opts ...grpc.CallOption) (*QueryPoolBatchDepositMsgResponse, error) {
-	out := new(QueryPoolBatchDepositMsgResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/PoolBatchDepositMsg", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPoolTwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolTwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryPoolTwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolTwapResponse.Merge(m, src)
+}
+func (m *QueryPoolTwapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolTwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolTwapResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) PoolBatchWithdrawMsgs(ctx context.Context, in *QueryPoolBatchWithdrawMsgsRequest, opts ...grpc.CallOption) (*QueryPoolBatchWithdrawMsgsResponse, error) {
-	out := new(QueryPoolBatchWithdrawMsgsResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/PoolBatchWithdrawMsgs", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryPoolTwapResponse proto.InternalMessageInfo
+
+// the request type for the QueryPoolBatchRecords RPC method. Requestable including specified pool_id.
+type QueryPoolBatchRecordsRequest struct {
+	// id of the target pool for query
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) PoolBatchWithdrawMsg(ctx context.Context, in *QueryPoolBatchWithdrawMsgRequest, opts ...grpc.CallOption) (*QueryPoolBatchWithdrawMsgResponse, error) {
-	out := new(QueryPoolBatchWithdrawMsgResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/PoolBatchWithdrawMsg", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPoolBatchRecordsRequest) Reset()         { *m = QueryPoolBatchRecordsRequest{} }
+func (m *QueryPoolBatchRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolBatchRecordsRequest) ProtoMessage()    {}
+func (*QueryPoolBatchRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{26}
+}
+func (m *QueryPoolBatchRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolBatchRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolBatchRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryPoolBatchRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolBatchRecordsRequest.Merge(m, src)
+}
+func (m *QueryPoolBatchRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolBatchRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolBatchRecordsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPoolBatchRecordsRequest proto.InternalMessageInfo
+
+func (m *QueryPoolBatchRecordsRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Get existing liquidity pools.
-	LiquidityPools(context.Context, *QueryLiquidityPoolsRequest) (*QueryLiquidityPoolsResponse, error)
-	// Get specific liquidity pool.
-	LiquidityPool(context.Context, *QueryLiquidityPoolRequest) (*QueryLiquidityPoolResponse, error)
-	// Get specific liquidity pool corresponding to the pool_coin_denom.
-	LiquidityPoolByPoolCoinDenom(context.Context, *QueryLiquidityPoolByPoolCoinDenomRequest) (*QueryLiquidityPoolResponse, error)
-	// Get specific liquidity pool corresponding to the reserve account.
-	LiquidityPoolByReserveAcc(context.Context, *QueryLiquidityPoolByReserveAccRequest) (*QueryLiquidityPoolResponse, error)
-	// Get the pool's current batch.
-	LiquidityPoolBatch(context.Context, *QueryLiquidityPoolBatchRequest) (*QueryLiquidityPoolBatchResponse, error)
-	// Get all swap messages in the pool's current batch.
-	PoolBatchSwapMsgs(context.Context, *QueryPoolBatchSwapMsgsRequest) (*QueryPoolBatchSwapMsgsResponse, error)
-	// Get a specific swap message in the pool's current batch.
-	PoolBatchSwapMsg(context.Context, *QueryPoolBatchSwapMsgRequest) (*QueryPoolBatchSwapMsgResponse, error)
-	// Get all deposit messages in the pool's current batch.
-	PoolBatchDepositMsgs(context.Context, *QueryPoolBatchDepositMsgsRequest) (*QueryPoolBatchDepositMsgsResponse, error)
-	// Get a specific deposit message in the pool's current batch.
-	PoolBatchDepositMsg(context.Context, *QueryPoolBatchDepositMsgRequest) (*QueryPoolBatchDepositMsgResponse, error)
-	// Get all withdraw messages in the pool's current batch.
-	PoolBatchWithdrawMsgs(context.Context, *QueryPoolBatchWithdrawMsgsRequest) (*QueryPoolBatchWithdrawMsgsResponse, error)
-	// Get a specific withdraw message in the pool's current batch.
-	PoolBatchWithdrawMsg(context.Context, *QueryPoolBatchWithdrawMsgRequest) (*QueryPoolBatchWithdrawMsgResponse, error)
-	// Get all parameters of the liquidity module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+func (m *QueryPoolBatchRecordsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// the response type for the QueryPoolBatchRecords RPC method. This includes a list of the recorded results of the
+// executed batches of the pool and paging results that contain next_key and total count.
+type QueryPoolBatchRecordsResponse struct {
+	BatchRecords []PoolBatchRecord `protobuf:"bytes,1,rep,name=batch_records,json=batchRecords,proto3" json:"batch_records"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (*UnimplementedQueryServer) LiquidityPools(ctx context.Context, req *QueryLiquidityPoolsRequest) (*QueryLiquidityPoolsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LiquidityPools not implemented")
+func (m *QueryPoolBatchRecordsResponse) Reset()         { *m = QueryPoolBatchRecordsResponse{} }
+func (m *QueryPoolBatchRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolBatchRecordsResponse) ProtoMessage()    {}
+func (*QueryPoolBatchRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{27}
 }
-func (*UnimplementedQueryServer) LiquidityPool(ctx context.Context, req *QueryLiquidityPoolRequest) (*QueryLiquidityPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LiquidityPool not implemented")
+func (m *QueryPoolBatchRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) LiquidityPoolByPoolCoinDenom(ctx context.Context, req *QueryLiquidityPoolByPoolCoinDenomRequest) (*QueryLiquidityPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LiquidityPoolByPoolCoinDenom not implemented")
+func (m *QueryPoolBatchRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolBatchRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) LiquidityPoolByReserveAcc(ctx context.Context, req *QueryLiquidityPoolByReserveAccRequest) (*QueryLiquidityPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LiquidityPoolByReserveAcc not implemented")
+func (m *QueryPoolBatchRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolBatchRecordsResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) LiquidityPoolBatch(ctx context.Context, req *QueryLiquidityPoolBatchRequest) (*QueryLiquidityPoolBatchResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LiquidityPoolBatch not implemented")
+func (m *QueryPoolBatchRecordsResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) PoolBatchSwapMsgs(ctx context.Context, req *QueryPoolBatchSwapMsgsRequest) (*QueryPoolBatchSwapMsgsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PoolBatchSwapMsgs not implemented")
+func (m *QueryPoolBatchRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolBatchRecordsResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) PoolBatchSwapMsg(ctx context.Context, req *QueryPoolBatchSwapMsgRequest) (*QueryPoolBatchSwapMsgResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PoolBatchSwapMsg not implemented")
+
+var xxx_messageInfo_QueryPoolBatchRecordsResponse proto.InternalMessageInfo
+
+func (m *QueryPoolBatchRecordsResponse) GetBatchRecords() []PoolBatchRecord {
+	if m != nil {
+		return m.BatchRecords
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) PoolBatchDepositMsgs(ctx context.Context, req *QueryPoolBatchDepositMsgsRequest) (*QueryPoolBatchDepositMsgsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PoolBatchDepositMsgs not implemented")
+
+func (m *QueryPoolBatchRecordsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) PoolBatchDepositMsg(ctx context.Context, req *QueryPoolBatchDepositMsgRequest) (*QueryPoolBatchDepositMsgResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PoolBatchDepositMsg not implemented")
+
+// the request type for the QueryPoolBatchRecord RPC method. Requestable including specified pool_id and batch_index.
+type QueryPoolBatchRecordRequest struct {
+	// id of the target pool for query
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// index of the executed batch of the pool
+	BatchIndex uint64 `protobuf:"varint,2,opt,name=batch_index,json=batchIndex,proto3" json:"batch_index,omitempty"`
 }
-func (*UnimplementedQueryServer) PoolBatchWithdrawMsgs(ctx context.Context, req *QueryPoolBatchWithdrawMsgsRequest) (*QueryPoolBatchWithdrawMsgsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PoolBatchWithdrawMsgs not implemented")
+
+func (m *QueryPoolBatchRecordRequest) Reset()         { *m = QueryPoolBatchRecordRequest{} }
+func (m *QueryPoolBatchRecordRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolBatchRecordRequest) ProtoMessage()    {}
+func (*QueryPoolBatchRecordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{28}
 }
-func (*UnimplementedQueryServer) PoolBatchWithdrawMsg(ctx context.Context, req *QueryPoolBatchWithdrawMsgRequest) (*QueryPoolBatchWithdrawMsgResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PoolBatchWithdrawMsg not implemented")
+func (m *QueryPoolBatchRecordRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryPoolBatchRecordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolBatchRecordRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryPoolBatchRecordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolBatchRecordRequest.Merge(m, src)
+}
+func (m *QueryPoolBatchRecordRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolBatchRecordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolBatchRecordRequest.DiscardUnknown(m)
 }
 
-func _Query_LiquidityPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLiquidityPoolsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LiquidityPools(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.liquidity.v1beta1.Query/LiquidityPools",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LiquidityPools(ctx, req.(*QueryLiquidityPoolsRequest))
+var xxx_messageInfo_QueryPoolBatchRecordRequest proto.InternalMessageInfo
+
+func (m *QueryPoolBatchRecordRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_LiquidityPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLiquidityPoolRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LiquidityPool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.liquidity.v1beta1.Query/LiquidityPool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LiquidityPool(ctx, req.(*QueryLiquidityPoolRequest))
+func (m *QueryPoolBatchRecordRequest) GetBatchIndex() uint64 {
+	if m != nil {
+		return m.BatchIndex
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_LiquidityPoolByPoolCoinDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLiquidityPoolByPoolCoinDenomRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LiquidityPoolByPoolCoinDenom(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.liquidity.v1beta1.Query/LiquidityPoolByPoolCoinDenom",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LiquidityPoolByPoolCoinDenom(ctx, req.(*QueryLiquidityPoolByPoolCoinDenomRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// the response type for the QueryPoolBatchRecord RPC method. This includes the recorded result of the executed batch.
+type QueryPoolBatchRecordResponse struct {
+	BatchRecord PoolBatchRecord `protobuf:"bytes,1,opt,name=batch_record,json=batchRecord,proto3" json:"batch_record"`
 }
 
-func _Query_LiquidityPoolByReserveAcc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLiquidityPoolByReserveAccRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LiquidityPoolByReserveAcc(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.liquidity.v1beta1.Query/LiquidityPoolByReserveAcc",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LiquidityPoolByReserveAcc(ctx, req.(*QueryLiquidityPoolByReserveAccRequest))
+func (m *QueryPoolBatchRecordResponse) Reset()         { *m = QueryPoolBatchRecordResponse{} }
+func (m *QueryPoolBatchRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolBatchRecordResponse) ProtoMessage()    {}
+func (*QueryPoolBatchRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{29}
+}
+func (m *QueryPoolBatchRecordResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolBatchRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolBatchRecordResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryPoolBatchRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolBatchRecordResponse.Merge(m, src)
+}
+func (m *QueryPoolBatchRecordResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolBatchRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolBatchRecordResponse.DiscardUnknown(m)
 }
 
-func _Query_LiquidityPoolBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLiquidityPoolBatchRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LiquidityPoolBatch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.liquidity.v1beta1.Query/LiquidityPoolBatch",
+var xxx_messageInfo_QueryPoolBatchRecordResponse proto.InternalMessageInfo
+
+func (m *QueryPoolBatchRecordResponse) GetBatchRecord() PoolBatchRecord {
+	if m != nil {
+		return m.BatchRecord
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LiquidityPoolBatch(ctx, req.(*QueryLiquidityPoolBatchRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return PoolBatchRecord{}
 }
 
-func _Query_PoolBatchSwapMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolBatchSwapMsgsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PoolBatchSwapMsgs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.liquidity.v1beta1.Query/PoolBatchSwapMsgs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PoolBatchSwapMsgs(ctx, req.(*QueryPoolBatchSwapMsgsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// the request type for the QueryBatchMsgsByAddress RPC method. Requestable including specified address and pagination offset, limit, key.
+type QueryBatchMsgsByAddressRequest struct {
+	// the depositor, withdrawer or swap requester address of the batch messages
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_PoolBatchSwapMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolBatchSwapMsgRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PoolBatchSwapMsg(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.liquidity.v1beta1.Query/PoolBatchSwapMsg",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PoolBatchSwapMsg(ctx, req.(*QueryPoolBatchSwapMsgRequest))
+func (m *QueryBatchMsgsByAddressRequest) Reset()         { *m = QueryBatchMsgsByAddressRequest{} }
+func (m *QueryBatchMsgsByAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBatchMsgsByAddressRequest) ProtoMessage()    {}
+func (*QueryBatchMsgsByAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{30}
+}
+func (m *QueryBatchMsgsByAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBatchMsgsByAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBatchMsgsByAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryBatchMsgsByAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBatchMsgsByAddressRequest.Merge(m, src)
+}
+func (m *QueryBatchMsgsByAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBatchMsgsByAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBatchMsgsByAddressRequest.DiscardUnknown(m)
 }
 
-func _Query_PoolBatchDepositMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolBatchDepositMsgsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PoolBatchDepositMsgs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.liquidity.v1beta1.Query/PoolBatchDepositMsgs",
+var xxx_messageInfo_QueryBatchMsgsByAddressRequest proto.InternalMessageInfo
+
+func (m *QueryBatchMsgsByAddressRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PoolBatchDepositMsgs(ctx, req.(*QueryPoolBatchDepositMsgsRequest))
+	return ""
+}
+
+func (m *QueryBatchMsgsByAddressRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_PoolBatchDepositMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolBatchDepositMsgRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+// the response type for the QueryBatchMsgsByAddress RPC method. This includes the deposit, withdraw, swap and swap route
+// messages of the address in the batches of all pools and paging results that contain next_key and total count.
+type QueryBatchMsgsByAddressResponse struct {
+	Deposits   []DepositMsgState   `protobuf:"bytes,1,rep,name=deposits,proto3" json:"deposits"`
+	Withdraws  []WithdrawMsgState  `protobuf:"bytes,2,rep,name=withdraws,proto3" json:"withdraws"`
+	Swaps      []SwapMsgState      `protobuf:"bytes,3,rep,name=swaps,proto3" json:"swaps"`
+	SwapRoutes []SwapRouteMsgState `protobuf:"bytes,4,rep,name=swap_routes,json=swapRoutes,proto3" json:"swap_routes"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBatchMsgsByAddressResponse) Reset()         { *m = QueryBatchMsgsByAddressResponse{} }
+func (m *QueryBatchMsgsByAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBatchMsgsByAddressResponse) ProtoMessage()    {}
+func (*QueryBatchMsgsByAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{31}
+}
+func (m *QueryBatchMsgsByAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBatchMsgsByAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBatchMsgsByAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).PoolBatchDepositMsg(ctx, in)
+}
+func (m *QueryBatchMsgsByAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBatchMsgsByAddressResponse.Merge(m, src)
+}
+func (m *QueryBatchMsgsByAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBatchMsgsByAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBatchMsgsByAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBatchMsgsByAddressResponse proto.InternalMessageInfo
+
+func (m *QueryBatchMsgsByAddressResponse) GetDeposits() []DepositMsgState {
+	if m != nil {
+		return m.Deposits
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.liquidity.v1beta1.Query/PoolBatchDepositMsg",
+	return nil
+}
+
+func (m *QueryBatchMsgsByAddressResponse) GetWithdraws() []WithdrawMsgState {
+	if m != nil {
+		return m.Withdraws
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PoolBatchDepositMsg(ctx, req.(*QueryPoolBatchDepositMsgRequest))
+	return nil
+}
+
+func (m *QueryBatchMsgsByAddressResponse) GetSwaps() []SwapMsgState {
+	if m != nil {
+		return m.Swaps
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_PoolBatchWithdrawMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolBatchWithdrawMsgsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryBatchMsgsByAddressResponse) GetSwapRoutes() []SwapRouteMsgState {
+	if m != nil {
+		return m.SwapRoutes
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).PoolBatchWithdrawMsgs(ctx, in)
+	return nil
+}
+
+func (m *QueryBatchMsgsByAddressResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.liquidity.v1beta1.Query/PoolBatchWithdrawMsgs",
+	return nil
+}
+
+// the request type for the QueryEstimateDeposit RPC method. Requestable including specified pool_id and deposit_coins.
+type QueryEstimateDepositRequest struct {
+	// id of the target pool for query
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// coins to deposit to the pool, for example 1000000denomX,2000000denomY
+	DepositCoins string `protobuf:"bytes,2,opt,name=deposit_coins,json=depositCoins,proto3" json:"deposit_coins,omitempty"`
+}
+
+func (m *QueryEstimateDepositRequest) Reset()         { *m = QueryEstimateDepositRequest{} }
+func (m *QueryEstimateDepositRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateDepositRequest) ProtoMessage()    {}
+func (*QueryEstimateDepositRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{32}
+}
+func (m *QueryEstimateDepositRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateDepositRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateDepositRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PoolBatchWithdrawMsgs(ctx, req.(*QueryPoolBatchWithdrawMsgsRequest))
+}
+func (m *QueryEstimateDepositRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateDepositRequest.Merge(m, src)
+}
+func (m *QueryEstimateDepositRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateDepositRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateDepositRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateDepositRequest proto.InternalMessageInfo
+
+func (m *QueryEstimateDepositRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_PoolBatchWithdrawMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolBatchWithdrawMsgRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryEstimateDepositRequest) GetDepositCoins() string {
+	if m != nil {
+		return m.DepositCoins
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).PoolBatchWithdrawMsg(ctx, in)
+	return ""
+}
+
+// the response type for the QueryEstimateDeposit RPC method. This includes the pool coin minted and the coins accepted
+// and refunded by the deposit.
+type QueryEstimateDepositResponse struct {
+	// pool coin minted to the depositor
+	PoolCoin types.Coin `protobuf:"bytes,1,opt,name=pool_coin,json=poolCoin,proto3" json:"pool_coin" yaml:"pool_coin"`
+	// deposit coins accepted into the reserves of the pool
+	AcceptedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=accepted_coins,json=acceptedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"accepted_coins" yaml:"accepted_coins"`
+	// deposit coins refunded to the depositor out of the reserve ratio of the pool
+	RefundedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=refunded_coins,json=refundedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"refunded_coins" yaml:"refunded_coins"`
+}
+
+func (m *QueryEstimateDepositResponse) Reset()         { *m = QueryEstimateDepositResponse{} }
+func (m *QueryEstimateDepositResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateDepositResponse) ProtoMessage()    {}
+func (*QueryEstimateDepositResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{33}
+}
+func (m *QueryEstimateDepositResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateDepositResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.liquidity.v1beta1.Query/PoolBatchWithdrawMsg",
+}
+func (m *QueryEstimateDepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateDepositResponse.Merge(m, src)
+}
+func (m *QueryEstimateDepositResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateDepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateDepositResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateDepositResponse proto.InternalMessageInfo
+
+func (m *QueryEstimateDepositResponse) GetPoolCoin() types.Coin {
+	if m != nil {
+		return m.PoolCoin
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PoolBatchWithdrawMsg(ctx, req.(*QueryPoolBatchWithdrawMsgRequest))
+	return types.Coin{}
+}
+
+func (m *QueryEstimateDepositResponse) GetAcceptedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.AcceptedCoins
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryEstimateDepositResponse) GetRefundedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RefundedCoins
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+	return nil
+}
+
+// the request type for the QueryEstimateWithdraw RPC method. Requestable including specified pool_id and pool_coin.
+type QueryEstimateWithdrawRequest struct {
+	// id of the target pool for query
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// pool coin to withdraw from the pool, for example 1000poolD35A0CC16EE598F90B044CE296A405BA9C381E38837599D96F2F70C2F02A23A4
+	PoolCoin string `protobuf:"bytes,2,opt,name=pool_coin,json=poolCoin,proto3" json:"pool_coin,omitempty"`
+}
+
+func (m *QueryEstimateWithdrawRequest) Reset()         { *m = QueryEstimateWithdrawRequest{} }
+func (m *QueryEstimateWithdrawRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateWithdrawRequest) ProtoMessage()    {}
+func (*QueryEstimateWithdrawRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{34}
+}
+func (m *QueryEstimateWithdrawRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateWithdrawRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateWithdrawRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.liquidity.v1beta1.Query/Params",
+}
+func (m *QueryEstimateWithdrawRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateWithdrawRequest.Merge(m, src)
+}
+func (m *QueryEstimateWithdrawRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateWithdrawRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateWithdrawRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateWithdrawRequest proto.InternalMessageInfo
+
+func (m *QueryEstimateWithdrawRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	return 0
+}
+
+func (m *QueryEstimateWithdrawRequest) GetPoolCoin() string {
+	if m != nil {
+		return m.PoolCoin
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "tendermint.liquidity.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "LiquidityPools",
-			Handler:    _Query_LiquidityPools_Handler,
-		},
-		{
-			MethodName: "LiquidityPool",
-			Handler:    _Query_LiquidityPool_Handler,
-		},
-		{
-			MethodName: "LiquidityPoolByPoolCoinDenom",
-			Handler:    _Query_LiquidityPoolByPoolCoinDenom_Handler,
-		},
-		{
-			MethodName: "LiquidityPoolByReserveAcc",
-			Handler:    _Query_LiquidityPoolByReserveAcc_Handler,
-		},
-		{
-			MethodName: "LiquidityPoolBatch",
-			Handler:    _Query_LiquidityPoolBatch_Handler,
-		},
-		{
-			MethodName: "PoolBatchSwapMsgs",
-			Handler:    _Query_PoolBatchSwapMsgs_Handler,
-		},
-		{
-			MethodName: "PoolBatchSwapMsg",
-			Handler:    _Query_PoolBatchSwapMsg_Handler,
-		},
-		{
-			MethodName: "PoolBatchDepositMsgs",
-			Handler:    _Query_PoolBatchDepositMsgs_Handler,
-		},
-		{
-			MethodName: "PoolBatchDepositMsg",
-			Handler:    _Query_PoolBatchDepositMsg_Handler,
-		},
-		{
-			MethodName: "PoolBatchWithdrawMsgs",
-			Handler:    _Query_PoolBatchWithdrawMsgs_Handler,
-		},
-		{
-			MethodName: "PoolBatchWithdrawMsg",
-			Handler:    _Query_PoolBatchWithdrawMsg_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "tendermint/liquidity/v1beta1/query.proto",
+// the response type for the QueryEstimateWithdraw RPC method. This includes the reserve coins withdrawn and the
+// withdraw fee coins of the withdrawal.
+type QueryEstimateWithdrawResponse struct {
+	// reserve coins paid out to the withdrawer
+	WithdrawCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=withdraw_coins,json=withdrawCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"withdraw_coins" yaml:"withdraw_coins"`
+	// reserve coins kept in the pool as the withdraw fee
+	WithdrawFeeCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=withdraw_fee_coins,json=withdrawFeeCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"withdraw_fee_coins" yaml:"withdraw_fee_coins"`
 }
 
-func (m *QueryLiquidityPoolRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryEstimateWithdrawResponse) Reset()         { *m = QueryEstimateWithdrawResponse{} }
+func (m *QueryEstimateWithdrawResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateWithdrawResponse) ProtoMessage()    {}
+func (*QueryEstimateWithdrawResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{35}
+}
+func (m *QueryEstimateWithdrawResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateWithdrawResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateWithdrawResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryLiquidityPoolRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryEstimateWithdrawResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateWithdrawResponse.Merge(m, src)
+}
+func (m *QueryEstimateWithdrawResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateWithdrawResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateWithdrawResponse.DiscardUnknown(m)
 }
 
-func (m *QueryLiquidityPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryEstimateWithdrawResponse proto.InternalMessageInfo
+
+func (m *QueryEstimateWithdrawResponse) GetWithdrawCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.WithdrawCoins
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryLiquidityPoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryEstimateWithdrawResponse) GetWithdrawFeeCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.WithdrawFeeCoins
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryLiquidityPoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// the request type for the QuerySimulateSwap RPC method. Requestable including specified pool_id, offer_coin and
+// demand_coin_denom.
+type QuerySimulateSwapRequest struct {
+	// id of the target pool for query
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// offer coin of the swap, for example 1000000denomX
+	OfferCoin string `protobuf:"bytes,2,opt,name=offer_coin,json=offerCoin,proto3" json:"offer_coin,omitempty"`
+	// denom of the coin to demand from the pool
+	DemandCoinDenom string `protobuf:"bytes,3,opt,name=demand_coin_denom,json=demandCoinDenom,proto3" json:"demand_coin_denom,omitempty"`
+	// optional limit order price of the swap, the price of the pair in the alphabetically sorted denom order X/Y, the
+	// swap is simulated as a market order at the max deviated order price when it is not given
+	OrderPrice string `protobuf:"bytes,4,opt,name=order_price,json=orderPrice,proto3" json:"order_price,omitempty"`
+}
+
+func (m *QuerySimulateSwapRequest) Reset()         { *m = QuerySimulateSwapRequest{} }
+func (m *QuerySimulateSwapRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateSwapRequest) ProtoMessage()    {}
+func (*QuerySimulateSwapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{36}
+}
+func (m *QuerySimulateSwapRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryLiquidityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+func (m *QuerySimulateSwapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateSwapRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryLiquidityPoolByPoolCoinDenomRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QuerySimulateSwapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateSwapRequest.Merge(m, src)
 }
-
-func (m *QueryLiquidityPoolByPoolCoinDenomRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySimulateSwapRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateSwapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateSwapRequest.DiscardUnknown(m)
 }
 
-func (m *QueryLiquidityPoolByPoolCoinDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PoolCoinDenom) > 0 {
-		i -= len(m.PoolCoinDenom)
-		copy(dAtA[i:], m.PoolCoinDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PoolCoinDenom)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QuerySimulateSwapRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateSwapRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryLiquidityPoolByReserveAccRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateSwapRequest) GetOfferCoin() string {
+	if m != nil {
+		return m.OfferCoin
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryLiquidityPoolByReserveAccRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySimulateSwapRequest) GetDemandCoinDenom() string {
+	if m != nil {
+		return m.DemandCoinDenom
+	}
+	return ""
 }
 
-func (m *QueryLiquidityPoolByReserveAccRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ReserveAcc) > 0 {
-		i -= len(m.ReserveAcc)
-		copy(dAtA[i:], m.ReserveAcc)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReserveAcc)))
-		i--
-		dAtA[i] = 0xa
+func (m *QuerySimulateSwapRequest) GetOrderPrice() string {
+	if m != nil {
+		return m.OrderPrice
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryLiquidityPoolBatchRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// the response type for the QuerySimulateSwap RPC method. This includes the expected result of the swap matched in the
+// batch of the pool, and the order amount of the swap against the max order amount of the pool.
+type QuerySimulateSwapResponse struct {
+	// offer coin matched in the batch
+	ExchangedOfferCoin types.Coin `protobuf:"bytes,1,opt,name=exchanged_offer_coin,json=exchangedOfferCoin,proto3" json:"exchanged_offer_coin" yaml:"exchanged_offer_coin"`
+	// offer coin not matched in the batch, carried over to the next batches until the order expiry height
+	RemainingOfferCoin types.Coin `protobuf:"bytes,2,opt,name=remaining_offer_coin,json=remainingOfferCoin,proto3" json:"remaining_offer_coin" yaml:"remaining_offer_coin"`
+	// demand coin received by the swap requester, without the exchanged coin fee
+	ExchangedDemandCoin types.Coin `protobuf:"bytes,3,opt,name=exchanged_demand_coin,json=exchangedDemandCoin,proto3" json:"exchanged_demand_coin" yaml:"exchanged_demand_coin"`
+	// swap fee paid in the offer coin for the matched offer coin
+	OfferCoinFee types.Coin `protobuf:"bytes,4,opt,name=offer_coin_fee,json=offerCoinFee,proto3" json:"offer_coin_fee" yaml:"offer_coin_fee"`
+	// swap fee paid in the demand coin for the exchanged demand coin
+	ExchangedCoinFee types.Coin `protobuf:"bytes,5,opt,name=exchanged_coin_fee,json=exchangedCoinFee,proto3" json:"exchanged_coin_fee" yaml:"exchanged_coin_fee"`
+	// current pool price of the pair of the swap, the price in the alphabetically sorted denom order X/Y
+	PoolPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=pool_price,json=poolPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"pool_price" yaml:"pool_price"`
+	// universal swap price of the pair in the batch, the price in the alphabetically sorted denom order X/Y
+	SwapPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=swap_price,json=swapPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_price" yaml:"swap_price"`
+	// price of the coins paid including the offer coin fee and the coins received, in X/Y as the pool price and the swap price
+	EffectivePrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=effective_price,json=effectivePrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"effective_price" yaml:"effective_price"`
+	// ratio of the difference between the swap price and the pool price to the pool price
+	PriceImpact github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=price_impact,json=priceImpact,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_impact" yaml:"price_impact"`
+	// largest offer coin that can be ordered in the pool by the max order amount ratio param
+	MaxOrderCoin types.Coin `protobuf:"bytes,10,opt,name=max_order_coin,json=maxOrderCoin,proto3" json:"max_order_coin" yaml:"max_order_coin"`
+	// ratio of the offer coin amount to the reserve coin amount of the offer coin denom of the pool
+	OrderAmountRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=order_amount_ratio,json=orderAmountRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"order_amount_ratio" yaml:"order_amount_ratio"`
+}
+
+func (m *QuerySimulateSwapResponse) Reset()         { *m = QuerySimulateSwapResponse{} }
+func (m *QuerySimulateSwapResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateSwapResponse) ProtoMessage()    {}
+func (*QuerySimulateSwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f8c9321d314a3b1d, []int{37}
+}
+func (m *QuerySimulateSwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateSwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateSwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryLiquidityPoolBatchRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySimulateSwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateSwapResponse.Merge(m, src)
+}
+func (m *QuerySimulateSwapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateSwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateSwapResponse.DiscardUnknown(m)
 }
 
-func (m *QueryLiquidityPoolBatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QuerySimulateSwapResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateSwapResponse) GetExchangedOfferCoin() types.Coin {
+	if m != nil {
+		return m.ExchangedOfferCoin
 	}
-	return len(dAtA) - i, nil
+	return types.Coin{}
 }
 
-func (m *QueryLiquidityPoolBatchResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateSwapResponse) GetRemainingOfferCoin() types.Coin {
+	if m != nil {
+		return m.RemainingOfferCoin
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *QueryLiquidityPoolBatchResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySimulateSwapResponse) GetExchangedDemandCoin() types.Coin {
+	if m != nil {
+		return m.ExchangedDemandCoin
+	}
+	return types.Coin{}
 }
 
-func (m *QueryLiquidityPoolBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Batch.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QuerySimulateSwapResponse) GetOfferCoinFee() types.Coin {
+	if m != nil {
+		return m.OfferCoinFee
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return types.Coin{}
 }
 
-func (m *QueryLiquidityPoolsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateSwapResponse) GetExchangedCoinFee() types.Coin {
+	if m != nil {
+		return m.ExchangedCoinFee
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *QueryLiquidityPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryLiquidityPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *QuerySimulateSwapResponse) GetMaxOrderCoin() types.Coin {
+	if m != nil {
+		return m.MaxOrderCoin
 	}
-	return len(dAtA) - i, nil
+	return types.Coin{}
 }
 
-func (m *QueryLiquidityPoolsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() {
+	proto.RegisterType((*QueryLiquidityPoolRequest)(nil), "tendermint.liquidity.v1beta1.QueryLiquidityPoolRequest")
+	proto.RegisterType((*QueryLiquidityPoolResponse)(nil), "tendermint.liquidity.v1beta1.QueryLiquidityPoolResponse")
+	proto.RegisterType((*QueryLiquidityPoolByPoolCoinDenomRequest)(nil), "tendermint.liquidity.v1beta1.QueryLiquidityPoolByPoolCoinDenomRequest")
+	proto.RegisterType((*QueryLiquidityPoolByReserveAccRequest)(nil), "tendermint.liquidity.v1beta1.QueryLiquidityPoolByReserveAccRequest")
+	proto.RegisterType((*QueryLiquidityPoolBatchRequest)(nil), "tendermint.liquidity.v1beta1.QueryLiquidityPoolBatchRequest")
+	proto.RegisterType((*QueryLiquidityPoolBatchResponse)(nil), "tendermint.liquidity.v1beta1.QueryLiquidityPoolBatchResponse")
+	proto.RegisterType((*QueryLiquidityPoolsRequest)(nil), "tendermint.liquidity.v1beta1.QueryLiquidityPoolsRequest")
+	proto.RegisterType((*QueryLiquidityPoolsByReserveCoinDenomRequest)(nil), "tendermint.liquidity.v1beta1.QueryLiquidityPoolsByReserveCoinDenomRequest")
+	proto.RegisterType((*QueryLiquidityPoolsByPairRequest)(nil), "tendermint.liquidity.v1beta1.QueryLiquidityPoolsByPairRequest")
+	proto.RegisterType((*QueryLiquidityPoolsResponse)(nil), "tendermint.liquidity.v1beta1.QueryLiquidityPoolsResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "tendermint.liquidity.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "tendermint.liquidity.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryPoolBatchSwapMsgsRequest)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchSwapMsgsRequest")
+	proto.RegisterType((*QueryPoolBatchSwapMsgRequest)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchSwapMsgRequest")
+	proto.RegisterType((*QueryPoolBatchSwapMsgsResponse)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchSwapMsgsResponse")
+	proto.RegisterType((*QueryPoolBatchSwapMsgResponse)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchSwapMsgResponse")
+	proto.RegisterType((*QueryPoolBatchDepositMsgsRequest)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchDepositMsgsRequest")
+	proto.RegisterType((*QueryPoolBatchDepositMsgRequest)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchDepositMsgRequest")
+	proto.RegisterType((*QueryPoolBatchDepositMsgsResponse)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchDepositMsgsResponse")
+	proto.RegisterType((*QueryPoolBatchDepositMsgResponse)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchDepositMsgResponse")
+	proto.RegisterType((*QueryPoolBatchWithdrawMsgsRequest)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchWithdrawMsgsRequest")
+	proto.RegisterType((*QueryPoolBatchWithdrawMsgRequest)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchWithdrawMsgRequest")
+	proto.RegisterType((*QueryPoolBatchWithdrawMsgsResponse)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchWithdrawMsgsResponse")
+	proto.RegisterType((*QueryPoolBatchWithdrawMsgResponse)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchWithdrawMsgResponse")
+	proto.RegisterType((*QueryPoolTwapRequest)(nil), "tendermint.liquidity.v1beta1.QueryPoolTwapRequest")
+	proto.RegisterType((*QueryPoolTwapResponse)(nil), "tendermint.liquidity.v1beta1.QueryPoolTwapResponse")
+	proto.RegisterType((*QueryPoolBatchRecordsRequest)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchRecordsRequest")
+	proto.RegisterType((*QueryPoolBatchRecordsResponse)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchRecordsResponse")
+	proto.RegisterType((*QueryPoolBatchRecordRequest)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchRecordRequest")
+	proto.RegisterType((*QueryPoolBatchRecordResponse)(nil), "tendermint.liquidity.v1beta1.QueryPoolBatchRecordResponse")
+	proto.RegisterType((*QueryBatchMsgsByAddressRequest)(nil), "tendermint.liquidity.v1beta1.QueryBatchMsgsByAddressRequest")
+	proto.RegisterType((*QueryBatchMsgsByAddressResponse)(nil), "tendermint.liquidity.v1beta1.QueryBatchMsgsByAddressResponse")
+	proto.RegisterType((*QueryEstimateDepositRequest)(nil), "tendermint.liquidity.v1beta1.QueryEstimateDepositRequest")
+	proto.RegisterType((*QueryEstimateDepositResponse)(nil), "tendermint.liquidity.v1beta1.QueryEstimateDepositResponse")
+	proto.RegisterType((*QueryEstimateWithdrawRequest)(nil), "tendermint.liquidity.v1beta1.QueryEstimateWithdrawRequest")
+	proto.RegisterType((*QueryEstimateWithdrawResponse)(nil), "tendermint.liquidity.v1beta1.QueryEstimateWithdrawResponse")
+	proto.RegisterType((*QuerySimulateSwapRequest)(nil), "tendermint.liquidity.v1beta1.QuerySimulateSwapRequest")
+	proto.RegisterType((*QuerySimulateSwapResponse)(nil), "tendermint.liquidity.v1beta1.QuerySimulateSwapResponse")
 }
 
-func (m *QueryLiquidityPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterFile("tendermint/liquidity/v1beta1/query.proto", fileDescriptor_f8c9321d314a3b1d)
 }
 
-func (m *QueryLiquidityPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Pools) > 0 {
-		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+var fileDescriptor_f8c9321d314a3b1d = []byte{
+	// 4158 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x5f, 0x90, 0x1c, 0xc5,
+	0x79, 0x67, 0x75, 0xb3, 0x27, 0x5d, 0xdf, 0x09, 0x89, 0x46, 0x8a, 0x4f, 0x83, 0x74, 0xd7, 0x8c,
+	0x1d, 0x90, 0xc9, 0x69, 0x57, 0x7f, 0x20, 0xa0, 0x13, 0x82, 0xec, 0x49, 0x1c, 0x1c, 0x09, 0x46,
+	0x5e, 0x29, 0x80, 0x21, 0xc9, 0x7a, 0x76, 0xa6, 0x6f, 0x77, 0x60, 0x77, 0x66, 0x34, 0xdd, 0x7b,
+	0xb7, 0x9b, 0xcb, 0x05, 0x9b, 0xb8, 0x62, 0x5c, 0x76, 0xb0, 0x6a, 0x5d, 0x76, 0xb9, 0xe2, 0x0a,
+	0x76, 0x42, 0x82, 0x0d, 0x38, 0x65, 0x3b, 0x65, 0x57, 0xc5, 0x65, 0x93, 0xc4, 0xa4, 0x6c, 0xe3,
+	0x54, 0x25, 0x65, 0xc7, 0x95, 0x8a, 0x2b, 0xe5, 0xe0, 0x04, 0xf2, 0x92, 0x27, 0x27, 0x3c, 0xe4,
+	0x21, 0x4f, 0xae, 0xfe, 0x33, 0xb3, 0x33, 0xb3, 0xb3, 0xb7, 0x3b, 0x73, 0x07, 0x07, 0xc5, 0xbd,
+	0x48, 0x3b, 0x3d, 0xfd, 0x7d, 0xfd, 0xf5, 0xf7, 0xfd, 0x7e, 0xdd, 0x5f, 0xf7, 0x74, 0x1f, 0x38,
+	0x4a, 0xb1, 0x6d, 0x62, 0xaf, 0x69, 0xd9, 0xb4, 0xd8, 0xb0, 0x2e, 0xb7, 0x2c, 0xd3, 0xa2, 0x9d,
+	0xe2, 0xca, 0x89, 0x2a, 0xa6, 0xfa, 0x89, 0xe2, 0xe5, 0x16, 0xf6, 0x3a, 0x05, 0xd7, 0x73, 0xa8,
+	0x03, 0x0f, 0xf7, 0x6a, 0x16, 0x82, 0x9a, 0x05, 0x59, 0x53, 0x3d, 0x50, 0x73, 0x6a, 0x0e, 0xaf,
+	0x58, 0x64, 0xbf, 0x84, 0x8c, 0x3a, 0x5b, 0x73, 0x9c, 0x5a, 0x03, 0x17, 0xf9, 0x53, 0xb5, 0xb5,
+	0x5c, 0xa4, 0x56, 0x13, 0x13, 0xaa, 0x37, 0x5d, 0x59, 0x61, 0x6e, 0xc3, 0xe6, 0x7b, 0xcd, 0x88,
+	0xda, 0xef, 0xdd, 0xb0, 0xb6, 0xab, 0x7b, 0x7a, 0x93, 0xc8, 0xaa, 0x87, 0x65, 0xcb, 0xba, 0x6b,
+	0x15, 0x75, 0xdb, 0x76, 0xa8, 0x4e, 0x2d, 0xc7, 0xf6, 0xdf, 0x1e, 0x31, 0x1c, 0xd2, 0x74, 0x48,
+	0x45, 0x18, 0xec, 0xea, 0x35, 0xcb, 0xe6, 0xef, 0xe5, 0xeb, 0x77, 0x45, 0x5e, 0x1b, 0x8e, 0xe5,
+	0xbf, 0x10, 0xff, 0x19, 0xc7, 0x6a, 0xd8, 0x3e, 0xe6, 0xb8, 0xd8, 0xd6, 0x5d, 0x6b, 0xe5, 0x64,
+	0xd1, 0x71, 0xb9, 0xee, 0xfe, 0x76, 0xb4, 0x9b, 0xc1, 0xa1, 0xf7, 0x33, 0x17, 0xfe, 0x86, 0x6f,
+	0xec, 0x05, 0xc7, 0x69, 0x94, 0xf1, 0xe5, 0x16, 0x26, 0x14, 0xbe, 0x0b, 0xec, 0x76, 0x1d, 0xa7,
+	0x51, 0xb1, 0xcc, 0xe9, 0x1c, 0xca, 0x1d, 0x55, 0xca, 0xe3, 0xec, 0x71, 0xc9, 0xd4, 0x1e, 0x06,
+	0x6a, 0x92, 0x14, 0x71, 0x1d, 0x9b, 0x60, 0x78, 0x3b, 0x50, 0x58, 0x3d, 0x2e, 0x33, 0x79, 0x52,
+	0x2b, 0x6c, 0x14, 0x96, 0x02, 0x93, 0x5c, 0x50, 0x5e, 0x7e, 0x65, 0xf6, 0xaa, 0x32, 0x97, 0xd2,
+	0xca, 0xe0, 0x68, 0xbf, 0xee, 0x05, 0xfe, 0xef, 0x39, 0xc7, 0xb2, 0xcf, 0x63, 0xdb, 0x69, 0xfa,
+	0x06, 0xde, 0x00, 0xf6, 0x71, 0x03, 0x99, 0x03, 0x2a, 0x26, 0x7b, 0xc3, 0x1b, 0x9d, 0x28, 0xef,
+	0x75, 0xc3, 0xd5, 0xb5, 0x7b, 0xc0, 0x2f, 0x27, 0xe9, 0x2c, 0x63, 0x82, 0xbd, 0x15, 0x5c, 0x32,
+	0x0c, 0x5f, 0xe1, 0x2c, 0x98, 0xf4, 0x44, 0x61, 0x45, 0x37, 0x0c, 0xa9, 0x0c, 0x78, 0x41, 0x3d,
+	0xed, 0x34, 0x98, 0x49, 0xd0, 0xa4, 0x53, 0xa3, 0x3e, 0xd4, 0x69, 0xcb, 0x60, 0x76, 0xa0, 0xa8,
+	0xf4, 0xdc, 0x39, 0x90, 0xaf, 0xb2, 0x02, 0xe9, 0xba, 0x1b, 0x47, 0x70, 0x1d, 0xab, 0x2e, 0xfd,
+	0x27, 0x64, 0x35, 0x33, 0x29, 0x38, 0xc4, 0x37, 0x6f, 0x11, 0x80, 0x1e, 0x9a, 0x64, 0x3b, 0x37,
+	0x14, 0x04, 0x9c, 0x0a, 0x55, 0x9d, 0xe0, 0x82, 0xa0, 0x54, 0xd0, 0x88, 0x5e, 0xc3, 0x52, 0xb6,
+	0x1c, 0x92, 0xd4, 0xfe, 0x3c, 0x07, 0xe6, 0x12, 0x9a, 0x09, 0x9c, 0xda, 0x17, 0xab, 0x39, 0x00,
+	0x7d, 0xd7, 0xf6, 0x85, 0x6b, 0xbf, 0x17, 0x13, 0x8a, 0x99, 0xb9, 0x2b, 0xb3, 0x99, 0x7f, 0x93,
+	0x03, 0x28, 0xd1, 0xcc, 0x0b, 0xba, 0xe5, 0x85, 0x42, 0xc6, 0xad, 0xa9, 0xb4, 0xa5, 0x3d, 0xe3,
+	0xfc, 0xf1, 0xa1, 0xde, 0x8b, 0xce, 0xf4, 0xae, 0xd0, 0x8b, 0x0f, 0x40, 0x04, 0xa6, 0x78, 0x90,
+	0x69, 0xc7, 0xc5, 0x2c, 0xd2, 0x63, 0x28, 0x77, 0x74, 0x6f, 0x19, 0xb0, 0xb2, 0x4b, 0x1d, 0x17,
+	0x2f, 0x99, 0xb1, 0x0e, 0x28, 0x99, 0x3b, 0xf0, 0x6c, 0x0e, 0x5c, 0x97, 0x18, 0x4e, 0x09, 0x99,
+	0x3b, 0x40, 0x9e, 0xb5, 0x4a, 0xa6, 0x73, 0x68, 0x2c, 0x15, 0xdb, 0x84, 0x18, 0xbc, 0x3b, 0xc1,
+	0xd1, 0x37, 0x0e, 0xb5, 0x53, 0x34, 0x1e, 0x31, 0xf4, 0x00, 0x80, 0xdc, 0xce, 0x0b, 0x7c, 0x90,
+	0x93, 0x5d, 0xd1, 0x3e, 0x00, 0xae, 0x8d, 0x94, 0x4a, 0xab, 0x17, 0xc0, 0xb8, 0x18, 0x0c, 0x25,
+	0x02, 0xdf, 0x33, 0xc4, 0x6c, 0x5e, 0x57, 0x1a, 0x2e, 0x25, 0xb5, 0x0f, 0xe5, 0xc0, 0x11, 0xa1,
+	0xdb, 0xe7, 0xc1, 0xc5, 0x55, 0xdd, 0xbd, 0x8f, 0xd4, 0xc8, 0x30, 0x2a, 0x6e, 0x19, 0xba, 0x2e,
+	0x81, 0xc3, 0x89, 0x16, 0x0c, 0x35, 0xe0, 0x3a, 0x30, 0xd1, 0x24, 0xb5, 0x8a, 0x65, 0x9b, 0xb8,
+	0xcd, 0xdb, 0x57, 0xca, 0x7b, 0x9a, 0xa4, 0xb6, 0xc4, 0x9e, 0xb5, 0xaf, 0xe5, 0xc0, 0x4c, 0xa2,
+	0xda, 0x9e, 0xff, 0x16, 0x41, 0x9e, 0xac, 0xea, 0xae, 0x1f, 0xf5, 0x9b, 0x36, 0x76, 0x9f, 0x14,
+	0xbf, 0x48, 0x75, 0x8a, 0xfd, 0xe8, 0x73, 0xf1, 0xad, 0x8b, 0x3e, 0x1e, 0x10, 0x8b, 0xc0, 0xe2,
+	0xf3, 0x40, 0x61, 0x4d, 0xca, 0x78, 0xa7, 0x37, 0x98, 0x4b, 0x6b, 0x7f, 0xe0, 0xd3, 0x39, 0x68,
+	0xe7, 0x3c, 0x76, 0x1d, 0x62, 0xd1, 0x37, 0x35, 0xec, 0x0f, 0x82, 0xd9, 0x41, 0x46, 0x6c, 0x2e,
+	0xf2, 0x2f, 0xe6, 0xc0, 0xf5, 0x1b, 0x74, 0x4f, 0xba, 0xf2, 0x7e, 0xb0, 0xc7, 0x14, 0xc5, 0x7e,
+	0xfc, 0x8f, 0x6d, 0xec, 0xce, 0x9e, 0x92, 0xb0, 0x47, 0x03, 0x25, 0x5b, 0x87, 0x82, 0xcb, 0x83,
+	0xa3, 0x13, 0x58, 0x7f, 0x1f, 0x1b, 0x53, 0x79, 0xa9, 0xc4, 0x42, 0x26, 0xe3, 0x7d, 0x1d, 0xda,
+	0x47, 0xfa, 0x5c, 0xf6, 0xa0, 0x45, 0xeb, 0xa6, 0xa7, 0xaf, 0xbe, 0xa9, 0x90, 0x78, 0x08, 0xa0,
+	0x81, 0x56, 0x6c, 0x0e, 0x13, 0xdf, 0xc9, 0x01, 0x6d, 0xa3, 0x0e, 0x4a, 0xb7, 0x96, 0xc1, 0xc4,
+	0xaa, 0x2c, 0xf7, 0x51, 0x51, 0xd8, 0xd8, 0xb1, 0x21, 0x35, 0x61, 0xcf, 0xf6, 0xd4, 0x6c, 0x1d,
+	0x2e, 0x5a, 0x1b, 0xc4, 0x28, 0xe8, 0xc1, 0x05, 0xb0, 0xc7, 0x6f, 0x5a, 0x22, 0x23, 0x5b, 0x07,
+	0x02, 0x2d, 0xda, 0xcf, 0x73, 0xe0, 0x40, 0xd0, 0xee, 0xa5, 0x55, 0xdd, 0x1d, 0x1a, 0x89, 0xeb,
+	0xc1, 0x14, 0xa1, 0xba, 0x47, 0x2b, 0x75, 0x6c, 0xd5, 0xea, 0x94, 0xf7, 0x79, 0xac, 0x3c, 0xc9,
+	0xcb, 0xee, 0xe1, 0x45, 0xf0, 0x08, 0x00, 0xd8, 0x36, 0xfd, 0x0a, 0x63, 0xbc, 0xc2, 0x04, 0xb6,
+	0x4d, 0xf9, 0xfa, 0x4e, 0x00, 0x84, 0x06, 0xb6, 0x90, 0x90, 0xf3, 0xbe, 0x5a, 0x10, 0xb9, 0x7e,
+	0xc1, 0x5f, 0x65, 0x14, 0x2e, 0xf9, 0xab, 0x8c, 0x05, 0xe5, 0xca, 0xcf, 0x66, 0x73, 0xe5, 0x09,
+	0x2e, 0xc3, 0x4a, 0xe1, 0x19, 0xb0, 0x87, 0xe9, 0xe7, 0xe2, 0xf9, 0x11, 0xc5, 0x77, 0x63, 0xdb,
+	0x64, 0x65, 0xda, 0xa3, 0xe0, 0x60, 0xac, 0xc3, 0xd2, 0xb9, 0xef, 0x07, 0x0a, 0xf5, 0x87, 0xdf,
+	0x89, 0x85, 0xb3, 0xcc, 0x51, 0xff, 0xf6, 0xca, 0xec, 0x0d, 0x35, 0x8b, 0xd6, 0x5b, 0xd5, 0x82,
+	0xe1, 0x34, 0x8b, 0x22, 0xac, 0xf2, 0xbf, 0x63, 0xc4, 0x7c, 0xac, 0xc8, 0x92, 0x1b, 0x52, 0x38,
+	0x8f, 0x8d, 0xd7, 0x5f, 0x99, 0x9d, 0xec, 0xe8, 0xcd, 0xc6, 0xbc, 0xc6, 0x74, 0x68, 0x65, 0xae,
+	0x4a, 0x7b, 0x3c, 0x3e, 0xf9, 0x95, 0xb1, 0xe1, 0x78, 0xe6, 0x9b, 0xc7, 0xb9, 0x97, 0xfa, 0x12,
+	0x80, 0xc0, 0x02, 0xd9, 0xeb, 0x87, 0xc0, 0x5e, 0x9e, 0x13, 0x57, 0x3c, 0xf1, 0x62, 0xb4, 0xe1,
+	0x32, 0xa6, 0x4e, 0xc2, 0x6a, 0xaa, 0x1a, 0x6a, 0x61, 0xeb, 0xa8, 0xf1, 0xa0, 0x4c, 0xef, 0x62,
+	0x8d, 0x0e, 0x75, 0xe2, 0x2c, 0x98, 0x14, 0x5d, 0x0b, 0x8f, 0x1a, 0x80, 0x17, 0x89, 0x71, 0x63,
+	0x25, 0x39, 0x3c, 0x81, 0x6f, 0x1e, 0x00, 0x53, 0x61, 0xdf, 0x8c, 0x36, 0x18, 0x27, 0xbb, 0x66,
+	0x32, 0xe4, 0x1a, 0xed, 0x09, 0x3f, 0x7b, 0xe1, 0xf5, 0xd8, 0x18, 0xb5, 0xd0, 0x29, 0x99, 0xa6,
+	0x87, 0x49, 0x80, 0x8c, 0x69, 0xb0, 0x5b, 0x17, 0x25, 0x32, 0xdf, 0xf6, 0x1f, 0xb7, 0x0c, 0x1a,
+	0x5f, 0x19, 0x03, 0xb3, 0x03, 0x8d, 0x78, 0xa3, 0xa6, 0xd1, 0xc8, 0x10, 0xbc, 0x6b, 0x6b, 0x86,
+	0xe0, 0x20, 0xd1, 0x1b, 0xdb, 0x5c, 0xa2, 0xf7, 0x00, 0x98, 0x64, 0x3f, 0x2a, 0x9e, 0xd3, 0xa2,
+	0x98, 0x4c, 0x2b, 0x5c, 0x5b, 0x71, 0xb8, 0xb6, 0x32, 0xab, 0x1f, 0x53, 0x09, 0x88, 0xff, 0x22,
+	0xce, 0x83, 0x7c, 0x76, 0x1e, 0x3c, 0x22, 0x79, 0x70, 0x17, 0xa1, 0x56, 0x53, 0xa7, 0x58, 0x3a,
+	0x7b, 0x28, 0x0f, 0xde, 0x0d, 0xf6, 0xca, 0x00, 0xf0, 0x65, 0x25, 0x91, 0x0b, 0xb5, 0x29, 0x59,
+	0xc8, 0x56, 0x94, 0x44, 0xfb, 0xc4, 0x18, 0x38, 0x9c, 0xac, 0x3d, 0x98, 0x7b, 0x26, 0x82, 0x8d,
+	0x04, 0xc9, 0x84, 0x43, 0x91, 0x5e, 0xf8, 0xf6, 0x33, 0x7d, 0x0b, 0xd3, 0xcc, 0x0d, 0xaf, 0xbf,
+	0x32, 0xbb, 0x5f, 0x0c, 0x8a, 0x81, 0xa4, 0x56, 0xde, 0xe3, 0xef, 0x3b, 0xc0, 0x8f, 0xe7, 0xc0,
+	0xd5, 0xba, 0x61, 0x60, 0x97, 0x62, 0x33, 0xb0, 0x6c, 0x6c, 0x63, 0xbd, 0x4b, 0x52, 0xef, 0x41,
+	0xa1, 0x37, 0x2a, 0xae, 0x3d, 0xff, 0xb3, 0xd9, 0xa3, 0x23, 0x8c, 0xd7, 0xbc, 0xc7, 0xe5, 0xbd,
+	0xbe, 0x30, 0x7f, 0xe4, 0xd6, 0x78, 0x78, 0xb9, 0x65, 0x9b, 0x81, 0x35, 0x63, 0x29, 0xad, 0x89,
+	0x8a, 0xa7, 0xb4, 0xc6, 0x17, 0x16, 0xe1, 0xb8, 0x14, 0x8b, 0x86, 0x4f, 0x83, 0x51, 0x12, 0xa5,
+	0x5e, 0x98, 0x44, 0xa0, 0x03, 0x8f, 0x6b, 0xdf, 0xdb, 0x05, 0x8e, 0x0c, 0x50, 0x2b, 0xa3, 0xcc,
+	0xbc, 0xe0, 0x53, 0x4b, 0x7a, 0x21, 0x97, 0xd2, 0x0b, 0x51, 0xf1, 0x94, 0x5e, 0xf0, 0x85, 0x45,
+	0x4c, 0x3e, 0x93, 0x03, 0x30, 0x50, 0xb7, 0x8c, 0xf1, 0xa8, 0x28, 0xb9, 0x4f, 0x5a, 0x74, 0x28,
+	0x66, 0x51, 0xa0, 0x22, 0x9d, 0x55, 0xfb, 0x7d, 0x05, 0x8b, 0x18, 0x8b, 0xf0, 0x7c, 0x3e, 0x07,
+	0xa6, 0xb9, 0x23, 0x2f, 0x5a, 0xcd, 0x56, 0x43, 0xa7, 0xf8, 0xe2, 0x28, 0xa9, 0xd3, 0x11, 0x00,
+	0x9c, 0xe5, 0x65, 0xec, 0x85, 0x83, 0x33, 0xc1, 0x4b, 0x38, 0x1f, 0x6e, 0x02, 0xd7, 0x98, 0xb8,
+	0xa9, 0xdb, 0x66, 0x78, 0xf7, 0x67, 0x8c, 0xd7, 0xda, 0x27, 0x5e, 0xf4, 0x36, 0x7f, 0x66, 0xc1,
+	0xa4, 0xe3, 0x99, 0xd8, 0xab, 0xb8, 0x9e, 0x65, 0x88, 0x24, 0x6a, 0xa2, 0x0c, 0x78, 0xd1, 0x05,
+	0x56, 0xa2, 0xfd, 0xdd, 0x04, 0x38, 0x94, 0x60, 0xa1, 0x0c, 0xb3, 0x0b, 0x0e, 0xe0, 0xb6, 0x51,
+	0xd7, 0xed, 0x1a, 0x36, 0x2b, 0x21, 0x9b, 0x86, 0xf2, 0xfa, 0xdd, 0xd2, 0xb3, 0xd7, 0x09, 0xcf,
+	0x26, 0x29, 0xd1, 0xca, 0x30, 0x28, 0xbe, 0x3f, 0xe8, 0x9c, 0x0b, 0x0e, 0x78, 0xb8, 0xa9, 0x5b,
+	0xb6, 0x65, 0xd7, 0x2a, 0x31, 0x2f, 0xa4, 0x69, 0x31, 0x49, 0x89, 0x56, 0x86, 0x41, 0x71, 0xaf,
+	0x45, 0x02, 0x0e, 0xf6, 0xcc, 0x0b, 0x39, 0x76, 0x7a, 0x6c, 0x58, 0x93, 0xef, 0x91, 0x4d, 0x1e,
+	0x8e, 0x77, 0x32, 0xa4, 0x45, 0x2b, 0x5f, 0x1b, 0x94, 0x9f, 0x0f, 0x82, 0x03, 0x7f, 0x07, 0x5c,
+	0xdd, 0xb3, 0x8b, 0xe1, 0x6d, 0x5a, 0x19, 0xd6, 0xda, 0x91, 0x28, 0x7d, 0xa2, 0xe2, 0x5a, 0x79,
+	0x2a, 0x00, 0xc8, 0x22, 0xc6, 0xf0, 0x51, 0xd0, 0x73, 0x6e, 0xaf, 0x8d, 0xfc, 0xb0, 0x36, 0xae,
+	0x8f, 0x12, 0xa2, 0x5f, 0x85, 0x56, 0xde, 0x1f, 0x14, 0xfa, 0x6d, 0x55, 0x01, 0xdf, 0xad, 0x93,
+	0x10, 0x1b, 0xe7, 0x69, 0xf1, 0xb9, 0xd4, 0x69, 0xf1, 0x35, 0xa1, 0x19, 0x80, 0x6b, 0xd2, 0xca,
+	0x7c, 0x84, 0xe2, 0x30, 0x65, 0x6d, 0xf0, 0x49, 0x57, 0xb4, 0xb1, 0x7b, 0x73, 0x6d, 0xf4, 0x34,
+	0x69, 0xe5, 0x09, 0xf6, 0x20, 0xda, 0xb8, 0x0c, 0xf6, 0xe1, 0xe5, 0x65, 0x6c, 0x50, 0x6b, 0x05,
+	0xcb, 0x86, 0xf6, 0xf0, 0x86, 0xee, 0x49, 0xdd, 0xd0, 0x2f, 0x49, 0xff, 0x45, 0xd5, 0x69, 0xe5,
+	0xab, 0x83, 0x12, 0xd1, 0x64, 0x1d, 0x4c, 0xf1, 0x37, 0x15, 0xab, 0xe9, 0xea, 0x06, 0x9d, 0x9e,
+	0xe0, 0xed, 0xdd, 0x95, 0xba, 0xbd, 0x6b, 0xa5, 0xf3, 0x42, 0xba, 0xb4, 0xf2, 0x24, 0x7f, 0x5c,
+	0xe2, 0x4f, 0x0c, 0x70, 0x4d, 0xbd, 0x5d, 0x11, 0x83, 0x01, 0x87, 0x37, 0x48, 0x09, 0xb8, 0xa8,
+	0xb8, 0x56, 0x9e, 0x6a, 0xea, 0xed, 0xfb, 0xd9, 0x33, 0x07, 0x74, 0x07, 0x40, 0xf1, 0x52, 0x6f,
+	0x3a, 0x2d, 0x9b, 0x56, 0x3c, 0x9d, 0x5a, 0xce, 0xf4, 0x24, 0xef, 0xcf, 0xaf, 0xa7, 0xee, 0x8f,
+	0xc4, 0x5f, 0xbf, 0x46, 0xad, 0xbc, 0x9f, 0x17, 0x96, 0x78, 0x59, 0x99, 0x15, 0x9d, 0xfc, 0xea,
+	0x1a, 0xc8, 0xf3, 0x21, 0x0c, 0x5e, 0x51, 0xc0, 0xd5, 0xd1, 0xcd, 0x5d, 0x78, 0xdb, 0xc6, 0x89,
+	0xd9, 0xe0, 0xed, 0x7d, 0xf5, 0x74, 0x06, 0x49, 0x31, 0x6c, 0x6a, 0x4f, 0x8e, 0x75, 0x4b, 0xff,
+	0xbe, 0x4b, 0x3d, 0x5b, 0xc6, 0xb4, 0xe5, 0xd9, 0x04, 0xe9, 0xa8, 0x61, 0x11, 0x8a, 0x9c, 0x65,
+	0xa4, 0x37, 0x1a, 0x28, 0xd0, 0x85, 0xf8, 0xbe, 0x31, 0x62, 0xb3, 0x06, 0xea, 0xe5, 0x6f, 0xc8,
+	0xc3, 0xa4, 0xd5, 0xa0, 0x05, 0x8d, 0x80, 0x63, 0x8b, 0x96, 0x6d, 0x22, 0xa7, 0x45, 0x51, 0xd3,
+	0xf1, 0x30, 0xd2, 0xab, 0xec, 0x27, 0xad, 0x63, 0xc4, 0x33, 0x41, 0xa4, 0xdb, 0x26, 0xc2, 0x9e,
+	0xe7, 0x78, 0xc8, 0x70, 0x4c, 0x4c, 0xe0, 0x42, 0x9d, 0x52, 0x97, 0xcc, 0x17, 0x8b, 0x21, 0x3f,
+	0x27, 0x7e, 0x45, 0xab, 0x36, 0x9c, 0x6a, 0xd1, 0xc4, 0x2b, 0xb8, 0xe1, 0xb8, 0x45, 0xd3, 0x31,
+	0x8a, 0x46, 0xc3, 0xc2, 0x36, 0x2d, 0x34, 0xcd, 0x7b, 0x9f, 0xcd, 0x81, 0xb1, 0x5b, 0x8e, 0x1f,
+	0x87, 0x4f, 0xe7, 0xc0, 0xc1, 0x25, 0x9b, 0x62, 0xcf, 0xd6, 0x1b, 0xe8, 0x22, 0xfb, 0xa2, 0xe0,
+	0xa1, 0xbb, 0x58, 0x5b, 0x6c, 0x9b, 0x68, 0xbf, 0xee, 0xba, 0x0d, 0xcb, 0xe0, 0xe6, 0x16, 0x1f,
+	0x25, 0x8e, 0x0d, 0xdd, 0x35, 0x8d, 0xd9, 0xa0, 0xcd, 0x9f, 0x9c, 0xd3, 0x9a, 0x98, 0x10, 0xbd,
+	0x86, 0xb5, 0x79, 0xcd, 0x73, 0x0d, 0x61, 0xe0, 0x3c, 0xb7, 0x10, 0x9d, 0x45, 0xef, 0x73, 0xe8,
+	0xa2, 0xd3, 0xb2, 0x4d, 0x64, 0x62, 0x62, 0xa0, 0xb3, 0xe8, 0x52, 0x1d, 0xb3, 0x8e, 0x79, 0x18,
+	0xd9, 0x8e, 0x74, 0x87, 0xeb, 0x61, 0xc2, 0x8c, 0x99, 0x47, 0x8f, 0xe1, 0x0e, 0xb2, 0x1d, 0x8a,
+	0x96, 0x99, 0x84, 0x36, 0xa7, 0x99, 0x98, 0xea, 0x56, 0x83, 0x68, 0xf3, 0x8f, 0xfc, 0xf6, 0xfa,
+	0x13, 0x3f, 0xfe, 0xaf, 0x4f, 0xed, 0xba, 0x1e, 0xce, 0xfa, 0x40, 0x4a, 0xf8, 0x44, 0xc8, 0xe3,
+	0xff, 0x9d, 0x3c, 0xd8, 0x1b, 0x89, 0x12, 0xbc, 0x35, 0x6d, 0x5c, 0x7d, 0x40, 0xdc, 0x96, 0x5e,
+	0x50, 0xe2, 0xe1, 0xdb, 0x4a, 0xb7, 0xf4, 0x51, 0x45, 0x3d, 0xe3, 0xe3, 0x81, 0x85, 0x30, 0x8a,
+	0x02, 0x44, 0xeb, 0x3a, 0x45, 0x86, 0xe3, 0x79, 0x5c, 0xc6, 0x24, 0x88, 0x3a, 0xbc, 0x9a, 0xcc,
+	0x10, 0xb6, 0x11, 0x0d, 0x37, 0x0b, 0x34, 0x4c, 0x2e, 0xe8, 0x26, 0xf2, 0xbf, 0x45, 0x3c, 0x95,
+	0x84, 0x81, 0xdf, 0xf5, 0x31, 0x70, 0x2a, 0x8c, 0x01, 0x46, 0x6b, 0xd4, 0xb4, 0x48, 0x93, 0x2d,
+	0x1f, 0xe7, 0x10, 0xff, 0xe2, 0x80, 0x29, 0xf6, 0xe6, 0xfd, 0xae, 0xcd, 0xf9, 0x10, 0x21, 0xd4,
+	0x33, 0x1c, 0x7b, 0x85, 0x7d, 0xa2, 0x20, 0xf8, 0x37, 0x2d, 0x9b, 0xce, 0xb3, 0xda, 0xc4, 0xb2,
+	0x6b, 0xe8, 0xa6, 0x79, 0x64, 0xd9, 0x2b, 0x7a, 0xc3, 0x32, 0x11, 0xe9, 0xd8, 0x54, 0x6f, 0xc7,
+	0xd0, 0x70, 0xef, 0x73, 0x12, 0xb6, 0x7f, 0x3a, 0x10, 0xb6, 0x1f, 0x4d, 0x32, 0x99, 0x64, 0x84,
+	0x6d, 0x2c, 0x78, 0xa7, 0x90, 0xe9, 0x60, 0x62, 0xdf, 0x48, 0x11, 0x6e, 0x5b, 0x84, 0x8e, 0x80,
+	0xdc, 0x5f, 0x81, 0xef, 0x1d, 0x82, 0xdc, 0xe2, 0x9a, 0xf4, 0xcf, 0x3a, 0xfc, 0xc6, 0x38, 0x38,
+	0xbc, 0xd1, 0x37, 0x5c, 0xb8, 0x98, 0x16, 0x99, 0xc9, 0x1f, 0x81, 0x37, 0x81, 0xf0, 0x6e, 0xbe,
+	0x5b, 0xfa, 0xae, 0xa2, 0x9e, 0x5b, 0xa2, 0xc8, 0x1b, 0x0c, 0xf2, 0x1e, 0xbe, 0x59, 0x50, 0xc3,
+	0x08, 0xef, 0x65, 0xb2, 0xdb, 0x84, 0xf4, 0xaf, 0x73, 0xa4, 0xdf, 0x0c, 0xbf, 0x9c, 0x03, 0x13,
+	0xef, 0x73, 0x28, 0xe2, 0xe1, 0xd6, 0x9e, 0x4e, 0x02, 0xcd, 0xc7, 0x72, 0x3e, 0x6a, 0x6e, 0xd9,
+	0x14, 0x6a, 0xc4, 0xb8, 0x2f, 0xfc, 0x62, 0xd9, 0x88, 0xf7, 0x1e, 0xb5, 0xdb, 0x69, 0xb0, 0x74,
+	0xef, 0x8f, 0x24, 0xee, 0x7f, 0x30, 0x10, 0xf7, 0x5f, 0x49, 0xea, 0xc2, 0x1f, 0xe7, 0x32, 0x02,
+	0x3f, 0x63, 0x50, 0x53, 0xf3, 0xe3, 0x1c, 0x2c, 0x0d, 0xe3, 0x47, 0xac, 0x89, 0xe2, 0x5a, 0xac,
+	0x60, 0x1d, 0x3e, 0x3d, 0x0e, 0x0e, 0x0d, 0x3c, 0xa7, 0x00, 0xcf, 0xa5, 0x27, 0x4d, 0xdf, 0x29,
+	0x87, 0x4d, 0x30, 0xe6, 0xc3, 0xf9, 0x6e, 0xe9, 0xdb, 0xd9, 0x18, 0x23, 0x3f, 0xf1, 0x23, 0xdd,
+	0x30, 0x58, 0x16, 0xb4, 0x4d, 0x8c, 0x79, 0x41, 0x32, 0xe6, 0x99, 0x08, 0x63, 0x3e, 0x9d, 0x04,
+	0xb7, 0x0f, 0x65, 0x65, 0x4c, 0x42, 0x6f, 0x91, 0xdc, 0xe0, 0x64, 0x4c, 0xb1, 0x08, 0x47, 0x11,
+	0x9f, 0x18, 0xde, 0xa6, 0x44, 0x89, 0xf7, 0x2e, 0x2d, 0x51, 0xce, 0xc0, 0xd3, 0xc3, 0x88, 0x12,
+	0x3a, 0x86, 0x53, 0x5c, 0x0b, 0x3d, 0xac, 0xc3, 0xbf, 0xce, 0x03, 0x34, 0xec, 0xd0, 0x09, 0xbc,
+	0x37, 0x75, 0x1e, 0x3c, 0xf0, 0xe4, 0xca, 0x66, 0x72, 0xea, 0x4f, 0x28, 0xdd, 0xd2, 0x37, 0xc7,
+	0xd4, 0x0f, 0xe7, 0xfa, 0x93, 0xea, 0x7e, 0x5f, 0x13, 0xb4, 0x5a, 0xb7, 0x8c, 0x3a, 0xaa, 0xeb,
+	0x2b, 0x38, 0xe2, 0xe6, 0xd0, 0x48, 0x6b, 0xd9, 0x48, 0x27, 0x06, 0x16, 0xd1, 0xe0, 0x8b, 0x0a,
+	0x5f, 0x17, 0x8f, 0x96, 0x65, 0xbe, 0xb5, 0x12, 0xf3, 0x17, 0x25, 0x80, 0xbf, 0x31, 0x10, 0xc0,
+	0x9f, 0x4b, 0x02, 0xf0, 0x1f, 0x6e, 0x82, 0x7a, 0xf1, 0xcc, 0x9c, 0xfb, 0x63, 0x80, 0x37, 0xdb,
+	0xed, 0x11, 0xc0, 0xba, 0x04, 0xef, 0x1e, 0x15, 0xac, 0xe1, 0x81, 0xbd, 0xbf, 0x6c, 0x1d, 0xfe,
+	0x74, 0x1c, 0x1c, 0x48, 0x3a, 0x88, 0x04, 0xef, 0xc8, 0x00, 0xd7, 0xd0, 0x09, 0xa6, 0xcd, 0x40,
+	0xf4, 0xe5, 0x7c, 0xb7, 0xf4, 0x91, 0xbc, 0xfa, 0xfc, 0x88, 0x10, 0x0d, 0xa3, 0x8d, 0x67, 0xc9,
+	0x21, 0xcc, 0x56, 0x1d, 0x5a, 0x17, 0x9e, 0xed, 0xd5, 0xd3, 0x2d, 0x0f, 0xe9, 0x24, 0xc1, 0xf9,
+	0xe4, 0xed, 0x86, 0xe5, 0x17, 0xe4, 0xb2, 0xe2, 0x99, 0xd8, 0xb2, 0xe2, 0x53, 0x49, 0x08, 0x7e,
+	0x3c, 0xcb, 0xb2, 0x42, 0x9e, 0x26, 0xdb, 0x92, 0xb5, 0xc5, 0xd7, 0x24, 0xf3, 0x9e, 0x1b, 0xc8,
+	0xbc, 0x4f, 0x26, 0xd9, 0xbd, 0xf6, 0x06, 0x10, 0x8f, 0x63, 0xa0, 0xdd, 0x2e, 0x76, 0x3a, 0x23,
+	0xb0, 0x6d, 0x1e, 0xde, 0x36, 0x34, 0x87, 0xd2, 0x2d, 0xaf, 0xb8, 0x26, 0x4f, 0xec, 0xad, 0xfb,
+	0xbf, 0x3a, 0xeb, 0xf0, 0x3f, 0xf3, 0x00, 0xf6, 0x9f, 0xae, 0x84, 0xb7, 0xa7, 0xce, 0x99, 0x42,
+	0xe7, 0x39, 0xd5, 0xb3, 0x19, 0xa5, 0x25, 0xbd, 0xfe, 0x51, 0xe9, 0x96, 0xba, 0x8a, 0xba, 0x18,
+	0x5e, 0x45, 0x1b, 0x2d, 0xcf, 0xc3, 0x36, 0x45, 0xfc, 0xb3, 0x69, 0x94, 0x51, 0x3b, 0x0b, 0xea,
+	0x77, 0xd2, 0x82, 0xfa, 0x04, 0x2c, 0x8e, 0xbc, 0xa0, 0x2e, 0x72, 0xb4, 0xc0, 0xff, 0xcf, 0x83,
+	0x6b, 0xfa, 0xce, 0x05, 0xc2, 0x33, 0x23, 0x80, 0x74, 0xd0, 0x31, 0x49, 0xf5, 0xf6, 0x6c, 0xc2,
+	0x12, 0xe0, 0xff, 0xad, 0x74, 0x4b, 0x5f, 0x52, 0xd4, 0xdf, 0x4a, 0xde, 0x36, 0x64, 0x1b, 0xd5,
+	0x48, 0xfa, 0x94, 0x8f, 0xf8, 0x1b, 0xe3, 0xff, 0x2d, 0xb7, 0xab, 0xb8, 0x03, 0xfb, 0x37, 0x00,
+	0xf6, 0xb7, 0xc2, 0x5b, 0x52, 0xc2, 0xbe, 0x28, 0x4e, 0x31, 0xfc, 0xc9, 0x38, 0xd8, 0x1f, 0x47,
+	0x22, 0x9c, 0xcf, 0x00, 0x5f, 0x1f, 0xfa, 0x67, 0x32, 0xc9, 0x4a, 0xe4, 0x7f, 0x32, 0xdf, 0x2d,
+	0xbd, 0xa4, 0xa8, 0x0f, 0x84, 0x87, 0xf6, 0x30, 0xde, 0x07, 0x8e, 0xe6, 0xc1, 0x61, 0x3f, 0x9f,
+	0x10, 0xac, 0xb3, 0x37, 0x92, 0x28, 0x2f, 0xb6, 0x07, 0xf3, 0x5f, 0x92, 0x98, 0xff, 0x42, 0x0c,
+	0xf3, 0x57, 0x92, 0x00, 0xf4, 0x7b, 0x29, 0x31, 0x1f, 0xf4, 0x7b, 0x4b, 0x50, 0xff, 0x7d, 0x89,
+	0xfa, 0xbf, 0x1d, 0x88, 0xfa, 0xbf, 0x48, 0x32, 0xfa, 0x4a, 0x6e, 0x4d, 0xf3, 0x1c, 0x87, 0x6a,
+	0xf3, 0x21, 0xf8, 0x87, 0x14, 0xa7, 0x5f, 0x31, 0x37, 0x49, 0x0d, 0xd5, 0xac, 0x15, 0x6c, 0x87,
+	0x02, 0x7b, 0x22, 0x4a, 0x0a, 0xe4, 0x78, 0xc8, 0xc4, 0x0d, 0x4c, 0x71, 0xdf, 0x92, 0x7f, 0x7d,
+	0xe4, 0xbd, 0xa3, 0x44, 0x4e, 0x14, 0xd7, 0x82, 0x46, 0xd7, 0xe1, 0xc7, 0xc6, 0xc1, 0x81, 0xa4,
+	0xa3, 0xc3, 0x23, 0xad, 0x2f, 0x36, 0x38, 0x52, 0xad, 0xde, 0x99, 0x59, 0x5e, 0x72, 0xe5, 0xe7,
+	0x4a, 0xb7, 0xf4, 0x82, 0xa2, 0x56, 0x92, 0x67, 0x09, 0x79, 0x58, 0x67, 0x67, 0xa2, 0xd8, 0x99,
+	0x28, 0xd2, 0x2e, 0x06, 0xe2, 0xa4, 0x08, 0x4e, 0xe3, 0xfd, 0xe5, 0x38, 0xb8, 0x36, 0x01, 0x92,
+	0xf0, 0x6c, 0x36, 0x28, 0xfb, 0x4c, 0xb8, 0x23, 0xab, 0xb8, 0x24, 0xc2, 0x67, 0xf2, 0xdd, 0xd2,
+	0xf7, 0x14, 0xf5, 0xe1, 0xf0, 0xa4, 0x11, 0x83, 0xff, 0xe6, 0xe6, 0x8d, 0xc2, 0xce, 0xc4, 0xf1,
+	0x8e, 0x9a, 0x38, 0x16, 0xe1, 0xf9, 0xac, 0x1c, 0x89, 0xcc, 0x1d, 0x4f, 0x8d, 0x83, 0x83, 0x89,
+	0x57, 0x0c, 0x60, 0xaa, 0xc1, 0x3f, 0xe1, 0xf6, 0x85, 0xfa, 0x6b, 0xd9, 0x15, 0x48, 0xd6, 0xfc,
+	0xaf, 0xd2, 0x2d, 0x7d, 0x59, 0x51, 0x3f, 0x98, 0x3c, 0x7d, 0xf8, 0x27, 0xd8, 0x76, 0xe6, 0x8f,
+	0x9d, 0xf9, 0x23, 0xed, 0x77, 0x86, 0x38, 0x37, 0x7a, 0x47, 0xaf, 0xff, 0x2a, 0x9c, 0x4c, 0x85,
+	0x50, 0x99, 0x2e, 0x99, 0xea, 0xbf, 0x07, 0xa4, 0xde, 0x99, 0x59, 0x5e, 0xb2, 0xe1, 0xb3, 0xf9,
+	0x6e, 0xe9, 0xfb, 0x8a, 0xfa, 0x48, 0x78, 0x0e, 0x89, 0x73, 0x60, 0x67, 0x12, 0xd9, 0x99, 0x44,
+	0x46, 0x9f, 0x44, 0xee, 0x86, 0x77, 0x65, 0x26, 0x4a, 0x64, 0x16, 0x79, 0x32, 0x0f, 0xf6, 0xf8,
+	0x97, 0x8f, 0xe0, 0xc9, 0x11, 0x81, 0x1e, 0xba, 0x9a, 0xa5, 0x9e, 0x4a, 0x25, 0xe3, 0x1f, 0xe4,
+	0x50, 0xba, 0xa5, 0x9f, 0x8c, 0xa9, 0x5f, 0xcf, 0x85, 0x19, 0x41, 0xad, 0x26, 0x3e, 0xb6, 0xca,
+	0x6f, 0x65, 0x61, 0x13, 0xe9, 0x2b, 0xd8, 0x63, 0xb4, 0xe0, 0xc7, 0x0b, 0xd3, 0xec, 0xb9, 0xa2,
+	0x2a, 0xa6, 0xab, 0x18, 0x0b, 0xae, 0xf0, 0x6b, 0x5a, 0x02, 0xfa, 0xb6, 0x89, 0xc4, 0x1d, 0x30,
+	0x32, 0xc7, 0xfc, 0x3b, 0xb8, 0x16, 0xb3, 0x83, 0x7d, 0xda, 0xc3, 0x36, 0xb2, 0x1d, 0x5f, 0x86,
+	0x6f, 0x95, 0xf3, 0xb0, 0x6d, 0x13, 0xd5, 0xde, 0x6e, 0x43, 0xf9, 0x71, 0x58, 0x18, 0x1d, 0xa1,
+	0xec, 0x7e, 0x1a, 0x7c, 0x36, 0xbc, 0x59, 0xe4, 0xdf, 0xdb, 0x4a, 0xb5, 0x59, 0x14, 0xbd, 0xd0,
+	0xa6, 0x9e, 0xc9, 0x24, 0x1b, 0x1a, 0xb3, 0xff, 0x45, 0x51, 0x9f, 0x1c, 0xf0, 0x99, 0x4d, 0x5c,
+	0xc2, 0xc2, 0xa6, 0x4c, 0x44, 0x82, 0xef, 0x67, 0xb8, 0x8d, 0x8d, 0x16, 0xc3, 0x2f, 0x27, 0x1d,
+	0x8e, 0x7e, 0x7f, 0x1b, 0xfc, 0x01, 0x8d, 0xd7, 0x46, 0x62, 0x30, 0xd8, 0x49, 0x75, 0xde, 0x09,
+	0xa9, 0xce, 0x69, 0x78, 0x6b, 0xca, 0x11, 0xdc, 0xbf, 0x14, 0x09, 0x9f, 0x1f, 0x07, 0xfb, 0x62,
+	0xb8, 0x85, 0xa7, 0xd3, 0x63, 0xdd, 0xa7, 0xc9, 0x7c, 0x16, 0x51, 0xc9, 0x92, 0xcf, 0xe7, 0xbb,
+	0xa5, 0x7f, 0x52, 0xd4, 0x6a, 0x78, 0x1c, 0x8f, 0x51, 0x23, 0x99, 0x19, 0x23, 0x8d, 0xe8, 0xa1,
+	0x0b, 0x93, 0xdb, 0x04, 0xff, 0xe7, 0x25, 0xfc, 0xff, 0x2c, 0x06, 0xff, 0x6e, 0x12, 0x96, 0x7e,
+	0x3f, 0x25, 0xfc, 0x43, 0xdd, 0xdb, 0x12, 0x0a, 0x7c, 0x57, 0x52, 0xe0, 0xc5, 0x81, 0x14, 0x78,
+	0x26, 0xc9, 0xec, 0xa7, 0x36, 0x73, 0xfa, 0x48, 0x04, 0x53, 0x84, 0x9c, 0xc5, 0x34, 0xd4, 0xa7,
+	0xa4, 0xac, 0xc6, 0xf5, 0x5a, 0x36, 0x36, 0x47, 0xa0, 0x47, 0xfa, 0x04, 0xc7, 0xa7, 0x47, 0x71,
+	0x2d, 0x64, 0xc3, 0x3a, 0xfc, 0xd6, 0x38, 0x80, 0xfd, 0x97, 0x4a, 0x47, 0xfa, 0xc6, 0x3c, 0xf0,
+	0x42, 0xac, 0x7a, 0x36, 0xa3, 0xb4, 0x64, 0xcd, 0x17, 0xf3, 0xdd, 0xd2, 0x6b, 0x8a, 0xfa, 0xd2,
+	0xae, 0xe4, 0xb9, 0xc5, 0x95, 0x33, 0x04, 0x03, 0xb4, 0x87, 0x0d, 0x6c, 0xd3, 0x46, 0xa7, 0x47,
+	0x1f, 0xb9, 0x19, 0x30, 0x17, 0xac, 0x20, 0xe6, 0xc4, 0x47, 0x0c, 0x56, 0x9b, 0xff, 0xe0, 0x57,
+	0x46, 0x7b, 0x4b, 0x6b, 0xa9, 0x54, 0x8a, 0x39, 0x5e, 0x4f, 0x90, 0x4d, 0x3f, 0x9e, 0x94, 0x11,
+	0x1d, 0xc3, 0x5e, 0x70, 0xf0, 0x4d, 0x2e, 0x36, 0x42, 0xd3, 0x18, 0x5b, 0xb9, 0x6f, 0x78, 0x97,
+	0x80, 0x9d, 0xb3, 0xef, 0xd9, 0x19, 0x18, 0xc0, 0x32, 0x27, 0x99, 0xcd, 0x22, 0x5d, 0x10, 0xd6,
+	0xc6, 0x6d, 0x8a, 0xaa, 0x0d, 0xc7, 0x78, 0x6c, 0x0e, 0x11, 0xce, 0xf5, 0x0e, 0xaf, 0x26, 0xce,
+	0x28, 0x62, 0x13, 0x39, 0x76, 0xa3, 0x13, 0x98, 0xc0, 0xea, 0x45, 0x27, 0x4a, 0xd1, 0x88, 0xe5,
+	0xd8, 0x6f, 0xd3, 0xfb, 0x0b, 0x19, 0x0e, 0x28, 0x66, 0x3a, 0x90, 0x38, 0x7c, 0x53, 0x56, 0xaa,
+	0xc5, 0xa4, 0xb8, 0x26, 0x7f, 0xfa, 0x74, 0x6a, 0xb2, 0xad, 0xa4, 0x9f, 0xe6, 0xc1, 0xbe, 0xd8,
+	0x1d, 0xdc, 0x91, 0xa6, 0x9a, 0xe4, 0x5b, 0xc1, 0xea, 0x7c, 0x16, 0x51, 0x49, 0x9a, 0x1f, 0x29,
+	0xdd, 0xd2, 0xc7, 0x15, 0xf5, 0x7f, 0x22, 0x6b, 0x86, 0xde, 0xc1, 0x66, 0xa6, 0x92, 0x01, 0xcc,
+	0x36, 0xc5, 0x4e, 0x92, 0x63, 0x31, 0x5e, 0xc9, 0x4b, 0xb5, 0x92, 0x4a, 0xe2, 0x4e, 0x2b, 0xaa,
+	0x76, 0x90, 0x1e, 0xec, 0xe1, 0x46, 0xe9, 0x21, 0x2e, 0x64, 0x86, 0x57, 0x14, 0xc3, 0x96, 0x1b,
+	0x73, 0xc8, 0xd0, 0x1b, 0x06, 0xbf, 0xd4, 0xc8, 0x60, 0x1b, 0xd9, 0xc6, 0x92, 0x87, 0xa8, 0xa2,
+	0x49, 0xa0, 0x1e, 0xdd, 0x43, 0xb6, 0x48, 0x8f, 0x32, 0x8c, 0x57, 0x0c, 0xbc, 0xab, 0x9e, 0x45,
+	0xd9, 0x00, 0x40, 0xa8, 0x4e, 0xf1, 0x36, 0x41, 0xfc, 0x0b, 0x12, 0xe2, 0x9f, 0x1d, 0x08, 0xf1,
+	0xc7, 0x13, 0x10, 0xfe, 0x58, 0xe2, 0x14, 0xd8, 0x8f, 0xf0, 0x25, 0x31, 0x91, 0x95, 0xbc, 0x5a,
+	0xab, 0xc9, 0x3c, 0x25, 0x81, 0xee, 0xcf, 0x6f, 0x76, 0xab, 0x59, 0x15, 0xc9, 0x71, 0xf8, 0x1c,
+	0x5a, 0x12, 0xb8, 0x6f, 0x87, 0xf3, 0xa3, 0xcf, 0x13, 0x58, 0x42, 0xab, 0x22, 0x9d, 0x0f, 0xff,
+	0x35, 0x0f, 0xf6, 0xc7, 0x6f, 0x1f, 0xc3, 0x34, 0x20, 0x8d, 0xdd, 0x84, 0x56, 0xcf, 0x64, 0x92,
+	0x95, 0x08, 0xff, 0x81, 0xd2, 0x2d, 0x3d, 0xa1, 0xa8, 0xaf, 0xe7, 0xa2, 0xd9, 0x54, 0xcf, 0x01,
+	0x24, 0x18, 0xba, 0xed, 0x00, 0xe8, 0x7e, 0x09, 0x5a, 0xc6, 0x7e, 0x25, 0x36, 0x56, 0x07, 0xe5,
+	0x7a, 0x23, 0x8c, 0x3e, 0x8e, 0x6f, 0xb4, 0xec, 0x39, 0xcd, 0x37, 0x1c, 0xe0, 0x21, 0x03, 0xde,
+	0xaa, 0x18, 0xff, 0xb4, 0xc4, 0xf8, 0x1f, 0x0d, 0xc4, 0x38, 0x4d, 0xc0, 0xf8, 0x07, 0x37, 0x87,
+	0xf1, 0xaa, 0x6e, 0xc6, 0xef, 0x63, 0x24, 0x01, 0xfb, 0x2c, 0x3c, 0x93, 0x01, 0xd8, 0xbe, 0xd3,
+	0xe1, 0xb7, 0x76, 0x83, 0xa9, 0xf0, 0x65, 0x6b, 0xf8, 0xab, 0x23, 0x20, 0x33, 0xe1, 0xfe, 0xb8,
+	0x7a, 0x6b, 0x6a, 0x39, 0x89, 0xe6, 0x6f, 0x8e, 0x77, 0x4b, 0xff, 0x97, 0x57, 0x9f, 0x1b, 0x0b,
+	0xa3, 0x19, 0xb7, 0x5d, 0x6c, 0x30, 0x18, 0x04, 0xf7, 0x7b, 0x91, 0xb8, 0xc6, 0xcc, 0xbd, 0x31,
+	0xd7, 0x3b, 0x92, 0xb1, 0x8c, 0xb1, 0x78, 0x0a, 0xee, 0xb2, 0xca, 0x9d, 0x20, 0x1f, 0xf7, 0xe2,
+	0x49, 0xdc, 0x42, 0x15, 0x88, 0xe7, 0x72, 0x12, 0x88, 0xbd, 0x7b, 0xcb, 0xc8, 0xb2, 0x25, 0x9e,
+	0xfb, 0xae, 0xb3, 0x87, 0x37, 0x54, 0x87, 0xb3, 0x80, 0xe7, 0xef, 0xbd, 0x14, 0xa6, 0x65, 0x5b,
+	0x2b, 0xd8, 0x23, 0xba, 0x3c, 0x32, 0x15, 0xd9, 0xa6, 0x12, 0x49, 0x0a, 0x75, 0x6a, 0x98, 0xd6,
+	0xb1, 0xd7, 0x3b, 0x58, 0x29, 0x2c, 0x64, 0xeb, 0x7e, 0xc2, 0x50, 0xdd, 0xc2, 0x66, 0x24, 0xcd,
+	0x1a, 0x89, 0x5d, 0x89, 0xfc, 0x99, 0xe3, 0x6e, 0xa9, 0x3b, 0xab, 0xc8, 0x68, 0x38, 0x04, 0xf7,
+	0x5c, 0x20, 0x40, 0x66, 0xf5, 0x36, 0x93, 0xf5, 0xb6, 0x30, 0x00, 0x89, 0xfb, 0xae, 0x61, 0xd5,
+	0xdb, 0x44, 0xc2, 0x7f, 0x96, 0x24, 0xfc, 0x87, 0x81, 0x24, 0xfc, 0x6a, 0x52, 0x2e, 0xf5, 0xb9,
+	0xdc, 0xe6, 0x68, 0x68, 0xe8, 0x36, 0x4f, 0x9b, 0x70, 0xdb, 0xc0, 0xd8, 0x0c, 0x39, 0x86, 0xdf,
+	0x00, 0x8e, 0x4f, 0x3d, 0x44, 0xe2, 0x43, 0xb7, 0x51, 0x15, 0x8b, 0x8a, 0x02, 0x0a, 0xba, 0x78,
+	0xd8, 0xf4, 0x0a, 0x9f, 0x48, 0x0a, 0x55, 0x18, 0x46, 0xe0, 0xdf, 0xef, 0x02, 0xe3, 0xe2, 0x6f,
+	0x28, 0xc2, 0xe3, 0xa3, 0xac, 0xce, 0xc3, 0x7f, 0xc2, 0x51, 0x3d, 0x91, 0x42, 0x42, 0x72, 0xf5,
+	0xc7, 0xb9, 0x6e, 0xe9, 0x8b, 0x39, 0xb5, 0x18, 0xac, 0x47, 0x58, 0xaa, 0xef, 0x2f, 0x54, 0x49,
+	0xff, 0xd1, 0xf2, 0xa6, 0x63, 0xb6, 0x1a, 0xb8, 0xa0, 0x51, 0x30, 0x33, 0x08, 0x30, 0xae, 0x30,
+	0xbf, 0x9c, 0x09, 0x21, 0xed, 0xd0, 0x0b, 0xe2, 0x62, 0xa3, 0x78, 0xfc, 0xb6, 0x8a, 0x50, 0x58,
+	0x68, 0x9a, 0xdc, 0xb9, 0x1a, 0x44, 0x1b, 0x38, 0x97, 0x57, 0x5d, 0xb8, 0xef, 0xe5, 0x57, 0x67,
+	0x72, 0x3f, 0x7c, 0x75, 0x26, 0xf7, 0x1f, 0xaf, 0xce, 0xe4, 0xae, 0xbc, 0x36, 0x73, 0xd5, 0x0f,
+	0x5f, 0x9b, 0xb9, 0xea, 0x27, 0xaf, 0xcd, 0x5c, 0xf5, 0xf0, 0xa9, 0x90, 0x35, 0x35, 0x4f, 0x5f,
+	0xb1, 0x68, 0xe7, 0x98, 0x89, 0x57, 0xc2, 0xba, 0xc2, 0x26, 0xf0, 0x4b, 0xe3, 0xd5, 0x71, 0xfe,
+	0xe7, 0xbc, 0x4e, 0xfd, 0x62, 0x00, 0x54, 0x04, 0x11, 0x82, 0xe1, 0x58, 0x00, 0x00,
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Get existing liquidity pools.
+	LiquidityPools(ctx context.Context, in *QueryLiquidityPoolsRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolsResponse, error)
+	// Get specific liquidity pool.
+	LiquidityPool(ctx context.Context, in *QueryLiquidityPoolRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolResponse, error)
+	// Get specific liquidity pool corresponding to the pool_coin_denom.
+	LiquidityPoolByPoolCoinDenom(ctx context.Context, in *QueryLiquidityPoolByPoolCoinDenomRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolResponse, error)
+	// Get specific liquidity pool corresponding to the reserve account.
+	LiquidityPoolByReserveAcc(ctx context.Context, in *QueryLiquidityPoolByReserveAccRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolResponse, error)
+	// Get all liquidity pools with the reserve coin denom.
+	LiquidityPoolsByReserveCoinDenom(ctx context.Context, in *QueryLiquidityPoolsByReserveCoinDenomRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolsResponse, error)
+	// Get all liquidity pools with both denoms of the pair.
+	LiquidityPoolsByPair(ctx context.Context, in *QueryLiquidityPoolsByPairRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolsResponse, error)
+	// Get the pool's current batch.
+	LiquidityPoolBatch(ctx context.Context, in *QueryLiquidityPoolBatchRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolBatchResponse, error)
+	// Get all swap messages in the pool's current batch.
+	PoolBatchSwapMsgs(ctx context.Context, in *QueryPoolBatchSwapMsgsRequest, opts ...grpc.CallOption) (*QueryPoolBatchSwapMsgsResponse, error)
+	// Get a specific swap message in the pool's current batch.
+	PoolBatchSwapMsg(ctx context.Context, in *QueryPoolBatchSwapMsgRequest, opts ...grpc.CallOption) (*QueryPoolBatchSwapMsgResponse, error)
+	// Get all deposit messages in the pool's current batch.
+	PoolBatchDepositMsgs(ctx context.Context, in *QueryPoolBatchDepositMsgsRequest, opts ...grpc.CallOption) (*QueryPoolBatchDepositMsgsResponse, error)
+	// Get a specific deposit message in the pool's current batch.
+	PoolBatchDepositMsg(ctx context.Context, in *QueryPoolBatchDepositMsgRequest, opts ...grpc.CallOption) (*QueryPoolBatchDepositMsgResponse, error)
+	// Get all withdraw messages in the pool's current batch.
+	PoolBatchWithdrawMsgs(ctx context.Context, in *QueryPoolBatchWithdrawMsgsRequest, opts ...grpc.CallOption) (*QueryPoolBatchWithdrawMsgsResponse, error)
+	// Get a specific withdraw message in the pool's current batch.
+	PoolBatchWithdrawMsg(ctx context.Context, in *QueryPoolBatchWithdrawMsgRequest, opts ...grpc.CallOption) (*QueryPoolBatchWithdrawMsgResponse, error)
+	// Get the time-weighted average price of the pool between two heights or timestamps.
+	PoolTwap(ctx context.Context, in *QueryPoolTwapRequest, opts ...grpc.CallOption) (*QueryPoolTwapResponse, error)
+	// Get all recorded results of the executed batches of the pool.
+	PoolBatchRecords(ctx context.Context, in *QueryPoolBatchRecordsRequest, opts ...grpc.CallOption) (*QueryPoolBatchRecordsResponse, error)
+	// Get the recorded result of an executed batch of the pool.
+	PoolBatchRecord(ctx context.Context, in *QueryPoolBatchRecordRequest, opts ...grpc.CallOption) (*QueryPoolBatchRecordResponse, error)
+	// Get all batch messages of an address across all pools.
+	BatchMsgsByAddress(ctx context.Context, in *QueryBatchMsgsByAddressRequest, opts ...grpc.CallOption) (*QueryBatchMsgsByAddressResponse, error)
+	// Estimate the result of a deposit to the pool on the current reserves.
+	EstimateDeposit(ctx context.Context, in *QueryEstimateDepositRequest, opts ...grpc.CallOption) (*QueryEstimateDepositResponse, error)
+	// Estimate the result of a withdrawal from the pool on the current reserves.
+	EstimateWithdraw(ctx context.Context, in *QueryEstimateWithdrawRequest, opts ...grpc.CallOption) (*QueryEstimateWithdrawResponse, error)
+	// Simulate a swap of the pool with the swap orders queued in the batch of the pool on the current reserves.
+	SimulateSwap(ctx context.Context, in *QuerySimulateSwapRequest, opts ...grpc.CallOption) (*QuerySimulateSwapResponse, error)
+	// Get all parameters of the liquidity module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LiquidityPools(ctx context.Context, in *QueryLiquidityPoolsRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolsResponse, error) {
+	out := new(QueryLiquidityPoolsResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/LiquidityPools", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LiquidityPool(ctx context.Context, in *QueryLiquidityPoolRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolResponse, error) {
+	out := new(QueryLiquidityPoolResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/LiquidityPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) LiquidityPoolByPoolCoinDenom(ctx context.Context, in *QueryLiquidityPoolByPoolCoinDenomRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolResponse, error) {
+	out := new(QueryLiquidityPoolResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/LiquidityPoolByPoolCoinDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPoolBatchSwapMsgsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LiquidityPoolByReserveAcc(ctx context.Context, in *QueryLiquidityPoolByReserveAccRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolResponse, error) {
+	out := new(QueryLiquidityPoolResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/LiquidityPoolByReserveAcc", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPoolBatchSwapMsgsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LiquidityPoolsByReserveCoinDenom(ctx context.Context, in *QueryLiquidityPoolsByReserveCoinDenomRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolsResponse, error) {
+	out := new(QueryLiquidityPoolsResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/LiquidityPoolsByReserveCoinDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryPoolBatchSwapMsgsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) LiquidityPoolsByPair(ctx context.Context, in *QueryLiquidityPoolsByPairRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolsResponse, error) {
+	out := new(QueryLiquidityPoolsResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/LiquidityPoolsByPair", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPoolBatchSwapMsgRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LiquidityPoolBatch(ctx context.Context, in *QueryLiquidityPoolBatchRequest, opts ...grpc.CallOption) (*QueryLiquidityPoolBatchResponse, error) {
+	out := new(QueryLiquidityPoolBatchResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/LiquidityPoolBatch", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPoolBatchSwapMsgRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PoolBatchSwapMsgs(ctx context.Context, in *QueryPoolBatchSwapMsgsRequest, opts ...grpc.CallOption) (*QueryPoolBatchSwapMsgsResponse, error) {
+	out := new(QueryPoolBatchSwapMsgsResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/PoolBatchSwapMsgs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryPoolBatchSwapMsgRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MsgIndex != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MsgIndex))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryPoolBatchSwapMsgsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PoolBatchSwapMsg(ctx context.Context, in *QueryPoolBatchSwapMsgRequest, opts ...grpc.CallOption) (*QueryPoolBatchSwapMsgResponse, error) {
+	out := new(QueryPoolBatchSwapMsgResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/PoolBatchSwapMsg", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPoolBatchSwapMsgsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryPoolBatchSwapMsgsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Swaps) > 0 {
-		for iNdEx := len(m.Swaps) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Swaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) PoolBatchDepositMsgs(ctx context.Context, in *QueryPoolBatchDepositMsgsRequest, opts ...grpc.CallOption) (*QueryPoolBatchDepositMsgsResponse, error) {
+	out := new(QueryPoolBatchDepositMsgsResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/PoolBatchDepositMsgs", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPoolBatchSwapMsgResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PoolBatchDepositMsg(ctx context.Context, in *QueryPoolBatchDepositMsgRequest, opts ...grpc.CallOption) (*QueryPoolBatchDepositMsgResponse, error) {
+	out := new(QueryPoolBatchDepositMsgResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/PoolBatchDepositMsg", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPoolBatchSwapMsgResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryPoolBatchSwapMsgResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Swap.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) PoolBatchWithdrawMsgs(ctx context.Context, in *QueryPoolBatchWithdrawMsgsRequest, opts ...grpc.CallOption) (*QueryPoolBatchWithdrawMsgsResponse, error) {
+	out := new(QueryPoolBatchWithdrawMsgsResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/PoolBatchWithdrawMsgs", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPoolBatchDepositMsgsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PoolBatchWithdrawMsg(ctx context.Context, in *QueryPoolBatchWithdrawMsgRequest, opts ...grpc.CallOption) (*QueryPoolBatchWithdrawMsgResponse, error) {
+	out := new(QueryPoolBatchWithdrawMsgResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/PoolBatchWithdrawMsg", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPoolBatchDepositMsgsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryPoolBatchDepositMsgsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) PoolTwap(ctx context.Context, in *QueryPoolTwapRequest, opts ...grpc.CallOption) (*QueryPoolTwapResponse, error) {
+	out := new(QueryPoolTwapResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/PoolTwap", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPoolBatchDepositMsgRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PoolBatchRecords(ctx context.Context, in *QueryPoolBatchRecordsRequest, opts ...grpc.CallOption) (*QueryPoolBatchRecordsResponse, error) {
+	out := new(QueryPoolBatchRecordsResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/PoolBatchRecords", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPoolBatchDepositMsgRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryPoolBatchDepositMsgRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MsgIndex != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MsgIndex))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) PoolBatchRecord(ctx context.Context, in *QueryPoolBatchRecordRequest, opts ...grpc.CallOption) (*QueryPoolBatchRecordResponse, error) {
+	out := new(QueryPoolBatchRecordResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/PoolBatchRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPoolBatchDepositMsgsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BatchMsgsByAddress(ctx context.Context, in *QueryBatchMsgsByAddressRequest, opts ...grpc.CallOption) (*QueryBatchMsgsByAddressResponse, error) {
+	out := new(QueryBatchMsgsByAddressResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/BatchMsgsByAddress", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPoolBatchDepositMsgsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryPoolBatchDepositMsgsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Deposits) > 0 {
-		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) EstimateDeposit(ctx context.Context, in *QueryEstimateDepositRequest, opts ...grpc.CallOption) (*QueryEstimateDepositResponse, error) {
+	out := new(QueryEstimateDepositResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/EstimateDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPoolBatchDepositMsgResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EstimateWithdraw(ctx context.Context, in *QueryEstimateWithdrawRequest, opts ...grpc.CallOption) (*QueryEstimateWithdrawResponse, error) {
+	out := new(QueryEstimateWithdrawResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/EstimateWithdraw", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPoolBatchDepositMsgResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryPoolBatchDepositMsgResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) SimulateSwap(ctx context.Context, in *QuerySimulateSwapRequest, opts ...grpc.CallOption) (*QuerySimulateSwapResponse, error) {
+	out := new(QuerySimulateSwapResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/SimulateSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPoolBatchWithdrawMsgsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPoolBatchWithdrawMsgsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryPoolBatchWithdrawMsgsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Get existing liquidity pools.
+	LiquidityPools(context.Context, *QueryLiquidityPoolsRequest) (*QueryLiquidityPoolsResponse, error)
+	// Get specific liquidity pool.
+	LiquidityPool(context.Context, *QueryLiquidityPoolRequest) (*QueryLiquidityPoolResponse, error)
+	// Get specific liquidity pool corresponding to the pool_coin_denom.
+	LiquidityPoolByPoolCoinDenom(context.Context, *QueryLiquidityPoolByPoolCoinDenomRequest) (*QueryLiquidityPoolResponse, error)
+	// Get specific liquidity pool corresponding to the reserve account.
+	LiquidityPoolByReserveAcc(context.Context, *QueryLiquidityPoolByReserveAccRequest) (*QueryLiquidityPoolResponse, error)
+	// Get all liquidity pools with the reserve coin denom.
+	LiquidityPoolsByReserveCoinDenom(context.Context, *QueryLiquidityPoolsByReserveCoinDenomRequest) (*QueryLiquidityPoolsResponse, error)
+	// Get all liquidity pools with both denoms of the pair.
+	LiquidityPoolsByPair(context.Context, *QueryLiquidityPoolsByPairRequest) (*QueryLiquidityPoolsResponse, error)
+	// Get the pool's current batch.
+	LiquidityPoolBatch(context.Context, *QueryLiquidityPoolBatchRequest) (*QueryLiquidityPoolBatchResponse, error)
+	// Get all swap messages in the pool's current batch.
+	PoolBatchSwapMsgs(context.Context, *QueryPoolBatchSwapMsgsRequest) (*QueryPoolBatchSwapMsgsResponse, error)
+	// Get a specific swap message in the pool's current batch.
+	PoolBatchSwapMsg(context.Context, *QueryPoolBatchSwapMsgRequest) (*QueryPoolBatchSwapMsgResponse, error)
+	// Get all deposit messages in the pool's current batch.
+	PoolBatchDepositMsgs(context.Context, *QueryPoolBatchDepositMsgsRequest) (*QueryPoolBatchDepositMsgsResponse, error)
+	// Get a specific deposit message in the pool's current batch.
+	PoolBatchDepositMsg(context.Context, *QueryPoolBatchDepositMsgRequest) (*QueryPoolBatchDepositMsgResponse, error)
+	// Get all withdraw messages in the pool's current batch.
+	PoolBatchWithdrawMsgs(context.Context, *QueryPoolBatchWithdrawMsgsRequest) (*QueryPoolBatchWithdrawMsgsResponse, error)
+	// Get a specific withdraw message in the pool's current batch.
+	PoolBatchWithdrawMsg(context.Context, *QueryPoolBatchWithdrawMsgRequest) (*QueryPoolBatchWithdrawMsgResponse, error)
+	// Get the time-weighted average price of the pool between two heights or timestamps.
+	PoolTwap(context.Context, *QueryPoolTwapRequest) (*QueryPoolTwapResponse, error)
+	// Get all recorded results of the executed batches of the pool.
+	PoolBatchRecords(context.Context, *QueryPoolBatchRecordsRequest) (*QueryPoolBatchRecordsResponse, error)
+	// Get the recorded result of an executed batch of the pool.
+	PoolBatchRecord(context.Context, *QueryPoolBatchRecordRequest) (*QueryPoolBatchRecordResponse, error)
+	// Get all batch messages of an address across all pools.
+	BatchMsgsByAddress(context.Context, *QueryBatchMsgsByAddressRequest) (*QueryBatchMsgsByAddressResponse, error)
+	// Estimate the result of a deposit to the pool on the current reserves.
+	EstimateDeposit(context.Context, *QueryEstimateDepositRequest) (*QueryEstimateDepositResponse, error)
+	// Estimate the result of a withdrawal from the pool on the current reserves.
+	EstimateWithdraw(context.Context, *QueryEstimateWithdrawRequest) (*QueryEstimateWithdrawResponse, error)
+	// Simulate a swap of the pool with the swap orders queued in the batch of the pool on the current reserves.
+	SimulateSwap(context.Context, *QuerySimulateSwapRequest) (*QuerySimulateSwapResponse, error)
+	// Get all parameters of the liquidity module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
 
-func (m *QueryPoolBatchWithdrawMsgRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryPoolBatchWithdrawMsgRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) LiquidityPools(ctx context.Context, req *QueryLiquidityPoolsRequest) (*QueryLiquidityPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityPools not implemented")
+}
+func (*UnimplementedQueryServer) LiquidityPool(ctx context.Context, req *QueryLiquidityPoolRequest) (*QueryLiquidityPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityPool not implemented")
+}
+func (*UnimplementedQueryServer) LiquidityPoolByPoolCoinDenom(ctx context.Context, req *QueryLiquidityPoolByPoolCoinDenomRequest) (*QueryLiquidityPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityPoolByPoolCoinDenom not implemented")
+}
+func (*UnimplementedQueryServer) LiquidityPoolByReserveAcc(ctx context.Context, req *QueryLiquidityPoolByReserveAccRequest) (*QueryLiquidityPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityPoolByReserveAcc not implemented")
+}
+func (*UnimplementedQueryServer) LiquidityPoolsByReserveCoinDenom(ctx context.Context, req *QueryLiquidityPoolsByReserveCoinDenomRequest) (*QueryLiquidityPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityPoolsByReserveCoinDenom not implemented")
+}
+func (*UnimplementedQueryServer) LiquidityPoolsByPair(ctx context.Context, req *QueryLiquidityPoolsByPairRequest) (*QueryLiquidityPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityPoolsByPair not implemented")
+}
+func (*UnimplementedQueryServer) LiquidityPoolBatch(ctx context.Context, req *QueryLiquidityPoolBatchRequest) (*QueryLiquidityPoolBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityPoolBatch not implemented")
+}
+func (*UnimplementedQueryServer) PoolBatchSwapMsgs(ctx context.Context, req *QueryPoolBatchSwapMsgsRequest) (*QueryPoolBatchSwapMsgsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolBatchSwapMsgs not implemented")
+}
+func (*UnimplementedQueryServer) PoolBatchSwapMsg(ctx context.Context, req *QueryPoolBatchSwapMsgRequest) (*QueryPoolBatchSwapMsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolBatchSwapMsg not implemented")
+}
+func (*UnimplementedQueryServer) PoolBatchDepositMsgs(ctx context.Context, req *QueryPoolBatchDepositMsgsRequest) (*QueryPoolBatchDepositMsgsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolBatchDepositMsgs not implemented")
+}
+func (*UnimplementedQueryServer) PoolBatchDepositMsg(ctx context.Context, req *QueryPoolBatchDepositMsgRequest) (*QueryPoolBatchDepositMsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolBatchDepositMsg not implemented")
+}
+func (*UnimplementedQueryServer) PoolBatchWithdrawMsgs(ctx context.Context, req *QueryPoolBatchWithdrawMsgsRequest) (*QueryPoolBatchWithdrawMsgsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolBatchWithdrawMsgs not implemented")
+}
+func (*UnimplementedQueryServer) PoolBatchWithdrawMsg(ctx context.Context, req *QueryPoolBatchWithdrawMsgRequest) (*QueryPoolBatchWithdrawMsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolBatchWithdrawMsg not implemented")
+}
+func (*UnimplementedQueryServer) PoolTwap(ctx context.Context, req *QueryPoolTwapRequest) (*QueryPoolTwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolTwap not implemented")
+}
+func (*UnimplementedQueryServer) PoolBatchRecords(ctx context.Context, req *QueryPoolBatchRecordsRequest) (*QueryPoolBatchRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolBatchRecords not implemented")
+}
+func (*UnimplementedQueryServer) PoolBatchRecord(ctx context.Context, req *QueryPoolBatchRecordRequest) (*QueryPoolBatchRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolBatchRecord not implemented")
+}
+func (*UnimplementedQueryServer) BatchMsgsByAddress(ctx context.Context, req *QueryBatchMsgsByAddressRequest) (*QueryBatchMsgsByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchMsgsByAddress not implemented")
+}
+func (*UnimplementedQueryServer) EstimateDeposit(ctx context.Context, req *QueryEstimateDepositRequest) (*QueryEstimateDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateDeposit not implemented")
+}
+func (*UnimplementedQueryServer) EstimateWithdraw(ctx context.Context, req *QueryEstimateWithdrawRequest) (*QueryEstimateWithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateWithdraw not implemented")
+}
+func (*UnimplementedQueryServer) SimulateSwap(ctx context.Context, req *QuerySimulateSwapRequest) (*QuerySimulateSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateSwap not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
 
-func (m *QueryPoolBatchWithdrawMsgRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MsgIndex != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MsgIndex))
-		i--
-		dAtA[i] = 0x10
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_LiquidityPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidityPoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityPools(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/LiquidityPools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityPools(ctx, req.(*QueryLiquidityPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolBatchWithdrawMsgsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_LiquidityPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidityPoolRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/LiquidityPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityPool(ctx, req.(*QueryLiquidityPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolBatchWithdrawMsgsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_LiquidityPoolByPoolCoinDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidityPoolByPoolCoinDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityPoolByPoolCoinDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/LiquidityPoolByPoolCoinDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityPoolByPoolCoinDenom(ctx, req.(*QueryLiquidityPoolByPoolCoinDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolBatchWithdrawMsgsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_LiquidityPoolByReserveAcc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidityPoolByReserveAccRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Withdraws) > 0 {
-		for iNdEx := len(m.Withdraws) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Withdraws[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityPoolByReserveAcc(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/LiquidityPoolByReserveAcc",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityPoolByReserveAcc(ctx, req.(*QueryLiquidityPoolByReserveAccRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolBatchWithdrawMsgResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_LiquidityPoolsByReserveCoinDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidityPoolsByReserveCoinDenomRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityPoolsByReserveCoinDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/LiquidityPoolsByReserveCoinDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityPoolsByReserveCoinDenom(ctx, req.(*QueryLiquidityPoolsByReserveCoinDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolBatchWithdrawMsgResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_LiquidityPoolsByPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidityPoolsByPairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityPoolsByPair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/LiquidityPoolsByPair",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityPoolsByPair(ctx, req.(*QueryLiquidityPoolsByPairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolBatchWithdrawMsgResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Withdraw.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_LiquidityPoolBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidityPoolBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityPoolBatch(ctx, in)
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryLiquidityPoolRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/LiquidityPoolBatch",
 	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityPoolBatch(ctx, req.(*QueryLiquidityPoolBatchRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLiquidityPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PoolBatchSwapMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolBatchSwapMsgsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Pool.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryLiquidityPoolByPoolCoinDenomRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(QueryServer).PoolBatchSwapMsgs(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.PoolCoinDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/PoolBatchSwapMsgs",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolBatchSwapMsgs(ctx, req.(*QueryPoolBatchSwapMsgsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLiquidityPoolByReserveAccRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PoolBatchSwapMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolBatchSwapMsgRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ReserveAcc)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).PoolBatchSwapMsg(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryLiquidityPoolBatchRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/PoolBatchSwapMsg",
 	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolBatchSwapMsg(ctx, req.(*QueryPoolBatchSwapMsgRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLiquidityPoolBatchResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PoolBatchDepositMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolBatchDepositMsgsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Batch.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryLiquidityPoolsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(QueryServer).PoolBatchDepositMsgs(ctx, in)
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/PoolBatchDepositMsgs",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolBatchDepositMsgs(ctx, req.(*QueryPoolBatchDepositMsgsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLiquidityPoolsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PoolBatchDepositMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolBatchDepositMsgRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Pools) > 0 {
-		for _, e := range m.Pools {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolBatchDepositMsg(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/PoolBatchDepositMsg",
 	}
-	return n
-}
-
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolBatchDepositMsg(ctx, req.(*QueryPoolBatchDepositMsgRequest))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PoolBatchWithdrawMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolBatchWithdrawMsgsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).PoolBatchWithdrawMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/PoolBatchWithdrawMsgs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolBatchWithdrawMsgs(ctx, req.(*QueryPoolBatchWithdrawMsgsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolBatchSwapMsgsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PoolBatchWithdrawMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolBatchWithdrawMsgRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	if interceptor == nil {
+		return srv.(QueryServer).PoolBatchWithdrawMsg(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/PoolBatchWithdrawMsg",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolBatchWithdrawMsg(ctx, req.(*QueryPoolBatchWithdrawMsgRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolBatchSwapMsgRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PoolTwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolTwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	if interceptor == nil {
+		return srv.(QueryServer).PoolTwap(ctx, in)
 	}
-	if m.MsgIndex != 0 {
-		n += 1 + sovQuery(uint64(m.MsgIndex))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/PoolTwap",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolTwap(ctx, req.(*QueryPoolTwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolBatchSwapMsgsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PoolBatchRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolBatchRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Swaps) > 0 {
-		for _, e := range m.Swaps {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolBatchRecords(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/PoolBatchRecords",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolBatchRecords(ctx, req.(*QueryPoolBatchRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolBatchSwapMsgResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PoolBatchRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolBatchRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Swap.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).PoolBatchRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/PoolBatchRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolBatchRecord(ctx, req.(*QueryPoolBatchRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolBatchDepositMsgsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_BatchMsgsByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBatchMsgsByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	if interceptor == nil {
+		return srv.(QueryServer).BatchMsgsByAddress(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/BatchMsgsByAddress",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BatchMsgsByAddress(ctx, req.(*QueryBatchMsgsByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolBatchDepositMsgRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_EstimateDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateDeposit(ctx, in)
 	}
-	if m.MsgIndex != 0 {
-		n += 1 + sovQuery(uint64(m.MsgIndex))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/EstimateDeposit",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateDeposit(ctx, req.(*QueryEstimateDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolBatchDepositMsgsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_EstimateWithdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateWithdrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Deposits) > 0 {
-		for _, e := range m.Deposits {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateWithdraw(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/EstimateWithdraw",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateWithdraw(ctx, req.(*QueryEstimateWithdrawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolBatchDepositMsgResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_SimulateSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Deposit.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/SimulateSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateSwap(ctx, req.(*QuerySimulateSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolBatchWithdrawMsgsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Query/Params",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPoolBatchWithdrawMsgRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "tendermint.liquidity.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "LiquidityPools",
+			Handler:    _Query_LiquidityPools_Handler,
+		},
+		{
+			MethodName: "LiquidityPool",
+			Handler:    _Query_LiquidityPool_Handler,
+		},
+		{
+			MethodName: "LiquidityPoolByPoolCoinDenom",
+			Handler:    _Query_LiquidityPoolByPoolCoinDenom_Handler,
+		},
+		{
+			MethodName: "LiquidityPoolByReserveAcc",
+			Handler:    _Query_LiquidityPoolByReserveAcc_Handler,
+		},
+		{
+			MethodName: "LiquidityPoolsByReserveCoinDenom",
+			Handler:    _Query_LiquidityPoolsByReserveCoinDenom_Handler,
+		},
+		{
+			MethodName: "LiquidityPoolsByPair",
+			Handler:    _Query_LiquidityPoolsByPair_Handler,
+		},
+		{
+			MethodName: "LiquidityPoolBatch",
+			Handler:    _Query_LiquidityPoolBatch_Handler,
+		},
+		{
+			MethodName: "PoolBatchSwapMsgs",
+			Handler:    _Query_PoolBatchSwapMsgs_Handler,
+		},
+		{
+			MethodName: "PoolBatchSwapMsg",
+			Handler:    _Query_PoolBatchSwapMsg_Handler,
+		},
+		{
+			MethodName: "PoolBatchDepositMsgs",
+			Handler:    _Query_PoolBatchDepositMsgs_Handler,
+		},
+		{
+			MethodName: "PoolBatchDepositMsg",
+			Handler:    _Query_PoolBatchDepositMsg_Handler,
+		},
+		{
+			MethodName: "PoolBatchWithdrawMsgs",
+			Handler:    _Query_PoolBatchWithdrawMsgs_Handler,
+		},
+		{
+			MethodName: "PoolBatchWithdrawMsg",
+			Handler:    _Query_PoolBatchWithdrawMsg_Handler,
+		},
+		{
+			MethodName: "PoolTwap",
+			Handler:    _Query_PoolTwap_Handler,
+		},
+		{
+			MethodName: "PoolBatchRecords",
+			Handler:    _Query_PoolBatchRecords_Handler,
+		},
+		{
+			MethodName: "PoolBatchRecord",
+			Handler:    _Query_PoolBatchRecord_Handler,
+		},
+		{
+			MethodName: "BatchMsgsByAddress",
+			Handler:    _Query_BatchMsgsByAddress_Handler,
+		},
+		{
+			MethodName: "EstimateDeposit",
+			Handler:    _Query_EstimateDeposit_Handler,
+		},
+		{
+			MethodName: "EstimateWithdraw",
+			Handler:    _Query_EstimateWithdraw_Handler,
+		},
+		{
+			MethodName: "SimulateSwap",
+			Handler:    _Query_SimulateSwap_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tendermint/liquidity/v1beta1/query.proto",
+}
+
+func (m *QueryLiquidityPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	if m.MsgIndex != 0 {
-		n += 1 + sovQuery(uint64(m.MsgIndex))
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPoolBatchWithdrawMsgsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryLiquidityPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Withdraws) > 0 {
-		for _, e := range m.Withdraws {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityPoolByPoolCoinDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityPoolByPoolCoinDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityPoolByPoolCoinDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolCoinDenom) > 0 {
+		i -= len(m.PoolCoinDenom)
+		copy(dAtA[i:], m.PoolCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PoolCoinDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityPoolByReserveAccRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityPoolByReserveAccRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityPoolByReserveAccRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReserveAcc) > 0 {
+		i -= len(m.ReserveAcc)
+		copy(dAtA[i:], m.ReserveAcc)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReserveAcc)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityPoolBatchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityPoolBatchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityPoolBatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityPoolBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityPoolBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityPoolBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Batch.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityPoolsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityPoolsByReserveCoinDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityPoolsByReserveCoinDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityPoolsByReserveCoinDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ReserveCoinDenom) > 0 {
+		i -= len(m.ReserveCoinDenom)
+		copy(dAtA[i:], m.ReserveCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReserveCoinDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityPoolsByPairRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityPoolsByPairRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityPoolsByPairRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PoolTypeId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolTypeId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.DenomY) > 0 {
+		i -= len(m.DenomY)
+		copy(dAtA[i:], m.DenomY)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DenomY)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DenomX) > 0 {
+		i -= len(m.DenomX)
+		copy(dAtA[i:], m.DenomX)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DenomX)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityPoolsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolBatchSwapMsgsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolBatchSwapMsgsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolBatchSwapMsgsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolBatchSwapMsgRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolBatchSwapMsgRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolBatchSwapMsgRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MsgIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MsgIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolBatchSwapMsgsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolBatchSwapMsgsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolBatchSwapMsgsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Swaps) > 0 {
+		for iNdEx := len(m.Swaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Swaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolBatchSwapMsgResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolBatchSwapMsgResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolBatchSwapMsgResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Swap.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolBatchDepositMsgsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolBatchDepositMsgsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolBatchDepositMsgsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolBatchDepositMsgRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolBatchDepositMsgRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolBatchDepositMsgRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MsgIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MsgIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolBatchDepositMsgsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolBatchDepositMsgsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolBatchDepositMsgsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Deposits) > 0 {
+		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolBatchDepositMsgResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolBatchDepositMsgResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolBatchDepositMsgResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolBatchWithdrawMsgsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolBatchWithdrawMsgsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolBatchWithdrawMsgsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolBatchWithdrawMsgRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolBatchWithdrawMsgRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolBatchWithdrawMsgRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MsgIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MsgIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolBatchWithdrawMsgsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolBatchWithdrawMsgsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolBatchWithdrawMsgsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Withdraws) > 0 {
+		for iNdEx := len(m.Withdraws) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdraws[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolBatchWithdrawMsgResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolBatchWithdrawMsgResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolBatchWithdrawMsgResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Withdraw.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolTwapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolTwapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolTwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndTime != nil {
+		n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime):])
+		if err17 != nil {
+			return 0, err17
+		}
+		i -= n17
+		i = encodeVarintQuery(dAtA, i, uint64(n17))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.StartTime != nil {
+		n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
+		if err18 != nil {
+			return 0, err18
+		}
+		i -= n18
+		i = encodeVarintQuery(dAtA, i, uint64(n18))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolTwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolTwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolTwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Twap.Size()
+		i -= size
+		if _, err := m.Twap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolBatchRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolBatchRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolBatchRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolBatchRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolBatchRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolBatchRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BatchRecords) > 0 {
+		for iNdEx := len(m.BatchRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BatchRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolBatchRecordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolBatchRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolBatchRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BatchIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BatchIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolBatchRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolBatchRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolBatchRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BatchRecord.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBatchMsgsByAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBatchMsgsByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBatchMsgsByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBatchMsgsByAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBatchMsgsByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBatchMsgsByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SwapRoutes) > 0 {
+		for iNdEx := len(m.SwapRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SwapRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Swaps) > 0 {
+		for iNdEx := len(m.Swaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Swaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Withdraws) > 0 {
+		for iNdEx := len(m.Withdraws) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdraws[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Deposits) > 0 {
+		for iNdEx := len(m.Deposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateDepositRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateDepositRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateDepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DepositCoins) > 0 {
+		i -= len(m.DepositCoins)
+		copy(dAtA[i:], m.DepositCoins)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DepositCoins)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RefundedCoins) > 0 {
+		for iNdEx := len(m.RefundedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RefundedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AcceptedCoins) > 0 {
+		for iNdEx := len(m.AcceptedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AcceptedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.PoolCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateWithdrawRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateWithdrawRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateWithdrawRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolCoin) > 0 {
+		i -= len(m.PoolCoin)
+		copy(dAtA[i:], m.PoolCoin)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PoolCoin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateWithdrawResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateWithdrawResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawFeeCoins) > 0 {
+		for iNdEx := len(m.WithdrawFeeCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithdrawFeeCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.WithdrawCoins) > 0 {
+		for iNdEx := len(m.WithdrawCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithdrawCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateSwapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateSwapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateSwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OrderPrice) > 0 {
+		i -= len(m.OrderPrice)
+		copy(dAtA[i:], m.OrderPrice)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrderPrice)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DemandCoinDenom) > 0 {
+		i -= len(m.DemandCoinDenom)
+		copy(dAtA[i:], m.DemandCoinDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DemandCoinDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OfferCoin) > 0 {
+		i -= len(m.OfferCoin)
+		copy(dAtA[i:], m.OfferCoin)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OfferCoin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateSwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateSwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.OrderAmountRatio.Size()
+		i -= size
+		if _, err := m.OrderAmountRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size, err := m.MaxOrderCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.PriceImpact.Size()
+		i -= size
+		if _, err := m.PriceImpact.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.EffectivePrice.Size()
+		i -= size
+		if _, err := m.EffectivePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.SwapPrice.Size()
+		i -= size
+		if _, err := m.SwapPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.PoolPrice.Size()
+		i -= size
+		if _, err := m.PoolPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.ExchangedCoinFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.OfferCoinFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.ExchangedDemandCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.RemainingOfferCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ExchangedOfferCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryLiquidityPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryLiquidityPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pool.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLiquidityPoolByPoolCoinDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLiquidityPoolByReserveAccRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReserveAcc)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLiquidityPoolBatchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryLiquidityPoolBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Batch.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLiquidityPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLiquidityPoolsByReserveCoinDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReserveCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLiquidityPoolsByPairRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomX)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DenomY)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolTypeId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolTypeId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLiquidityPoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPoolBatchSwapMsgsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolBatchSwapMsgRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.MsgIndex != 0 {
+		n += 1 + sovQuery(uint64(m.MsgIndex))
+	}
+	return n
+}
+
+func (m *QueryPoolBatchSwapMsgsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Swaps) > 0 {
+		for _, e := range m.Swaps {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolBatchSwapMsgResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Swap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPoolBatchDepositMsgsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolBatchDepositMsgRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.MsgIndex != 0 {
+		n += 1 + sovQuery(uint64(m.MsgIndex))
+	}
+	return n
+}
+
+func (m *QueryPoolBatchDepositMsgsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deposits) > 0 {
+		for _, e := range m.Deposits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolBatchDepositMsgResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Deposit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPoolBatchWithdrawMsgsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolBatchWithdrawMsgRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.MsgIndex != 0 {
+		n += 1 + sovQuery(uint64(m.MsgIndex))
+	}
+	return n
+}
+
+func (m *QueryPoolBatchWithdrawMsgsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Withdraws) > 0 {
+		for _, e := range m.Withdraws {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolBatchWithdrawMsgResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Withdraw.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPoolTwapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	if m.StartTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolTwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Twap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPoolBatchRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolBatchRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BatchRecords) > 0 {
+		for _, e := range m.BatchRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolBatchRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.BatchIndex != 0 {
+		n += 1 + sovQuery(uint64(m.BatchIndex))
+	}
+	return n
+}
+
+func (m *QueryPoolBatchRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BatchRecord.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBatchMsgsByAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBatchMsgsByAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deposits) > 0 {
+		for _, e := range m.Deposits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Withdraws) > 0 {
+		for _, e := range m.Withdraws {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Swaps) > 0 {
+		for _, e := range m.Swaps {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.SwapRoutes) > 0 {
+		for _, e := range m.SwapRoutes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEstimateDepositRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.DepositCoins)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEstimateDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PoolCoin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.AcceptedCoins) > 0 {
+		for _, e := range m.AcceptedCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.RefundedCoins) > 0 {
+		for _, e := range m.RefundedCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEstimateWithdrawRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.PoolCoin)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEstimateWithdrawResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.WithdrawCoins) > 0 {
+		for _, e := range m.WithdrawCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.WithdrawFeeCoins) > 0 {
+		for _, e := range m.WithdrawFeeCoins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySimulateSwapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.OfferCoin)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DemandCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OrderPrice)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateSwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ExchangedOfferCoin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RemainingOfferCoin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ExchangedDemandCoin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.OfferCoinFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ExchangedCoinFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PoolPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SwapPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EffectivePrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PriceImpact.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MaxOrderCoin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.OrderAmountRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryLiquidityPoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityPoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityPoolByPoolCoinDenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityPoolByPoolCoinDenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityPoolByPoolCoinDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityPoolByReserveAccRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityPoolByReserveAccRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityPoolByReserveAccRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveAcc", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReserveAcc = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityPoolBatchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityPoolBatchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityPoolBatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityPoolBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityPoolBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityPoolBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Batch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityPoolsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityPoolsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityPoolsByReserveCoinDenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityPoolsByReserveCoinDenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityPoolsByReserveCoinDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReserveCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityPoolsByPairRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityPoolsByPairRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityPoolsByPairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomX", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomX = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomY", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomY = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolTypeId", wireType)
+			}
+			m.PoolTypeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolTypeId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityPoolsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityPoolsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, Pool{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolBatchSwapMsgsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolBatchSwapMsgsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolBatchSwapMsgsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolBatchSwapMsgRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolBatchSwapMsgRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolBatchSwapMsgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			}
+			m.MsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolBatchSwapMsgsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolBatchSwapMsgsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolBatchSwapMsgsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Swaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Swaps = append(m.Swaps, SwapMsgState{})
+			if err := m.Swaps[len(m.Swaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolBatchSwapMsgResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolBatchSwapMsgResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolBatchSwapMsgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Swap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Swap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolBatchDepositMsgsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolBatchDepositMsgsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolBatchDepositMsgsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolBatchDepositMsgRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolBatchDepositMsgRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolBatchDepositMsgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			}
+			m.MsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolBatchDepositMsgsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolBatchDepositMsgsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolBatchDepositMsgsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposits = append(m.Deposits, DepositMsgState{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolBatchDepositMsgResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolBatchDepositMsgResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolBatchDepositMsgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolBatchWithdrawMsgsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolBatchWithdrawMsgsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolBatchWithdrawMsgsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolBatchWithdrawMsgRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolBatchWithdrawMsgRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolBatchWithdrawMsgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			}
+			m.MsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryPoolBatchWithdrawMsgResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Withdraw.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
+func (m *QueryPoolBatchWithdrawMsgsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolBatchWithdrawMsgsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolBatchWithdrawMsgsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdraws", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withdraws = append(m.Withdraws, WithdrawMsgState{})
+			if err := m.Withdraws[len(m.Withdraws)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryLiquidityPoolRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolBatchWithdrawMsgResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2905,17 +7773,17 @@ func (m *QueryLiquidityPoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidityPoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolBatchWithdrawMsgResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidityPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolBatchWithdrawMsgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdraw", wireType)
 			}
-			m.PoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2925,11 +7793,25 @@ func (m *QueryLiquidityPoolRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Withdraw.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2951,7 +7833,7 @@ func (m *QueryLiquidityPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLiquidityPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolTwapRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2961,28 +7843,121 @@ func (m *QueryLiquidityPoolResponse) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolTwapRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolTwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidityPoolResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidityPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3009,7 +7984,10 @@ func (m *QueryLiquidityPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3034,7 +8012,7 @@ func (m *QueryLiquidityPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLiquidityPoolByPoolCoinDenomRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolTwapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3057,15 +8035,15 @@ func (m *QueryLiquidityPoolByPoolCoinDenomRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidityPoolByPoolCoinDenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolTwapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidityPoolByPoolCoinDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolTwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolCoinDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Twap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3093,7 +8071,9 @@ func (m *QueryLiquidityPoolByPoolCoinDenomRequest) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolCoinDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.Twap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3116,7 +8096,7 @@ func (m *QueryLiquidityPoolByPoolCoinDenomRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryLiquidityPoolByReserveAccRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolBatchRecordsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3139,17 +8119,36 @@ func (m *QueryLiquidityPoolByReserveAccRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidityPoolByReserveAccRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolBatchRecordsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidityPoolByReserveAccRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolBatchRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReserveAcc", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3159,23 +8158,27 @@ func (m *QueryLiquidityPoolByReserveAccRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReserveAcc = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3198,7 +8201,7 @@ func (m *QueryLiquidityPoolByReserveAccRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLiquidityPoolBatchRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolBatchRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3221,17 +8224,17 @@ func (m *QueryLiquidityPoolBatchRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidityPoolBatchRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolBatchRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidityPoolBatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolBatchRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchRecords", wireType)
 			}
-			m.PoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3241,11 +8244,62 @@ func (m *QueryLiquidityPoolBatchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchRecords = append(m.BatchRecords, PoolBatchRecord{})
+			if err := m.BatchRecords[len(m.BatchRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3267,7 +8321,7 @@ func (m *QueryLiquidityPoolBatchRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLiquidityPoolBatchResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolBatchRecordRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3290,17 +8344,17 @@ func (m *QueryLiquidityPoolBatchResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidityPoolBatchResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolBatchRecordRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidityPoolBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolBatchRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var msglen int
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3310,25 +8364,30 @@ func (m *QueryLiquidityPoolBatchResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchIndex", wireType)
 			}
-			if err := m.Batch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.BatchIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3350,7 +8409,7 @@ func (m *QueryLiquidityPoolBatchResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLiquidityPoolsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolBatchRecordResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3373,15 +8432,15 @@ func (m *QueryLiquidityPoolsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidityPoolsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolBatchRecordResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidityPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolBatchRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchRecord", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3408,10 +8467,7 @@ func (m *QueryLiquidityPoolsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BatchRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3436,7 +8492,7 @@ func (m *QueryLiquidityPoolsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLiquidityPoolsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBatchMsgsByAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3459,17 +8515,17 @@ func (m *QueryLiquidityPoolsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidityPoolsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBatchMsgsByAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidityPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBatchMsgsByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3479,25 +8535,23 @@ func (m *QueryLiquidityPoolsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pools = append(m.Pools, Pool{})
-			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -3518,73 +8572,23 @@ func (m *QueryLiquidityPoolsResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3606,7 +8610,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBatchMsgsByAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3629,15 +8633,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBatchMsgsByAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBatchMsgsByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3664,65 +8668,84 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Deposits = append(m.Deposits, DepositMsgState{})
+			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdraws", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPoolBatchSwapMsgsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Withdraws = append(m.Withdraws, WithdrawMsgState{})
+			if err := m.Withdraws[len(m.Withdraws)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Swaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Swaps = append(m.Swaps, SwapMsgState{})
+			if err := m.Swaps[len(m.Swaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolBatchSwapMsgsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolBatchSwapMsgsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapRoutes", wireType)
 			}
-			m.PoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3732,12 +8755,27 @@ func (m *QueryPoolBatchSwapMsgsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapRoutes = append(m.SwapRoutes, SwapRouteMsgState{})
+			if err := m.SwapRoutes[len(m.SwapRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -3767,7 +8805,7 @@ func (m *QueryPoolBatchSwapMsgsRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -3794,7 +8832,7 @@ func (m *QueryPoolBatchSwapMsgsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolBatchSwapMsgRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEstimateDepositRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3817,10 +8855,10 @@ func (m *QueryPoolBatchSwapMsgRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolBatchSwapMsgRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEstimateDepositRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolBatchSwapMsgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEstimateDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3843,10 +8881,10 @@ func (m *QueryPoolBatchSwapMsgRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositCoins", wireType)
 			}
-			m.MsgIndex = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3856,11 +8894,24 @@ func (m *QueryPoolBatchSwapMsgRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MsgIndex |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositCoins = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3882,7 +8933,7 @@ func (m *QueryPoolBatchSwapMsgRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolBatchSwapMsgsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEstimateDepositResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3905,15 +8956,15 @@ func (m *QueryPoolBatchSwapMsgsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolBatchSwapMsgsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEstimateDepositResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolBatchSwapMsgsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEstimateDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Swaps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCoin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3940,14 +8991,13 @@ func (m *QueryPoolBatchSwapMsgsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Swaps = append(m.Swaps, SwapMsgState{})
-			if err := m.Swaps[len(m.Swaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PoolCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedCoins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3968,72 +9018,20 @@ func (m *QueryPoolBatchSwapMsgsResponse) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthQuery
 			}
 			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPoolBatchSwapMsgResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolBatchSwapMsgResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolBatchSwapMsgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AcceptedCoins = append(m.AcceptedCoins, types.Coin{})
+			if err := m.AcceptedCoins[len(m.AcceptedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Swap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundedCoins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4060,7 +9058,8 @@ func (m *QueryPoolBatchSwapMsgResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Swap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RefundedCoins = append(m.RefundedCoins, types.Coin{})
+			if err := m.RefundedCoins[len(m.RefundedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4085,7 +9084,7 @@ func (m *QueryPoolBatchSwapMsgResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolBatchDepositMsgsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEstimateWithdrawRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4108,10 +9107,10 @@ func (m *QueryPoolBatchDepositMsgsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolBatchDepositMsgsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEstimateWithdrawRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolBatchDepositMsgsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEstimateWithdrawRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4135,9 +9134,9 @@ func (m *QueryPoolBatchDepositMsgsRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCoin", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4147,27 +9146,23 @@ func (m *QueryPoolBatchDepositMsgsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PoolCoin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4190,7 +9185,7 @@ func (m *QueryPoolBatchDepositMsgsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolBatchDepositMsgRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEstimateWithdrawResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4213,17 +9208,17 @@ func (m *QueryPoolBatchDepositMsgRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolBatchDepositMsgRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEstimateWithdrawResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolBatchDepositMsgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEstimateWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawCoins", wireType)
 			}
-			m.PoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4233,16 +9228,31 @@ func (m *QueryPoolBatchDepositMsgRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawCoins = append(m.WithdrawCoins, types.Coin{})
+			if err := m.WithdrawCoins[len(m.WithdrawCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawFeeCoins", wireType)
 			}
-			m.MsgIndex = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4252,11 +9262,26 @@ func (m *QueryPoolBatchDepositMsgRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MsgIndex |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawFeeCoins = append(m.WithdrawFeeCoins, types.Coin{})
+			if err := m.WithdrawFeeCoins[len(m.WithdrawFeeCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4278,7 +9303,7 @@ func (m *QueryPoolBatchDepositMsgRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolBatchDepositMsgsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateSwapRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4301,17 +9326,36 @@ func (m *QueryPoolBatchDepositMsgsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolBatchDepositMsgsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateSwapRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolBatchDepositMsgsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateSwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferCoin", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4321,31 +9365,29 @@ func (m *QueryPoolBatchDepositMsgsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Deposits = append(m.Deposits, DepositMsgState{})
-			if err := m.Deposits[len(m.Deposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OfferCoin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DemandCoinDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4355,27 +9397,55 @@ func (m *QueryPoolBatchDepositMsgsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			m.DemandCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderPrice", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.OrderPrice = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4398,7 +9468,7 @@ func (m *QueryPoolBatchDepositMsgsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolBatchDepositMsgResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateSwapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4421,15 +9491,15 @@ func (m *QueryPoolBatchDepositMsgResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolBatchDepositMsgResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateSwapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolBatchDepositMsgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedOfferCoin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4456,65 +9526,81 @@ func (m *QueryPoolBatchDepositMsgResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExchangedOfferCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingOfferCoin", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPoolBatchWithdrawMsgsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.RemainingOfferCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedDemandCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.ExchangedDemandCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolBatchWithdrawMsgsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolBatchWithdrawMsgsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferCoinFee", wireType)
 			}
-			m.PoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4524,14 +9610,28 @@ func (m *QueryPoolBatchWithdrawMsgsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OfferCoinFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedCoinFee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4558,68 +9658,49 @@ func (m *QueryPoolBatchWithdrawMsgsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExchangedCoinFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolPrice", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPoolBatchWithdrawMsgRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.PoolPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolBatchWithdrawMsgRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolBatchWithdrawMsgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapPrice", wireType)
 			}
-			m.PoolId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4629,16 +9710,31 @@ func (m *QueryPoolBatchWithdrawMsgRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.MsgIndex = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectivePrice", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4648,66 +9744,31 @@ func (m *QueryPoolBatchWithdrawMsgRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MsgIndex |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPoolBatchWithdrawMsgsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.EffectivePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolBatchWithdrawMsgsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolBatchWithdrawMsgsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Withdraws", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceImpact", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4717,29 +9778,29 @@ func (m *QueryPoolBatchWithdrawMsgsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Withdraws = append(m.Withdraws, WithdrawMsgState{})
-			if err := m.Withdraws[len(m.Withdraws)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOrderCoin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4766,68 +9827,15 @@ func (m *QueryPoolBatchWithdrawMsgsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxOrderCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPoolBatchWithdrawMsgResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolBatchWithdrawMsgResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolBatchWithdrawMsgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Withdraw", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderAmountRatio", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4837,22 +9845,23 @@ func (m *QueryPoolBatchWithdrawMsgResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Withdraw.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OrderAmountRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,531 @@
+package types
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MatchType is the type of the match result of a batch
+type MatchType int
+
+const (
+	ExactMatch MatchType = iota + 1
+	NoMatch
+	FractionalMatch
+)
+
+// PriceDirection is the direction of the swap price from the current pool price
+type PriceDirection int
+
+const (
+	Increasing PriceDirection = iota + 1
+	Decreasing
+	Staying
+)
+
+// OrderDirection is the direction of a swap order
+type OrderDirection int
+
+const (
+	DirectionXtoY OrderDirection = iota + 1
+	DirectionYtoX
+)
+
+// maxOrderPriceDeviation and minOrderPriceDeviation are the bounds of the order prices of the market orders
+// relative to the current pool price.
+var (
+	maxOrderPriceDeviation = sdk.MustNewDecFromStr("1.10")
+	minOrderPriceDeviation = sdk.MustNewDecFromStr("0.90")
+)
+
+// GetMaxDeviatedOrderPrice returns the most tolerant order price of a swap in the direction
+// within the order price deviation from the current pool price.
+func GetMaxDeviatedOrderPrice(currentPoolPrice sdk.Dec, direction OrderDirection) sdk.Dec {
+	if direction == DirectionXtoY {
+		return currentPoolPrice.Mul(maxOrderPriceDeviation)
+	}
+	return currentPoolPrice.Mul(minOrderPriceDeviation)
+}
+
+// Order is the aggregation of the swap msg states that share the same order price.
+// BuyOfferAmt is the amount of X offered to buy Y and SellOfferAmt is the amount of Y offered to sell for X.
+type Order struct {
+	Price         sdk.Dec
+	BuyOfferAmt   sdk.Int
+	SellOfferAmt  sdk.Int
+	SwapMsgStates []*SwapMsgState
+}
+
+// OrderBook is a list of orders
+type OrderBook []Order
+
+// Len implements sort.Interface for OrderBook
+func (orderBook OrderBook) Len() int { return len(orderBook) }
+
+// Less implements sort.Interface for OrderBook
+func (orderBook OrderBook) Less(i, j int) bool {
+	return orderBook[i].Price.LT(orderBook[j].Price)
+}
+
+// Swap implements sort.Interface for OrderBook
+func (orderBook OrderBook) Swap(i, j int) { orderBook[i], orderBook[j] = orderBook[j], orderBook[i] }
+
+// Sort sorts the orderbook in increasing order of the order price.
+func (orderBook OrderBook) Sort() {
+	sort.Slice(orderBook, func(i, j int) bool {
+		return orderBook[i].Price.LT(orderBook[j].Price)
+	})
+}
+
+// Reverse sorts the orderbook in decreasing order of the order price.
+func (orderBook OrderBook) Reverse() {
+	sort.Slice(orderBook, func(i, j int) bool {
+		return orderBook[i].Price.GT(orderBook[j].Price)
+	})
+}
+
+// OrderMap is the map of orders indexed by the order price string.
+type OrderMap map[string]Order
+
+// SortOrderBook makes an orderbook from the order map, sorted in decreasing order of the order price.
+func (orderMap OrderMap) SortOrderBook() (orderBook OrderBook) {
+	for _, o := range orderMap {
+		orderBook = append(orderBook, o)
+	}
+	orderBook.Reverse()
+	return orderBook
+}
+
+// BatchResult is the swap matching result of the batch.
+type BatchResult struct {
+	MatchType      MatchType
+	PriceDirection PriceDirection
+	SwapPrice      sdk.Dec
+	EX             sdk.Dec
+	EY             sdk.Dec
+	OriginalEX     sdk.Int
+	OriginalEY     sdk.Int
+	PoolX          sdk.Dec
+	PoolY          sdk.Dec
+	TransactAmt    sdk.Dec
+}
+
+// NewBatchResult returns a zero batch result to avoid nil values.
+func NewBatchResult() BatchResult {
+	return BatchResult{
+		SwapPrice:   sdk.ZeroDec(),
+		EX:          sdk.ZeroDec(),
+		EY:          sdk.ZeroDec(),
+		OriginalEX:  sdk.ZeroInt(),
+		OriginalEY:  sdk.ZeroInt(),
+		PoolX:       sdk.ZeroDec(),
+		PoolY:       sdk.ZeroDec(),
+		TransactAmt: sdk.ZeroDec(),
+	}
+}
+
+// MatchResult is the swap matching result of each swap msg state of the batch.
+type MatchResult struct {
+	OrderDirection         OrderDirection
+	OfferCoinAmt           sdk.Dec
+	TransactedCoinAmt      sdk.Dec
+	ExchangedDemandCoinAmt sdk.Dec
+	OfferCoinFeeAmt        sdk.Dec
+	ExchangedCoinFeeAmt    sdk.Dec
+	SwapMsgState           *SwapMsgState
+}
+
+// Match calculates the batch result of the orderbook with the reserve amounts of the pool on the swap curve.
+// The returned bool is true when the price direction of the orderbook is staying.
+func (orderBook OrderBook) Match(curve SwapCurve, x, y sdk.Dec) (BatchResult, bool) {
+	currentPrice := curve.Price(x, y)
+	priceDirection := orderBook.PriceDirection(currentPrice)
+	if priceDirection == Staying {
+		return orderBook.CalculateMatchStay(currentPrice), true
+	}
+	return orderBook.CalculateMatch(priceDirection, curve, x, y), false
+}
+
+// CalculateMatchStay calculates the batch result when the price direction is staying.
+func (orderBook OrderBook) CalculateMatchStay(currentPrice sdk.Dec) (r BatchResult) {
+	r = NewBatchResult()
+	r.SwapPrice = currentPrice
+	r.OriginalEX, r.OriginalEY = orderBook.ExecutableAmt(r.SwapPrice)
+	r.EX = sdk.NewDecFromInt(r.OriginalEX)
+	r.EY = sdk.NewDecFromInt(r.OriginalEY)
+	r.PriceDirection = Staying
+
+	s := r.SwapPrice.Mul(r.EY)
+	switch {
+	case r.EX.IsZero() || r.EY.IsZero():
+		r.MatchType = NoMatch
+	case r.EX.Equal(s):
+		r.MatchType = ExactMatch
+	default:
+		// Decimal Error, When calculating the Executable value, conservatively Truncated decimal
+		r.MatchType = FractionalMatch
+		if r.EX.GT(s) {
+			r.EX = s
+		} else if r.EX.LT(s) {
+			r.EY = r.EX.Quo(r.SwapPrice)
+		}
+	}
+	return
+}
+
+// CalculateMatch calculates the batch result with the logic for each price direction.
+// The scenario with the largest transact amount is chosen, an exact match is preferred.
+func (orderBook OrderBook) CalculateMatch(direction PriceDirection, curve SwapCurve, x, y sdk.Dec) (maxScenario BatchResult) {
+	currentPrice := curve.Price(x, y)
+	lastOrderPrice := currentPrice
+	var matchScenarios []BatchResult
+	// the orderbook is sorted in decreasing order, so the increasing scenarios are iterated from the end
+	start, end, delta := 0, len(orderBook)-1, 1
+	if direction == Increasing {
+		start, end, delta = end, start, -1
+	}
+	for i := start; i != end+delta; i += delta {
+		order := orderBook[i]
+		if (direction == Increasing && order.Price.LT(currentPrice)) ||
+			(direction == Decreasing && order.Price.GT(currentPrice)) {
+			continue
+		}
+		r := orderBook.CalculateSwap(direction, curve, x, y, currentPrice, order.Price, lastOrderPrice)
+		// skip the scenario that exceeds a value that can be a decimal error
+		if (direction == Increasing && r.PoolY.Sub(r.EX.Quo(r.SwapPrice)).GTE(sdk.OneDec())) ||
+			(direction == Decreasing && r.PoolX.Sub(r.EY.Mul(r.SwapPrice)).GTE(sdk.OneDec())) {
+			continue
+		}
+		matchScenarios = append(matchScenarios, r)
+		lastOrderPrice = order.Price
+	}
+
+	maxScenario = NewBatchResult()
+	for _, s := range matchScenarios {
+		mustEX, mustEY := orderBook.MustExecutableAmt(s.SwapPrice)
+		if s.EX.GTE(sdk.NewDecFromInt(mustEX)) && s.EY.GTE(sdk.NewDecFromInt(mustEY)) {
+			if s.MatchType == ExactMatch && s.TransactAmt.IsPositive() {
+				maxScenario = s
+				break
+			} else if s.TransactAmt.GT(maxScenario.TransactAmt) {
+				maxScenario = s
+			}
+		}
+	}
+	maxScenario.PriceDirection = direction
+	if maxScenario.MatchType == 0 {
+		maxScenario.MatchType = NoMatch
+	}
+	return maxScenario
+}
+
+// CalculateSwap calculates the batch result of a scenario between the last order price and the order price.
+func (orderBook OrderBook) CalculateSwap(direction PriceDirection, curve SwapCurve, x, y, currentPrice, orderPrice, lastOrderPrice sdk.Dec) BatchResult {
+	r := NewBatchResult()
+	r.OriginalEX, r.OriginalEY = orderBook.ExecutableAmt(lastOrderPrice.Add(orderPrice).Quo(sdk.NewDec(2)))
+	r.EX = sdk.NewDecFromInt(r.OriginalEX)
+	r.EY = sdk.NewDecFromInt(r.OriginalEY)
+
+	r.SwapPrice = curve.SwapPrice(x, y, r.EX, r.EY, sdk.MinDec(lastOrderPrice, orderPrice), sdk.MaxDec(lastOrderPrice, orderPrice))
+
+	if direction == Increasing {
+		r.PoolY = curve.PoolY(x, y, r.SwapPrice)
+		if lastOrderPrice.LT(r.SwapPrice) && r.SwapPrice.LT(orderPrice) && !r.PoolY.IsNegative() {
+			if r.EX.IsZero() && r.EY.IsZero() {
+				r.MatchType = NoMatch
+			} else {
+				r.MatchType = ExactMatch
+			}
+		}
+	} else if direction == Decreasing {
+		r.PoolX = curve.PoolX(x, y, r.SwapPrice)
+		if orderPrice.LT(r.SwapPrice) && r.SwapPrice.LT(lastOrderPrice) && !r.PoolX.IsNegative() {
+			if r.EX.IsZero() && r.EY.IsZero() {
+				r.MatchType = NoMatch
+			} else {
+				r.MatchType = ExactMatch
+			}
+		}
+	}
+
+	if r.MatchType == 0 {
+		r.OriginalEX, r.OriginalEY = orderBook.ExecutableAmt(orderPrice)
+		r.EX = sdk.NewDecFromInt(r.OriginalEX)
+		r.EY = sdk.NewDecFromInt(r.OriginalEY)
+		r.SwapPrice = orderPrice
+		// When calculating the Pool value, conservatively Truncated decimal, so Ceil it to reduce the decimal error
+		if direction == Increasing {
+			r.PoolY = curve.PoolY(x, y, r.SwapPrice)
+			r.EX = sdk.MinDec(r.EX, r.EY.Add(r.PoolY).Mul(r.SwapPrice)).Ceil()
+			r.EY = sdk.MaxDec(sdk.MinDec(r.EY, r.EX.Quo(r.SwapPrice).Sub(r.PoolY)), sdk.ZeroDec()).Ceil()
+		} else if direction == Decreasing {
+			r.PoolX = curve.PoolX(x, y, r.SwapPrice)
+			r.EY = sdk.MinDec(r.EY, r.EX.Add(r.PoolX).Quo(r.SwapPrice)).Ceil()
+			r.EX = sdk.MaxDec(sdk.MinDec(r.EX, r.EY.Mul(r.SwapPrice).Sub(r.PoolX)), sdk.ZeroDec()).Ceil()
+		}
+		r.MatchType = FractionalMatch
+	}
+
+	if direction == Increasing {
+		if r.SwapPrice.LT(currentPrice) || r.PoolY.IsNegative() {
+			r.TransactAmt = sdk.ZeroDec()
+		} else {
+			r.TransactAmt = sdk.MinDec(r.EX, r.EY.Add(r.PoolY).Mul(r.SwapPrice))
+		}
+	} else if direction == Decreasing {
+		if r.SwapPrice.GT(currentPrice) || r.PoolX.IsNegative() {
+			r.TransactAmt = sdk.ZeroDec()
+		} else {
+			r.TransactAmt = sdk.MinDec(r.EY, r.EX.Add(r.PoolX).Quo(r.SwapPrice))
+		}
+	}
+	return r
+}
+
+// PriceDirection returns the price direction of the orderbook with the current price.
+func (orderBook OrderBook) PriceDirection(currentPrice sdk.Dec) PriceDirection {
+	buyAmtOverCurrentPrice := sdk.ZeroDec()
+	buyAmtAtCurrentPrice := sdk.ZeroDec()
+	sellAmtUnderCurrentPrice := sdk.ZeroDec()
+	sellAmtAtCurrentPrice := sdk.ZeroDec()
+
+	for _, order := range orderBook {
+		switch {
+		case order.Price.GT(currentPrice):
+			buyAmtOverCurrentPrice = buyAmtOverCurrentPrice.Add(sdk.NewDecFromInt(order.BuyOfferAmt))
+		case order.Price.Equal(currentPrice):
+			buyAmtAtCurrentPrice = buyAmtAtCurrentPrice.Add(sdk.NewDecFromInt(order.BuyOfferAmt))
+			sellAmtAtCurrentPrice = sellAmtAtCurrentPrice.Add(sdk.NewDecFromInt(order.SellOfferAmt))
+		default:
+			sellAmtUnderCurrentPrice = sellAmtUnderCurrentPrice.Add(sdk.NewDecFromInt(order.SellOfferAmt))
+		}
+	}
+
+	if buyAmtOverCurrentPrice.GT(currentPrice.Mul(sellAmtUnderCurrentPrice.Add(sellAmtAtCurrentPrice))) {
+		return Increasing
+	} else if currentPrice.Mul(sellAmtUnderCurrentPrice).GT(buyAmtOverCurrentPrice.Add(buyAmtAtCurrentPrice)) {
+		return Decreasing
+	}
+	return Staying
+}
+
+// ExecutableAmt returns the executable buy amount of X and sell amount of Y of the orderbook at the swap price.
+func (orderBook OrderBook) ExecutableAmt(swapPrice sdk.Dec) (executableBuyAmtX, executableSellAmtY sdk.Int) {
+	executableBuyAmtX = sdk.ZeroInt()
+	executableSellAmtY = sdk.ZeroInt()
+	for _, order := range orderBook {
+		if order.Price.GTE(swapPrice) {
+			executableBuyAmtX = executableBuyAmtX.Add(order.BuyOfferAmt)
+		}
+		if order.Price.LTE(swapPrice) {
+			executableSellAmtY = executableSellAmtY.Add(order.SellOfferAmt)
+		}
+	}
+	return
+}
+
+// MustExecutableAmt returns the amounts of the orders that must be executed at the swap price,
+// which are the orders with a strictly better price than the swap price.
+func (orderBook OrderBook) MustExecutableAmt(swapPrice sdk.Dec) (mustExecutableBuyAmtX, mustExecutableSellAmtY sdk.Int) {
+	mustExecutableBuyAmtX = sdk.ZeroInt()
+	mustExecutableSellAmtY = sdk.ZeroInt()
+	for _, order := range orderBook {
+		if order.Price.GT(swapPrice) {
+			mustExecutableBuyAmtX = mustExecutableBuyAmtX.Add(order.BuyOfferAmt)
+		}
+		if order.Price.LT(swapPrice) {
+			mustExecutableSellAmtY = mustExecutableSellAmtY.Add(order.SellOfferAmt)
+		}
+	}
+	return
+}
+
+// MakeOrderMap makes the order map indexed by the order price from the swap msg states,
+// and splits them into the X to Y list and the Y to X list.
+func MakeOrderMap(swapMsgStates []*SwapMsgState, denomX, denomY string, onlyNotMatched bool) (OrderMap, []*SwapMsgState, []*SwapMsgState) {
+	orderMap := make(OrderMap)
+	var xToY []*SwapMsgState // buying Y from X
+	var yToX []*SwapMsgState // selling Y for X
+	for _, m := range swapMsgStates {
+		if onlyNotMatched && (m.ToBeDeleted || m.RemainingOfferCoin.IsZero()) {
+			continue
+		}
+		priceKey := m.Msg.OrderPrice.String()
+		order, ok := orderMap[priceKey]
+		if !ok {
+			order = Order{
+				Price:        m.Msg.OrderPrice,
+				BuyOfferAmt:  sdk.ZeroInt(),
+				SellOfferAmt: sdk.ZeroInt(),
+			}
+		}
+		switch m.Msg.OfferCoin.Denom {
+		case denomX:
+			xToY = append(xToY, m)
+			order.BuyOfferAmt = order.BuyOfferAmt.Add(m.RemainingOfferCoin.Amount)
+		case denomY:
+			yToX = append(yToX, m)
+			order.SellOfferAmt = order.SellOfferAmt.Add(m.RemainingOfferCoin.Amount)
+		default:
+			panic(ErrInvalidDenom)
+		}
+		order.SwapMsgStates = append(order.SwapMsgStates, m)
+		orderMap[priceKey] = order
+	}
+	return orderMap, xToY, yToX
+}
+
+// FindOrderMatch finds the matched orders of a direction up to the executable amount at the swap price.
+// Orders with a better price are matched first, and orders sharing the last matched price are matched
+// fractionally by the same ratio.
+func FindOrderMatch(direction OrderDirection, swapMsgStates []*SwapMsgState, executableAmt, swapPrice sdk.Dec) (matchResults []MatchResult) {
+	if !executableAmt.IsPositive() {
+		return nil
+	}
+
+	sort.SliceStable(swapMsgStates, func(i, j int) bool {
+		if direction == DirectionXtoY {
+			return swapMsgStates[i].Msg.OrderPrice.GT(swapMsgStates[j].Msg.OrderPrice)
+		}
+		return swapMsgStates[i].Msg.OrderPrice.LT(swapMsgStates[j].Msg.OrderPrice)
+	})
+
+	accumMatchAmt := sdk.ZeroDec()
+	for i := 0; i < len(swapMsgStates); {
+		orderPrice := swapMsgStates[i].Msg.OrderPrice
+		if (direction == DirectionXtoY && orderPrice.LT(swapPrice)) ||
+			(direction == DirectionYtoX && orderPrice.GT(swapPrice)) {
+			break
+		}
+		remainingAmt := executableAmt.Sub(accumMatchAmt)
+		if !remainingAmt.IsPositive() {
+			break
+		}
+
+		// group the orders sharing the same order price
+		j := i
+		groupAmt := sdk.ZeroInt()
+		for ; j < len(swapMsgStates) && swapMsgStates[j].Msg.OrderPrice.Equal(orderPrice); j++ {
+			groupAmt = groupAmt.Add(swapMsgStates[j].RemainingOfferCoin.Amount)
+		}
+		group := swapMsgStates[i:j]
+		i = j
+		if !groupAmt.IsPositive() {
+			continue
+		}
+
+		fractionalMatchRatio := sdk.MinDec(remainingAmt.QuoInt(groupAmt), sdk.OneDec())
+		for _, sms := range group {
+			offerAmt := sdk.NewDecFromInt(sms.RemainingOfferCoin.Amount)
+			// TransactedCoinAmt is a value that should not be lost, so Ceil it conservatively considering the decimal error.
+			transactedAmt := sdk.MinDec(offerAmt.Mul(fractionalMatchRatio).Ceil(), offerAmt)
+			if !transactedAmt.IsPositive() {
+				continue
+			}
+
+			// Fee and exchanged amounts are values that should not be overmeasured, so Truncate them.
+			offerCoinFeeAmt := sdk.NewDecFromInt(sms.ReservedOfferCoinFee.Amount)
+			if transactedAmt.LT(offerAmt) {
+				offerCoinFeeAmt = offerCoinFeeAmt.Mul(transactedAmt).Quo(offerAmt).TruncateDec()
+			}
+			var exchangedDemandCoinAmt, exchangedCoinFeeAmt sdk.Dec
+			if direction == DirectionXtoY {
+				exchangedDemandCoinAmt = transactedAmt.Quo(swapPrice).TruncateDec()
+				exchangedCoinFeeAmt = offerCoinFeeAmt.Quo(swapPrice).TruncateDec()
+			} else {
+				exchangedDemandCoinAmt = transactedAmt.Mul(swapPrice).TruncateDec()
+				exchangedCoinFeeAmt = offerCoinFeeAmt.Mul(swapPrice).TruncateDec()
+			}
+			exchangedCoinFeeAmt = sdk.MinDec(exchangedCoinFeeAmt, exchangedDemandCoinAmt)
+
+			matchResults = append(matchResults, MatchResult{
+				OrderDirection:         direction,
+				OfferCoinAmt:           offerAmt,
+				TransactedCoinAmt:      transactedAmt,
+				ExchangedDemandCoinAmt: exchangedDemandCoinAmt,
+				OfferCoinFeeAmt:        offerCoinFeeAmt,
+				ExchangedCoinFeeAmt:    exchangedCoinFeeAmt,
+				SwapMsgState:           sms,
+			})
+		}
+		accumMatchAmt = accumMatchAmt.Add(sdk.NewDecFromInt(groupAmt).Mul(fractionalMatchRatio))
+	}
+	return matchResults
+}
+
+// UpdateSwapMsgStates applies the match results to their swap msg states, writing back the exchanged offer coin,
+// the remaining offer coin and the reserved offer coin fee. Fully matched msgs are marked to be deleted.
+func UpdateSwapMsgStates(matchResults []MatchResult) {
+	for _, match := range matchResults {
+		sms := match.SwapMsgState
+		transactedCoin := sdk.NewCoin(sms.RemainingOfferCoin.Denom, match.TransactedCoinAmt.TruncateInt())
+		offerCoinFee := sdk.NewCoin(sms.ReservedOfferCoinFee.Denom, match.OfferCoinFeeAmt.TruncateInt())
+
+		sms.ExchangedOfferCoin = sms.ExchangedOfferCoin.Add(transactedCoin)
+		sms.RemainingOfferCoin = sms.RemainingOfferCoin.Sub(transactedCoin)
+		sms.ReservedOfferCoinFee = sms.ReservedOfferCoinFee.Sub(offerCoinFee)
+		sms.Succeeded = true
+		if sms.RemainingOfferCoin.IsZero() {
+			sms.ToBeDeleted = true
+		}
+	}
+}
+
+// GetExpiredOrders returns the swap msg states that are not to be deleted yet and whose order expiry height
+// has been reached at the given height.
+func GetExpiredOrders(swapMsgStates []*SwapMsgState, currentHeight int64) (expired []*SwapMsgState) {
+	for _, sms := range swapMsgStates {
+		if sms.ToBeDeleted {
+			continue
+		}
+		if currentHeight >= sms.OrderExpiryHeight {
+			expired = append(expired, sms)
+		}
+	}
+	return expired
+}
+
+// CheckSwapPrice checks the validity of the swap price with the match results.
+func CheckSwapPrice(matchResultXtoY, matchResultYtoX []MatchResult, swapPrice sdk.Dec) bool {
+	if len(matchResultXtoY) == 0 && len(matchResultYtoX) == 0 {
+		return true
+	}
+	if !swapPrice.IsPositive() {
+		return false
+	}
+	// check if it is greater than a value that can be a decimal error
+	for _, m := range matchResultXtoY {
+		if m.TransactedCoinAmt.Quo(swapPrice).Sub(m.ExchangedDemandCoinAmt).Abs().GT(sdk.OneDec()) {
+			return false
+		}
+	}
+	for _, m := range matchResultYtoX {
+		if m.TransactedCoinAmt.Mul(swapPrice).Sub(m.ExchangedDemandCoinAmt).Abs().GT(sdk.OneDec()) {
+			return false
+		}
+	}
+	return true
+}
+
+// CountNotMatchedMsgs returns the number of not matched msgs in the list.
+func CountNotMatchedMsgs(swapMsgStates []*SwapMsgState) int {
+	cnt := 0
+	for _, m := range swapMsgStates {
+		if m.Executed && !m.Succeeded {
+			cnt++
+		}
+	}
+	return cnt
+}
+
+// CountFractionalMatchedMsgs returns the number of fractionally matched msgs in the list.
+func CountFractionalMatchedMsgs(swapMsgStates []*SwapMsgState) int {
+	cnt := 0
+	for _, m := range swapMsgStates {
+		if m.Executed && m.Succeeded && !m.RemainingOfferCoin.IsZero() {
+			cnt++
+		}
+	}
+	return cnt
+}
@@ -0,0 +1,157 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gravity-devs/liquidity/v2/x/liquidity/types"
+)
+
+func newSwapMsgState(msgIndex uint64, offerCoin sdk.Coin, demandCoinDenom string, orderPrice sdk.Dec) *types.SwapMsgState {
+	msg := types.NewMsgSwapWithinBatch(sdk.AccAddress("requester"), 1, types.DefaultSwapTypeID, offerCoin, demandCoinDenom, orderPrice, types.DefaultSwapFeeRate)
+	return &types.SwapMsgState{
+		MsgIndex:             msgIndex,
+		Executed:             true,
+		ExchangedOfferCoin:   sdk.NewCoin(offerCoin.Denom, sdk.ZeroInt()),
+		RemainingOfferCoin:   offerCoin,
+		ReservedOfferCoinFee: msg.OfferCoinFee,
+		Msg:                  msg,
+	}
+}
+
+func TestMakeOrderMap(t *testing.T) {
+	price := sdk.MustNewDecFromStr("1.1")
+	swapMsgStates := []*types.SwapMsgState{
+		newSwapMsgState(1, sdk.NewCoin(DenomX, sdk.NewInt(1000)), DenomY, price),
+		newSwapMsgState(2, sdk.NewCoin(DenomX, sdk.NewInt(2000)), DenomY, price),
+		newSwapMsgState(3, sdk.NewCoin(DenomY, sdk.NewInt(3000)), DenomX, sdk.MustNewDecFromStr("0.9")),
+	}
+
+	orderMap, xToY, yToX := types.MakeOrderMap(swapMsgStates, DenomX, DenomY, false)
+	require.Len(t, orderMap, 2)
+	require.Len(t, xToY, 2)
+	require.Len(t, yToX, 1)
+	require.Equal(t, sdk.NewInt(3000), orderMap[price.String()].BuyOfferAmt)
+	require.Len(t, orderMap[price.String()].SwapMsgStates, 2)
+
+	orderBook := orderMap.SortOrderBook()
+	require.True(t, orderBook[0].Price.GT(orderBook[1].Price))
+
+	require.Panics(t, func() {
+		types.MakeOrderMap(swapMsgStates, "denomA", "denomB", false)
+	})
+}
+
+func TestOrderBookPriceDirection(t *testing.T) {
+	currentPrice := sdk.OneDec()
+	for _, tc := range []struct {
+		name      string
+		orderBook types.OrderBook
+		expected  types.PriceDirection
+	}{
+		{
+			"buy orders over the current price",
+			types.OrderBook{{Price: sdk.MustNewDecFromStr("1.1"), BuyOfferAmt: sdk.NewInt(1000), SellOfferAmt: sdk.ZeroInt()}},
+			types.Increasing,
+		},
+		{
+			"sell orders under the current price",
+			types.OrderBook{{Price: sdk.MustNewDecFromStr("0.9"), BuyOfferAmt: sdk.ZeroInt(), SellOfferAmt: sdk.NewInt(1000)}},
+			types.Decreasing,
+		},
+		{
+			"balanced orders",
+			types.OrderBook{
+				{Price: sdk.MustNewDecFromStr("1.1"), BuyOfferAmt: sdk.NewInt(1000), SellOfferAmt: sdk.ZeroInt()},
+				{Price: sdk.MustNewDecFromStr("0.9"), BuyOfferAmt: sdk.ZeroInt(), SellOfferAmt: sdk.NewInt(1000)},
+			},
+			types.Staying,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.orderBook.PriceDirection(currentPrice))
+		})
+	}
+}
+
+func TestOrderBookMatch(t *testing.T) {
+	x, y := sdk.NewDec(1_000_000_000), sdk.NewDec(1_000_000_000)
+
+	// orders that match each other exactly at the current price
+	swapMsgStates := []*types.SwapMsgState{
+		newSwapMsgState(1, sdk.NewCoin(DenomX, sdk.NewInt(10000)), DenomY, sdk.MustNewDecFromStr("1.1")),
+		newSwapMsgState(2, sdk.NewCoin(DenomY, sdk.NewInt(10000)), DenomX, sdk.MustNewDecFromStr("0.9")),
+	}
+	orderMap, xToY, yToX := types.MakeOrderMap(swapMsgStates, DenomX, DenomY, false)
+	result, staying := orderMap.SortOrderBook().Match(types.ConstantProductCurve{}, x, y)
+	require.True(t, staying)
+	require.Equal(t, types.ExactMatch, result.MatchType)
+	require.Equal(t, sdk.OneDec(), result.SwapPrice)
+
+	matchResultXtoY := types.FindOrderMatch(types.DirectionXtoY, xToY, result.EX, result.SwapPrice)
+	matchResultYtoX := types.FindOrderMatch(types.DirectionYtoX, yToX, result.EY, result.SwapPrice)
+	require.Len(t, matchResultXtoY, 1)
+	require.Len(t, matchResultYtoX, 1)
+	require.True(t, types.CheckSwapPrice(matchResultXtoY, matchResultYtoX, result.SwapPrice))
+
+	types.UpdateSwapMsgStates(matchResultXtoY)
+	types.UpdateSwapMsgStates(matchResultYtoX)
+	for _, sms := range swapMsgStates {
+		require.True(t, sms.Succeeded)
+		require.True(t, sms.ToBeDeleted)
+		require.True(t, sms.RemainingOfferCoin.IsZero())
+		require.True(t, sms.ReservedOfferCoinFee.IsZero())
+	}
+	require.Zero(t, types.CountNotMatchedMsgs(swapMsgStates))
+	require.Zero(t, types.CountFractionalMatchedMsgs(swapMsgStates))
+
+	// a single order over the current price is matched with the pool and increases the price
+	swapMsgStates = []*types.SwapMsgState{
+		newSwapMsgState(1, sdk.NewCoin(DenomX, sdk.NewInt(10_000_000)), DenomY, sdk.MustNewDecFromStr("1.1")),
+	}
+	orderMap, _, _ = types.MakeOrderMap(swapMsgStates, DenomX, DenomY, false)
+	result, staying = orderMap.SortOrderBook().Match(types.ConstantProductCurve{}, x, y)
+	require.False(t, staying)
+	require.Equal(t, types.Increasing, result.PriceDirection)
+	require.NotEqual(t, types.NoMatch, result.MatchType)
+	require.True(t, result.SwapPrice.GT(x.Quo(y)))
+	require.True(t, result.PoolY.IsPositive())
+}
+
+func TestFindOrderMatchFractional(t *testing.T) {
+	price := sdk.OneDec()
+	swapMsgStates := []*types.SwapMsgState{
+		newSwapMsgState(1, sdk.NewCoin(DenomX, sdk.NewInt(10000)), DenomY, sdk.MustNewDecFromStr("1.1")),
+		newSwapMsgState(2, sdk.NewCoin(DenomX, sdk.NewInt(10000)), DenomY, price),
+		newSwapMsgState(3, sdk.NewCoin(DenomX, sdk.NewInt(10000)), DenomY, price),
+		newSwapMsgState(4, sdk.NewCoin(DenomX, sdk.NewInt(10000)), DenomY, sdk.MustNewDecFromStr("0.9")),
+	}
+
+	// the order with the best price is fully matched, the orders at the swap price share the rest
+	matchResults := types.FindOrderMatch(types.DirectionXtoY, swapMsgStates, sdk.NewDec(20000), price)
+	require.Len(t, matchResults, 3)
+	require.Equal(t, sdk.NewDec(10000), matchResults[0].TransactedCoinAmt)
+	require.Equal(t, sdk.NewDec(5000), matchResults[1].TransactedCoinAmt)
+	require.Equal(t, sdk.NewDec(5000), matchResults[2].TransactedCoinAmt)
+
+	types.UpdateSwapMsgStates(matchResults)
+	require.Equal(t, 2, types.CountFractionalMatchedMsgs(swapMsgStates))
+	require.Equal(t, 1, types.CountNotMatchedMsgs(swapMsgStates))
+
+	// the orders that are not fully matched are expired at their expiry height
+	for _, sms := range swapMsgStates {
+		sms.OrderExpiryHeight = 10
+	}
+	require.Empty(t, types.GetExpiredOrders(swapMsgStates, 9))
+	expired := types.GetExpiredOrders(swapMsgStates, 10)
+	require.Len(t, expired, 3)
+	for _, sms := range expired {
+		require.False(t, sms.ToBeDeleted)
+		require.True(t, sms.RemainingOfferCoin.IsPositive())
+		sms.ToBeDeleted = true
+	}
+
+	require.Empty(t, types.FindOrderMatch(types.DirectionXtoY, swapMsgStates, sdk.ZeroDec(), price))
+}
@@ -31,15 +31,65 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// PoolStatus enumerates the statuses of a pool, which restrict the msgs of the pool.
+type PoolStatus int32
+
+const (
+	// all msgs of the pool are allowed
+	PoolStatusActive PoolStatus = 0
+	// deposits to the pool are rejected, withdrawals and swaps are allowed
+	PoolStatusDepositsPaused PoolStatus = 1
+	// deposits to the pool and swaps through the pool are rejected, only withdrawals are allowed
+	PoolStatusWithdrawOnly PoolStatus = 2
+	// deposits, withdrawals and swaps of the pool are all rejected
+	PoolStatusFrozen PoolStatus = 3
+	// deposits and swaps of the pool are rejected, and the reserve coins of the pool coin holders are paid out over the
+	// following batches until the pool is removed
+	PoolStatusWindDown PoolStatus = 4
+)
+
+var PoolStatus_name = map[int32]string{
+	0: "POOL_STATUS_ACTIVE",
+	1: "POOL_STATUS_DEPOSITS_PAUSED",
+	2: "POOL_STATUS_WITHDRAW_ONLY",
+	3: "POOL_STATUS_FROZEN",
+	4: "POOL_STATUS_WIND_DOWN",
+}
+
+var PoolStatus_value = map[string]int32{
+	"POOL_STATUS_ACTIVE":          0,
+	"POOL_STATUS_DEPOSITS_PAUSED": 1,
+	"POOL_STATUS_WITHDRAW_ONLY":   2,
+	"POOL_STATUS_FROZEN":          3,
+	"POOL_STATUS_WIND_DOWN":       4,
+}
+
+func (x PoolStatus) String() string {
+	return proto.EnumName(PoolStatus_name, int32(x))
+}
+
+func (PoolStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_deae1e5d4eb3529c, []int{0}
+}
+
 // MsgCreatePool defines an sdk.Msg type that supports submitting a create liquidity pool tx.
 //
 // See: https://github.com/gravity-devs/liquidity/blob/develop/x/liquidity/spec/04_messages.md
 type MsgCreatePool struct {
 	PoolCreatorAddress string `protobuf:"bytes,1,opt,name=pool_creator_address,json=poolCreatorAddress,proto3" json:"pool_creator_address,omitempty" yaml:"pool_creator_address"`
-	// id of the target pool type, must match the value in the pool. Only pool-type-id 1 is supported.
+	// id of the target pool type, must match the value in the pool. The pool types of the params are supported.
 	PoolTypeId uint32 `protobuf:"varint,2,opt,name=pool_type_id,json=poolTypeId,proto3" json:"pool_type_id,omitempty" yaml:"pool_type_id"`
 	// reserve coin pair of the pool to deposit.
 	DepositCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=deposit_coins,json=depositCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit_coins" yaml:"deposit_coins"`
+	// weights of the reserve coins in the order of deposit_coins, summing up to 100. the initial deposit sets the pool price
+	// of the weighted reserve coins. empty means the reserve coins are equally weighted.
+	ReserveCoinWeights []uint32 `protobuf:"varint,5,rep,packed,name=reserve_coin_weights,json=reserveCoinWeights,proto3" json:"reserve_coin_weights,omitempty" yaml:"reserve_coin_weights"`
+	// swap fee rate of the pool, one of the swap fee tiers of the params. zero or empty means the swap fee rate of the
+	// params is applied to the pool.
+	SwapFeeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=swap_fee_rate,json=swapFeeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee_rate" yaml:"swap_fee_rate"`
+	// number of blocks in a batch of the pool, up to the max batch interval of the params. zero means the unit batch
+	// height of the params is applied to the pool.
+	BatchInterval uint32 `protobuf:"varint,7,opt,name=batch_interval,json=batchInterval,proto3" json:"batch_interval,omitempty" yaml:"batch_interval"`
 }
 
 func (m *MsgCreatePool) Reset()         { *m = MsgCreatePool{} }
@@ -126,6 +176,9 @@ type MsgDepositWithinBatch struct {
 	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id" yaml:"pool_id"`
 	// reserve coin pair of the pool to deposit
 	DepositCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=deposit_coins,json=depositCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit_coins" yaml:"deposit_coins"`
+	// minimum amount of pool coin to be minted for the deposit, the deposit is refunded when less pool coin is minted.
+	// zero or empty means no minimum amount.
+	MinPoolCoinAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=min_pool_coin_amount,json=minPoolCoinAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_pool_coin_amount" yaml:"min_pool_coin_amount"`
 }
 
 func (m *MsgDepositWithinBatch) Reset()         { *m = MsgDepositWithinBatch{} }
@@ -198,6 +251,98 @@ func (m *MsgDepositWithinBatchResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDepositWithinBatchResponse proto.InternalMessageInfo
 
+// `MsgDepositSingleAssetWithinBatch` defines an `sdk.Msg` type that supports submitting
+// a deposit request of a single reserve coin to the batch of the liquidity pool.
+// The optimal portion of the `deposit_coin` is swapped to the other reserve coin by the batch swap
+// of the pool, and the rest of the deposit coin and the exchanged coin are deposited to the pool
+// in the same batch execution.
+// This request is stacked in the batch of the liquidity pool, is not processed
+// immediately, and is processed in the `endblock` at the same time as other requests.
+//
+// See: https://github.com/gravity-devs/liquidity/blob/develop/x/liquidity/spec/04_messages.md
+type MsgDepositSingleAssetWithinBatch struct {
+	DepositorAddress string `protobuf:"bytes,1,opt,name=depositor_address,json=depositorAddress,proto3" json:"depositor_address,omitempty" yaml:"depositor_address"`
+	// id of the target pool
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id" yaml:"pool_id"`
+	// one of the reserve coins of the pool to deposit
+	DepositCoin types.Coin `protobuf:"bytes,3,opt,name=deposit_coin,json=depositCoin,proto3" json:"deposit_coin" yaml:"deposit_coin"`
+	// minimum amount of pool coin to be minted for the deposit, the deposit is refunded when less pool coin is minted.
+	// zero or empty means no minimum amount.
+	MinPoolCoinAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=min_pool_coin_amount,json=minPoolCoinAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_pool_coin_amount" yaml:"min_pool_coin_amount"`
+}
+
+func (m *MsgDepositSingleAssetWithinBatch) Reset()         { *m = MsgDepositSingleAssetWithinBatch{} }
+func (m *MsgDepositSingleAssetWithinBatch) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositSingleAssetWithinBatch) ProtoMessage()    {}
+func (*MsgDepositSingleAssetWithinBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deae1e5d4eb3529c, []int{4}
+}
+func (m *MsgDepositSingleAssetWithinBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositSingleAssetWithinBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositSingleAssetWithinBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDepositSingleAssetWithinBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositSingleAssetWithinBatch.Merge(m, src)
+}
+func (m *MsgDepositSingleAssetWithinBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositSingleAssetWithinBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositSingleAssetWithinBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDepositSingleAssetWithinBatch proto.InternalMessageInfo
+
+// MsgDepositSingleAssetWithinBatchResponse defines the Msg/DepositSingleAssetWithinBatch response type.
+type MsgDepositSingleAssetWithinBatchResponse struct {
+}
+
+func (m *MsgDepositSingleAssetWithinBatchResponse) Reset() {
+	*m = MsgDepositSingleAssetWithinBatchResponse{}
+}
+func (m *MsgDepositSingleAssetWithinBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositSingleAssetWithinBatchResponse) ProtoMessage()    {}
+func (*MsgDepositSingleAssetWithinBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deae1e5d4eb3529c, []int{5}
+}
+func (m *MsgDepositSingleAssetWithinBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositSingleAssetWithinBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositSingleAssetWithinBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDepositSingleAssetWithinBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositSingleAssetWithinBatchResponse.Merge(m, src)
+}
+func (m *MsgDepositSingleAssetWithinBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositSingleAssetWithinBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositSingleAssetWithinBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDepositSingleAssetWithinBatchResponse proto.InternalMessageInfo
+
 // `MsgWithdrawWithinBatch` defines an `sdk.Msg` type that supports submitting
 // a withdraw request to the batch of the liquidity pool.
 // Withdraw is submitted to the batch from the Liquidity pool with the
@@ -211,13 +356,19 @@ type MsgWithdrawWithinBatch struct {
 	// id of the target pool
 	PoolId   uint64     `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id" yaml:"pool_id"`
 	PoolCoin types.Coin `protobuf:"bytes,3,opt,name=pool_coin,json=poolCoin,proto3" json:"pool_coin" yaml:"pool_coin"`
+	// minimum amounts of reserve coins to be withdrawn, the withdrawal is refunded when less coins are withdrawn
+	// for any of the denoms. empty means no minimum amounts.
+	MinWithdrawCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=min_withdraw_coins,json=minWithdrawCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_withdraw_coins" yaml:"min_withdraw_coins"`
+	// denom of the reserve coin to redeem the pool coin into, the other reserve coin withdrawn is swapped to the target denom
+	// by the batch swap of the pool in the same batch. empty means the reserve coins are withdrawn as they are.
+	TargetDenom string `protobuf:"bytes,5,opt,name=target_denom,json=targetDenom,proto3" json:"target_denom,omitempty" yaml:"target_denom"`
 }
 
 func (m *MsgWithdrawWithinBatch) Reset()         { *m = MsgWithdrawWithinBatch{} }
 func (m *MsgWithdrawWithinBatch) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawWithinBatch) ProtoMessage()    {}
 func (*MsgWithdrawWithinBatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_deae1e5d4eb3529c, []int{4}
+	return fileDescriptor_deae1e5d4eb3529c, []int{6}
 }
 func (m *MsgWithdrawWithinBatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -248,13 +399,21 @@ var xxx_messageInfo_MsgWithdrawWithinBatch proto.InternalMessageInfo
 
 // MsgWithdrawWithinBatchResponse defines the Msg/WithdrawWithinBatch response type.
 type MsgWithdrawWithinBatchResponse struct {
+	// index of the withdraw msg in the batch, the target coin paid on the batch execution and the index of
+	// the swap msg of the target swap are recorded on the withdraw msg state
+	MsgIndex uint64 `protobuf:"varint,1,opt,name=msg_index,json=msgIndex,proto3" json:"msg_index,omitempty" yaml:"msg_index"`
+	// denom of the reserve coin to redeem the pool coin into, empty when the withdrawal has no target denom
+	TargetDenom string `protobuf:"bytes,2,opt,name=target_denom,json=targetDenom,proto3" json:"target_denom,omitempty" yaml:"target_denom"`
+	// target coin expected on the current reserves of the pool and the swap msgs in the batch of the pool,
+	// which can differ by the other msgs executed in the same batch
+	ExpectedTargetCoin types.Coin `protobuf:"bytes,3,opt,name=expected_target_coin,json=expectedTargetCoin,proto3" json:"expected_target_coin" yaml:"expected_target_coin"`
 }
 
 func (m *MsgWithdrawWithinBatchResponse) Reset()         { *m = MsgWithdrawWithinBatchResponse{} }
 func (m *MsgWithdrawWithinBatchResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawWithinBatchResponse) ProtoMessage()    {}
 func (*MsgWithdrawWithinBatchResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_deae1e5d4eb3529c, []int{5}
+	return fileDescriptor_deae1e5d4eb3529c, []int{7}
 }
 func (m *MsgWithdrawWithinBatchResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -283,10 +442,31 @@ func (m *MsgWithdrawWithinBatchResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgWithdrawWithinBatchResponse proto.InternalMessageInfo
 
+func (m *MsgWithdrawWithinBatchResponse) GetMsgIndex() uint64 {
+	if m != nil {
+		return m.MsgIndex
+	}
+	return 0
+}
+
+func (m *MsgWithdrawWithinBatchResponse) GetTargetDenom() string {
+	if m != nil {
+		return m.TargetDenom
+	}
+	return ""
+}
+
+func (m *MsgWithdrawWithinBatchResponse) GetExpectedTargetCoin() types.Coin {
+	if m != nil {
+		return m.ExpectedTargetCoin
+	}
+	return types.Coin{}
+}
+
 // `MsgSwapWithinBatch` defines an sdk.Msg type that supports submitting a swap offer request to the batch of the liquidity pool.
 // Submit swap offer to the liquidity pool batch with the specified the `pool_id`, `swap_type_id`,
 // `demand_coin_denom` with the coin and the price you're offering
-// and `offer_coin_fee` must be half of offer coin amount * current `params.swap_fee_rate` and ceil for reservation to pay fees.
+// and `offer_coin_fee` must be half of offer coin amount * the swap fee rate of the pool and ceil for reservation to pay fees.
 // This request is stacked in the batch of the liquidity pool, is not processed
 // immediately, and is processed in the `endblock` at the same time as other requests.
 // You must request the same fields as the pool.
@@ -305,7 +485,7 @@ type MsgSwapWithinBatch struct {
 	OfferCoin types.Coin `protobuf:"bytes,4,opt,name=offer_coin,json=offerCoin,proto3" json:"offer_coin" yaml:"offer_coin"`
 	// denom of demand coin to be exchanged on the swap request, must match the denom in the pool.
 	DemandCoinDenom string `protobuf:"bytes,5,opt,name=demand_coin_denom,json=demandCoinDenom,proto3" json:"demand_coin_denom,omitempty" yaml:"demand_coin_denom"`
-	// half of offer coin amount * params.swap_fee_rate and ceil for reservation to pay fees.
+	// half of offer coin amount * the swap fee rate of the pool and ceil for reservation to pay fees.
 	OfferCoinFee types.Coin `protobuf:"bytes,6,opt,name=offer_coin_fee,json=offerCoinFee,proto3" json:"offer_coin_fee" yaml:"offer_coin_fee"`
 	// limit order price for the order, the price is the exchange ratio of X/Y
 	// where X is the amount of the first coin and Y is the amount
@@ -317,7 +497,7 @@ func (m *MsgSwapWithinBatch) Reset()         { *m = MsgSwapWithinBatch{} }
 func (m *MsgSwapWithinBatch) String() string { return proto.CompactTextString(m) }
 func (*MsgSwapWithinBatch) ProtoMessage()    {}
 func (*MsgSwapWithinBatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_deae1e5d4eb3529c, []int{6}
+	return fileDescriptor_deae1e5d4eb3529c, []int{8}
 }
 func (m *MsgSwapWithinBatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -354,7 +534,7 @@ func (m *MsgSwapWithinBatchResponse) Reset()         { *m = MsgSwapWithinBatchRe
 func (m *MsgSwapWithinBatchResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSwapWithinBatchResponse) ProtoMessage()    {}
 func (*MsgSwapWithinBatchResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_deae1e5d4eb3529c, []int{7}
+	return fileDescriptor_deae1e5d4eb3529c, []int{9}
 }
 func (m *MsgSwapWithinBatchResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -383,751 +563,5060 @@ func (m *MsgSwapWithinBatchResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSwapWithinBatchResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreatePool)(nil), "tendermint.liquidity.v1beta1.MsgCreatePool")
-	proto.RegisterType((*MsgCreatePoolResponse)(nil), "tendermint.liquidity.v1beta1.MsgCreatePoolResponse")
-	proto.RegisterType((*MsgDepositWithinBatch)(nil), "tendermint.liquidity.v1beta1.MsgDepositWithinBatch")
-	proto.RegisterType((*MsgDepositWithinBatchResponse)(nil), "tendermint.liquidity.v1beta1.MsgDepositWithinBatchResponse")
-	proto.RegisterType((*MsgWithdrawWithinBatch)(nil), "tendermint.liquidity.v1beta1.MsgWithdrawWithinBatch")
-	proto.RegisterType((*MsgWithdrawWithinBatchResponse)(nil), "tendermint.liquidity.v1beta1.MsgWithdrawWithinBatchResponse")
-	proto.RegisterType((*MsgSwapWithinBatch)(nil), "tendermint.liquidity.v1beta1.MsgSwapWithinBatch")
-	proto.RegisterType((*MsgSwapWithinBatchResponse)(nil), "tendermint.liquidity.v1beta1.MsgSwapWithinBatchResponse")
+// `MsgSwapRoute` defines an sdk.Msg type that supports submitting a multi-hop swap route
+// across several liquidity pools.
+// The route is appended to the batch of the first pool of the route, and all swaps of the route are
+// executed in sequence at the execution of the batch. The intermediate coins are kept in the escrow,
+// and the whole route is refunded when any of the swaps is not fully matched or
+// the final demand coin is less than the minimum demand coin.
+//
+// See: https://github.com/gravity-devs/liquidity/blob/develop/x/liquidity/spec/04_messages.md
+type MsgSwapRoute struct {
+	// address of swap requester
+	SwapRequesterAddress string `protobuf:"bytes,1,opt,name=swap_requester_address,json=swapRequesterAddress,proto3" json:"swap_requester_address,omitempty" yaml:"swap_requester_address"`
+	// ordered ids of the pools to swap through
+	PoolIds []uint64 `protobuf:"varint,2,rep,packed,name=pool_ids,json=poolIds,proto3" json:"pool_ids,omitempty" yaml:"pool_ids"`
+	// offer sdk.coin for the first swap of the route, must match a reserve coin denom of the first pool.
+	OfferCoin types.Coin `protobuf:"bytes,3,opt,name=offer_coin,json=offerCoin,proto3" json:"offer_coin" yaml:"offer_coin"`
+	// half of offer coin amount * the swap fee rate of the first pool and ceil for reservation to pay fees of the first swap.
+	OfferCoinFee types.Coin `protobuf:"bytes,4,opt,name=offer_coin_fee,json=offerCoinFee,proto3" json:"offer_coin_fee" yaml:"offer_coin_fee"`
+	// minimum demand coin to receive at the end of the route, its denom must be the demand coin denom of the last swap.
+	MinDemandCoin types.Coin `protobuf:"bytes,5,opt,name=min_demand_coin,json=minDemandCoin,proto3" json:"min_demand_coin" yaml:"min_demand_coin"`
 }
 
-func init() {
-	proto.RegisterFile("tendermint/liquidity/v1beta1/tx.proto", fileDescriptor_deae1e5d4eb3529c)
+func (m *MsgSwapRoute) Reset()         { *m = MsgSwapRoute{} }
+func (m *MsgSwapRoute) String() string { return proto.CompactTextString(m) }
+func (*MsgSwapRoute) ProtoMessage()    {}
+func (*MsgSwapRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deae1e5d4eb3529c, []int{10}
+}
+func (m *MsgSwapRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSwapRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwapRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSwapRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapRoute.Merge(m, src)
+}
+func (m *MsgSwapRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSwapRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapRoute.DiscardUnknown(m)
 }
 
-var fileDescriptor_deae1e5d4eb3529c = []byte{
-	// 1161 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x97, 0xcf, 0x6f, 0xdb, 0x54,
-	0x1c, 0xc0, 0xe3, 0x26, 0xeb, 0x8f, 0xb7, 0xb6, 0xac, 0x5e, 0xb7, 0x65, 0x61, 0x8b, 0xad, 0x27,
-	0x0d, 0x05, 0xb1, 0x3a, 0x89, 0x9d, 0xb4, 0x4d, 0xe1, 0xe2, 0x24, 0x2d, 0x22, 0x52, 0xa5, 0x61,
-	0x86, 0x18, 0x20, 0x14, 0xb9, 0xf6, 0x6b, 0x6a, 0x96, 0xbc, 0xe7, 0xfa, 0x39, 0x6d, 0x03, 0xda,
-	0x15, 0x81, 0x76, 0x19, 0x99, 0xb8, 0x21, 0x31, 0xf5, 0x88, 0xc4, 0xbf, 0x80, 0x84, 0x84, 0xd0,
-	0x0e, 0x1c, 0x76, 0x44, 0x1c, 0x02, 0x6a, 0x2f, 0x88, 0x03, 0x87, 0x1e, 0x10, 0x27, 0x84, 0x9e,
-	0xed, 0xa4, 0x6e, 0x12, 0x96, 0x6d, 0x9a, 0xd4, 0xc3, 0x72, 0xc9, 0x7b, 0xdf, 0xdf, 0xdf, 0xf7,
-	0x3e, 0x7e, 0xcf, 0x06, 0xd7, 0x5c, 0x84, 0x4d, 0xe4, 0x34, 0x2c, 0xec, 0xa6, 0xeb, 0xd6, 0x76,
-	0xd3, 0x32, 0x2d, 0xb7, 0x95, 0xde, 0xc9, 0x6e, 0x20, 0x57, 0xcf, 0xa6, 0xdd, 0x3d, 0xc9, 0x76,
-	0x88, 0x4b, 0xf8, 0x2b, 0xc7, 0x66, 0x52, 0xcf, 0x4c, 0x0a, 0xcc, 0x12, 0xf3, 0x35, 0x52, 0x23,
-	0x9e, 0x61, 0x9a, 0x8d, 0x7c, 0x9f, 0xc4, 0x25, 0x83, 0xd0, 0x06, 0xa1, 0x55, 0x5f, 0x61, 0x10,
-	0x0b, 0x07, 0x0a, 0xff, 0xcf, 0x58, 0xa8, 0x21, 0xbc, 0x40, 0x6c, 0x84, 0x75, 0xdb, 0xda, 0x91,
-	0xd3, 0xc4, 0x76, 0x2d, 0x82, 0x69, 0x5a, 0xc7, 0x98, 0xb8, 0xba, 0x37, 0xf6, 0x0d, 0xe1, 0x37,
-	0x31, 0x30, 0xb3, 0x4e, 0x6b, 0x25, 0x07, 0xe9, 0x2e, 0xba, 0x41, 0x48, 0x9d, 0xff, 0x89, 0x03,
-	0xf3, 0x36, 0x21, 0xf5, 0xaa, 0xc1, 0x64, 0xc4, 0xa9, 0xea, 0xa6, 0xe9, 0x20, 0x4a, 0xe3, 0x9c,
-	0xc8, 0xa5, 0xa6, 0x8a, 0xf7, 0xb9, 0xb6, 0xba, 0x2d, 0x2f, 0xe8, 0x86, 0x41, 0x9a, 0xd8, 0x15,
-	0x03, 0xa5, 0x48, 0x36, 0x45, 0x77, 0x0b, 0x89, 0xc4, 0xb1, 0x6a, 0x16, 0xf6, 0x67, 0x16, 0x15,
-	0x1b, 0x88, 0x52, 0xbd, 0x86, 0x2a, 0x69, 0xe8, 0xd7, 0x9b, 0x45, 0x4a, 0xbe, 0xb5, 0x58, 0x70,
-	0xb6, 0x1c, 0x77, 0xa9, 0x95, 0x6b, 0x19, 0x28, 0x5f, 0xcf, 0x37, 0x97, 0x14, 0xfa, 0x31, 0xde,
-	0x6b, 0x66, 0xea, 0x8a, 0xb2, 0xbb, 0xf3, 0x09, 0x6e, 0x35, 0x31, 0xdc, 0x1f, 0x9b, 0xa5, 0xe6,
-	0x6d, 0x49, 0x35, 0x0c, 0xd5, 0x8f, 0x7f, 0xd4, 0x11, 0x5e, 0x6e, 0xe9, 0x8d, 0xfa, 0x0a, 0x1c,
-	0x56, 0x1a, 0xd4, 0x78, 0x26, 0x2e, 0xf9, 0xd2, 0xc0, 0x85, 0xaf, 0x80, 0x69, 0xcf, 0xd8, 0x6d,
-	0xd9, 0xa8, 0x6a, 0x99, 0xf1, 0x31, 0x91, 0x4b, 0xcd, 0x14, 0x53, 0x6d, 0x75, 0xb6, 0x12, 0x85,
-	0x59, 0xb8, 0x3f, 0x36, 0xde, 0xb4, 0xb0, 0xab, 0xc8, 0x47, 0x1d, 0xe1, 0x7c, 0x28, 0x76, 0x60,
-	0x0e, 0x35, 0xc0, 0xa6, 0x37, 0x5b, 0x36, 0x7a, 0xcb, 0xe4, 0xff, 0xe2, 0xc0, 0x8c, 0x89, 0x6c,
-	0x42, 0x2d, 0xb7, 0xca, 0x56, 0x9b, 0xc6, 0x63, 0x62, 0x34, 0x75, 0x56, 0xbe, 0x2c, 0xf9, 0x8d,
-	0x49, 0x1b, 0x3a, 0x45, 0xdd, 0x3d, 0x93, 0x4a, 0xc4, 0xc2, 0xc5, 0xef, 0xb8, 0xb6, 0xba, 0x51,
-	0xb9, 0xf9, 0xe1, 0xa7, 0xd0, 0x44, 0x98, 0x34, 0xe0, 0x8a, 0xe8, 0x0f, 0x6e, 0xc1, 0xeb, 0x22,
-	0xd4, 0x1b, 0x6c, 0xf5, 0x98, 0x2c, 0x9b, 0xf1, 0x7e, 0xf0, 0xce, 0x75, 0xb1, 0xdf, 0xf2, 0xfd,
-	0x93, 0x96, 0x72, 0xd7, 0xf2, 0xa3, 0xfd, 0xb1, 0x29, 0xb6, 0x3c, 0x2c, 0x0d, 0x7d, 0xd8, 0x11,
-	0x22, 0x47, 0x1d, 0x61, 0xde, 0xef, 0xe0, 0x44, 0x8d, 0xf0, 0xdb, 0xdf, 0x84, 0x54, 0xcd, 0x72,
-	0xb7, 0x9a, 0x1b, 0x92, 0x41, 0x1a, 0x69, 0xbf, 0xd4, 0xe0, 0x6f, 0x81, 0x9a, 0xb7, 0xd3, 0xac,
-	0x57, 0xea, 0xc7, 0xd1, 0xa6, 0x03, 0x5f, 0x6f, 0xb6, 0x32, 0xf9, 0xf9, 0x03, 0x21, 0xf2, 0xc7,
-	0x03, 0x21, 0x02, 0x2f, 0x81, 0x0b, 0x27, 0x00, 0xd1, 0x10, 0xb5, 0x09, 0xa6, 0x08, 0x7e, 0x1d,
-	0xf3, 0x34, 0x65, 0xdf, 0xed, 0x3d, 0xcb, 0xdd, 0xb2, 0x70, 0x51, 0x77, 0x8d, 0x2d, 0xfe, 0x7b,
-	0x0e, 0xcc, 0x05, 0xd1, 0x06, 0xf8, 0xb9, 0x77, 0x5a, 0xfc, 0xc4, 0x4f, 0xac, 0x50, 0x18, 0x9e,
-	0x73, 0x3d, 0x59, 0x17, 0x9d, 0x37, 0xc1, 0x84, 0xc7, 0x42, 0x40, 0x4d, 0xac, 0x28, 0xf5, 0x51,
-	0xb3, 0x98, 0xfb, 0xb3, 0x23, 0x74, 0x6d, 0x8e, 0x3a, 0xc2, 0x6c, 0x08, 0x20, 0xc6, 0xce, 0x38,
-	0x1b, 0x0d, 0xe5, 0x26, 0xfa, 0xa2, 0x70, 0x23, 0x80, 0xab, 0x43, 0xe9, 0xe8, 0xf1, 0xf3, 0x77,
-	0x14, 0x5c, 0x5c, 0xa7, 0x35, 0xa6, 0x32, 0x1d, 0x7d, 0x37, 0x0c, 0xd0, 0x0f, 0x1c, 0xe0, 0x77,
-	0x03, 0x39, 0xea, 0x27, 0xe8, 0xcb, 0xd3, 0x22, 0xe8, 0xb2, 0xbf, 0x56, 0x83, 0x85, 0x41, 0x6d,
-	0xee, 0x58, 0xf8, 0xdc, 0x19, 0xfa, 0x91, 0x03, 0x53, 0xfe, 0xa9, 0x47, 0x2c, 0x1c, 0x8f, 0x8a,
-	0xdc, 0xe3, 0xf9, 0xb9, 0xcb, 0xb5, 0x55, 0xbb, 0x62, 0x84, 0xa0, 0x60, 0xce, 0x65, 0x25, 0xaf,
-	0x66, 0x4a, 0xa5, 0xec, 0xe2, 0xea, 0x6a, 0xbe, 0xb0, 0xbc, 0x56, 0xc8, 0x14, 0x33, 0xb9, 0x5c,
-	0x69, 0x55, 0x2e, 0x2c, 0xaa, 0xb9, 0x4c, 0xbe, 0xa8, 0x16, 0x4a, 0xca, 0x72, 0x76, 0x55, 0x59,
-	0x5e, 0x56, 0x96, 0xf2, 0x85, 0x42, 0xb9, 0xb0, 0xb8, 0x26, 0xaf, 0x2d, 0x65, 0x4a, 0xf2, 0x5a,
-	0x46, 0x56, 0x65, 0x45, 0xcd, 0x0d, 0xc2, 0x07, 0xef, 0xec, 0x8f, 0x4d, 0x76, 0x71, 0x0a, 0x68,
-	0x3a, 0x17, 0x3e, 0xa3, 0x89, 0x85, 0xa1, 0x36, 0xe9, 0x1d, 0xcc, 0xc4, 0xc2, 0x21, 0x32, 0x44,
-	0x90, 0x1c, 0xbe, 0xef, 0x3d, 0x34, 0xfe, 0x19, 0x07, 0xfc, 0x3a, 0xad, 0xbd, 0xb3, 0xab, 0xdb,
-	0x61, 0x2c, 0x7e, 0xe6, 0xc0, 0x45, 0xba, 0xab, 0xdb, 0x55, 0x07, 0x6d, 0x37, 0x11, 0x75, 0x07,
-	0xd0, 0xf8, 0xea, 0xb4, 0xd0, 0xb8, 0xea, 0x37, 0x3e, 0xbc, 0x38, 0xa8, 0xcd, 0x33, 0x85, 0xd6,
-	0x95, 0x3f, 0x77, 0x42, 0x2a, 0x60, 0xda, 0xcb, 0xdc, 0xbd, 0xe9, 0xa2, 0x23, 0x6f, 0xba, 0xb0,
-	0x39, 0xd4, 0x00, 0x9b, 0x06, 0x37, 0xdd, 0x5d, 0x0e, 0x00, 0xb2, 0xb9, 0x89, 0x1c, 0x1f, 0xb7,
-	0xd8, 0x28, 0xdc, 0xde, 0x6e, 0xab, 0xf9, 0x4a, 0xea, 0x49, 0x0f, 0xab, 0x41, 0x64, 0xe6, 0xfc,
-	0x82, 0x8e, 0x53, 0x42, 0x6d, 0xca, 0x9b, 0x30, 0x1b, 0xfe, 0x5d, 0x76, 0x91, 0x34, 0x74, 0x6c,
-	0x7a, 0xaa, 0xaa, 0x17, 0x3b, 0x7e, 0xc6, 0xdb, 0xeb, 0x57, 0xdb, 0x2a, 0xa8, 0x4c, 0xfa, 0xe9,
-	0x8a, 0x30, 0x7c, 0xc0, 0xf7, 0xd9, 0x43, 0xed, 0x25, 0x5f, 0xc6, 0x22, 0x96, 0x99, 0x84, 0xbf,
-	0xcf, 0x81, 0xd9, 0xe3, 0x8c, 0xd5, 0x4d, 0x84, 0xe2, 0xe3, 0xa3, 0x1a, 0xd5, 0xda, 0xaa, 0x5c,
-	0xb9, 0x36, 0xa2, 0xd1, 0xfc, 0xff, 0x74, 0x79, 0xa1, 0xbf, 0x4b, 0x96, 0x13, 0x6a, 0xd3, 0xbd,
-	0x4e, 0xd7, 0x10, 0xe2, 0x5b, 0xe0, 0x2c, 0x71, 0x4c, 0xe4, 0x54, 0x6d, 0xc7, 0x32, 0x50, 0x7c,
-	0xc2, 0x6b, 0xf3, 0x56, 0x5b, 0x9d, 0xab, 0x9c, 0x81, 0x59, 0x89, 0xed, 0xe3, 0x04, 0x0b, 0x5b,
-	0x46, 0x06, 0x8b, 0xfa, 0x6b, 0x47, 0x78, 0xe5, 0x09, 0x0e, 0xe9, 0x32, 0x32, 0x8e, 0x3a, 0x02,
-	0x1f, 0xe4, 0x3f, 0x0e, 0x0f, 0x35, 0xe0, 0xcd, 0x6e, 0xb0, 0x49, 0xe8, 0xe1, 0xbc, 0x02, 0x12,
-	0x83, 0x4f, 0x5e, 0xf7, 0xc1, 0x94, 0xff, 0x8d, 0x82, 0xe8, 0x3a, 0xad, 0xf1, 0x18, 0x80, 0xd0,
-	0x2b, 0xe3, 0x6b, 0xd2, 0xe3, 0x5e, 0x61, 0xa5, 0x13, 0xaf, 0x0f, 0x09, 0xe5, 0x29, 0x8c, 0xbb,
-	0x79, 0xf9, 0xcf, 0x38, 0xc0, 0x0f, 0x79, 0xd1, 0x18, 0x1d, 0x6b, 0xd0, 0x29, 0xf1, 0xfa, 0x33,
-	0x38, 0xf5, 0x0a, 0xf9, 0x82, 0x03, 0xe7, 0x87, 0xdd, 0x58, 0xb9, 0x91, 0x41, 0x87, 0x78, 0x25,
-	0xde, 0x78, 0x16, 0xaf, 0x5e, 0x2d, 0x0e, 0x88, 0xb1, 0x7d, 0xe2, 0x33, 0x23, 0xa3, 0xf4, 0x6d,
-	0x67, 0x62, 0xf9, 0x69, 0x3d, 0xba, 0x39, 0x8b, 0xeb, 0x0f, 0x0f, 0x92, 0xdc, 0xa3, 0x83, 0x24,
-	0xf7, 0xfb, 0x41, 0x92, 0xbb, 0x77, 0x98, 0x8c, 0x3c, 0x3a, 0x4c, 0x46, 0x7e, 0x39, 0x4c, 0x46,
-	0x3e, 0x50, 0x42, 0x30, 0xd6, 0x1c, 0x7d, 0xc7, 0x72, 0x5b, 0x0b, 0x26, 0xda, 0xa1, 0xa1, 0x4f,
-	0x9f, 0xbd, 0xd0, 0xd8, 0xa3, 0x73, 0x63, 0xdc, 0xfb, 0x0a, 0x51, 0xfe, 0x0b, 0x00, 0x00, 0xff,
-	0xff, 0x43, 0x80, 0x9a, 0x7c, 0x2b, 0x0d, 0x00, 0x00,
+var xxx_messageInfo_MsgSwapRoute proto.InternalMessageInfo
+
+// MsgSwapRouteResponse defines the Msg/SwapRoute response type.
+type MsgSwapRouteResponse struct {
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *MsgSwapRouteResponse) Reset()         { *m = MsgSwapRouteResponse{} }
+func (m *MsgSwapRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSwapRouteResponse) ProtoMessage()    {}
+func (*MsgSwapRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deae1e5d4eb3529c, []int{11}
+}
+func (m *MsgSwapRouteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSwapRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwapRouteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSwapRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapRouteResponse.Merge(m, src)
+}
+func (m *MsgSwapRouteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSwapRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapRouteResponse.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgSwapRouteResponse proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
+// `MsgCancelDeposit` defines an sdk.Msg type that supports cancelling a deposit request
+// in the batch of the liquidity pool that is not executed yet.
+// The escrowed coins of the request are released to the requester immediately.
+// Only the original requester of the batch msg can cancel it.
 //
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// Submit a create liquidity pool message.
-	CreatePool(ctx context.Context, in *MsgCreatePool, opts ...grpc.CallOption) (*MsgCreatePoolResponse, error)
-	// Submit a deposit to the liquidity pool batch.
-	DepositWithinBatch(ctx context.Context, in *MsgDepositWithinBatch, opts ...grpc.CallOption) (*MsgDepositWithinBatchResponse, error)
-	// Submit a withdraw from the liquidity pool batch.
-	WithdrawWithinBatch(ctx context.Context, in *MsgWithdrawWithinBatch, opts ...grpc.CallOption) (*MsgWithdrawWithinBatchResponse, error)
-	// Submit a swap to the liquidity pool batch.
-	Swap(ctx context.Context, in *MsgSwapWithinBatch, opts ...grpc.CallOption) (*MsgSwapWithinBatchResponse, error)
+// See: https://github.com/gravity-devs/liquidity/blob/develop/x/liquidity/spec/04_messages.md
+type MsgCancelDeposit struct {
+	DepositorAddress string `protobuf:"bytes,1,opt,name=depositor_address,json=depositorAddress,proto3" json:"depositor_address,omitempty" yaml:"depositor_address"`
+	// id of the target pool
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id" yaml:"pool_id"`
+	// index of the deposit msg to cancel in the batch of the pool
+	MsgIndex uint64 `protobuf:"varint,3,opt,name=msg_index,json=msgIndex,proto3" json:"msg_index,omitempty" yaml:"msg_index"`
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgCancelDeposit) Reset()         { *m = MsgCancelDeposit{} }
+func (m *MsgCancelDeposit) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelDeposit) ProtoMessage()    {}
+func (*MsgCancelDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deae1e5d4eb3529c, []int{12}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgCancelDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) CreatePool(ctx context.Context, in *MsgCreatePool, opts ...grpc.CallOption) (*MsgCreatePoolResponse, error) {
-	out := new(MsgCreatePoolResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Msg/CreatePool", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCancelDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) DepositWithinBatch(ctx context.Context, in *MsgDepositWithinBatch, opts ...grpc.CallOption) (*MsgDepositWithinBatchResponse, error) {
-	out := new(MsgDepositWithinBatchResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Msg/DepositWithinBatch", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgCancelDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelDeposit.Merge(m, src)
+}
+func (m *MsgCancelDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelDeposit.DiscardUnknown(m)
 }
 
-func (c *msgClient) WithdrawWithinBatch(ctx context.Context, in *MsgWithdrawWithinBatch, opts ...grpc.CallOption) (*MsgWithdrawWithinBatchResponse, error) {
-	out := new(MsgWithdrawWithinBatchResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Msg/WithdrawWithinBatch", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgCancelDeposit proto.InternalMessageInfo
+
+// MsgCancelDepositResponse defines the Msg/CancelDeposit response type.
+type MsgCancelDepositResponse struct {
 }
 
-func (c *msgClient) Swap(ctx context.Context, in *MsgSwapWithinBatch, opts ...grpc.CallOption) (*MsgSwapWithinBatchResponse, error) {
-	out := new(MsgSwapWithinBatchResponse)
-	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Msg/Swap", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCancelDepositResponse) Reset()         { *m = MsgCancelDepositResponse{} }
+func (m *MsgCancelDepositResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelDepositResponse) ProtoMessage()    {}
+func (*MsgCancelDepositResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deae1e5d4eb3529c, []int{13}
+}
+func (m *MsgCancelDepositResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelDepositResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// Submit a create liquidity pool message.
-	CreatePool(context.Context, *MsgCreatePool) (*MsgCreatePoolResponse, error)
-	// Submit a deposit to the liquidity pool batch.
-	DepositWithinBatch(context.Context, *MsgDepositWithinBatch) (*MsgDepositWithinBatchResponse, error)
-	// Submit a withdraw from the liquidity pool batch.
-	WithdrawWithinBatch(context.Context, *MsgWithdrawWithinBatch) (*MsgWithdrawWithinBatchResponse, error)
-	// Submit a swap to the liquidity pool batch.
-	Swap(context.Context, *MsgSwapWithinBatch) (*MsgSwapWithinBatchResponse, error)
+func (m *MsgCancelDepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelDepositResponse.Merge(m, src)
+}
+func (m *MsgCancelDepositResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelDepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelDepositResponse.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgCancelDepositResponse proto.InternalMessageInfo
+
+// `MsgCancelWithdraw` defines an sdk.Msg type that supports cancelling a withdraw request
+// in the batch of the liquidity pool that is not executed yet.
+// The escrowed coins of the request are released to the requester immediately.
+// Only the original requester of the batch msg can cancel it.
+//
+// See: https://github.com/gravity-devs/liquidity/blob/develop/x/liquidity/spec/04_messages.md
+type MsgCancelWithdraw struct {
+	WithdrawerAddress string `protobuf:"bytes,1,opt,name=withdrawer_address,json=withdrawerAddress,proto3" json:"withdrawer_address,omitempty" yaml:"withdrawer_address"`
+	// id of the target pool
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id" yaml:"pool_id"`
+	// index of the withdraw msg to cancel in the batch of the pool
+	MsgIndex uint64 `protobuf:"varint,3,opt,name=msg_index,json=msgIndex,proto3" json:"msg_index,omitempty" yaml:"msg_index"`
 }
 
-func (*UnimplementedMsgServer) CreatePool(ctx context.Context, req *MsgCreatePool) (*MsgCreatePoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreatePool not implemented")
+func (m *MsgCancelWithdraw) Reset()         { *m = MsgCancelWithdraw{} }
+func (m *MsgCancelWithdraw) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelWithdraw) ProtoMessage()    {}
+func (*MsgCancelWithdraw) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deae1e5d4eb3529c, []int{14}
 }
-func (*UnimplementedMsgServer) DepositWithinBatch(ctx context.Context, req *MsgDepositWithinBatch) (*MsgDepositWithinBatchResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DepositWithinBatch not implemented")
+func (m *MsgCancelWithdraw) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) WithdrawWithinBatch(ctx context.Context, req *MsgWithdrawWithinBatch) (*MsgWithdrawWithinBatchResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawWithinBatch not implemented")
+func (m *MsgCancelWithdraw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelWithdraw.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) Swap(ctx context.Context, req *MsgSwapWithinBatch) (*MsgSwapWithinBatchResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Swap not implemented")
+func (m *MsgCancelWithdraw) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelWithdraw.Merge(m, src)
+}
+func (m *MsgCancelWithdraw) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelWithdraw) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelWithdraw.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgCancelWithdraw proto.InternalMessageInfo
+
+// MsgCancelWithdrawResponse defines the Msg/CancelWithdraw response type.
+type MsgCancelWithdrawResponse struct {
 }
 
-func _Msg_CreatePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreatePool)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreatePool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.liquidity.v1beta1.Msg/CreatePool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreatePool(ctx, req.(*MsgCreatePool))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgCancelWithdrawResponse) Reset()         { *m = MsgCancelWithdrawResponse{} }
+func (m *MsgCancelWithdrawResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelWithdrawResponse) ProtoMessage()    {}
+func (*MsgCancelWithdrawResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deae1e5d4eb3529c, []int{15}
 }
-
-func _Msg_DepositWithinBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDepositWithinBatch)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DepositWithinBatch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.liquidity.v1beta1.Msg/DepositWithinBatch",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DepositWithinBatch(ctx, req.(*MsgDepositWithinBatch))
+func (m *MsgCancelWithdrawResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelWithdrawResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelWithdrawResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgCancelWithdrawResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelWithdrawResponse.Merge(m, src)
+}
+func (m *MsgCancelWithdrawResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelWithdrawResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelWithdrawResponse.DiscardUnknown(m)
 }
 
-func _Msg_WithdrawWithinBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdrawWithinBatch)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WithdrawWithinBatch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.liquidity.v1beta1.Msg/WithdrawWithinBatch",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WithdrawWithinBatch(ctx, req.(*MsgWithdrawWithinBatch))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgCancelWithdrawResponse proto.InternalMessageInfo
+
+// `MsgCancelSwap` defines an sdk.Msg type that supports cancelling a swap request
+// in the batch of the liquidity pool that is not executed yet.
+// The escrowed coins of the request are released to the requester immediately.
+// Only the original requester of the batch msg can cancel it.
+//
+// See: https://github.com/gravity-devs/liquidity/blob/develop/x/liquidity/spec/04_messages.md
+type MsgCancelSwap struct {
+	SwapRequesterAddress string `protobuf:"bytes,1,opt,name=swap_requester_address,json=swapRequesterAddress,proto3" json:"swap_requester_address,omitempty" yaml:"swap_requester_address"`
+	// id of the target pool
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id" yaml:"pool_id"`
+	// index of the swap msg to cancel in the batch of the pool
+	MsgIndex uint64 `protobuf:"varint,3,opt,name=msg_index,json=msgIndex,proto3" json:"msg_index,omitempty" yaml:"msg_index"`
 }
 
-func _Msg_Swap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSwapWithinBatch)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Swap(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tendermint.liquidity.v1beta1.Msg/Swap",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Swap(ctx, req.(*MsgSwapWithinBatch))
+func (m *MsgCancelSwap) Reset()         { *m = MsgCancelSwap{} }
+func (m *MsgCancelSwap) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelSwap) ProtoMessage()    {}
+func (*MsgCancelSwap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deae1e5d4eb3529c, []int{16}
+}
+func (m *MsgCancelSwap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelSwap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgCancelSwap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelSwap.Merge(m, src)
+}
+func (m *MsgCancelSwap) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelSwap) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelSwap.DiscardUnknown(m)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "tendermint.liquidity.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreatePool",
-			Handler:    _Msg_CreatePool_Handler,
-		},
-		{
-			MethodName: "DepositWithinBatch",
-			Handler:    _Msg_DepositWithinBatch_Handler,
-		},
-		{
-			MethodName: "WithdrawWithinBatch",
-			Handler:    _Msg_WithdrawWithinBatch_Handler,
-		},
-		{
-			MethodName: "Swap",
-			Handler:    _Msg_Swap_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "tendermint/liquidity/v1beta1/tx.proto",
+var xxx_messageInfo_MsgCancelSwap proto.InternalMessageInfo
+
+// MsgCancelSwapResponse defines the Msg/CancelSwap response type.
+type MsgCancelSwapResponse struct {
 }
 
-func (m *MsgCreatePool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelSwapResponse) Reset()         { *m = MsgCancelSwapResponse{} }
+func (m *MsgCancelSwapResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelSwapResponse) ProtoMessage()    {}
+func (*MsgCancelSwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deae1e5d4eb3529c, []int{17}
+}
+func (m *MsgCancelSwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelSwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelSwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgCancelSwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelSwapResponse.Merge(m, src)
+}
+func (m *MsgCancelSwapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelSwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelSwapResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreatePool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgCancelSwapResponse proto.InternalMessageInfo
+
+// `MsgSetPoolStatus` defines an sdk.Msg type that supports setting the status of the liquidity pool,
+// which restricts the deposits, withdrawals and swaps of the pool.
+// The authority is the governance module account, or one of the guardians of the params which can only make
+// the status of the pool more restrictive.
+//
+// See: https://github.com/gravity-devs/liquidity/blob/develop/x/liquidity/spec/04_messages.md
+type MsgSetPoolStatus struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	// id of the target pool
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id" yaml:"pool_id"`
+	// new status of the pool
+	Status PoolStatus `protobuf:"varint,3,opt,name=status,proto3,enum=tendermint.liquidity.v1beta1.PoolStatus" json:"status,omitempty" yaml:"status"`
 }
 
-func (m *MsgCreatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DepositCoins) > 0 {
-		for iNdEx := len(m.DepositCoins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DepositCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+func (m *MsgSetPoolStatus) Reset()         { *m = MsgSetPoolStatus{} }
+func (m *MsgSetPoolStatus) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPoolStatus) ProtoMessage()    {}
+func (*MsgSetPoolStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deae1e5d4eb3529c, []int{18}
+}
+func (m *MsgSetPoolStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPoolStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPoolStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.PoolTypeId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PoolTypeId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.PoolCreatorAddress) > 0 {
-		i -= len(m.PoolCreatorAddress)
-		copy(dAtA[i:], m.PoolCreatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PoolCreatorAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSetPoolStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPoolStatus.Merge(m, src)
+}
+func (m *MsgSetPoolStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPoolStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPoolStatus.DiscardUnknown(m)
 }
 
-func (m *MsgCreatePoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_MsgSetPoolStatus proto.InternalMessageInfo
+
+// MsgSetPoolStatusResponse defines the Msg/SetPoolStatus response type.
+type MsgSetPoolStatusResponse struct {
 }
 
-func (m *MsgCreatePoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetPoolStatusResponse) Reset()         { *m = MsgSetPoolStatusResponse{} }
+func (m *MsgSetPoolStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPoolStatusResponse) ProtoMessage()    {}
+func (*MsgSetPoolStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deae1e5d4eb3529c, []int{19}
+}
+func (m *MsgSetPoolStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPoolStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPoolStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPoolStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPoolStatusResponse.Merge(m, src)
+}
+func (m *MsgSetPoolStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPoolStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPoolStatusResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreatePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgSetPoolStatusResponse proto.InternalMessageInfo
+
+// `MsgSetCircuitBreaker` defines an sdk.Msg type that supports enabling or disabling the circuit breaker of all pools,
+// or of the liquidity pool when the pool id is set.
+// The authority is the governance module account, or one of the guardians of the params which can only enable
+// the circuit breaker.
+//
+// See: https://github.com/gravity-devs/liquidity/blob/develop/x/liquidity/spec/04_messages.md
+type MsgSetCircuitBreaker struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	// id of the target pool, zero for all pools
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id" yaml:"pool_id"`
+	// whether the circuit breaker is enabled
+	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty" yaml:"enabled"`
 }
 
-func (m *MsgDepositWithinBatch) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetCircuitBreaker) Reset()         { *m = MsgSetCircuitBreaker{} }
+func (m *MsgSetCircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCircuitBreaker) ProtoMessage()    {}
+func (*MsgSetCircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deae1e5d4eb3529c, []int{20}
+}
+func (m *MsgSetCircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCircuitBreaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgDepositWithinBatch) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetCircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCircuitBreaker.Merge(m, src)
+}
+func (m *MsgSetCircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCircuitBreaker.DiscardUnknown(m)
 }
 
-func (m *MsgDepositWithinBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DepositCoins) > 0 {
-		for iNdEx := len(m.DepositCoins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DepositCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+var xxx_messageInfo_MsgSetCircuitBreaker proto.InternalMessageInfo
+
+// MsgSetCircuitBreakerResponse defines the Msg/SetCircuitBreaker response type.
+type MsgSetCircuitBreakerResponse struct {
+}
+
+func (m *MsgSetCircuitBreakerResponse) Reset()         { *m = MsgSetCircuitBreakerResponse{} }
+func (m *MsgSetCircuitBreakerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCircuitBreakerResponse) ProtoMessage()    {}
+func (*MsgSetCircuitBreakerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deae1e5d4eb3529c, []int{21}
+}
+func (m *MsgSetCircuitBreakerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCircuitBreakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCircuitBreakerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.DepositorAddress) > 0 {
-		i -= len(m.DepositorAddress)
-		copy(dAtA[i:], m.DepositorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DepositorAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSetCircuitBreakerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCircuitBreakerResponse.Merge(m, src)
+}
+func (m *MsgSetCircuitBreakerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCircuitBreakerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCircuitBreakerResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDepositWithinBatchResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_MsgSetCircuitBreakerResponse proto.InternalMessageInfo
+
+// `MsgUpdateParams` defines an sdk.Msg type that supports updating the params of the liquidity module.
+// The authority is the governance module account.
+//
+// See: https://github.com/gravity-devs/liquidity/blob/develop/x/liquidity/spec/04_messages.md
+type MsgUpdateParams struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	// all params of the liquidity module to update, every param must be set
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params" yaml:"params"`
 }
 
-func (m *MsgDepositWithinBatchResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deae1e5d4eb3529c, []int{22}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
 }
 
-func (m *MsgDepositWithinBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+// MsgUpdateParamsResponse defines the Msg/UpdateParams response type.
+type MsgUpdateParamsResponse struct {
 }
 
-func (m *MsgWithdrawWithinBatch) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deae1e5d4eb3529c, []int{23}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgWithdrawWithinBatch) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// `MsgWindDownPool` defines an sdk.Msg type that supports delisting the liquidity pool. The pool is winding down,
+// the proportional reserve coins of each pool coin holder are paid out without the withdraw fee over the following
+// batches, and the pool is removed when the pool coin supply is zero. The authority is the governance module account.
+//
+// See: https://github.com/gravity-devs/liquidity/blob/develop/x/liquidity/spec/04_messages.md
+type MsgWindDownPool struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty" yaml:"authority"`
+	// id of the target pool
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id" yaml:"pool_id"`
 }
 
-func (m *MsgWithdrawWithinBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.PoolCoin.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgWindDownPool) Reset()         { *m = MsgWindDownPool{} }
+func (m *MsgWindDownPool) String() string { return proto.CompactTextString(m) }
+func (*MsgWindDownPool) ProtoMessage()    {}
+func (*MsgWindDownPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deae1e5d4eb3529c, []int{24}
+}
+func (m *MsgWindDownPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWindDownPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWindDownPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.PoolId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.WithdrawerAddress) > 0 {
-		i -= len(m.WithdrawerAddress)
-		copy(dAtA[i:], m.WithdrawerAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.WithdrawerAddress)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgWindDownPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWindDownPool.Merge(m, src)
+}
+func (m *MsgWindDownPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWindDownPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWindDownPool.DiscardUnknown(m)
 }
 
-func (m *MsgWithdrawWithinBatchResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgWindDownPool proto.InternalMessageInfo
+
+// MsgWindDownPoolResponse defines the Msg/WindDownPool response type.
+type MsgWindDownPoolResponse struct {
+}
+
+func (m *MsgWindDownPoolResponse) Reset()         { *m = MsgWindDownPoolResponse{} }
+func (m *MsgWindDownPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWindDownPoolResponse) ProtoMessage()    {}
+func (*MsgWindDownPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_deae1e5d4eb3529c, []int{25}
+}
+func (m *MsgWindDownPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWindDownPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWindDownPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgWindDownPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWindDownPoolResponse.Merge(m, src)
+}
+func (m *MsgWindDownPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWindDownPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWindDownPoolResponse.DiscardUnknown(m)
 }
 
-func (m *MsgWithdrawWithinBatchResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgWindDownPoolResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("tendermint.liquidity.v1beta1.PoolStatus", PoolStatus_name, PoolStatus_value)
+	proto.RegisterType((*MsgCreatePool)(nil), "tendermint.liquidity.v1beta1.MsgCreatePool")
+	proto.RegisterType((*MsgCreatePoolResponse)(nil), "tendermint.liquidity.v1beta1.MsgCreatePoolResponse")
+	proto.RegisterType((*MsgDepositWithinBatch)(nil), "tendermint.liquidity.v1beta1.MsgDepositWithinBatch")
+	proto.RegisterType((*MsgDepositWithinBatchResponse)(nil), "tendermint.liquidity.v1beta1.MsgDepositWithinBatchResponse")
+	proto.RegisterType((*MsgDepositSingleAssetWithinBatch)(nil), "tendermint.liquidity.v1beta1.MsgDepositSingleAssetWithinBatch")
+	proto.RegisterType((*MsgDepositSingleAssetWithinBatchResponse)(nil), "tendermint.liquidity.v1beta1.MsgDepositSingleAssetWithinBatchResponse")
+	proto.RegisterType((*MsgWithdrawWithinBatch)(nil), "tendermint.liquidity.v1beta1.MsgWithdrawWithinBatch")
+	proto.RegisterType((*MsgWithdrawWithinBatchResponse)(nil), "tendermint.liquidity.v1beta1.MsgWithdrawWithinBatchResponse")
+	proto.RegisterType((*MsgSwapWithinBatch)(nil), "tendermint.liquidity.v1beta1.MsgSwapWithinBatch")
+	proto.RegisterType((*MsgSwapWithinBatchResponse)(nil), "tendermint.liquidity.v1beta1.MsgSwapWithinBatchResponse")
+	proto.RegisterType((*MsgSwapRoute)(nil), "tendermint.liquidity.v1beta1.MsgSwapRoute")
+	proto.RegisterType((*MsgSwapRouteResponse)(nil), "tendermint.liquidity.v1beta1.MsgSwapRouteResponse")
+	proto.RegisterType((*MsgCancelDeposit)(nil), "tendermint.liquidity.v1beta1.MsgCancelDeposit")
+	proto.RegisterType((*MsgCancelDepositResponse)(nil), "tendermint.liquidity.v1beta1.MsgCancelDepositResponse")
+	proto.RegisterType((*MsgCancelWithdraw)(nil), "tendermint.liquidity.v1beta1.MsgCancelWithdraw")
+	proto.RegisterType((*MsgCancelWithdrawResponse)(nil), "tendermint.liquidity.v1beta1.MsgCancelWithdrawResponse")
+	proto.RegisterType((*MsgCancelSwap)(nil), "tendermint.liquidity.v1beta1.MsgCancelSwap")
+	proto.RegisterType((*MsgCancelSwapResponse)(nil), "tendermint.liquidity.v1beta1.MsgCancelSwapResponse")
+	proto.RegisterType((*MsgSetPoolStatus)(nil), "tendermint.liquidity.v1beta1.MsgSetPoolStatus")
+	proto.RegisterType((*MsgSetPoolStatusResponse)(nil), "tendermint.liquidity.v1beta1.MsgSetPoolStatusResponse")
+	proto.RegisterType((*MsgSetCircuitBreaker)(nil), "tendermint.liquidity.v1beta1.MsgSetCircuitBreaker")
+	proto.RegisterType((*MsgSetCircuitBreakerResponse)(nil), "tendermint.liquidity.v1beta1.MsgSetCircuitBreakerResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "tendermint.liquidity.v1beta1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "tendermint.liquidity.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgWindDownPool)(nil), "tendermint.liquidity.v1beta1.MsgWindDownPool")
+	proto.RegisterType((*MsgWindDownPoolResponse)(nil), "tendermint.liquidity.v1beta1.MsgWindDownPoolResponse")
 }
 
-func (m *MsgWithdrawWithinBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterFile("tendermint/liquidity/v1beta1/tx.proto", fileDescriptor_deae1e5d4eb3529c)
 }
 
-func (m *MsgSwapWithinBatch) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var fileDescriptor_deae1e5d4eb3529c = []byte{
+	// 2440 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x5d, 0x6c, 0xdb, 0xd6,
+	0xf5, 0x37, 0x25, 0xf9, 0xeb, 0xfa, 0x23, 0x36, 0xa3, 0x38, 0x32, 0x9b, 0x58, 0xc4, 0xfd, 0x37,
+	0xff, 0xa9, 0x49, 0x2c, 0x4b, 0x94, 0x65, 0x5b, 0xd9, 0x07, 0x40, 0x49, 0x76, 0x6b, 0xa1, 0x8e,
+	0x3d, 0xca, 0x99, 0xdb, 0xa6, 0x81, 0x40, 0x8b, 0x37, 0x32, 0x13, 0x89, 0x54, 0x48, 0xca, 0x8e,
+	0xbc, 0x0d, 0xe8, 0x50, 0xa0, 0x68, 0xd3, 0x97, 0x56, 0x41, 0x81, 0x01, 0x5d, 0x80, 0xc0, 0x7b,
+	0x18, 0x30, 0x6c, 0xc0, 0xb0, 0xa7, 0x3d, 0x0d, 0x18, 0x30, 0x0c, 0xdd, 0xd0, 0x87, 0xbe, 0x0c,
+	0x1b, 0xf6, 0xe0, 0x15, 0x09, 0x06, 0x0c, 0x7b, 0x18, 0x86, 0x00, 0x1b, 0xb6, 0xb7, 0xe1, 0x5e,
+	0x52, 0x24, 0xf5, 0x51, 0x4b, 0x4a, 0x3c, 0x24, 0xed, 0xe6, 0x17, 0xf3, 0xde, 0x7b, 0xee, 0x39,
+	0xe7, 0x9e, 0xf3, 0xfb, 0x5d, 0x9e, 0x7b, 0x29, 0x70, 0xce, 0x40, 0x8a, 0x84, 0xb4, 0x92, 0xac,
+	0x18, 0x73, 0x45, 0xf9, 0x56, 0x45, 0x96, 0x64, 0xa3, 0x3a, 0xb7, 0x1b, 0xdd, 0x46, 0x86, 0x18,
+	0x9d, 0x33, 0x6e, 0x87, 0xcb, 0x9a, 0x6a, 0xa8, 0xf4, 0x19, 0x47, 0x2c, 0x6c, 0x8b, 0x85, 0x2d,
+	0x31, 0xe6, 0x85, 0x23, 0x95, 0x94, 0x45, 0x4d, 0x2c, 0xe9, 0xa6, 0x22, 0xc6, 0x5f, 0x50, 0x0b,
+	0x2a, 0x79, 0x9c, 0xc3, 0x4f, 0x56, 0xef, 0xe9, 0xbc, 0xaa, 0x97, 0x54, 0x3d, 0x67, 0x0e, 0xe4,
+	0x55, 0x59, 0xb1, 0x06, 0xcc, 0x7f, 0xf9, 0xd9, 0x02, 0x52, 0x66, 0xd5, 0x32, 0x52, 0xc4, 0xb2,
+	0xbc, 0xcb, 0xcd, 0xa9, 0x65, 0x43, 0x56, 0x15, 0x7d, 0x4e, 0x54, 0x14, 0xd5, 0x10, 0xc9, 0xb3,
+	0x29, 0x08, 0x7f, 0x3b, 0x00, 0xc6, 0xd6, 0xf4, 0x42, 0x4a, 0x43, 0xa2, 0x81, 0x36, 0x54, 0xb5,
+	0x48, 0xff, 0x8a, 0x02, 0xfe, 0xb2, 0xaa, 0x16, 0x73, 0x79, 0xdc, 0xa7, 0x6a, 0x39, 0x51, 0x92,
+	0x34, 0xa4, 0xeb, 0x01, 0x8a, 0xa5, 0x42, 0xc3, 0xc9, 0xbb, 0x54, 0x8d, 0xbf, 0xc5, 0xcd, 0x8a,
+	0xf9, 0xbc, 0x5a, 0x51, 0x0c, 0xd6, 0x1a, 0x64, 0xd5, 0xeb, 0xac, 0xb1, 0x83, 0x58, 0x55, 0x93,
+	0x0b, 0xb2, 0x62, 0xb6, 0x64, 0x9d, 0x2d, 0x21, 0x5d, 0x17, 0x0b, 0x28, 0x33, 0x07, 0x4d, 0x7f,
+	0xa3, 0x28, 0x16, 0xaf, 0x2e, 0x24, 0xb4, 0x1d, 0xcd, 0x58, 0xac, 0xce, 0x57, 0xf3, 0x28, 0x5e,
+	0x8c, 0x57, 0x16, 0x63, 0xfa, 0x0d, 0xe5, 0x76, 0x25, 0x52, 0x8c, 0xc5, 0xf6, 0x76, 0xf7, 0x95,
+	0x6a, 0x45, 0x81, 0x07, 0x9e, 0x71, 0x5d, 0xba, 0x19, 0xe6, 0xf3, 0x79, 0xde, 0xd4, 0xff, 0xe8,
+	0x30, 0xf8, 0x5c, 0x55, 0x2c, 0x15, 0x2f, 0xc1, 0x76, 0xae, 0x41, 0x81, 0xc6, 0xdd, 0x29, 0xb3,
+	0xd7, 0x9a, 0x42, 0x67, 0xc0, 0x28, 0x11, 0x36, 0xaa, 0x65, 0x94, 0x93, 0xa5, 0x80, 0x87, 0xa5,
+	0x42, 0x63, 0xc9, 0x50, 0x8d, 0x1f, 0xcf, 0x78, 0x61, 0x14, 0x1e, 0x78, 0x06, 0x2a, 0xb2, 0x62,
+	0xc4, 0xb8, 0x47, 0x87, 0xc1, 0x93, 0x2e, 0xdd, 0x96, 0x38, 0x14, 0x00, 0x6e, 0x6e, 0x56, 0xcb,
+	0x68, 0x55, 0xa2, 0xff, 0x4a, 0x81, 0x31, 0x09, 0x95, 0x55, 0x5d, 0x36, 0x72, 0x38, 0xda, 0x7a,
+	0xc0, 0xc7, 0x7a, 0x43, 0x23, 0xdc, 0x74, 0xd8, 0x5c, 0x58, 0x78, 0x5b, 0xd4, 0x51, 0x3d, 0xbd,
+	0xe1, 0x94, 0x2a, 0x2b, 0xc9, 0x1f, 0x53, 0x35, 0x7e, 0x3b, 0xb3, 0x79, 0xf5, 0x9b, 0x50, 0x42,
+	0x8a, 0x5a, 0x82, 0x97, 0x58, 0xf3, 0xe1, 0x15, 0x78, 0x91, 0x85, 0x62, 0x09, 0x47, 0x0f, 0xf7,
+	0x45, 0x23, 0xe4, 0x0f, 0x7e, 0xfb, 0x22, 0xdb, 0x2c, 0xf9, 0x6a, 0xa3, 0x24, 0x57, 0x97, 0xbc,
+	0x76, 0xe0, 0x19, 0xc6, 0xe1, 0xc1, 0x66, 0xf4, 0x8f, 0x0e, 0x83, 0x7d, 0x8f, 0x0e, 0x83, 0x7e,
+	0x73, 0x05, 0x0d, 0x3e, 0xc2, 0x1f, 0xfe, 0x31, 0x18, 0x2a, 0xc8, 0xc6, 0x4e, 0x65, 0x3b, 0x9c,
+	0x57, 0x4b, 0x73, 0xa6, 0xab, 0xd6, 0xbf, 0x59, 0x5d, 0xba, 0x39, 0x87, 0xd7, 0xaa, 0x9b, 0x7a,
+	0x84, 0x51, 0x6b, 0x2e, 0x69, 0xd1, 0xd7, 0x80, 0x5f, 0x43, 0x3a, 0xd2, 0x76, 0x11, 0xd1, 0x95,
+	0xdb, 0x43, 0x72, 0x61, 0xc7, 0xd0, 0x03, 0xfd, 0xac, 0x37, 0x34, 0x96, 0xbc, 0x50, 0xe3, 0x87,
+	0x33, 0x83, 0x57, 0x97, 0x22, 0x17, 0xb9, 0xc8, 0x35, 0x27, 0x37, 0xed, 0x66, 0x40, 0x81, 0xb6,
+	0xba, 0xb1, 0xe2, 0x2d, 0xb3, 0x93, 0x7e, 0x83, 0x02, 0x63, 0xfa, 0x9e, 0x58, 0xce, 0x5d, 0x47,
+	0x28, 0xa7, 0x89, 0x06, 0x0a, 0x0c, 0x10, 0x74, 0xbd, 0x5e, 0xe3, 0x4f, 0x66, 0x06, 0x61, 0x24,
+	0x1c, 0x89, 0xc4, 0xe0, 0x81, 0x67, 0x10, 0x2f, 0x33, 0x8d, 0xf2, 0x78, 0x91, 0x7f, 0x38, 0x0c,
+	0xfe, 0x7f, 0x17, 0x8b, 0x49, 0xa3, 0xbc, 0x13, 0x8e, 0x06, 0x13, 0x50, 0x18, 0xc1, 0xed, 0x15,
+	0x84, 0x04, 0xd1, 0x40, 0xb4, 0x00, 0xc6, 0xb7, 0x45, 0x23, 0xbf, 0x93, 0x93, 0x15, 0x03, 0x69,
+	0xbb, 0x62, 0x31, 0x30, 0x48, 0x00, 0x72, 0xa1, 0xc6, 0x9f, 0xc8, 0xf8, 0x60, 0x34, 0xd2, 0x80,
+	0x90, 0x53, 0xa6, 0xc2, 0xc6, 0x19, 0x50, 0x18, 0x23, 0x1d, 0xab, 0x56, 0xfb, 0xd2, 0xd0, 0xdb,
+	0xf7, 0x83, 0x7d, 0x7f, 0xbe, 0x1f, 0xec, 0x83, 0xa7, 0xc1, 0xa9, 0x06, 0x5a, 0x09, 0x48, 0x2f,
+	0xab, 0x8a, 0x8e, 0xe0, 0x4f, 0xfb, 0xc9, 0x48, 0xda, 0x0c, 0xf6, 0x96, 0x6c, 0xec, 0xc8, 0x4a,
+	0x12, 0x2b, 0xa1, 0x7f, 0x4e, 0x81, 0x49, 0x2b, 0x07, 0x2d, 0xac, 0x7b, 0xef, 0x69, 0xb1, 0x2e,
+	0xd0, 0x80, 0x2b, 0x37, 0xe5, 0x26, 0xec, 0xbe, 0x3a, 0xe1, 0x5e, 0x04, 0x83, 0x84, 0x41, 0x16,
+	0xd7, 0x7c, 0xc9, 0x70, 0x13, 0xd7, 0x16, 0xe6, 0xff, 0x72, 0x18, 0xac, 0xcb, 0x3c, 0x3a, 0x0c,
+	0x8e, 0xbb, 0x68, 0x87, 0x19, 0x37, 0x80, 0x9f, 0xda, 0xb2, 0xcd, 0xfb, 0xc5, 0x66, 0xdb, 0x5d,
+	0x0a, 0xf8, 0x4b, 0xb2, 0x92, 0x33, 0x37, 0x37, 0xcc, 0x1e, 0xd3, 0x91, 0x80, 0x8f, 0x64, 0x7f,
+	0xbb, 0xc6, 0xd3, 0x99, 0x01, 0xe2, 0x7c, 0x9d, 0x14, 0xab, 0x8a, 0xd1, 0x03, 0x29, 0x56, 0x15,
+	0xc3, 0x61, 0x69, 0x3b, 0x43, 0x50, 0x98, 0x2c, 0xc9, 0x0a, 0x06, 0x2a, 0x76, 0x88, 0x27, 0x7d,
+	0x2e, 0x34, 0x07, 0xc1, 0xd9, 0xb6, 0x98, 0xb5, 0x51, 0xfd, 0xa9, 0x0f, 0xb0, 0x8e, 0x44, 0x56,
+	0x56, 0x0a, 0x45, 0xc4, 0xeb, 0x3a, 0xfa, 0x1f, 0xc0, 0xdb, 0x02, 0xfc, 0x7d, 0x0a, 0x8c, 0xba,
+	0xc1, 0x13, 0xf0, 0xb2, 0xd4, 0xd1, 0xf8, 0xce, 0xd6, 0xf8, 0x78, 0x26, 0xd4, 0x2d, 0xba, 0x0f,
+	0x3c, 0x43, 0x75, 0xc8, 0x5a, 0x88, 0x3d, 0xd9, 0x8a, 0x58, 0x28, 0x8c, 0xb8, 0x40, 0xf8, 0xcc,
+	0x63, 0xf0, 0x3c, 0x08, 0x75, 0x42, 0x98, 0x0d, 0xc7, 0x9f, 0x0d, 0x80, 0xa9, 0x35, 0xbd, 0x80,
+	0x87, 0x24, 0x4d, 0xdc, 0x73, 0x83, 0xf0, 0x17, 0x14, 0xa0, 0xf7, 0xac, 0x7e, 0xd4, 0x8c, 0xc2,
+	0xf7, 0x9f, 0x16, 0x0a, 0xa7, 0xcd, 0xb0, 0xb4, 0x3a, 0x06, 0x85, 0x49, 0xa7, 0xf3, 0xd8, 0x71,
+	0xf8, 0x4b, 0x0a, 0x0c, 0xdb, 0x69, 0xe8, 0x0c, 0xc2, 0x77, 0xa9, 0x1a, 0x5f, 0xce, 0xe4, 0x5d,
+	0x28, 0xc4, 0x93, 0xd3, 0xb1, 0x38, 0x1f, 0x49, 0xa5, 0xa2, 0x0b, 0xcb, 0xcb, 0xf1, 0xc4, 0xd2,
+	0x4a, 0x22, 0x92, 0x8c, 0xcc, 0xcf, 0xa7, 0x96, 0xb9, 0xc4, 0x02, 0x3f, 0x1f, 0x89, 0x27, 0xf9,
+	0x44, 0x2a, 0xb6, 0x14, 0x5d, 0x8e, 0x2d, 0x2d, 0xc5, 0x16, 0xe3, 0x89, 0x44, 0x3a, 0xb1, 0xb0,
+	0xc2, 0xad, 0x2c, 0x46, 0x52, 0xdc, 0x4a, 0x84, 0xe3, 0xb9, 0x18, 0x3f, 0xdf, 0x8a, 0xe1, 0x76,
+	0x00, 0x9e, 0x70, 0x97, 0x7f, 0x04, 0xbd, 0x43, 0x65, 0x0b, 0x2a, 0xf4, 0x3f, 0x28, 0x40, 0x63,
+	0x44, 0xd5, 0x23, 0xd5, 0x6d, 0x89, 0xf6, 0x23, 0xaa, 0xc6, 0xbf, 0x9e, 0xb9, 0xdc, 0xcd, 0x4b,
+	0xa3, 0xcb, 0x37, 0x46, 0xfb, 0xd7, 0xc5, 0xb4, 0x03, 0xfa, 0x46, 0x17, 0x7b, 0x7b, 0x67, 0x4c,
+	0x94, 0x64, 0xa5, 0x0e, 0x69, 0xf3, 0xbd, 0xf1, 0x22, 0x18, 0x35, 0x44, 0xad, 0x80, 0x8c, 0x1c,
+	0x71, 0x28, 0xd0, 0x4f, 0x50, 0xfc, 0x7c, 0x8d, 0x07, 0x99, 0xa1, 0xfa, 0x52, 0x1c, 0xf2, 0xbb,
+	0x45, 0xa1, 0x30, 0x62, 0x36, 0xd3, 0xb8, 0xe5, 0xa2, 0xd9, 0x77, 0x3c, 0x60, 0xa6, 0x3d, 0x75,
+	0xea, 0xec, 0xa2, 0xa3, 0x60, 0xb8, 0xa4, 0x17, 0x72, 0xb2, 0x22, 0xa1, 0xdb, 0x84, 0x38, 0xbe,
+	0xa4, 0xdf, 0xc9, 0x90, 0x3d, 0x04, 0x85, 0xa1, 0x92, 0x5e, 0x58, 0xc5, 0x8f, 0xf4, 0xa5, 0x26,
+	0x47, 0x3d, 0xc4, 0xd1, 0xd3, 0xdd, 0xf8, 0x46, 0x97, 0x81, 0x1f, 0xdd, 0x2e, 0xa3, 0xbc, 0x81,
+	0xa4, 0x9c, 0x25, 0xd6, 0x1d, 0x5c, 0xff, 0xcf, 0x0a, 0xbf, 0xb5, 0xe7, 0xb4, 0x53, 0x02, 0x05,
+	0xba, 0xde, 0xbd, 0x49, 0x7a, 0xf1, 0x44, 0xf8, 0xcf, 0x01, 0x40, 0xaf, 0xe9, 0x85, 0xec, 0x9e,
+	0x58, 0x76, 0x6f, 0x1d, 0x1f, 0x53, 0x60, 0x8a, 0x54, 0x94, 0x1a, 0xba, 0x55, 0x41, 0xba, 0xd1,
+	0xb2, 0x7d, 0x7c, 0xf0, 0xb4, 0xb6, 0x8f, 0xb3, 0xae, 0x72, 0xb7, 0xc5, 0x39, 0x28, 0xf8, 0xf1,
+	0x80, 0x50, 0xef, 0x3f, 0xf6, 0x5d, 0x24, 0x03, 0x46, 0x89, 0xe5, 0xfa, 0x41, 0xcb, 0xdb, 0xf1,
+	0xa0, 0xe5, 0x16, 0x87, 0x02, 0xc0, 0x4d, 0xeb, 0xa0, 0xf5, 0x2e, 0x05, 0x80, 0x7a, 0xfd, 0x3a,
+	0xd2, 0xcc, 0x1c, 0xfb, 0x3a, 0xe5, 0xf8, 0xeb, 0x4f, 0xf8, 0x5e, 0x9c, 0x34, 0x1d, 0x72, 0x4c,
+	0x42, 0x61, 0x98, 0x34, 0xc8, 0xc6, 0x72, 0x05, 0x17, 0x2c, 0x25, 0x51, 0x91, 0xc8, 0x50, 0x03,
+	0xc9, 0x5e, 0x70, 0x91, 0x2c, 0x09, 0xdd, 0x85, 0x44, 0x93, 0x3c, 0x14, 0x4e, 0x98, 0x7d, 0x58,
+	0xa3, 0x89, 0xe8, 0xbb, 0x14, 0x18, 0x77, 0x2c, 0xe2, 0x03, 0x4a, 0x60, 0xa0, 0xd3, 0x42, 0x85,
+	0x1a, 0xcf, 0x65, 0xce, 0x75, 0x58, 0x68, 0xfc, 0x33, 0x56, 0x79, 0xaa, 0x79, 0x95, 0xd8, 0x26,
+	0x14, 0x46, 0xed, 0x95, 0xae, 0x20, 0x44, 0x57, 0xc1, 0x88, 0xaa, 0x49, 0x48, 0xcb, 0x95, 0x35,
+	0x39, 0x8f, 0xc8, 0x69, 0x68, 0x38, 0xf9, 0x4a, 0x8d, 0x9f, 0xcc, 0xf4, 0xc3, 0x68, 0x38, 0xfa,
+	0x24, 0xc7, 0x31, 0xda, 0xb2, 0xef, 0xa8, 0x87, 0x02, 0x20, 0xad, 0x0d, 0xdc, 0x70, 0x6d, 0x3f,
+	0x67, 0x00, 0xd3, 0xca, 0x3c, 0xfb, 0xbd, 0xfe, 0xb7, 0x7e, 0x30, 0x6a, 0x0d, 0x0b, 0x6a, 0xc5,
+	0x40, 0x5f, 0x34, 0x4a, 0xbe, 0x04, 0x86, 0x2c, 0x76, 0xe9, 0x01, 0x0f, 0xeb, 0x0d, 0xf9, 0x92,
+	0xb3, 0x35, 0xfe, 0x74, 0x06, 0x5c, 0x85, 0x51, 0x9c, 0x68, 0x0e, 0x5e, 0x3b, 0xf0, 0x0c, 0x5d,
+	0xbd, 0x66, 0x92, 0xf3, 0xd1, 0x61, 0xf0, 0x44, 0x03, 0x23, 0x75, 0x28, 0x0c, 0x9a, 0x94, 0xd4,
+	0x9b, 0x79, 0xe4, 0x7d, 0x3c, 0x1e, 0xf1, 0xc7, 0xc4, 0xa3, 0x36, 0x80, 0xf7, 0x3d, 0x1e, 0xe0,
+	0x9b, 0x3d, 0x8a, 0x3f, 0x01, 0xe0, 0xbf, 0x4b, 0x81, 0x13, 0x25, 0x42, 0x53, 0x9b, 0xb2, 0x81,
+	0xfe, 0x4e, 0x6e, 0x5d, 0xa9, 0xf1, 0xf3, 0x99, 0x2f, 0x35, 0xbb, 0x95, 0x6a, 0x74, 0x2b, 0xf1,
+	0x99, 0x71, 0x9a, 0x72, 0x4a, 0x01, 0x97, 0x59, 0x28, 0x8c, 0x95, 0xf0, 0xe6, 0x50, 0xdf, 0x26,
+	0x5c, 0x84, 0x98, 0x02, 0x7e, 0x37, 0xe2, 0x6d, 0x2a, 0xfc, 0xdd, 0x03, 0x26, 0xf0, 0x0d, 0x83,
+	0xa8, 0xe4, 0x51, 0xd1, 0xaa, 0x8a, 0xe9, 0x5f, 0x1f, 0x71, 0xc2, 0xfa, 0x90, 0xaa, 0xf1, 0xdf,
+	0xe2, 0x96, 0xba, 0x60, 0x02, 0x62, 0xc9, 0xad, 0x06, 0x5b, 0xd2, 0x0b, 0xac, 0xa1, 0xb2, 0x79,
+	0x62, 0xe2, 0xf3, 0x7b, 0xd8, 0x4a, 0xba, 0xcb, 0x15, 0x2f, 0x51, 0x75, 0xae, 0x45, 0xd5, 0xd1,
+	0xf5, 0x8b, 0x2b, 0x1f, 0x0c, 0x08, 0x34, 0x87, 0xdd, 0xce, 0xc9, 0xbf, 0x3c, 0x60, 0xd2, 0x1e,
+	0xac, 0x57, 0x50, 0xf4, 0xc7, 0x47, 0x9d, 0x38, 0xbe, 0xf7, 0x0c, 0x64, 0xe5, 0x29, 0x1d, 0x3e,
+	0x8e, 0x37, 0x2f, 0xcf, 0x81, 0xe9, 0x96, 0xd0, 0xdb, 0x89, 0x79, 0xc3, 0x0b, 0xc6, 0xec, 0x51,
+	0xcc, 0x25, 0xfa, 0x77, 0x9d, 0x5e, 0x1c, 0xf7, 0x9f, 0x81, 0xc4, 0x3c, 0xdd, 0xb2, 0xee, 0x78,
+	0xf3, 0x63, 0x5d, 0x88, 0xda, 0x19, 0xb0, 0x73, 0xf3, 0x96, 0x97, 0x6c, 0x64, 0x59, 0x64, 0xe0,
+	0xa3, 0x7f, 0xd6, 0x10, 0x8d, 0x8a, 0x4e, 0xff, 0x84, 0x02, 0xc3, 0x62, 0xc5, 0xd8, 0x51, 0x35,
+	0xd9, 0xa8, 0xba, 0x0e, 0xe7, 0xfb, 0xdc, 0x42, 0x53, 0x26, 0x0a, 0xea, 0x2e, 0xd2, 0x14, 0xac,
+	0x96, 0x2d, 0xa9, 0x52, 0xa5, 0x88, 0xd8, 0x7a, 0xca, 0x54, 0x8d, 0x15, 0xd9, 0x42, 0x45, 0xd4,
+	0x24, 0x59, 0x54, 0x9c, 0x7c, 0x44, 0xa4, 0xc8, 0x62, 0x95, 0x5b, 0x88, 0x17, 0x4a, 0xa5, 0xca,
+	0xae, 0x31, 0xbf, 0x1f, 0xd9, 0x4b, 0x88, 0x7b, 0x4b, 0x4b, 0x91, 0x1b, 0x8a, 0xae, 0x2d, 0x46,
+	0x22, 0x37, 0x16, 0xf6, 0x95, 0xc4, 0xcd, 0xf6, 0xf9, 0xb0, 0x56, 0x6a, 0x3b, 0x06, 0x05, 0xc7,
+	0xc9, 0xe3, 0x8b, 0x7b, 0x11, 0x0c, 0xe8, 0x24, 0x0a, 0x24, 0xe8, 0xe3, 0x5c, 0x28, 0x7c, 0xd4,
+	0xb7, 0xa4, 0xb0, 0x13, 0xb5, 0xe4, 0xf9, 0x1a, 0x3f, 0x95, 0xf1, 0xc3, 0x8d, 0xf5, 0xf5, 0x97,
+	0x73, 0xd9, 0x4d, 0x7e, 0xf3, 0x4a, 0x36, 0xb7, 0x22, 0xac, 0xbf, 0xb6, 0x7c, 0x19, 0xd7, 0xa7,
+	0x63, 0x16, 0x98, 0x88, 0x28, 0x14, 0x2c, 0x1b, 0x2d, 0x3b, 0x5b, 0x43, 0x1e, 0xec, 0x24, 0xfd,
+	0xc6, 0x63, 0xbe, 0x86, 0x90, 0x91, 0x92, 0xb5, 0x7c, 0x45, 0x36, 0x92, 0x1a, 0x12, 0x6f, 0x22,
+	0xed, 0xbf, 0x3a, 0x51, 0x17, 0xc1, 0x20, 0x52, 0xc4, 0xed, 0x22, 0x32, 0x8f, 0x3c, 0x43, 0x49,
+	0xda, 0x11, 0xb6, 0x06, 0xa0, 0x50, 0x17, 0x71, 0x05, 0x7a, 0x06, 0x9c, 0x69, 0x17, 0x4b, 0x3b,
+	0xd8, 0x1f, 0x78, 0xc0, 0x89, 0x35, 0xbd, 0x70, 0xa5, 0x2c, 0xe1, 0x8f, 0x07, 0xe4, 0x63, 0x20,
+	0x7d, 0xd0, 0x26, 0xce, 0x6f, 0x52, 0x35, 0xbe, 0xc8, 0x85, 0xba, 0x8d, 0xf3, 0x7f, 0x3e, 0xb2,
+	0x59, 0x30, 0x60, 0x7e, 0xbb, 0x24, 0x81, 0x1d, 0xe1, 0x9e, 0xef, 0x80, 0x5c, 0x22, 0x9b, 0x3c,
+	0x65, 0x95, 0x46, 0x16, 0x40, 0x4d, 0x0d, 0x38, 0xca, 0xe4, 0xc1, 0x15, 0xb7, 0x69, 0x70, 0xba,
+	0x29, 0x2c, 0x76, 0xc8, 0xde, 0x31, 0x43, 0xb6, 0x25, 0x2b, 0x52, 0x5a, 0xdd, 0x23, 0x97, 0x88,
+	0x9f, 0x8f, 0x90, 0x1d, 0x17, 0x18, 0x5b, 0xc2, 0xe4, 0x0e, 0x45, 0x3d, 0x4c, 0xe7, 0x3f, 0xf4,
+	0x00, 0xe0, 0xda, 0x65, 0x2f, 0x02, 0xda, 0xbd, 0x57, 0xf0, 0xa9, 0xcd, 0xd5, 0x6f, 0x2c, 0x4f,
+	0xf4, 0x31, 0xfe, 0x3b, 0xf7, 0xd8, 0x09, 0x47, 0x8e, 0xcf, 0x1b, 0xf2, 0x2e, 0xa2, 0xbf, 0x0a,
+	0x9e, 0x73, 0x4b, 0xa7, 0x97, 0x37, 0xd6, 0xb3, 0xab, 0x9b, 0xd9, 0xdc, 0x06, 0x7f, 0x25, 0xbb,
+	0x9c, 0x9e, 0xa0, 0x98, 0x33, 0x77, 0xee, 0xb1, 0x01, 0x67, 0x9a, 0x55, 0x1d, 0xe9, 0x1b, 0x62,
+	0x45, 0x47, 0x12, 0x9d, 0x00, 0xd3, 0xee, 0xe9, 0x5b, 0xab, 0x9b, 0x2f, 0xa5, 0x05, 0x7e, 0x2b,
+	0xb7, 0x7e, 0xf9, 0xe5, 0x57, 0x27, 0x3c, 0x0c, 0x73, 0xe7, 0x1e, 0x3b, 0xe5, 0x4c, 0xae, 0xbf,
+	0xc2, 0xd7, 0x95, 0x62, 0xb5, 0xd9, 0x4f, 0x73, 0x4f, 0x9b, 0xf0, 0x36, 0xfb, 0xb9, 0xa2, 0xa9,
+	0xfb, 0x48, 0xa1, 0xa3, 0xe0, 0x54, 0xa3, 0xa1, 0xcb, 0xe9, 0x5c, 0x7a, 0x7d, 0xeb, 0xf2, 0x84,
+	0x8f, 0x99, 0xba, 0x73, 0x8f, 0xa5, 0xdd, 0x46, 0xcc, 0x18, 0x31, 0xbe, 0xb7, 0xbf, 0x3f, 0xd3,
+	0xc7, 0xfd, 0x69, 0x14, 0x78, 0xd7, 0xf4, 0x02, 0xad, 0x00, 0xe0, 0xfa, 0x1e, 0x7e, 0xe1, 0x68,
+	0x10, 0x37, 0x7c, 0xe5, 0x63, 0x62, 0x3d, 0x08, 0xdb, 0xf7, 0x69, 0x6f, 0x51, 0x80, 0x6e, 0xf3,
+	0x3d, 0xb0, 0xb3, 0xae, 0xd6, 0x49, 0xcc, 0x97, 0x1f, 0x63, 0x92, 0xed, 0xc8, 0x0f, 0x28, 0x70,
+	0xf6, 0xe8, 0x4f, 0x38, 0x5f, 0xeb, 0x56, 0x7d, 0xfb, 0xf9, 0xcc, 0xca, 0x93, 0xcd, 0xb7, 0x3d,
+	0x7d, 0x87, 0x02, 0x27, 0xdb, 0xdd, 0xee, 0xcf, 0x77, 0xd4, 0xdf, 0x66, 0x16, 0xf3, 0x95, 0xc7,
+	0x99, 0x65, 0xfb, 0xa2, 0x01, 0x1f, 0x29, 0x29, 0x23, 0x1d, 0xb5, 0x34, 0x5d, 0x6b, 0x30, 0x4b,
+	0xbd, 0xce, 0xb0, 0x6d, 0xde, 0x04, 0xc3, 0xce, 0x25, 0xc8, 0xf9, 0xae, 0xd4, 0x10, 0x59, 0x86,
+	0xeb, 0x5e, 0xd6, 0x36, 0xb6, 0x07, 0xc6, 0x1a, 0x8f, 0x99, 0xe1, 0xce, 0x28, 0x77, 0xcb, 0x33,
+	0x0b, 0xbd, 0xc9, 0xdb, 0x86, 0xf7, 0xc1, 0x78, 0xd3, 0x59, 0x6a, 0xae, 0x4b, 0x4d, 0xf5, 0x09,
+	0xcc, 0x62, 0x8f, 0x13, 0x6c, 0xdb, 0x78, 0x13, 0x70, 0x8e, 0x0b, 0x17, 0xba, 0x54, 0x83, 0x85,
+	0x99, 0x58, 0x0f, 0xc2, 0xee, 0x20, 0x37, 0x96, 0xc0, 0x9d, 0x83, 0xdc, 0x20, 0xcf, 0x2c, 0xf4,
+	0x26, 0x6f, 0x1b, 0x7e, 0x93, 0x02, 0x93, 0xad, 0x75, 0x1d, 0xd7, 0x8d, 0xb6, 0xc6, 0x39, 0xcc,
+	0xa5, 0xde, 0xe7, 0xd8, 0x5e, 0x18, 0x60, 0xb4, 0xa1, 0xde, 0x99, 0xed, 0xa8, 0xcb, 0x2d, 0xce,
+	0xc4, 0x7b, 0x12, 0x77, 0x5b, 0x6d, 0x28, 0x19, 0x66, 0xbb, 0xd8, 0x08, 0x1c, 0x71, 0x26, 0xde,
+	0x93, 0x78, 0xdd, 0x6a, 0x72, 0xed, 0xa3, 0x07, 0x33, 0xd4, 0x27, 0x0f, 0x66, 0xa8, 0x4f, 0x1f,
+	0xcc, 0x50, 0xef, 0x3d, 0x9c, 0xe9, 0xfb, 0xe4, 0xe1, 0x4c, 0xdf, 0xef, 0x1f, 0xce, 0xf4, 0xbd,
+	0x16, 0x73, 0xdd, 0xa8, 0x16, 0x34, 0x71, 0x57, 0x36, 0xaa, 0xb3, 0x12, 0xda, 0xd5, 0x5d, 0x3f,
+	0x12, 0xbb, 0xed, 0x7a, 0x26, 0x57, 0xac, 0xdb, 0x03, 0xe4, 0x97, 0x5c, 0xb1, 0x7f, 0x0f, 0x00,
+	0x57, 0xe5, 0x79, 0x4e, 0x9a, 0x26, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// Submit a create liquidity pool message.
+	CreatePool(ctx context.Context, in *MsgCreatePool, opts ...grpc.CallOption) (*MsgCreatePoolResponse, error)
+	// Submit a deposit to the liquidity pool batch.
+	DepositWithinBatch(ctx context.Context, in *MsgDepositWithinBatch, opts ...grpc.CallOption) (*MsgDepositWithinBatchResponse, error)
+	// Submit a single asset deposit to the liquidity pool batch.
+	DepositSingleAssetWithinBatch(ctx context.Context, in *MsgDepositSingleAssetWithinBatch, opts ...grpc.CallOption) (*MsgDepositSingleAssetWithinBatchResponse, error)
+	// Submit a withdraw from the liquidity pool batch.
+	WithdrawWithinBatch(ctx context.Context, in *MsgWithdrawWithinBatch, opts ...grpc.CallOption) (*MsgWithdrawWithinBatchResponse, error)
+	// Submit a swap to the liquidity pool batch.
+	Swap(ctx context.Context, in *MsgSwapWithinBatch, opts ...grpc.CallOption) (*MsgSwapWithinBatchResponse, error)
+	// Submit a multi-hop swap route across several pools to the batch of the first pool of the route.
+	SwapRoute(ctx context.Context, in *MsgSwapRoute, opts ...grpc.CallOption) (*MsgSwapRouteResponse, error)
+	// Cancel a deposit that is not executed yet from the liquidity pool batch.
+	CancelDeposit(ctx context.Context, in *MsgCancelDeposit, opts ...grpc.CallOption) (*MsgCancelDepositResponse, error)
+	// Cancel a withdraw that is not executed yet from the liquidity pool batch.
+	CancelWithdraw(ctx context.Context, in *MsgCancelWithdraw, opts ...grpc.CallOption) (*MsgCancelWithdrawResponse, error)
+	// Cancel a swap that is not executed yet from the liquidity pool batch.
+	CancelSwap(ctx context.Context, in *MsgCancelSwap, opts ...grpc.CallOption) (*MsgCancelSwapResponse, error)
+	// Set the status of the liquidity pool by the governance or a guardian.
+	SetPoolStatus(ctx context.Context, in *MsgSetPoolStatus, opts ...grpc.CallOption) (*MsgSetPoolStatusResponse, error)
+	// Enable or disable the circuit breaker of all pools or of the liquidity pool by the governance or a guardian.
+	SetCircuitBreaker(ctx context.Context, in *MsgSetCircuitBreaker, opts ...grpc.CallOption) (*MsgSetCircuitBreakerResponse, error)
+	// Update the params of the liquidity module by the governance.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// Delist the liquidity pool by the governance, which winds down the pool until it is removed.
+	WindDownPool(ctx context.Context, in *MsgWindDownPool, opts ...grpc.CallOption) (*MsgWindDownPoolResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreatePool(ctx context.Context, in *MsgCreatePool, opts ...grpc.CallOption) (*MsgCreatePoolResponse, error) {
+	out := new(MsgCreatePoolResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Msg/CreatePool", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSwapWithinBatch) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DepositWithinBatch(ctx context.Context, in *MsgDepositWithinBatch, opts ...grpc.CallOption) (*MsgDepositWithinBatchResponse, error) {
+	out := new(MsgDepositWithinBatchResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Msg/DepositWithinBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSwapWithinBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.OrderPrice.Size()
-		i -= size
-		if _, err := m.OrderPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size, err := m.OfferCoinFee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	if len(m.DemandCoinDenom) > 0 {
-		i -= len(m.DemandCoinDenom)
-		copy(dAtA[i:], m.DemandCoinDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DemandCoinDenom)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	{
-		size, err := m.OfferCoin.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if m.SwapTypeId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.SwapTypeId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.PoolId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.SwapRequesterAddress) > 0 {
-		i -= len(m.SwapRequesterAddress)
-		copy(dAtA[i:], m.SwapRequesterAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SwapRequesterAddress)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) DepositSingleAssetWithinBatch(ctx context.Context, in *MsgDepositSingleAssetWithinBatch, opts ...grpc.CallOption) (*MsgDepositSingleAssetWithinBatchResponse, error) {
+	out := new(MsgDepositSingleAssetWithinBatchResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Msg/DepositSingleAssetWithinBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgSwapWithinBatchResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) WithdrawWithinBatch(ctx context.Context, in *MsgWithdrawWithinBatch, opts ...grpc.CallOption) (*MsgWithdrawWithinBatchResponse, error) {
+	out := new(MsgWithdrawWithinBatchResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Msg/WithdrawWithinBatch", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSwapWithinBatchResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Swap(ctx context.Context, in *MsgSwapWithinBatch, opts ...grpc.CallOption) (*MsgSwapWithinBatchResponse, error) {
+	out := new(MsgSwapWithinBatchResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Msg/Swap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgSwapWithinBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) SwapRoute(ctx context.Context, in *MsgSwapRoute, opts ...grpc.CallOption) (*MsgSwapRouteResponse, error) {
+	out := new(MsgSwapRouteResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Msg/SwapRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *msgClient) CancelDeposit(ctx context.Context, in *MsgCancelDeposit, opts ...grpc.CallOption) (*MsgCancelDepositResponse, error) {
+	out := new(MsgCancelDepositResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Msg/CancelDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *MsgCreatePool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PoolCreatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.PoolTypeId != 0 {
-		n += 1 + sovTx(uint64(m.PoolTypeId))
-	}
-	if len(m.DepositCoins) > 0 {
-		for _, e := range m.DepositCoins {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+
+func (c *msgClient) CancelWithdraw(ctx context.Context, in *MsgCancelWithdraw, opts ...grpc.CallOption) (*MsgCancelWithdrawResponse, error) {
+	out := new(MsgCancelWithdrawResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Msg/CancelWithdraw", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgCreatePoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) CancelSwap(ctx context.Context, in *MsgCancelSwap, opts ...grpc.CallOption) (*MsgCancelSwapResponse, error) {
+	out := new(MsgCancelSwapResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Msg/CancelSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgDepositWithinBatch) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DepositorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
-	}
-	if len(m.DepositCoins) > 0 {
-		for _, e := range m.DepositCoins {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+func (c *msgClient) SetPoolStatus(ctx context.Context, in *MsgSetPoolStatus, opts ...grpc.CallOption) (*MsgSetPoolStatusResponse, error) {
+	out := new(MsgSetPoolStatusResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Msg/SetPoolStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgDepositWithinBatchResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) SetCircuitBreaker(ctx context.Context, in *MsgSetCircuitBreaker, opts ...grpc.CallOption) (*MsgSetCircuitBreakerResponse, error) {
+	out := new(MsgSetCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Msg/SetCircuitBreaker", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgWithdrawWithinBatch) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.WithdrawerAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.PoolCoin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgWithdrawWithinBatchResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) WindDownPool(ctx context.Context, in *MsgWindDownPool, opts ...grpc.CallOption) (*MsgWindDownPoolResponse, error) {
+	out := new(MsgWindDownPoolResponse)
+	err := c.cc.Invoke(ctx, "/tendermint.liquidity.v1beta1.Msg/WindDownPool", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgSwapWithinBatch) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SwapRequesterAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
-	}
-	if m.SwapTypeId != 0 {
-		n += 1 + sovTx(uint64(m.SwapTypeId))
-	}
-	l = m.OfferCoin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.DemandCoinDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.OfferCoinFee.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.OrderPrice.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// Submit a create liquidity pool message.
+	CreatePool(context.Context, *MsgCreatePool) (*MsgCreatePoolResponse, error)
+	// Submit a deposit to the liquidity pool batch.
+	DepositWithinBatch(context.Context, *MsgDepositWithinBatch) (*MsgDepositWithinBatchResponse, error)
+	// Submit a single asset deposit to the liquidity pool batch.
+	DepositSingleAssetWithinBatch(context.Context, *MsgDepositSingleAssetWithinBatch) (*MsgDepositSingleAssetWithinBatchResponse, error)
+	// Submit a withdraw from the liquidity pool batch.
+	WithdrawWithinBatch(context.Context, *MsgWithdrawWithinBatch) (*MsgWithdrawWithinBatchResponse, error)
+	// Submit a swap to the liquidity pool batch.
+	Swap(context.Context, *MsgSwapWithinBatch) (*MsgSwapWithinBatchResponse, error)
+	// Submit a multi-hop swap route across several pools to the batch of the first pool of the route.
+	SwapRoute(context.Context, *MsgSwapRoute) (*MsgSwapRouteResponse, error)
+	// Cancel a deposit that is not executed yet from the liquidity pool batch.
+	CancelDeposit(context.Context, *MsgCancelDeposit) (*MsgCancelDepositResponse, error)
+	// Cancel a withdraw that is not executed yet from the liquidity pool batch.
+	CancelWithdraw(context.Context, *MsgCancelWithdraw) (*MsgCancelWithdrawResponse, error)
+	// Cancel a swap that is not executed yet from the liquidity pool batch.
+	CancelSwap(context.Context, *MsgCancelSwap) (*MsgCancelSwapResponse, error)
+	// Set the status of the liquidity pool by the governance or a guardian.
+	SetPoolStatus(context.Context, *MsgSetPoolStatus) (*MsgSetPoolStatusResponse, error)
+	// Enable or disable the circuit breaker of all pools or of the liquidity pool by the governance or a guardian.
+	SetCircuitBreaker(context.Context, *MsgSetCircuitBreaker) (*MsgSetCircuitBreakerResponse, error)
+	// Update the params of the liquidity module by the governance.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// Delist the liquidity pool by the governance, which winds down the pool until it is removed.
+	WindDownPool(context.Context, *MsgWindDownPool) (*MsgWindDownPoolResponse, error)
 }
 
-func (m *MsgSwapWithinBatchResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (*UnimplementedMsgServer) CreatePool(ctx context.Context, req *MsgCreatePool) (*MsgCreatePoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePool not implemented")
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (*UnimplementedMsgServer) DepositWithinBatch(ctx context.Context, req *MsgDepositWithinBatch) (*MsgDepositWithinBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositWithinBatch not implemented")
+}
+func (*UnimplementedMsgServer) DepositSingleAssetWithinBatch(ctx context.Context, req *MsgDepositSingleAssetWithinBatch) (*MsgDepositSingleAssetWithinBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositSingleAssetWithinBatch not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawWithinBatch(ctx context.Context, req *MsgWithdrawWithinBatch) (*MsgWithdrawWithinBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawWithinBatch not implemented")
+}
+func (*UnimplementedMsgServer) Swap(ctx context.Context, req *MsgSwapWithinBatch) (*MsgSwapWithinBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Swap not implemented")
+}
+func (*UnimplementedMsgServer) SwapRoute(ctx context.Context, req *MsgSwapRoute) (*MsgSwapRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapRoute not implemented")
+}
+func (*UnimplementedMsgServer) CancelDeposit(ctx context.Context, req *MsgCancelDeposit) (*MsgCancelDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelDeposit not implemented")
+}
+func (*UnimplementedMsgServer) CancelWithdraw(ctx context.Context, req *MsgCancelWithdraw) (*MsgCancelWithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelWithdraw not implemented")
+}
+func (*UnimplementedMsgServer) CancelSwap(ctx context.Context, req *MsgCancelSwap) (*MsgCancelSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSwap not implemented")
+}
+func (*UnimplementedMsgServer) SetPoolStatus(ctx context.Context, req *MsgSetPoolStatus) (*MsgSetPoolStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPoolStatus not implemented")
+}
+func (*UnimplementedMsgServer) SetCircuitBreaker(ctx context.Context, req *MsgSetCircuitBreaker) (*MsgSetCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCircuitBreaker not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) WindDownPool(ctx context.Context, req *MsgWindDownPool) (*MsgWindDownPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WindDownPool not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreatePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreatePool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreatePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Msg/CreatePool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreatePool(ctx, req.(*MsgCreatePool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DepositWithinBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositWithinBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DepositWithinBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Msg/DepositWithinBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DepositWithinBatch(ctx, req.(*MsgDepositWithinBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DepositSingleAssetWithinBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositSingleAssetWithinBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DepositSingleAssetWithinBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Msg/DepositSingleAssetWithinBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DepositSingleAssetWithinBatch(ctx, req.(*MsgDepositSingleAssetWithinBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawWithinBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawWithinBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawWithinBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Msg/WithdrawWithinBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawWithinBatch(ctx, req.(*MsgWithdrawWithinBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Swap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSwapWithinBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Swap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Msg/Swap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Swap(ctx, req.(*MsgSwapWithinBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SwapRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSwapRoute)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SwapRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Msg/SwapRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SwapRoute(ctx, req.(*MsgSwapRoute))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Msg/CancelDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelDeposit(ctx, req.(*MsgCancelDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelWithdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelWithdraw)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelWithdraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Msg/CancelWithdraw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelWithdraw(ctx, req.(*MsgCancelWithdraw))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelSwap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Msg/CancelSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelSwap(ctx, req.(*MsgCancelSwap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPoolStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPoolStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPoolStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Msg/SetPoolStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPoolStatus(ctx, req.(*MsgSetPoolStatus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetCircuitBreaker)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetCircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Msg/SetCircuitBreaker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetCircuitBreaker(ctx, req.(*MsgSetCircuitBreaker))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WindDownPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWindDownPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WindDownPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tendermint.liquidity.v1beta1.Msg/WindDownPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WindDownPool(ctx, req.(*MsgWindDownPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "tendermint.liquidity.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePool",
+			Handler:    _Msg_CreatePool_Handler,
+		},
+		{
+			MethodName: "DepositWithinBatch",
+			Handler:    _Msg_DepositWithinBatch_Handler,
+		},
+		{
+			MethodName: "DepositSingleAssetWithinBatch",
+			Handler:    _Msg_DepositSingleAssetWithinBatch_Handler,
+		},
+		{
+			MethodName: "WithdrawWithinBatch",
+			Handler:    _Msg_WithdrawWithinBatch_Handler,
+		},
+		{
+			MethodName: "Swap",
+			Handler:    _Msg_Swap_Handler,
+		},
+		{
+			MethodName: "SwapRoute",
+			Handler:    _Msg_SwapRoute_Handler,
+		},
+		{
+			MethodName: "CancelDeposit",
+			Handler:    _Msg_CancelDeposit_Handler,
+		},
+		{
+			MethodName: "CancelWithdraw",
+			Handler:    _Msg_CancelWithdraw_Handler,
+		},
+		{
+			MethodName: "CancelSwap",
+			Handler:    _Msg_CancelSwap_Handler,
+		},
+		{
+			MethodName: "SetPoolStatus",
+			Handler:    _Msg_SetPoolStatus_Handler,
+		},
+		{
+			MethodName: "SetCircuitBreaker",
+			Handler:    _Msg_SetCircuitBreaker_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "WindDownPool",
+			Handler:    _Msg_WindDownPool_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tendermint/liquidity/v1beta1/tx.proto",
+}
+
+func (m *MsgCreatePool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BatchInterval != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BatchInterval))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.SwapFeeRate.Size()
+		i -= size
+		if _, err := m.SwapFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.ReserveCoinWeights) > 0 {
+		dAtA2 := make([]byte, len(m.ReserveCoinWeights)*10)
+		var j1 int
+		for _, num := range m.ReserveCoinWeights {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintTx(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DepositCoins) > 0 {
+		for iNdEx := len(m.DepositCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DepositCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.PoolTypeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolTypeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PoolCreatorAddress) > 0 {
+		i -= len(m.PoolCreatorAddress)
+		copy(dAtA[i:], m.PoolCreatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PoolCreatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreatePoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositWithinBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositWithinBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositWithinBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinPoolCoinAmount.Size()
+		i -= size
+		if _, err := m.MinPoolCoinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.DepositCoins) > 0 {
+		for iNdEx := len(m.DepositCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DepositCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DepositorAddress) > 0 {
+		i -= len(m.DepositorAddress)
+		copy(dAtA[i:], m.DepositorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DepositorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositWithinBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositWithinBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositWithinBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositSingleAssetWithinBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositSingleAssetWithinBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositSingleAssetWithinBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinPoolCoinAmount.Size()
+		i -= size
+		if _, err := m.MinPoolCoinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.DepositCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DepositorAddress) > 0 {
+		i -= len(m.DepositorAddress)
+		copy(dAtA[i:], m.DepositorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DepositorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositSingleAssetWithinBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositSingleAssetWithinBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositSingleAssetWithinBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawWithinBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawWithinBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawWithinBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TargetDenom) > 0 {
+		i -= len(m.TargetDenom)
+		copy(dAtA[i:], m.TargetDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TargetDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MinWithdrawCoins) > 0 {
+		for iNdEx := len(m.MinWithdrawCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinWithdrawCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.PoolCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.WithdrawerAddress) > 0 {
+		i -= len(m.WithdrawerAddress)
+		copy(dAtA[i:], m.WithdrawerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WithdrawerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawWithinBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawWithinBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawWithinBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ExpectedTargetCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TargetDenom) > 0 {
+		i -= len(m.TargetDenom)
+		copy(dAtA[i:], m.TargetDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TargetDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MsgIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MsgIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapWithinBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapWithinBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapWithinBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.OrderPrice.Size()
+		i -= size
+		if _, err := m.OrderPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.OfferCoinFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.DemandCoinDenom) > 0 {
+		i -= len(m.DemandCoinDenom)
+		copy(dAtA[i:], m.DemandCoinDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DemandCoinDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.OfferCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.SwapTypeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SwapTypeId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SwapRequesterAddress) > 0 {
+		i -= len(m.SwapRequesterAddress)
+		copy(dAtA[i:], m.SwapRequesterAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SwapRequesterAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapWithinBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapWithinBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapWithinBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MinDemandCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.OfferCoinFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.OfferCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.PoolIds) > 0 {
+		dAtA12 := make([]byte, len(m.PoolIds)*10)
+		var j11 int
+		for _, num := range m.PoolIds {
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
+		}
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintTx(dAtA, i, uint64(j11))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SwapRequesterAddress) > 0 {
+		i -= len(m.SwapRequesterAddress)
+		copy(dAtA[i:], m.SwapRequesterAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SwapRequesterAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MsgIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MsgIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DepositorAddress) > 0 {
+		i -= len(m.DepositorAddress)
+		copy(dAtA[i:], m.DepositorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DepositorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelWithdraw) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelWithdraw) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MsgIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MsgIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.WithdrawerAddress) > 0 {
+		i -= len(m.WithdrawerAddress)
+		copy(dAtA[i:], m.WithdrawerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WithdrawerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelWithdrawResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelWithdrawResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MsgIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MsgIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.SwapRequesterAddress) > 0 {
+		i -= len(m.SwapRequesterAddress)
+		copy(dAtA[i:], m.SwapRequesterAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SwapRequesterAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelSwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelSwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPoolStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPoolStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPoolStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPoolStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPoolStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPoolStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetCircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetCircuitBreakerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCircuitBreakerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCircuitBreakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWindDownPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWindDownPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWindDownPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWindDownPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWindDownPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWindDownPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreatePool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolCreatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolTypeId != 0 {
+		n += 1 + sovTx(uint64(m.PoolTypeId))
+	}
+	if len(m.DepositCoins) > 0 {
+		for _, e := range m.DepositCoins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ReserveCoinWeights) > 0 {
+		l = 0
+		for _, e := range m.ReserveCoinWeights {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	l = m.SwapFeeRate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.BatchInterval != 0 {
+		n += 1 + sovTx(uint64(m.BatchInterval))
+	}
+	return n
+}
+
+func (m *MsgCreatePoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDepositWithinBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DepositorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	if len(m.DepositCoins) > 0 {
+		for _, e := range m.DepositCoins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.MinPoolCoinAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDepositWithinBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDepositSingleAssetWithinBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DepositorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = m.DepositCoin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinPoolCoinAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDepositSingleAssetWithinBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawWithinBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WithdrawerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = m.PoolCoin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.MinWithdrawCoins) > 0 {
+		for _, e := range m.MinWithdrawCoins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.TargetDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawWithinBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgIndex != 0 {
+		n += 1 + sovTx(uint64(m.MsgIndex))
+	}
+	l = len(m.TargetDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ExpectedTargetCoin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSwapWithinBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SwapRequesterAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	if m.SwapTypeId != 0 {
+		n += 1 + sovTx(uint64(m.SwapTypeId))
+	}
+	l = m.OfferCoin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.DemandCoinDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.OfferCoinFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.OrderPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSwapWithinBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSwapRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SwapRequesterAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.PoolIds) > 0 {
+		l = 0
+		for _, e := range m.PoolIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	l = m.OfferCoin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.OfferCoinFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinDemandCoin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSwapRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DepositorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	if m.MsgIndex != 0 {
+		n += 1 + sovTx(uint64(m.MsgIndex))
+	}
+	return n
+}
+
+func (m *MsgCancelDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelWithdraw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WithdrawerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	if m.MsgIndex != 0 {
+		n += 1 + sovTx(uint64(m.MsgIndex))
+	}
+	return n
+}
+
+func (m *MsgCancelWithdrawResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SwapRequesterAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	if m.MsgIndex != 0 {
+		n += 1 + sovTx(uint64(m.MsgIndex))
+	}
+	return n
+}
+
+func (m *MsgCancelSwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetPoolStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *MsgSetPoolStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetCircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetCircuitBreakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWindDownPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *MsgWindDownPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCreatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolCreatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolTypeId", wireType)
+			}
+			m.PoolTypeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolTypeId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositCoins = append(m.DepositCoins, types.Coin{})
+			if err := m.DepositCoins[len(m.DepositCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ReserveCoinWeights = append(m.ReserveCoinWeights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ReserveCoinWeights) == 0 {
+					m.ReserveCoinWeights = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ReserveCoinWeights = append(m.ReserveCoinWeights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveCoinWeights", wireType)
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchInterval", wireType)
+			}
+			m.BatchInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchInterval |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositWithinBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositWithinBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositWithinBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositCoins = append(m.DepositCoins, types.Coin{})
+			if err := m.DepositCoins[len(m.DepositCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPoolCoinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinPoolCoinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositWithinBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositWithinBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositWithinBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositSingleAssetWithinBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositSingleAssetWithinBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositSingleAssetWithinBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DepositCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPoolCoinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinPoolCoinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositSingleAssetWithinBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositSingleAssetWithinBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositSingleAssetWithinBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawWithinBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawWithinBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawWithinBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinWithdrawCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinWithdrawCoins = append(m.MinWithdrawCoins, types.Coin{})
+			if err := m.MinWithdrawCoins[len(m.MinWithdrawCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawWithinBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawWithinBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawWithinBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			}
+			m.MsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedTargetCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExpectedTargetCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapWithinBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapWithinBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapWithinBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapRequesterAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapRequesterAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapTypeId", wireType)
+			}
+			m.SwapTypeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SwapTypeId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OfferCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DemandCoinDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DemandCoinDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferCoinFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OfferCoinFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OrderPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapWithinBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapWithinBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapWithinBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapRequesterAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapRequesterAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PoolIds = append(m.PoolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PoolIds) == 0 {
+					m.PoolIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PoolIds = append(m.PoolIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIds", wireType)
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OfferCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferCoinFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OfferCoinFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDemandCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinDemandCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapRouteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapRouteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			}
+			m.MsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelWithdraw) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelWithdraw: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			}
+			m.MsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelWithdrawResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelWithdrawResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelSwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelSwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapRequesterAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwapRequesterAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			}
+			m.MsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelSwapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelSwapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
+func (m *MsgSetPoolStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1150,15 +5639,15 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetPoolStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetPoolStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolCreatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1186,13 +5675,13 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolCreatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolTypeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.PoolTypeId = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1202,16 +5691,16 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolTypeId |= uint32(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositCoins", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1221,26 +5710,11 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Status |= PoolStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DepositCoins = append(m.DepositCoins, types.Coin{})
-			if err := m.DepositCoins[len(m.DepositCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1262,7 +5736,7 @@ func (m *MsgCreatePool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetPoolStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1285,10 +5759,10 @@ func (m *MsgCreatePoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetPoolStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetPoolStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1312,7 +5786,7 @@ func (m *MsgCreatePoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositWithinBatch) Unmarshal(dAtA []byte) error {
+func (m *MsgSetCircuitBreaker) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1335,15 +5809,15 @@ func (m *MsgDepositWithinBatch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositWithinBatch: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetCircuitBreaker: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositWithinBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetCircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1371,7 +5845,7 @@ func (m *MsgDepositWithinBatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DepositorAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -1393,10 +5867,10 @@ func (m *MsgDepositWithinBatch) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositCoins", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1406,26 +5880,12 @@ func (m *MsgDepositWithinBatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DepositCoins = append(m.DepositCoins, types.Coin{})
-			if err := m.DepositCoins[len(m.DepositCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1447,7 +5907,7 @@ func (m *MsgDepositWithinBatch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositWithinBatchResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetCircuitBreakerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1470,10 +5930,10 @@ func (m *MsgDepositWithinBatchResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositWithinBatchResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetCircuitBreakerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositWithinBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetCircuitBreakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1497,7 +5957,7 @@ func (m *MsgDepositWithinBatchResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawWithinBatch) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1520,15 +5980,15 @@ func (m *MsgWithdrawWithinBatch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawWithinBatch: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawWithinBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1556,30 +6016,11 @@ func (m *MsgWithdrawWithinBatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithdrawerAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1606,7 +6047,7 @@ func (m *MsgWithdrawWithinBatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PoolCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1631,7 +6072,7 @@ func (m *MsgWithdrawWithinBatch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawWithinBatchResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1654,10 +6095,10 @@ func (m *MsgWithdrawWithinBatchResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawWithinBatchResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawWithinBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1681,7 +6122,7 @@ func (m *MsgWithdrawWithinBatchResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapWithinBatch) Unmarshal(dAtA []byte) error {
+func (m *MsgWindDownPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1704,15 +6145,15 @@ func (m *MsgSwapWithinBatch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapWithinBatch: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWindDownPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapWithinBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWindDownPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapRequesterAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1740,7 +6181,7 @@ func (m *MsgSwapWithinBatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SwapRequesterAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -1761,157 +6202,6 @@ func (m *MsgSwapWithinBatch) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapTypeId", wireType)
-			}
-			m.SwapTypeId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SwapTypeId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OfferCoin", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.OfferCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DemandCoinDenom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DemandCoinDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OfferCoinFee", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.OfferCoinFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderPrice", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.OrderPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1933,7 +6223,7 @@ func (m *MsgSwapWithinBatch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapWithinBatchResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWindDownPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1956,10 +6246,10 @@ func (m *MsgSwapWithinBatchResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapWithinBatchResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWindDownPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapWithinBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWindDownPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4,6 +4,7 @@ import (
 	"crypto/sha256"
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -95,6 +96,25 @@ func GetOfferCoinFee(offerCoin sdk.Coin, swapFeeRate sdk.Dec) sdk.Coin {
 	return sdk.NewCoin(offerCoin.Denom, sdk.NewDecFromInt(offerCoin.Amount).Mul(swapFeeRate.QuoInt64(2)).Ceil().TruncateInt()) // Ceil(offerCoin.Amount * (swapFeeRate/2))
 }
 
+// GetOfferCoinWithinAmount returns the largest offer coin whose offer coin fee can be paid together within the given coin.
+func GetOfferCoinWithinAmount(coin sdk.Coin, swapFeeRate sdk.Dec) sdk.Coin {
+	// OfferAmount = Floor(Amount / (1 + swapFeeRate/2)), then decreased until the ceiled fee fits in the amount
+	offerAmt := sdk.NewDecFromInt(coin.Amount).Quo(sdk.OneDec().Add(swapFeeRate.QuoInt64(2))).TruncateInt()
+	for offerAmt.IsPositive() && offerAmt.Add(GetOfferCoinFee(sdk.NewCoin(coin.Denom, offerAmt), swapFeeRate).Amount).GT(coin.Amount) {
+		offerAmt = offerAmt.SubRaw(1)
+	}
+	return sdk.NewCoin(coin.Denom, offerAmt)
+}
+
+// PoolIDsString returns the comma separated string of the pool ids.
+func PoolIDsString(poolIDs []uint64) string {
+	strs := make([]string, len(poolIDs))
+	for i, poolID := range poolIDs {
+		strs[i] = strconv.FormatUint(poolID, 10)
+	}
+	return strings.Join(strs, ",")
+}
+
 func MustParseCoinsNormalized(coinStr string) sdk.Coins {
 	coins, err := sdk.ParseCoinsNormalized(coinStr)
 	if err != nil {
@@ -130,7 +130,7 @@ func TestGetPoolInformation(t *testing.T) {
 	}
 
 	for _, tc := range testCases {
-		poolName := types.PoolName(tc.reserveCoinDenoms, tc.poolTypeID)
+		poolName := types.PoolName(tc.reserveCoinDenoms, tc.poolTypeID, nil)
 		require.Equal(t, tc.expectedPoolName, poolName)
 
 		reserveAcc := types.GetPoolReserveAcc(poolName, tc.len32)
@@ -281,6 +281,54 @@ func TestGetOfferCoinFee(t *testing.T) {
 	}
 }
 
+func TestGetOfferCoinWithinAmount(t *testing.T) {
+	testDenom := "test"
+	testCases := []struct {
+		name            string
+		coin            sdk.Coin
+		swapFeeRate     sdk.Dec
+		expectOfferCoin sdk.Coin
+	}{
+		{
+			name:            "case1",
+			coin:            sdk.NewCoin(testDenom, sdk.NewInt(1)),
+			swapFeeRate:     types.DefaultSwapFeeRate,
+			expectOfferCoin: sdk.NewCoin(testDenom, sdk.NewInt(0)),
+		},
+		{
+			name:            "case2",
+			coin:            sdk.NewCoin(testDenom, sdk.NewInt(2)),
+			swapFeeRate:     types.DefaultSwapFeeRate,
+			expectOfferCoin: sdk.NewCoin(testDenom, sdk.NewInt(1)),
+		},
+		{
+			name:            "case3",
+			coin:            sdk.NewCoin(testDenom, sdk.NewInt(1000)),
+			swapFeeRate:     types.DefaultSwapFeeRate,
+			expectOfferCoin: sdk.NewCoin(testDenom, sdk.NewInt(998)),
+		},
+		{
+			name:            "case4",
+			coin:            sdk.NewCoin(testDenom, sdk.NewInt(1000000)),
+			swapFeeRate:     types.DefaultSwapFeeRate,
+			expectOfferCoin: sdk.NewCoin(testDenom, sdk.NewInt(998502)),
+		},
+		{
+			name:            "zero swap fee rate",
+			coin:            sdk.NewCoin(testDenom, sdk.NewInt(1000)),
+			swapFeeRate:     sdk.ZeroDec(),
+			expectOfferCoin: sdk.NewCoin(testDenom, sdk.NewInt(1000)),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			offerCoin := types.GetOfferCoinWithinAmount(tc.coin, tc.swapFeeRate)
+			require.True(t, tc.expectOfferCoin.IsEqual(offerCoin))
+			require.True(t, offerCoin.Add(types.GetOfferCoinFee(offerCoin, tc.swapFeeRate)).IsLTE(tc.coin))
+		})
+	}
+}
+
 func TestCheckOverflow(t *testing.T) {
 	testCases := []struct {
 		name      string